@@ -153,6 +153,857 @@ func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RestoreArchivedWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreArchivedWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreArchivedWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreArchivedWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreArchivedWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreArchivedWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *RestoreArchivedWorkflowExecutionRequest:
+		that1 = t
+	case RestoreArchivedWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RestoreArchivedWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreArchivedWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreArchivedWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreArchivedWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreArchivedWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreArchivedWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *RestoreArchivedWorkflowExecutionResponse:
+		that1 = t
+	case RestoreArchivedWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsRequest:
+		that1 = t
+	case AggregateWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsResponse:
+		that1 = t
+	case AggregateWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CheckVisibilityConsistencyRequest to the protobuf v3 wire format
+func (val *CheckVisibilityConsistencyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CheckVisibilityConsistencyRequest from the protobuf v3 wire format
+func (val *CheckVisibilityConsistencyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CheckVisibilityConsistencyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CheckVisibilityConsistencyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CheckVisibilityConsistencyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CheckVisibilityConsistencyRequest
+	switch t := that.(type) {
+	case *CheckVisibilityConsistencyRequest:
+		that1 = t
+	case CheckVisibilityConsistencyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CheckVisibilityConsistencyResponse to the protobuf v3 wire format
+func (val *CheckVisibilityConsistencyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CheckVisibilityConsistencyResponse from the protobuf v3 wire format
+func (val *CheckVisibilityConsistencyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CheckVisibilityConsistencyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CheckVisibilityConsistencyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CheckVisibilityConsistencyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CheckVisibilityConsistencyResponse
+	switch t := that.(type) {
+	case *CheckVisibilityConsistencyResponse:
+		that1 = t
+	case CheckVisibilityConsistencyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainVisibilityQueryRequest to the protobuf v3 wire format
+func (val *ExplainVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainVisibilityQueryRequest from the protobuf v3 wire format
+func (val *ExplainVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainVisibilityQueryRequest
+	switch t := that.(type) {
+	case *ExplainVisibilityQueryRequest:
+		that1 = t
+	case ExplainVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainVisibilityQueryResponse to the protobuf v3 wire format
+func (val *ExplainVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainVisibilityQueryResponse from the protobuf v3 wire format
+func (val *ExplainVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainVisibilityQueryResponse
+	switch t := that.(type) {
+	case *ExplainVisibilityQueryResponse:
+		that1 = t
+	case ExplainVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StreamVisibilityChangesRequest to the protobuf v3 wire format
+func (val *StreamVisibilityChangesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StreamVisibilityChangesRequest from the protobuf v3 wire format
+func (val *StreamVisibilityChangesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StreamVisibilityChangesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StreamVisibilityChangesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StreamVisibilityChangesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StreamVisibilityChangesRequest
+	switch t := that.(type) {
+	case *StreamVisibilityChangesRequest:
+		that1 = t
+	case StreamVisibilityChangesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StreamVisibilityChangesResponse to the protobuf v3 wire format
+func (val *StreamVisibilityChangesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StreamVisibilityChangesResponse from the protobuf v3 wire format
+func (val *StreamVisibilityChangesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StreamVisibilityChangesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StreamVisibilityChangesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StreamVisibilityChangesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StreamVisibilityChangesResponse
+	switch t := that.(type) {
+	case *StreamVisibilityChangesResponse:
+		that1 = t
+	case StreamVisibilityChangesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityChange to the protobuf v3 wire format
+func (val *VisibilityChange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityChange from the protobuf v3 wire format
+func (val *VisibilityChange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityChange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityChange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityChange
+	switch t := that.(type) {
+	case *VisibilityChange:
+		that1 = t
+	case VisibilityChange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityChangeCursor to the protobuf v3 wire format
+func (val *VisibilityChangeCursor) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityChangeCursor from the protobuf v3 wire format
+func (val *VisibilityChangeCursor) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityChangeCursor) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityChangeCursor values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityChangeCursor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityChangeCursor
+	switch t := that.(type) {
+	case *VisibilityChangeCursor:
+		that1 = t
+	case VisibilityChangeCursor:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityChangeShardCursor to the protobuf v3 wire format
+func (val *VisibilityChangeShardCursor) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityChangeShardCursor from the protobuf v3 wire format
+func (val *VisibilityChangeShardCursor) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityChangeShardCursor) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityChangeShardCursor values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityChangeShardCursor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityChangeShardCursor
+	switch t := that.(type) {
+	case *VisibilityChangeShardCursor:
+		that1 = t
+	case VisibilityChangeShardCursor:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *UpsertSavedVisibilityQueryRequest:
+		that1 = t
+	case UpsertSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *UpsertSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *UpsertSavedVisibilityQueryResponse:
+		that1 = t
+	case UpsertSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *DeleteSavedVisibilityQueryRequest:
+		that1 = t
+	case DeleteSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *DeleteSavedVisibilityQueryResponse:
+		that1 = t
+	case DeleteSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListSavedVisibilityQueriesRequest to the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListSavedVisibilityQueriesRequest from the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListSavedVisibilityQueriesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListSavedVisibilityQueriesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListSavedVisibilityQueriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListSavedVisibilityQueriesRequest
+	switch t := that.(type) {
+	case *ListSavedVisibilityQueriesRequest:
+		that1 = t
+	case ListSavedVisibilityQueriesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListSavedVisibilityQueriesResponse to the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListSavedVisibilityQueriesResponse from the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListSavedVisibilityQueriesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListSavedVisibilityQueriesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListSavedVisibilityQueriesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListSavedVisibilityQueriesResponse
+	switch t := that.(type) {
+	case *ListSavedVisibilityQueriesResponse:
+		that1 = t
+	case ListSavedVisibilityQueriesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateSearchAttributeRequest to the protobuf v3 wire format
+func (val *UpdateSearchAttributeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateSearchAttributeRequest from the protobuf v3 wire format
+func (val *UpdateSearchAttributeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateSearchAttributeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateSearchAttributeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateSearchAttributeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateSearchAttributeRequest
+	switch t := that.(type) {
+	case *UpdateSearchAttributeRequest:
+		that1 = t
+	case UpdateSearchAttributeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateSearchAttributeResponse to the protobuf v3 wire format
+func (val *UpdateSearchAttributeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateSearchAttributeResponse from the protobuf v3 wire format
+func (val *UpdateSearchAttributeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateSearchAttributeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateSearchAttributeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateSearchAttributeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateSearchAttributeResponse
+	switch t := that.(type) {
+	case *UpdateSearchAttributeResponse:
+		that1 = t
+	case UpdateSearchAttributeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DropSearchAttributeRequest to the protobuf v3 wire format
+func (val *DropSearchAttributeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DropSearchAttributeRequest from the protobuf v3 wire format
+func (val *DropSearchAttributeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DropSearchAttributeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DropSearchAttributeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DropSearchAttributeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DropSearchAttributeRequest
+	switch t := that.(type) {
+	case *DropSearchAttributeRequest:
+		that1 = t
+	case DropSearchAttributeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DropSearchAttributeResponse to the protobuf v3 wire format
+func (val *DropSearchAttributeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DropSearchAttributeResponse from the protobuf v3 wire format
+func (val *DropSearchAttributeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DropSearchAttributeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DropSearchAttributeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DropSearchAttributeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DropSearchAttributeResponse
+	switch t := that.(type) {
+	case *DropSearchAttributeResponse:
+		that1 = t
+	case DropSearchAttributeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeMutableStateRequest to the protobuf v3 wire format
 func (val *DescribeMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3297,3 +4148,299 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type PauseTaskQueueRequest to the protobuf v3 wire format
+func (val *PauseTaskQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseTaskQueueRequest from the protobuf v3 wire format
+func (val *PauseTaskQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseTaskQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseTaskQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseTaskQueueRequest
+	switch t := that.(type) {
+	case *PauseTaskQueueRequest:
+		that1 = t
+	case PauseTaskQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseTaskQueueResponse to the protobuf v3 wire format
+func (val *PauseTaskQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseTaskQueueResponse from the protobuf v3 wire format
+func (val *PauseTaskQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseTaskQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseTaskQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseTaskQueueResponse
+	switch t := that.(type) {
+	case *PauseTaskQueueResponse:
+		that1 = t
+	case PauseTaskQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeTaskQueueRequest to the protobuf v3 wire format
+func (val *ResumeTaskQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeTaskQueueRequest from the protobuf v3 wire format
+func (val *ResumeTaskQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeTaskQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeTaskQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeTaskQueueRequest
+	switch t := that.(type) {
+	case *ResumeTaskQueueRequest:
+		that1 = t
+	case ResumeTaskQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeTaskQueueResponse to the protobuf v3 wire format
+func (val *ResumeTaskQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeTaskQueueResponse from the protobuf v3 wire format
+func (val *ResumeTaskQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeTaskQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeTaskQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeTaskQueueResponse
+	switch t := that.(type) {
+	case *ResumeTaskQueueResponse:
+		that1 = t
+	case ResumeTaskQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeTaskQueueTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeTaskQueueTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeTaskQueueTasksRequest
+	switch t := that.(type) {
+	case *PurgeTaskQueueTasksRequest:
+		that1 = t
+	case PurgeTaskQueueTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeTaskQueueTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeTaskQueueTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeTaskQueueTasksResponse
+	switch t := that.(type) {
+	case *PurgeTaskQueueTasksResponse:
+		that1 = t
+	case PurgeTaskQueueTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogRequest:
+		that1 = t
+	case DescribeTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogResponse:
+		that1 = t
+	case DescribeTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
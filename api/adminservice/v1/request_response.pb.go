@@ -12,7 +12,7 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
-	v16 "go.temporal.io/api/enums/v1"
+	v13 "go.temporal.io/api/enums/v1"
 	v110 "go.temporal.io/api/namespace/v1"
 	v111 "go.temporal.io/api/replication/v1"
 	v114 "go.temporal.io/api/taskqueue/v1"
@@ -20,11 +20,11 @@ import (
 	v17 "go.temporal.io/api/workflow/v1"
 	v18 "go.temporal.io/server/api/cluster/v1"
 	v112 "go.temporal.io/server/api/common/v1"
-	v14 "go.temporal.io/server/api/enums/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 	v11 "go.temporal.io/server/api/history/v1"
-	v13 "go.temporal.io/server/api/namespace/v1"
-	v12 "go.temporal.io/server/api/persistence/v1"
-	v15 "go.temporal.io/server/api/replication/v1"
+	v15 "go.temporal.io/server/api/namespace/v1"
+	v14 "go.temporal.io/server/api/persistence/v1"
+	v16 "go.temporal.io/server/api/replication/v1"
 	v113 "go.temporal.io/server/api/taskqueue/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -247,28 +247,29 @@ func (x *ImportWorkflowExecutionResponse) GetToken() []byte {
 	return nil
 }
 
-type DescribeMutableStateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+type RestoreArchivedWorkflowExecutionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Both workflow_id and run_id are required.
+	Execution     *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeMutableStateRequest) Reset() {
-	*x = DescribeMutableStateRequest{}
+func (x *RestoreArchivedWorkflowExecutionRequest) Reset() {
+	*x = RestoreArchivedWorkflowExecutionRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeMutableStateRequest) String() string {
+func (x *RestoreArchivedWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeMutableStateRequest) ProtoMessage() {}
+func (*RestoreArchivedWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *DescribeMutableStateRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreArchivedWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,49 +281,47 @@ func (x *DescribeMutableStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeMutableStateRequest.ProtoReflect.Descriptor instead.
-func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreArchivedWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreArchivedWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DescribeMutableStateRequest) GetNamespace() string {
+func (x *RestoreArchivedWorkflowExecutionRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *DescribeMutableStateRequest) GetExecution() *v1.WorkflowExecution {
+func (x *RestoreArchivedWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
 		return x.Execution
 	}
 	return nil
 }
 
-type DescribeMutableStateResponse struct {
-	state                protoimpl.MessageState    `protogen:"open.v1"`
-	ShardId              string                    `protobuf:"bytes,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	HistoryAddr          string                    `protobuf:"bytes,2,opt,name=history_addr,json=historyAddr,proto3" json:"history_addr,omitempty"`
-	CacheMutableState    *v12.WorkflowMutableState `protobuf:"bytes,3,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
-	DatabaseMutableState *v12.WorkflowMutableState `protobuf:"bytes,4,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type RestoreArchivedWorkflowExecutionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of history events imported from the archival store.
+	HistoryLength int64 `protobuf:"varint,1,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeMutableStateResponse) Reset() {
-	*x = DescribeMutableStateResponse{}
+func (x *RestoreArchivedWorkflowExecutionResponse) Reset() {
+	*x = RestoreArchivedWorkflowExecutionResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeMutableStateResponse) String() string {
+func (x *RestoreArchivedWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeMutableStateResponse) ProtoMessage() {}
+func (*RestoreArchivedWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *DescribeMutableStateResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreArchivedWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,65 +333,46 @@ func (x *DescribeMutableStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeMutableStateResponse.ProtoReflect.Descriptor instead.
-func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreArchivedWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreArchivedWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DescribeMutableStateResponse) GetShardId() string {
-	if x != nil {
-		return x.ShardId
-	}
-	return ""
-}
-
-func (x *DescribeMutableStateResponse) GetHistoryAddr() string {
-	if x != nil {
-		return x.HistoryAddr
-	}
-	return ""
-}
-
-func (x *DescribeMutableStateResponse) GetCacheMutableState() *v12.WorkflowMutableState {
-	if x != nil {
-		return x.CacheMutableState
-	}
-	return nil
-}
-
-func (x *DescribeMutableStateResponse) GetDatabaseMutableState() *v12.WorkflowMutableState {
+func (x *RestoreArchivedWorkflowExecutionResponse) GetHistoryLength() int64 {
 	if x != nil {
-		return x.DatabaseMutableState
+		return x.HistoryLength
 	}
-	return nil
+	return 0
 }
 
-// At least one of the parameters needs to be provided.
-type DescribeHistoryHostRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	//ip:port
-	HostAddress       string                `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
-	ShardId           int32                 `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Namespace         string                `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type AggregateWorkflowExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query filtering the workflow executions, in the ListWorkflowExecutions syntax.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Keyword search attributes to group the workflow executions by.
+	GroupBy       []string                                          `protobuf:"bytes,3,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Aggregations  []*AggregateWorkflowExecutionsRequest_Aggregation `protobuf:"bytes,4,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
+	PageSize      int32                                             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                                            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeHistoryHostRequest) Reset() {
-	*x = DescribeHistoryHostRequest{}
+func (x *AggregateWorkflowExecutionsRequest) Reset() {
+	*x = AggregateWorkflowExecutionsRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeHistoryHostRequest) String() string {
+func (x *AggregateWorkflowExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeHistoryHostRequest) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsRequest) ProtoMessage() {}
 
-func (x *DescribeHistoryHostRequest) ProtoReflect() protoreflect.Message {
+func (x *AggregateWorkflowExecutionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -404,63 +384,75 @@ func (x *DescribeHistoryHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeHistoryHostRequest.ProtoReflect.Descriptor instead.
-func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregateWorkflowExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DescribeHistoryHostRequest) GetHostAddress() string {
+func (x *AggregateWorkflowExecutionsRequest) GetNamespace() string {
 	if x != nil {
-		return x.HostAddress
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *DescribeHistoryHostRequest) GetShardId() int32 {
+func (x *AggregateWorkflowExecutionsRequest) GetQuery() string {
 	if x != nil {
-		return x.ShardId
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *DescribeHistoryHostRequest) GetNamespace() string {
+func (x *AggregateWorkflowExecutionsRequest) GetGroupBy() []string {
 	if x != nil {
-		return x.Namespace
+		return x.GroupBy
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeHistoryHostRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (x *AggregateWorkflowExecutionsRequest) GetAggregations() []*AggregateWorkflowExecutionsRequest_Aggregation {
 	if x != nil {
-		return x.WorkflowExecution
+		return x.Aggregations
 	}
 	return nil
 }
 
-type DescribeHistoryHostResponse struct {
-	state          protoimpl.MessageState  `protogen:"open.v1"`
-	ShardsNumber   int32                   `protobuf:"varint,1,opt,name=shards_number,json=shardsNumber,proto3" json:"shards_number,omitempty"`
-	ShardIds       []int32                 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	NamespaceCache *v13.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
-	Address        string                  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AggregateWorkflowExecutionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *DescribeHistoryHostResponse) Reset() {
-	*x = DescribeHistoryHostResponse{}
+func (x *AggregateWorkflowExecutionsRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsResponse struct {
+	state         protoimpl.MessageState                                  `protogen:"open.v1"`
+	Groups        []*AggregateWorkflowExecutionsResponse_AggregationGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NextPageToken []byte                                                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse) Reset() {
+	*x = AggregateWorkflowExecutionsResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeHistoryHostResponse) String() string {
+func (x *AggregateWorkflowExecutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeHistoryHostResponse) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsResponse) ProtoMessage() {}
 
-func (x *DescribeHistoryHostResponse) ProtoReflect() protoreflect.Message {
+func (x *AggregateWorkflowExecutionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,60 +464,57 @@ func (x *DescribeHistoryHostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeHistoryHostResponse.ProtoReflect.Descriptor instead.
-func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregateWorkflowExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DescribeHistoryHostResponse) GetShardsNumber() int32 {
-	if x != nil {
-		return x.ShardsNumber
-	}
-	return 0
-}
-
-func (x *DescribeHistoryHostResponse) GetShardIds() []int32 {
+func (x *AggregateWorkflowExecutionsResponse) GetGroups() []*AggregateWorkflowExecutionsResponse_AggregationGroup {
 	if x != nil {
-		return x.ShardIds
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *DescribeHistoryHostResponse) GetNamespaceCache() *v13.NamespaceCacheInfo {
+func (x *AggregateWorkflowExecutionsResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.NamespaceCache
+		return x.NextPageToken
 	}
 	return nil
 }
 
-func (x *DescribeHistoryHostResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-type CloseShardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+type CheckVisibilityConsistencyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query restricting the checked workflow executions, in the ListWorkflowExecutions syntax. Optional.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Fraction of the records of the primary store which are checked, in (0, 1]. Defaults to 1, a full scan, which
+	// also lists the secondary store to find the records missing in the primary store.
+	SampleRate float64 `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// Regenerate the visibility tasks of the mismatched workflow executions, and delete the records of the workflow
+	// executions which don't exist anymore. History service must write to both visibility stores for the secondary
+	// store to be repaired.
+	Repair        bool   `protobuf:"varint,4,opt,name=repair,proto3" json:"repair,omitempty"`
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseShardRequest) Reset() {
-	*x = CloseShardRequest{}
+func (x *CheckVisibilityConsistencyRequest) Reset() {
+	*x = CheckVisibilityConsistencyRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseShardRequest) String() string {
+func (x *CheckVisibilityConsistencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseShardRequest) ProtoMessage() {}
+func (*CheckVisibilityConsistencyRequest) ProtoMessage() {}
 
-func (x *CloseShardRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckVisibilityConsistencyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,38 +526,77 @@ func (x *CloseShardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseShardRequest.ProtoReflect.Descriptor instead.
-func (*CloseShardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckVisibilityConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckVisibilityConsistencyRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CloseShardRequest) GetShardId() int32 {
+func (x *CheckVisibilityConsistencyRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CheckVisibilityConsistencyRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CheckVisibilityConsistencyRequest) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
 	}
 	return 0
 }
 
-type CloseShardResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *CheckVisibilityConsistencyRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *CheckVisibilityConsistencyRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CheckVisibilityConsistencyRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type CheckVisibilityConsistencyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of records of the primary store compared with the secondary store.
+	CheckedCount  int64                                          `protobuf:"varint,1,opt,name=checked_count,json=checkedCount,proto3" json:"checked_count,omitempty"`
+	Mismatches    []*CheckVisibilityConsistencyResponse_Mismatch `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	NextPageToken []byte                                         `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseShardResponse) Reset() {
-	*x = CloseShardResponse{}
+func (x *CheckVisibilityConsistencyResponse) Reset() {
+	*x = CheckVisibilityConsistencyResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseShardResponse) String() string {
+func (x *CheckVisibilityConsistencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseShardResponse) ProtoMessage() {}
+func (*CheckVisibilityConsistencyResponse) ProtoMessage() {}
 
-func (x *CloseShardResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckVisibilityConsistencyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -580,32 +608,57 @@ func (x *CloseShardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseShardResponse.ProtoReflect.Descriptor instead.
-func (*CloseShardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckVisibilityConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckVisibilityConsistencyResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{9}
 }
 
-type GetShardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+func (x *CheckVisibilityConsistencyResponse) GetCheckedCount() int64 {
+	if x != nil {
+		return x.CheckedCount
+	}
+	return 0
+}
+
+func (x *CheckVisibilityConsistencyResponse) GetMismatches() []*CheckVisibilityConsistencyResponse_Mismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *CheckVisibilityConsistencyResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ExplainVisibilityQueryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query, in the ListWorkflowExecutions syntax.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Page size of the explained statement. Defaults to the maximum page size of the namespace.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShardRequest) Reset() {
-	*x = GetShardRequest{}
+func (x *ExplainVisibilityQueryRequest) Reset() {
+	*x = ExplainVisibilityQueryRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShardRequest) String() string {
+func (x *ExplainVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShardRequest) ProtoMessage() {}
+func (*ExplainVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
+func (x *ExplainVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,39 +670,62 @@ func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShardRequest.ProtoReflect.Descriptor instead.
-func (*GetShardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExplainVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*ExplainVisibilityQueryRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetShardRequest) GetShardId() int32 {
+func (x *ExplainVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.ShardId
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExplainVisibilityQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ExplainVisibilityQueryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-type GetShardResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShardInfo     *v12.ShardInfo         `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
+type ExplainVisibilityQueryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the visibility store the query is run against.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// Elasticsearch search source in JSON, or SQL statement of the first page of the query.
+	TranslatedQuery string `protobuf:"bytes,2,opt,name=translated_query,json=translatedQuery,proto3" json:"translated_query,omitempty"`
+	// Arguments of the SQL statement, in order.
+	QueryArgs []string `protobuf:"bytes,3,rep,name=query_args,json=queryArgs,proto3" json:"query_args,omitempty"`
+	// Field names the custom search attribute aliases of the query are resolved to, keyed by alias.
+	SearchAttributeAliases map[string]string `protobuf:"bytes,4,rep,name=search_attribute_aliases,json=searchAttributeAliases,proto3" json:"search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Plan of the SQL statement, as returned by the database EXPLAIN statement. Empty for Elasticsearch.
+	Plan          string `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShardResponse) Reset() {
-	*x = GetShardResponse{}
+func (x *ExplainVisibilityQueryResponse) Reset() {
+	*x = ExplainVisibilityQueryResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShardResponse) String() string {
+func (x *ExplainVisibilityQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShardResponse) ProtoMessage() {}
+func (*ExplainVisibilityQueryResponse) ProtoMessage() {}
 
-func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
+func (x *ExplainVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -661,44 +737,70 @@ func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShardResponse.ProtoReflect.Descriptor instead.
-func (*GetShardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExplainVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*ExplainVisibilityQueryResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetShardResponse) GetShardInfo() *v12.ShardInfo {
+func (x *ExplainVisibilityQueryResponse) GetStoreName() string {
 	if x != nil {
-		return x.ShardInfo
+		return x.StoreName
 	}
-	return nil
+	return ""
 }
 
-type ListHistoryTasksRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	// The task category. See tasks.TaskCategoryRegistry for more.
-	Category      int32          `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	TaskRange     *v11.TaskRange `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
-	BatchSize     int32          `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	NextPageToken []byte         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ExplainVisibilityQueryResponse) GetTranslatedQuery() string {
+	if x != nil {
+		return x.TranslatedQuery
+	}
+	return ""
 }
 
-func (x *ListHistoryTasksRequest) Reset() {
-	*x = ListHistoryTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ExplainVisibilityQueryResponse) GetQueryArgs() []string {
+	if x != nil {
+		return x.QueryArgs
+	}
+	return nil
 }
 
-func (x *ListHistoryTasksRequest) String() string {
+func (x *ExplainVisibilityQueryResponse) GetSearchAttributeAliases() map[string]string {
+	if x != nil {
+		return x.SearchAttributeAliases
+	}
+	return nil
+}
+
+func (x *ExplainVisibilityQueryResponse) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type StreamVisibilityChangesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Cursor of the last received response to resume the stream from. The stream starts with the changes made after
+	// the call when not set.
+	Cursor        []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamVisibilityChangesRequest) Reset() {
+	*x = StreamVisibilityChangesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamVisibilityChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryTasksRequest) ProtoMessage() {}
+func (*StreamVisibilityChangesRequest) ProtoMessage() {}
 
-func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamVisibilityChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,68 +812,49 @@ func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListHistoryTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamVisibilityChangesRequest.ProtoReflect.Descriptor instead.
+func (*StreamVisibilityChangesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListHistoryTasksRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetCategory() int32 {
-	if x != nil {
-		return x.Category
-	}
-	return 0
-}
-
-func (x *ListHistoryTasksRequest) GetTaskRange() *v11.TaskRange {
-	if x != nil {
-		return x.TaskRange
-	}
-	return nil
-}
-
-func (x *ListHistoryTasksRequest) GetBatchSize() int32 {
+func (x *StreamVisibilityChangesRequest) GetNamespace() string {
 	if x != nil {
-		return x.BatchSize
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *ListHistoryTasksRequest) GetNextPageToken() []byte {
+func (x *StreamVisibilityChangesRequest) GetCursor() []byte {
 	if x != nil {
-		return x.NextPageToken
+		return x.Cursor
 	}
 	return nil
 }
 
-type ListHistoryTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type StreamVisibilityChangesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changes of a single history shard, in the order they were made. Empty for heartbeats which only advance the cursor.
+	Changes []*VisibilityChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Opaque cursor to resume the stream after this response.
+	Cursor        []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHistoryTasksResponse) Reset() {
-	*x = ListHistoryTasksResponse{}
+func (x *StreamVisibilityChangesResponse) Reset() {
+	*x = StreamVisibilityChangesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHistoryTasksResponse) String() string {
+func (x *StreamVisibilityChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHistoryTasksResponse) ProtoMessage() {}
+func (*StreamVisibilityChangesResponse) ProtoMessage() {}
 
-func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamVisibilityChangesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,52 +866,61 @@ func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHistoryTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListHistoryTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamVisibilityChangesResponse.ProtoReflect.Descriptor instead.
+func (*StreamVisibilityChangesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListHistoryTasksResponse) GetTasks() []*Task {
+func (x *StreamVisibilityChangesResponse) GetChanges() []*VisibilityChange {
 	if x != nil {
-		return x.Tasks
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *ListHistoryTasksResponse) GetNextPageToken() []byte {
+func (x *StreamVisibilityChangesResponse) GetCursor() []byte {
 	if x != nil {
-		return x.NextPageToken
+		return x.Cursor
 	}
 	return nil
 }
 
-type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType      v14.TaskType           `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
-	FireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
-	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// VisibilityChange is a change made to the visibility record of a workflow execution by a visibility task.
+type VisibilityChange struct {
+	state   protoimpl.MessageState   `protogen:"open.v1"`
+	Type    v12.VisibilityChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.VisibilityChangeType" json:"type,omitempty"`
+	ShardId int32                    `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// ID of the visibility task which made the change. Changes of the same shard can be delivered again after a
+	// history shard moves to another host, with the same task ID.
+	TaskId    int64                 `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=execution,proto3" json:"execution,omitempty"`
+	// The fields below are not set for deleted records.
+	WorkflowType     string                      `protobuf:"bytes,5,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	Status           v13.WorkflowExecutionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	StartTime        *timestamppb.Timestamp      `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	ExecutionTime    *timestamppb.Timestamp      `protobuf:"bytes,8,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	CloseTime        *timestamppb.Timestamp      `protobuf:"bytes,9,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	TaskQueue        string                      `protobuf:"bytes,10,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	SearchAttributes *v1.SearchAttributes        `protobuf:"bytes,11,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Memo             *v1.Memo                    `protobuf:"bytes,12,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *VisibilityChange) Reset() {
+	*x = VisibilityChange{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *VisibilityChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*VisibilityChange) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *VisibilityChange) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -840,85 +932,117 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisibilityChange.ProtoReflect.Descriptor instead.
+func (*VisibilityChange) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Task) GetNamespaceId() string {
+func (x *VisibilityChange) GetType() v12.VisibilityChangeType {
 	if x != nil {
-		return x.NamespaceId
+		return x.Type
 	}
-	return ""
+	return v12.VisibilityChangeType(0)
 }
 
-func (x *Task) GetWorkflowId() string {
+func (x *VisibilityChange) GetShardId() int32 {
 	if x != nil {
-		return x.WorkflowId
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (x *Task) GetRunId() string {
+func (x *VisibilityChange) GetTaskId() int64 {
 	if x != nil {
-		return x.RunId
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *VisibilityChange) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *VisibilityChange) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
 	}
 	return ""
 }
 
-func (x *Task) GetTaskId() int64 {
+func (x *VisibilityChange) GetStatus() v13.WorkflowExecutionStatus {
 	if x != nil {
-		return x.TaskId
+		return x.Status
 	}
-	return 0
+	return v13.WorkflowExecutionStatus(0)
 }
 
-func (x *Task) GetTaskType() v14.TaskType {
+func (x *VisibilityChange) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TaskType
+		return x.StartTime
 	}
-	return v14.TaskType(0)
+	return nil
 }
 
-func (x *Task) GetFireTime() *timestamppb.Timestamp {
+func (x *VisibilityChange) GetExecutionTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FireTime
+		return x.ExecutionTime
 	}
 	return nil
 }
 
-func (x *Task) GetVersion() int64 {
+func (x *VisibilityChange) GetCloseTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Version
+		return x.CloseTime
 	}
-	return 0
+	return nil
 }
 
-type RemoveTaskRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	// The task category. See tasks.TaskCategoryRegistry for more.
-	Category       int32                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
-	TaskId         int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	VisibilityTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *VisibilityChange) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
 }
 
-func (x *RemoveTaskRequest) Reset() {
-	*x = RemoveTaskRequest{}
+func (x *VisibilityChange) GetSearchAttributes() *v1.SearchAttributes {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *VisibilityChange) GetMemo() *v1.Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+// VisibilityChangeCursor is the position of a visibility change stream, encoded in the cursor of the responses.
+type VisibilityChangeCursor struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Shards        map[int32]*VisibilityChangeShardCursor `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisibilityChangeCursor) Reset() {
+	*x = VisibilityChangeCursor{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTaskRequest) String() string {
+func (x *VisibilityChangeCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTaskRequest) ProtoMessage() {}
+func (*VisibilityChangeCursor) ProtoMessage() {}
 
-func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *VisibilityChangeCursor) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,59 +1054,45 @@ func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTaskRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisibilityChangeCursor.ProtoReflect.Descriptor instead.
+func (*VisibilityChangeCursor) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RemoveTaskRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-func (x *RemoveTaskRequest) GetCategory() int32 {
-	if x != nil {
-		return x.Category
-	}
-	return 0
-}
-
-func (x *RemoveTaskRequest) GetTaskId() int64 {
-	if x != nil {
-		return x.TaskId
-	}
-	return 0
-}
-
-func (x *RemoveTaskRequest) GetVisibilityTime() *timestamppb.Timestamp {
+func (x *VisibilityChangeCursor) GetShards() map[int32]*VisibilityChangeShardCursor {
 	if x != nil {
-		return x.VisibilityTime
+		return x.Shards
 	}
 	return nil
 }
 
-type RemoveTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// VisibilityChangeShardCursor is the position of a visibility change stream in the changes of a history shard.
+type VisibilityChangeShardCursor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the load of the shard by a history host. Sequence numbers restart with each load.
+	Incarnation int64 `protobuf:"varint,1,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	// Sequence number of the last received change.
+	Sequence int64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Changes made by all the visibility tasks with a lower ID were received.
+	AckTaskId     int64 `protobuf:"varint,3,opt,name=ack_task_id,json=ackTaskId,proto3" json:"ack_task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTaskResponse) Reset() {
-	*x = RemoveTaskResponse{}
+func (x *VisibilityChangeShardCursor) Reset() {
+	*x = VisibilityChangeShardCursor{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTaskResponse) String() string {
+func (x *VisibilityChangeShardCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTaskResponse) ProtoMessage() {}
+func (*VisibilityChangeShardCursor) ProtoMessage() {}
 
-func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
+func (x *VisibilityChangeShardCursor) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -994,42 +1104,55 @@ func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTaskResponse.ProtoReflect.Descriptor instead.
-func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisibilityChangeShardCursor.ProtoReflect.Descriptor instead.
+func (*VisibilityChangeShardCursor) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{16}
 }
 
-// *
-// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
-// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
-type GetWorkflowExecutionRawHistoryV2Request struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,9,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
-	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
-	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *VisibilityChangeShardCursor) GetIncarnation() int64 {
+	if x != nil {
+		return x.Incarnation
+	}
+	return 0
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) Reset() {
-	*x = GetWorkflowExecutionRawHistoryV2Request{}
+func (x *VisibilityChangeShardCursor) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *VisibilityChangeShardCursor) GetAckTaskId() int64 {
+	if x != nil {
+		return x.AckTaskId
+	}
+	return 0
+}
+
+type UpsertSavedVisibilityQueryRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Namespace     string                    `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SavedQuery    *v14.SavedVisibilityQuery `protobuf:"bytes,3,opt,name=saved_query,json=savedQuery,proto3" json:"saved_query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertSavedVisibilityQueryRequest) Reset() {
+	*x = UpsertSavedVisibilityQueryRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) String() string {
+func (x *UpsertSavedVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
+func (*UpsertSavedVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Message {
+func (x *UpsertSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1041,91 +1164,52 @@ func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryV2Request.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpsertSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*UpsertSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetNamespaceId() string {
+func (x *UpsertSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetExecution() *v1.WorkflowExecution {
+func (x *UpsertSavedVisibilityQueryRequest) GetName() string {
 	if x != nil {
-		return x.Execution
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() int64 {
+func (x *UpsertSavedVisibilityQueryRequest) GetSavedQuery() *v14.SavedVisibilityQuery {
 	if x != nil {
-		return x.StartEventId
+		return x.SavedQuery
 	}
-	return 0
+	return nil
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() int64 {
-	if x != nil {
-		return x.StartEventVersion
-	}
-	return 0
+type UpsertSavedVisibilityQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() int64 {
-	if x != nil {
-		return x.EndEventId
-	}
-	return 0
+func (x *UpsertSavedVisibilityQueryResponse) Reset() {
+	*x = UpsertSavedVisibilityQueryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() int64 {
-	if x != nil {
-		return x.EndEventVersion
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() int32 {
-	if x != nil {
-		return x.MaximumPageSize
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-type GetWorkflowExecutionRawHistoryV2Response struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) Reset() {
-	*x = GetWorkflowExecutionRawHistoryV2Response{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) String() string {
+func (x *UpsertSavedVisibilityQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
+func (*UpsertSavedVisibilityQueryResponse) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.Message {
+func (x *UpsertSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1137,67 +1221,33 @@ func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryV2Response.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpsertSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*UpsertSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.DataBlob {
-	if x != nil {
-		return x.HistoryBatches
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.VersionHistory {
-	if x != nil {
-		return x.VersionHistory
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
-	if x != nil {
-		return x.HistoryNodeIds
-	}
-	return nil
-}
-
-type GetWorkflowExecutionRawHistoryRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
-	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
-	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DeleteSavedVisibilityQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) Reset() {
-	*x = GetWorkflowExecutionRawHistoryRequest{}
+func (x *DeleteSavedVisibilityQueryRequest) Reset() {
+	*x = DeleteSavedVisibilityQueryRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) String() string {
+func (x *DeleteSavedVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryRequest) ProtoMessage() {}
+func (*DeleteSavedVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,91 +1259,45 @@ func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetNamespaceId() string {
+func (x *DeleteSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetWorkflowExecutionRawHistoryRequest) GetExecution() *v1.WorkflowExecution {
-	if x != nil {
-		return x.Execution
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventId() int64 {
-	if x != nil {
-		return x.StartEventId
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventVersion() int64 {
-	if x != nil {
-		return x.StartEventVersion
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventId() int64 {
-	if x != nil {
-		return x.EndEventId
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventVersion() int64 {
-	if x != nil {
-		return x.EndEventVersion
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() int32 {
-	if x != nil {
-		return x.MaximumPageSize
-	}
-	return 0
-}
-
-func (x *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() []byte {
+func (x *DeleteSavedVisibilityQueryRequest) GetName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetWorkflowExecutionRawHistoryResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteSavedVisibilityQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) Reset() {
-	*x = GetWorkflowExecutionRawHistoryResponse{}
+func (x *DeleteSavedVisibilityQueryResponse) Reset() {
+	*x = DeleteSavedVisibilityQueryResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) String() string {
+func (x *DeleteSavedVisibilityQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowExecutionRawHistoryResponse) ProtoMessage() {}
+func (*DeleteSavedVisibilityQueryResponse) ProtoMessage() {}
 
-func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,61 +1309,32 @@ func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowExecutionRawHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkflowExecutionRawHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() []*v1.DataBlob {
-	if x != nil {
-		return x.HistoryBatches
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryResponse) GetVersionHistory() *v11.VersionHistory {
-	if x != nil {
-		return x.VersionHistory
-	}
-	return nil
-}
-
-func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryNodeIds() []int64 {
-	if x != nil {
-		return x.HistoryNodeIds
-	}
-	return nil
-}
-
-type GetReplicationMessagesRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Tokens        []*v15.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName   string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+type ListSavedVisibilityQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReplicationMessagesRequest) Reset() {
-	*x = GetReplicationMessagesRequest{}
+func (x *ListSavedVisibilityQueriesRequest) Reset() {
+	*x = ListSavedVisibilityQueriesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReplicationMessagesRequest) String() string {
+func (x *ListSavedVisibilityQueriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicationMessagesRequest) ProtoMessage() {}
+func (*ListSavedVisibilityQueriesRequest) ProtoMessage() {}
 
-func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSavedVisibilityQueriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1371,46 +1346,40 @@ func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSavedVisibilityQueriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedVisibilityQueriesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetReplicationMessagesRequest) GetTokens() []*v15.ReplicationToken {
+func (x *ListSavedVisibilityQueriesRequest) GetNamespace() string {
 	if x != nil {
-		return x.Tokens
-	}
-	return nil
-}
-
-func (x *GetReplicationMessagesRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
+		return x.Namespace
 	}
 	return ""
 }
 
-type GetReplicationMessagesResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	ShardMessages map[int32]*v15.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type ListSavedVisibilityQueriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Saved visibility queries by name.
+	SavedQueries  map[string]*v14.SavedVisibilityQuery `protobuf:"bytes,1,rep,name=saved_queries,json=savedQueries,proto3" json:"saved_queries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReplicationMessagesResponse) Reset() {
-	*x = GetReplicationMessagesResponse{}
+func (x *ListSavedVisibilityQueriesResponse) Reset() {
+	*x = ListSavedVisibilityQueriesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReplicationMessagesResponse) String() string {
+func (x *ListSavedVisibilityQueriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicationMessagesResponse) ProtoMessage() {}
+func (*ListSavedVisibilityQueriesResponse) ProtoMessage() {}
 
-func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSavedVisibilityQueriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1422,45 +1391,45 @@ func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSavedVisibilityQueriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedVisibilityQueriesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v15.ReplicationMessages {
+func (x *ListSavedVisibilityQueriesResponse) GetSavedQueries() map[string]*v14.SavedVisibilityQuery {
 	if x != nil {
-		return x.ShardMessages
+		return x.SavedQueries
 	}
 	return nil
 }
 
-type GetNamespaceReplicationMessagesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// lastRetrievedMessageId is where the next fetch should begin with.
-	LastRetrievedMessageId int64 `protobuf:"varint,1,opt,name=last_retrieved_message_id,json=lastRetrievedMessageId,proto3" json:"last_retrieved_message_id,omitempty"`
-	// lastProcessedMessageId is the last messageId that is processed on the passive side.
-	// This can be different than lastRetrievedMessageId if passive side supports prefetching messages.
-	LastProcessedMessageId int64 `protobuf:"varint,2,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// clusterName is the name of the pulling cluster.
-	ClusterName   string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+type UpdateSearchAttributeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Current name of the custom search attribute.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// New name of the custom search attribute. Optional, unless the visibility stores are Elasticsearch compatible.
+	NewName string `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// New type of the custom search attribute. Optional.
+	NewType       v13.IndexedValueType `protobuf:"varint,4,opt,name=new_type,json=newType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"new_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) Reset() {
-	*x = GetNamespaceReplicationMessagesRequest{}
+func (x *UpdateSearchAttributeRequest) Reset() {
+	*x = UpdateSearchAttributeRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) String() string {
+func (x *UpdateSearchAttributeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
+func (*UpdateSearchAttributeRequest) ProtoMessage() {}
 
-func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateSearchAttributeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,53 +1441,62 @@ func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSearchAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSearchAttributeRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) GetLastRetrievedMessageId() int64 {
+func (x *UpdateSearchAttributeRequest) GetNamespace() string {
 	if x != nil {
-		return x.LastRetrievedMessageId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) GetLastProcessedMessageId() int64 {
+func (x *UpdateSearchAttributeRequest) GetName() string {
 	if x != nil {
-		return x.LastProcessedMessageId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
+func (x *UpdateSearchAttributeRequest) GetNewName() string {
 	if x != nil {
-		return x.ClusterName
+		return x.NewName
 	}
 	return ""
 }
 
-type GetNamespaceReplicationMessagesResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Messages      *v15.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
+func (x *UpdateSearchAttributeRequest) GetNewType() v13.IndexedValueType {
+	if x != nil {
+		return x.NewType
+	}
+	return v13.IndexedValueType(0)
+}
+
+type UpdateSearchAttributeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System workflow changing the type of the search attribute. Not set for a rename of a search attribute of SQL
+	// visibility stores, which is applied immediately.
+	Execution     *v1.WorkflowExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) Reset() {
-	*x = GetNamespaceReplicationMessagesResponse{}
+func (x *UpdateSearchAttributeResponse) Reset() {
+	*x = UpdateSearchAttributeResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) String() string {
+func (x *UpdateSearchAttributeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
+func (*UpdateSearchAttributeResponse) ProtoMessage() {}
 
-func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSearchAttributeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1530,39 +1508,40 @@ func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSearchAttributeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSearchAttributeResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetNamespaceReplicationMessagesResponse) GetMessages() *v15.ReplicationMessages {
+func (x *UpdateSearchAttributeResponse) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.Messages
+		return x.Execution
 	}
 	return nil
 }
 
-type GetDLQReplicationMessagesRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	TaskInfos     []*v15.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+type DropSearchAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQReplicationMessagesRequest) Reset() {
-	*x = GetDLQReplicationMessagesRequest{}
+func (x *DropSearchAttributeRequest) Reset() {
+	*x = DropSearchAttributeRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQReplicationMessagesRequest) String() string {
+func (x *DropSearchAttributeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
+func (*DropSearchAttributeRequest) ProtoMessage() {}
 
-func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *DropSearchAttributeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1574,39 +1553,47 @@ func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DropSearchAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DropSearchAttributeRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v15.ReplicationTaskInfo {
+func (x *DropSearchAttributeRequest) GetNamespace() string {
 	if x != nil {
-		return x.TaskInfos
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type GetDLQReplicationMessagesResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReplicationTasks []*v15.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DropSearchAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetDLQReplicationMessagesResponse) Reset() {
-	*x = GetDLQReplicationMessagesResponse{}
+type DropSearchAttributeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System workflow clearing the values of the search attribute.
+	Execution     *v1.WorkflowExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropSearchAttributeResponse) Reset() {
+	*x = DropSearchAttributeResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQReplicationMessagesResponse) String() string {
+func (x *DropSearchAttributeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
+func (*DropSearchAttributeResponse) ProtoMessage() {}
 
-func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *DropSearchAttributeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1618,42 +1605,40 @@ func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DropSearchAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DropSearchAttributeResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (x *DropSearchAttributeResponse) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.ReplicationTasks
+		return x.Execution
 	}
 	return nil
 }
 
-// ReapplyEventsRequest is the request for reapply events API.
-type ReapplyEventsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId       string                 `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Events            *v1.DataBlob           `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DescribeMutableStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReapplyEventsRequest) Reset() {
-	*x = ReapplyEventsRequest{}
+func (x *DescribeMutableStateRequest) Reset() {
+	*x = DescribeMutableStateRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReapplyEventsRequest) String() string {
+func (x *DescribeMutableStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReapplyEventsRequest) ProtoMessage() {}
+func (*DescribeMutableStateRequest) ProtoMessage() {}
 
-func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeMutableStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1665,52 +1650,49 @@ func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReapplyEventsRequest.ProtoReflect.Descriptor instead.
-func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeMutableStateRequest.ProtoReflect.Descriptor instead.
+func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReapplyEventsRequest) GetNamespaceId() string {
+func (x *DescribeMutableStateRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
-	if x != nil {
-		return x.WorkflowExecution
-	}
-	return nil
-}
-
-func (x *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+func (x *DescribeMutableStateRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.Events
+		return x.Execution
 	}
 	return nil
 }
 
-type ReapplyEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DescribeMutableStateResponse struct {
+	state                protoimpl.MessageState    `protogen:"open.v1"`
+	ShardId              string                    `protobuf:"bytes,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	HistoryAddr          string                    `protobuf:"bytes,2,opt,name=history_addr,json=historyAddr,proto3" json:"history_addr,omitempty"`
+	CacheMutableState    *v14.WorkflowMutableState `protobuf:"bytes,3,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
+	DatabaseMutableState *v14.WorkflowMutableState `protobuf:"bytes,4,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ReapplyEventsResponse) Reset() {
-	*x = ReapplyEventsResponse{}
+func (x *DescribeMutableStateResponse) Reset() {
+	*x = DescribeMutableStateResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReapplyEventsResponse) String() string {
+func (x *DescribeMutableStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReapplyEventsResponse) ProtoMessage() {}
+func (*DescribeMutableStateResponse) ProtoMessage() {}
 
-func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeMutableStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1722,35 +1704,65 @@ func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReapplyEventsResponse.ProtoReflect.Descriptor instead.
-func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeMutableStateResponse.ProtoReflect.Descriptor instead.
+func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{28}
 }
 
-type AddSearchAttributesRequest struct {
-	state            protoimpl.MessageState          `protogen:"open.v1"`
-	SearchAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
-	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DescribeMutableStateResponse) GetShardId() string {
+	if x != nil {
+		return x.ShardId
+	}
+	return ""
 }
 
-func (x *AddSearchAttributesRequest) Reset() {
-	*x = AddSearchAttributesRequest{}
+func (x *DescribeMutableStateResponse) GetHistoryAddr() string {
+	if x != nil {
+		return x.HistoryAddr
+	}
+	return ""
+}
+
+func (x *DescribeMutableStateResponse) GetCacheMutableState() *v14.WorkflowMutableState {
+	if x != nil {
+		return x.CacheMutableState
+	}
+	return nil
+}
+
+func (x *DescribeMutableStateResponse) GetDatabaseMutableState() *v14.WorkflowMutableState {
+	if x != nil {
+		return x.DatabaseMutableState
+	}
+	return nil
+}
+
+// At least one of the parameters needs to be provided.
+type DescribeHistoryHostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//ip:port
+	HostAddress       string                `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	ShardId           int32                 `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Namespace         string                `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeHistoryHostRequest) Reset() {
+	*x = DescribeHistoryHostRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSearchAttributesRequest) String() string {
+func (x *DescribeHistoryHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSearchAttributesRequest) ProtoMessage() {}
+func (*DescribeHistoryHostRequest) ProtoMessage() {}
 
-func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeHistoryHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1762,59 +1774,63 @@ func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeHistoryHostRequest.ProtoReflect.Descriptor instead.
+func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddSearchAttributesRequest) GetSearchAttributes() map[string]v16.IndexedValueType {
+func (x *DescribeHistoryHostRequest) GetHostAddress() string {
 	if x != nil {
-		return x.SearchAttributes
+		return x.HostAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *AddSearchAttributesRequest) GetIndexName() string {
+func (x *DescribeHistoryHostRequest) GetShardId() int32 {
 	if x != nil {
-		return x.IndexName
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (x *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
+func (x *DescribeHistoryHostRequest) GetNamespace() string {
 	if x != nil {
-		return x.SkipSchemaUpdate
+		return x.Namespace
 	}
-	return false
+	return ""
 }
 
-func (x *AddSearchAttributesRequest) GetNamespace() string {
+func (x *DescribeHistoryHostRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.Namespace
+		return x.WorkflowExecution
 	}
-	return ""
+	return nil
 }
 
-type AddSearchAttributesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DescribeHistoryHostResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	ShardsNumber   int32                   `protobuf:"varint,1,opt,name=shards_number,json=shardsNumber,proto3" json:"shards_number,omitempty"`
+	ShardIds       []int32                 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	NamespaceCache *v15.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
+	Address        string                  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddSearchAttributesResponse) Reset() {
-	*x = AddSearchAttributesResponse{}
+func (x *DescribeHistoryHostResponse) Reset() {
+	*x = DescribeHistoryHostResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSearchAttributesResponse) String() string {
+func (x *DescribeHistoryHostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSearchAttributesResponse) ProtoMessage() {}
+func (*DescribeHistoryHostResponse) ProtoMessage() {}
 
-func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeHistoryHostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1826,91 +1842,103 @@ func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeHistoryHostResponse.ProtoReflect.Descriptor instead.
+func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{30}
 }
 
-type RemoveSearchAttributesRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SearchAttributes []string               `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	IndexName        string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DescribeHistoryHostResponse) GetShardsNumber() int32 {
+	if x != nil {
+		return x.ShardsNumber
+	}
+	return 0
 }
 
-func (x *RemoveSearchAttributesRequest) Reset() {
-	*x = RemoveSearchAttributesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DescribeHistoryHostResponse) GetShardIds() []int32 {
+	if x != nil {
+		return x.ShardIds
+	}
+	return nil
 }
 
-func (x *RemoveSearchAttributesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DescribeHistoryHostResponse) GetNamespaceCache() *v15.NamespaceCacheInfo {
+	if x != nil {
+		return x.NamespaceCache
+	}
+	return nil
 }
 
-func (*RemoveSearchAttributesRequest) ProtoMessage() {}
-
-func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+func (x *DescribeHistoryHostResponse) GetAddress() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Address
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RemoveSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{31}
+type CloseShardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveSearchAttributesRequest) GetSearchAttributes() []string {
-	if x != nil {
-		return x.SearchAttributes
-	}
-	return nil
+func (x *CloseShardRequest) Reset() {
+	*x = CloseShardRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveSearchAttributesRequest) GetIndexName() string {
+func (x *CloseShardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShardRequest) ProtoMessage() {}
+
+func (x *CloseShardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[31]
 	if x != nil {
-		return x.IndexName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RemoveSearchAttributesRequest) GetNamespace() string {
+// Deprecated: Use CloseShardRequest.ProtoReflect.Descriptor instead.
+func (*CloseShardRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CloseShardRequest) GetShardId() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-type RemoveSearchAttributesResponse struct {
+type CloseShardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveSearchAttributesResponse) Reset() {
-	*x = RemoveSearchAttributesResponse{}
+func (x *CloseShardResponse) Reset() {
+	*x = CloseShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveSearchAttributesResponse) String() string {
+func (x *CloseShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveSearchAttributesResponse) ProtoMessage() {}
+func (*CloseShardResponse) ProtoMessage() {}
 
-func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+func (x *CloseShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1922,33 +1950,32 @@ func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseShardResponse.ProtoReflect.Descriptor instead.
+func (*CloseShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{32}
 }
 
-type GetSearchAttributesRequest struct {
+type GetShardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ShardId       int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchAttributesRequest) Reset() {
-	*x = GetSearchAttributesRequest{}
+func (x *GetShardRequest) Reset() {
+	*x = GetShardRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchAttributesRequest) String() string {
+func (x *GetShardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchAttributesRequest) ProtoMessage() {}
+func (*GetShardRequest) ProtoMessage() {}
 
-func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetShardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1960,50 +1987,39 @@ func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchAttributesRequest.ProtoReflect.Descriptor instead.
-func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShardRequest.ProtoReflect.Descriptor instead.
+func (*GetShardRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetSearchAttributesRequest) GetIndexName() string {
-	if x != nil {
-		return x.IndexName
-	}
-	return ""
-}
-
-func (x *GetSearchAttributesRequest) GetNamespace() string {
+func (x *GetShardRequest) GetShardId() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-type GetSearchAttributesResponse struct {
-	state            protoimpl.MessageState          `protogen:"open.v1"`
-	CustomAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	SystemAttributes map[string]v16.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// State of the workflow that adds search attributes to the system.
-	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GetShardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShardInfo     *v14.ShardInfo         `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchAttributesResponse) Reset() {
-	*x = GetSearchAttributesResponse{}
+func (x *GetShardResponse) Reset() {
+	*x = GetShardResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchAttributesResponse) String() string {
+func (x *GetShardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchAttributesResponse) ProtoMessage() {}
+func (*GetShardResponse) ProtoMessage() {}
 
-func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetShardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2015,60 +2031,44 @@ func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchAttributesResponse.ProtoReflect.Descriptor instead.
-func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShardResponse.ProtoReflect.Descriptor instead.
+func (*GetShardResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetSearchAttributesResponse) GetCustomAttributes() map[string]v16.IndexedValueType {
-	if x != nil {
-		return x.CustomAttributes
-	}
-	return nil
-}
-
-func (x *GetSearchAttributesResponse) GetSystemAttributes() map[string]v16.IndexedValueType {
-	if x != nil {
-		return x.SystemAttributes
-	}
-	return nil
-}
-
-func (x *GetSearchAttributesResponse) GetMapping() map[string]string {
-	if x != nil {
-		return x.Mapping
-	}
-	return nil
-}
-
-func (x *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
+func (x *GetShardResponse) GetShardInfo() *v14.ShardInfo {
 	if x != nil {
-		return x.AddWorkflowExecutionInfo
+		return x.ShardInfo
 	}
 	return nil
 }
 
-type DescribeClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+type ListHistoryTasksRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category      int32          `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskRange     *v11.TaskRange `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
+	BatchSize     int32          `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeClusterRequest) Reset() {
-	*x = DescribeClusterRequest{}
+func (x *ListHistoryTasksRequest) Reset() {
+	*x = ListHistoryTasksRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeClusterRequest) String() string {
+func (x *ListHistoryTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeClusterRequest) ProtoMessage() {}
+func (*ListHistoryTasksRequest) ProtoMessage() {}
 
-func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListHistoryTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2080,52 +2080,68 @@ func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
-func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHistoryTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListHistoryTasksRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DescribeClusterRequest) GetClusterName() string {
+func (x *ListHistoryTasksRequest) GetShardId() int32 {
 	if x != nil {
-		return x.ClusterName
+		return x.ShardId
 	}
-	return ""
+	return 0
 }
 
-type DescribeClusterResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	SupportedClients         map[string]string      `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ServerVersion            string                 `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
-	MembershipInfo           *v18.MembershipInfo    `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
-	ClusterId                string                 `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	ClusterName              string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	HistoryShardCount        int32                  `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
-	PersistenceStore         string                 `protobuf:"bytes,7,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
-	VisibilityStore          string                 `protobuf:"bytes,8,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
-	VersionInfo              *v19.VersionInfo       `protobuf:"bytes,9,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
-	FailoverVersionIncrement int64                  `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
-	InitialFailoverVersion   int64                  `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
-	IsGlobalNamespaceEnabled bool                   `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
-	Tags                     map[string]string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	HttpAddress              string                 `protobuf:"bytes,14,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+func (x *ListHistoryTasksRequest) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
 }
 
-func (x *DescribeClusterResponse) Reset() {
-	*x = DescribeClusterResponse{}
+func (x *ListHistoryTasksRequest) GetTaskRange() *v11.TaskRange {
+	if x != nil {
+		return x.TaskRange
+	}
+	return nil
+}
+
+func (x *ListHistoryTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ListHistoryTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListHistoryTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryTasksResponse) Reset() {
+	*x = ListHistoryTasksResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeClusterResponse) String() string {
+func (x *ListHistoryTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeClusterResponse) ProtoMessage() {}
+func (*ListHistoryTasksResponse) ProtoMessage() {}
 
-func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
+func (x *ListHistoryTasksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2137,132 +2153,143 @@ func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
-func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListHistoryTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryTasksResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DescribeClusterResponse) GetSupportedClients() map[string]string {
+func (x *ListHistoryTasksResponse) GetTasks() []*Task {
 	if x != nil {
-		return x.SupportedClients
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *DescribeClusterResponse) GetServerVersion() string {
-	if x != nil {
-		return x.ServerVersion
-	}
-	return ""
-}
-
-func (x *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
+func (x *ListHistoryTasksResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.MembershipInfo
+		return x.NextPageToken
 	}
 	return nil
 }
 
-func (x *DescribeClusterResponse) GetClusterId() string {
-	if x != nil {
-		return x.ClusterId
-	}
-	return ""
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType      v12.TaskType           `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	FireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeClusterResponse) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeClusterResponse) GetHistoryShardCount() int32 {
-	if x != nil {
-		return x.HistoryShardCount
-	}
-	return 0
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DescribeClusterResponse) GetPersistenceStore() string {
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
 	if x != nil {
-		return x.PersistenceStore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DescribeClusterResponse) GetVisibilityStore() string {
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Task) GetNamespaceId() string {
 	if x != nil {
-		return x.VisibilityStore
+		return x.NamespaceId
 	}
 	return ""
 }
 
-func (x *DescribeClusterResponse) GetVersionInfo() *v19.VersionInfo {
+func (x *Task) GetWorkflowId() string {
 	if x != nil {
-		return x.VersionInfo
+		return x.WorkflowId
 	}
-	return nil
+	return ""
 }
 
-func (x *DescribeClusterResponse) GetFailoverVersionIncrement() int64 {
+func (x *Task) GetRunId() string {
 	if x != nil {
-		return x.FailoverVersionIncrement
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-func (x *DescribeClusterResponse) GetInitialFailoverVersion() int64 {
+func (x *Task) GetTaskId() int64 {
 	if x != nil {
-		return x.InitialFailoverVersion
+		return x.TaskId
 	}
 	return 0
 }
 
-func (x *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
+func (x *Task) GetTaskType() v12.TaskType {
 	if x != nil {
-		return x.IsGlobalNamespaceEnabled
+		return x.TaskType
 	}
-	return false
+	return v12.TaskType(0)
 }
 
-func (x *DescribeClusterResponse) GetTags() map[string]string {
+func (x *Task) GetFireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tags
+		return x.FireTime
 	}
 	return nil
 }
 
-func (x *DescribeClusterResponse) GetHttpAddress() string {
+func (x *Task) GetVersion() int64 {
 	if x != nil {
-		return x.HttpAddress
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type ListClustersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RemoveTaskRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShardId int32                  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// The task category. See tasks.TaskCategoryRegistry for more.
+	Category       int32                  `protobuf:"varint,2,opt,name=category,proto3" json:"category,omitempty"`
+	TaskId         int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListClustersRequest) Reset() {
-	*x = ListClustersRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+func (x *RemoveTaskRequest) Reset() {
+	*x = RemoveTaskRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersRequest) String() string {
+func (x *RemoveTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersRequest) ProtoMessage() {}
+func (*RemoveTaskRequest) ProtoMessage() {}
 
-func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[37]
+func (x *RemoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,48 +2300,60 @@ func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
-func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RemoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListClustersRequest) GetPageSize() int32 {
+func (x *RemoveTaskRequest) GetShardId() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.ShardId
 	}
 	return 0
 }
 
-func (x *ListClustersRequest) GetNextPageToken() []byte {
+func (x *RemoveTaskRequest) GetCategory() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Category
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *RemoveTaskRequest) GetVisibilityTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisibilityTime
 	}
 	return nil
 }
 
-type ListClustersResponse struct {
+type RemoveTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clusters      []*v12.ClusterMetadata `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClustersResponse) Reset() {
-	*x = ListClustersResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+func (x *RemoveTaskResponse) Reset() {
+	*x = RemoveTaskResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersResponse) String() string {
+func (x *RemoveTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersResponse) ProtoMessage() {}
+func (*RemoveTaskResponse) ProtoMessage() {}
 
-func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[38]
+func (x *RemoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,50 +2364,43 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
-func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *ListClustersResponse) GetClusters() []*v12.ClusterMetadata {
-	if x != nil {
-		return x.Clusters
-	}
-	return nil
-}
-
-func (x *ListClustersResponse) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+// Deprecated: Use RemoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{39}
 }
 
-type AddOrUpdateRemoteClusterRequest struct {
-	state                         protoimpl.MessageState `protogen:"open.v1"`
-	FrontendAddress               string                 `protobuf:"bytes,1,opt,name=frontend_address,json=frontendAddress,proto3" json:"frontend_address,omitempty"`
-	EnableRemoteClusterConnection bool                   `protobuf:"varint,2,opt,name=enable_remote_cluster_connection,json=enableRemoteClusterConnection,proto3" json:"enable_remote_cluster_connection,omitempty"`
-	// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
-	FrontendHttpAddress string `protobuf:"bytes,3,opt,name=frontend_http_address,json=frontendHttpAddress,proto3" json:"frontend_http_address,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// *
+// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
+// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
+type GetWorkflowExecutionRawHistoryV2Request struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,9,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
+	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
+	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) Reset() {
-	*x = AddOrUpdateRemoteClusterRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+func (x *GetWorkflowExecutionRawHistoryV2Request) Reset() {
+	*x = GetWorkflowExecutionRawHistoryV2Request{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) String() string {
+func (x *GetWorkflowExecutionRawHistoryV2Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
 
-func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[39]
+func (x *GetWorkflowExecutionRawHistoryV2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2379,90 +2411,91 @@ func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrUpdateRemoteClusterRequest.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetWorkflowExecutionRawHistoryV2Request.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) GetFrontendAddress() string {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetNamespaceId() string {
 	if x != nil {
-		return x.FrontendAddress
+		return x.NamespaceId
 	}
 	return ""
 }
 
-func (x *AddOrUpdateRemoteClusterRequest) GetEnableRemoteClusterConnection() bool {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.EnableRemoteClusterConnection
+		return x.Execution
 	}
-	return false
+	return nil
 }
 
-// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
-func (x *AddOrUpdateRemoteClusterRequest) GetFrontendHttpAddress() string {
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() int64 {
 	if x != nil {
-		return x.FrontendHttpAddress
+		return x.StartEventId
 	}
-	return ""
+	return 0
 }
 
-type AddOrUpdateRemoteClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() int64 {
+	if x != nil {
+		return x.StartEventVersion
+	}
+	return 0
 }
 
-func (x *AddOrUpdateRemoteClusterResponse) Reset() {
-	*x = AddOrUpdateRemoteClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() int64 {
+	if x != nil {
+		return x.EndEventId
+	}
+	return 0
 }
 
-func (x *AddOrUpdateRemoteClusterResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() int64 {
+	if x != nil {
+		return x.EndEventVersion
+	}
+	return 0
+}
 
-func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[40]
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaximumPageSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AddOrUpdateRemoteClusterResponse.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{40}
+func (x *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
 }
 
-type RemoveRemoteClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetWorkflowExecutionRawHistoryV2Response struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RemoveRemoteClusterRequest) Reset() {
-	*x = RemoveRemoteClusterRequest{}
+func (x *GetWorkflowExecutionRawHistoryV2Response) Reset() {
+	*x = GetWorkflowExecutionRawHistoryV2Response{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRemoteClusterRequest) String() string {
+func (x *GetWorkflowExecutionRawHistoryV2Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRemoteClusterRequest) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
 
-func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWorkflowExecutionRawHistoryV2Response) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2474,88 +2507,68 @@ func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRemoteClusterRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkflowExecutionRawHistoryV2Response.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RemoveRemoteClusterRequest) GetClusterName() string {
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() []byte {
 	if x != nil {
-		return x.ClusterName
+		return x.NextPageToken
 	}
-	return ""
-}
-
-type RemoveRemoteClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RemoveRemoteClusterResponse) Reset() {
-	*x = RemoveRemoteClusterResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *RemoveRemoteClusterResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.DataBlob {
+	if x != nil {
+		return x.HistoryBatches
+	}
+	return nil
 }
 
-func (*RemoveRemoteClusterResponse) ProtoMessage() {}
-
-func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.VersionHistory {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.VersionHistory
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RemoveRemoteClusterResponse.ProtoReflect.Descriptor instead.
-func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{42}
+func (x *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
+	if x != nil {
+		return x.HistoryNodeIds
+	}
+	return nil
 }
 
-type ListClusterMembersRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// (-- api-linter: core::0140::prepositions=disabled
-	//
-	//	aip.dev/not-precedent: "within" is used to indicate a time range. --)
-	LastHeartbeatWithin *durationpb.Duration  `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3" json:"last_heartbeat_within,omitempty"`
-	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
-	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Role                v14.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
-	// (-- api-linter: core::0140::prepositions=disabled
-	//
-	//	aip.dev/not-precedent: "after" is used to indicate a time range. --)
-	SessionStartedAfterTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3" json:"session_started_after_time,omitempty"`
-	PageSize                int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken           []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type GetWorkflowExecutionRawHistoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution         *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	StartEventId      int64                  `protobuf:"varint,3,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartEventVersion int64                  `protobuf:"varint,4,opt,name=start_event_version,json=startEventVersion,proto3" json:"start_event_version,omitempty"`
+	EndEventId        int64                  `protobuf:"varint,5,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndEventVersion   int64                  `protobuf:"varint,6,opt,name=end_event_version,json=endEventVersion,proto3" json:"end_event_version,omitempty"`
+	MaximumPageSize   int32                  `protobuf:"varint,7,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken     []byte                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListClusterMembersRequest) Reset() {
-	*x = ListClusterMembersRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+func (x *GetWorkflowExecutionRawHistoryRequest) Reset() {
+	*x = GetWorkflowExecutionRawHistoryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClusterMembersRequest) String() string {
+func (x *GetWorkflowExecutionRawHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClusterMembersRequest) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryRequest) ProtoMessage() {}
 
-func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
+func (x *GetWorkflowExecutionRawHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2566,83 +2579,92 @@ func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClusterMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetWorkflowExecutionRawHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListClusterMembersRequest) GetLastHeartbeatWithin() *durationpb.Duration {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.LastHeartbeatWithin
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *GetWorkflowExecutionRawHistoryRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *ListClusterMembersRequest) GetRpcAddress() string {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventId() int64 {
 	if x != nil {
-		return x.RpcAddress
+		return x.StartEventId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListClusterMembersRequest) GetHostId() string {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetStartEventVersion() int64 {
 	if x != nil {
-		return x.HostId
+		return x.StartEventVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *ListClusterMembersRequest) GetRole() v14.ClusterMemberRole {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventId() int64 {
 	if x != nil {
-		return x.Role
+		return x.EndEventId
 	}
-	return v14.ClusterMemberRole(0)
+	return 0
 }
 
-func (x *ListClusterMembersRequest) GetSessionStartedAfterTime() *timestamppb.Timestamp {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetEndEventVersion() int64 {
 	if x != nil {
-		return x.SessionStartedAfterTime
+		return x.EndEventVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *ListClusterMembersRequest) GetPageSize() int32 {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.MaximumPageSize
 	}
 	return 0
 }
 
-func (x *ListClusterMembersRequest) GetNextPageToken() []byte {
+func (x *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() []byte {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return nil
 }
 
-type ListClusterMembersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveMembers []*v18.ClusterMember   `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetWorkflowExecutionRawHistoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken  []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HistoryBatches []*v1.DataBlob         `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
+	VersionHistory *v11.VersionHistory    `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	HistoryNodeIds []int64                `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListClusterMembersResponse) Reset() {
-	*x = ListClusterMembersResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+func (x *GetWorkflowExecutionRawHistoryResponse) Reset() {
+	*x = GetWorkflowExecutionRawHistoryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClusterMembersResponse) String() string {
+func (x *GetWorkflowExecutionRawHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClusterMembersResponse) ProtoMessage() {}
+func (*GetWorkflowExecutionRawHistoryResponse) ProtoMessage() {}
 
-func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+func (x *GetWorkflowExecutionRawHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2653,52 +2675,62 @@ func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClusterMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetWorkflowExecutionRawHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowExecutionRawHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
+func (x *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.ActiveMembers
+		return x.NextPageToken
 	}
 	return nil
 }
 
-func (x *ListClusterMembersResponse) GetNextPageToken() []byte {
+func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() []*v1.DataBlob {
 	if x != nil {
-		return x.NextPageToken
+		return x.HistoryBatches
 	}
 	return nil
 }
 
-type GetDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *GetWorkflowExecutionRawHistoryResponse) GetVersionHistory() *v11.VersionHistory {
+	if x != nil {
+		return x.VersionHistory
+	}
+	return nil
 }
 
-func (x *GetDLQMessagesRequest) Reset() {
-	*x = GetDLQMessagesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetWorkflowExecutionRawHistoryResponse) GetHistoryNodeIds() []int64 {
+	if x != nil {
+		return x.HistoryNodeIds
+	}
+	return nil
 }
 
-func (x *GetDLQMessagesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type GetReplicationMessagesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tokens        []*v16.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName   string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (*GetDLQMessagesRequest) ProtoMessage() {}
+func (x *GetReplicationMessagesRequest) Reset() {
+	*x = GetReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
+func (x *GetReplicationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationMessagesRequest) ProtoMessage() {}
+
+func (x *GetReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2709,77 +2741,96 @@ func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
+func (x *GetReplicationMessagesRequest) GetTokens() []*v16.ReplicationToken {
 	if x != nil {
-		return x.Type
+		return x.Tokens
 	}
-	return v14.DeadLetterQueueType(0)
+	return nil
 }
 
-func (x *GetDLQMessagesRequest) GetShardId() int32 {
+func (x *GetReplicationMessagesRequest) GetClusterName() string {
 	if x != nil {
-		return x.ShardId
+		return x.ClusterName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDLQMessagesRequest) GetSourceCluster() string {
-	if x != nil {
-		return x.SourceCluster
-	}
-	return ""
+type GetReplicationMessagesResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	ShardMessages map[int32]*v16.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
-	if x != nil {
-		return x.InclusiveEndMessageId
-	}
-	return 0
+func (x *GetReplicationMessagesResponse) Reset() {
+	*x = GetReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQMessagesRequest) GetMaximumPageSize() int32 {
+func (x *GetReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *GetReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[45]
 	if x != nil {
-		return x.MaximumPageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetDLQMessagesRequest) GetNextPageToken() []byte {
+// Deprecated: Use GetReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v16.ReplicationMessages {
 	if x != nil {
-		return x.NextPageToken
+		return x.ShardMessages
 	}
 	return nil
 }
 
-type GetDLQMessagesResponse struct {
-	state                protoimpl.MessageState     `protogen:"open.v1"`
-	Type                 v14.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ReplicationTasks     []*v15.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	ReplicationTasksInfo []*v15.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetNamespaceReplicationMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lastRetrievedMessageId is where the next fetch should begin with.
+	LastRetrievedMessageId int64 `protobuf:"varint,1,opt,name=last_retrieved_message_id,json=lastRetrievedMessageId,proto3" json:"last_retrieved_message_id,omitempty"`
+	// lastProcessedMessageId is the last messageId that is processed on the passive side.
+	// This can be different than lastRetrievedMessageId if passive side supports prefetching messages.
+	LastProcessedMessageId int64 `protobuf:"varint,2,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// clusterName is the name of the pulling cluster.
+	ClusterName   string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQMessagesResponse) Reset() {
-	*x = GetDLQMessagesResponse{}
+func (x *GetNamespaceReplicationMessagesRequest) Reset() {
+	*x = GetNamespaceReplicationMessagesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQMessagesResponse) String() string {
+func (x *GetNamespaceReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQMessagesResponse) ProtoMessage() {}
+func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetNamespaceReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2791,63 +2842,53 @@ func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNamespaceReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetDLQMessagesResponse) GetType() v14.DeadLetterQueueType {
-	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *GetDLQMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (x *GetNamespaceReplicationMessagesRequest) GetLastRetrievedMessageId() int64 {
 	if x != nil {
-		return x.ReplicationTasks
+		return x.LastRetrievedMessageId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDLQMessagesResponse) GetNextPageToken() []byte {
+func (x *GetNamespaceReplicationMessagesRequest) GetLastProcessedMessageId() int64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.LastProcessedMessageId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v15.ReplicationTaskInfo {
+func (x *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
 	if x != nil {
-		return x.ReplicationTasksInfo
+		return x.ClusterName
 	}
-	return nil
+	return ""
 }
 
-type PurgeDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetNamespaceReplicationMessagesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Messages      *v16.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQMessagesRequest) Reset() {
-	*x = PurgeDLQMessagesRequest{}
+func (x *GetNamespaceReplicationMessagesResponse) Reset() {
+	*x = GetNamespaceReplicationMessagesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQMessagesRequest) String() string {
+func (x *GetNamespaceReplicationMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQMessagesRequest) ProtoMessage() {}
+func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
 
-func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetNamespaceReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2859,59 +2900,39 @@ func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNamespaceReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PurgeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
-	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *PurgeDLQMessagesRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
-}
-
-func (x *PurgeDLQMessagesRequest) GetSourceCluster() string {
-	if x != nil {
-		return x.SourceCluster
-	}
-	return ""
-}
-
-func (x *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+func (x *GetNamespaceReplicationMessagesResponse) GetMessages() *v16.ReplicationMessages {
 	if x != nil {
-		return x.InclusiveEndMessageId
+		return x.Messages
 	}
-	return 0
+	return nil
 }
 
-type PurgeDLQMessagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetDLQReplicationMessagesRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	TaskInfos     []*v16.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQMessagesResponse) Reset() {
-	*x = PurgeDLQMessagesResponse{}
+func (x *GetDLQReplicationMessagesRequest) Reset() {
+	*x = GetDLQReplicationMessagesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQMessagesResponse) String() string {
+func (x *GetDLQReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQMessagesResponse) ProtoMessage() {}
+func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDLQReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2923,37 +2944,39 @@ func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDLQReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{48}
 }
 
-type MergeDLQMessagesRequest struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
-	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v16.ReplicationTaskInfo {
+	if x != nil {
+		return x.TaskInfos
+	}
+	return nil
 }
 
-func (x *MergeDLQMessagesRequest) Reset() {
-	*x = MergeDLQMessagesRequest{}
+type GetDLQReplicationMessagesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReplicationTasks []*v16.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDLQReplicationMessagesResponse) Reset() {
+	*x = GetDLQReplicationMessagesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQMessagesRequest) String() string {
+func (x *GetDLQReplicationMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQMessagesRequest) ProtoMessage() {}
+func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 
-func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDLQReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2965,74 +2988,42 @@ func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQMessagesRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDLQReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MergeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
+func (x *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v16.ReplicationTask {
 	if x != nil {
-		return x.Type
-	}
-	return v14.DeadLetterQueueType(0)
-}
-
-func (x *MergeDLQMessagesRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
+		return x.ReplicationTasks
 	}
-	return 0
+	return nil
 }
 
-func (x *MergeDLQMessagesRequest) GetSourceCluster() string {
-	if x != nil {
-		return x.SourceCluster
-	}
-	return ""
+// ReapplyEventsRequest is the request for reapply events API.
+type ReapplyEventsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId       string                 `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Events            *v1.DataBlob           `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *MergeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
-	if x != nil {
-		return x.InclusiveEndMessageId
-	}
-	return 0
-}
-
-func (x *MergeDLQMessagesRequest) GetMaximumPageSize() int32 {
-	if x != nil {
-		return x.MaximumPageSize
-	}
-	return 0
-}
-
-func (x *MergeDLQMessagesRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-type MergeDLQMessagesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NextPageToken []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MergeDLQMessagesResponse) Reset() {
-	*x = MergeDLQMessagesResponse{}
+func (x *ReapplyEventsRequest) Reset() {
+	*x = ReapplyEventsRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQMessagesResponse) String() string {
+func (x *ReapplyEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQMessagesResponse) ProtoMessage() {}
+func (*ReapplyEventsRequest) ProtoMessage() {}
 
-func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *ReapplyEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3044,40 +3035,52 @@ func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQMessagesResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReapplyEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+func (x *ReapplyEventsRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.WorkflowExecution
 	}
 	return nil
 }
 
-type RefreshWorkflowTasksRequest struct {
+func (x *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ReapplyEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshWorkflowTasksRequest) Reset() {
-	*x = RefreshWorkflowTasksRequest{}
+func (x *ReapplyEventsResponse) Reset() {
+	*x = ReapplyEventsResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshWorkflowTasksRequest) String() string {
+func (x *ReapplyEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
+func (*ReapplyEventsResponse) ProtoMessage() {}
 
-func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *ReapplyEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3089,45 +3092,35 @@ func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshWorkflowTasksRequest.ProtoReflect.Descriptor instead.
-func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReapplyEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RefreshWorkflowTasksRequest) GetNamespaceId() string {
-	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
-}
-
-func (x *RefreshWorkflowTasksRequest) GetExecution() *v1.WorkflowExecution {
-	if x != nil {
-		return x.Execution
-	}
-	return nil
-}
-
-type RefreshWorkflowTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AddSearchAttributesRequest struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	SearchAttributes map[string]v13.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
+	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RefreshWorkflowTasksResponse) Reset() {
-	*x = RefreshWorkflowTasksResponse{}
+func (x *AddSearchAttributesRequest) Reset() {
+	*x = AddSearchAttributesRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshWorkflowTasksResponse) String() string {
+func (x *AddSearchAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
+func (*AddSearchAttributesRequest) ProtoMessage() {}
 
-func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *AddSearchAttributesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3139,39 +3132,59 @@ func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshWorkflowTasksResponse.ProtoReflect.Descriptor instead.
-func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{52}
 }
 
-type ResendReplicationTasksRequest struct {
+func (x *AddSearchAttributesRequest) GetSearchAttributes() map[string]v13.IndexedValueType {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *AddSearchAttributesRequest) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *AddSearchAttributesRequest) GetSkipSchemaUpdate() bool {
+	if x != nil {
+		return x.SkipSchemaUpdate
+	}
+	return false
+}
+
+func (x *AddSearchAttributesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type AddSearchAttributesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	RemoteCluster string                 `protobuf:"bytes,4,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
-	StartEventId  int64                  `protobuf:"varint,5,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
-	StartVersion  int64                  `protobuf:"varint,6,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
-	EndEventId    int64                  `protobuf:"varint,7,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
-	EndVersion    int64                  `protobuf:"varint,8,opt,name=end_version,json=endVersion,proto3" json:"end_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksRequest) Reset() {
-	*x = ResendReplicationTasksRequest{}
+func (x *AddSearchAttributesResponse) Reset() {
+	*x = AddSearchAttributesResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksRequest) String() string {
+func (x *AddSearchAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendReplicationTasksRequest) ProtoMessage() {}
+func (*AddSearchAttributesResponse) ProtoMessage() {}
 
-func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *AddSearchAttributesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3183,88 +3196,92 @@ func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ResendReplicationTasksRequest) GetNamespaceId() string {
-	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
+type RemoveSearchAttributesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SearchAttributes []string               `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	IndexName        string                 `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksRequest) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
+func (x *RemoveSearchAttributesRequest) Reset() {
+	*x = RemoveSearchAttributesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksRequest) GetRunId() string {
-	if x != nil {
-		return x.RunId
-	}
-	return ""
+func (x *RemoveSearchAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ResendReplicationTasksRequest) GetRemoteCluster() string {
+func (*RemoveSearchAttributesRequest) ProtoMessage() {}
+
+func (x *RemoveSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
 	if x != nil {
-		return x.RemoteCluster
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ResendReplicationTasksRequest) GetStartEventId() int64 {
-	if x != nil {
-		return x.StartEventId
-	}
-	return 0
+// Deprecated: Use RemoveSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ResendReplicationTasksRequest) GetStartVersion() int64 {
+func (x *RemoveSearchAttributesRequest) GetSearchAttributes() []string {
 	if x != nil {
-		return x.StartVersion
+		return x.SearchAttributes
 	}
-	return 0
+	return nil
 }
 
-func (x *ResendReplicationTasksRequest) GetEndEventId() int64 {
+func (x *RemoveSearchAttributesRequest) GetIndexName() string {
 	if x != nil {
-		return x.EndEventId
+		return x.IndexName
 	}
-	return 0
+	return ""
 }
 
-func (x *ResendReplicationTasksRequest) GetEndVersion() int64 {
+func (x *RemoveSearchAttributesRequest) GetNamespace() string {
 	if x != nil {
-		return x.EndVersion
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-type ResendReplicationTasksResponse struct {
+type RemoveSearchAttributesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendReplicationTasksResponse) Reset() {
-	*x = ResendReplicationTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
+func (x *RemoveSearchAttributesResponse) Reset() {
+	*x = RemoveSearchAttributesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendReplicationTasksResponse) String() string {
+func (x *RemoveSearchAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendReplicationTasksResponse) ProtoMessage() {}
+func (*RemoveSearchAttributesResponse) ProtoMessage() {}
 
-func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[54]
+func (x *RemoveSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3275,40 +3292,34 @@ func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{54}
-}
-
-type GetTaskQueueTasksRequest struct {
+// Deprecated: Use RemoveSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{55}
+}
+
+type GetSearchAttributesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
-	MinTaskId     int64                  `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
-	MaxTaskId     int64                  `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
-	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueTasksRequest) Reset() {
-	*x = GetTaskQueueTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
+func (x *GetSearchAttributesRequest) Reset() {
+	*x = GetSearchAttributesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueTasksRequest) String() string {
+func (x *GetSearchAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueTasksRequest) ProtoMessage() {}
+func (*GetSearchAttributesRequest) ProtoMessage() {}
 
-func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[55]
+func (x *GetSearchAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3319,90 +3330,51 @@ func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use GetSearchAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetTaskQueueTasksRequest) GetNamespace() string {
+func (x *GetSearchAttributesRequest) GetIndexName() string {
 	if x != nil {
-		return x.Namespace
+		return x.IndexName
 	}
 	return ""
 }
 
-func (x *GetTaskQueueTasksRequest) GetTaskQueue() string {
+func (x *GetSearchAttributesRequest) GetNamespace() string {
 	if x != nil {
-		return x.TaskQueue
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetTaskQueueTasksRequest) GetTaskQueueType() v16.TaskQueueType {
-	if x != nil {
-		return x.TaskQueueType
-	}
-	return v16.TaskQueueType(0)
-}
-
-func (x *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
-	if x != nil {
-		return x.MinTaskId
-	}
-	return 0
-}
-
-func (x *GetTaskQueueTasksRequest) GetMaxTaskId() int64 {
-	if x != nil {
-		return x.MaxTaskId
-	}
-	return 0
-}
-
-func (x *GetTaskQueueTasksRequest) GetBatchSize() int32 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
-}
-
-func (x *GetTaskQueueTasksRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
-}
-
-func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
-	if x != nil {
-		return x.Subqueue
-	}
-	return 0
-}
-
-type GetTaskQueueTasksResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Tasks         []*v12.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetSearchAttributesResponse struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	CustomAttributes map[string]v13.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	SystemAttributes map[string]v13.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
+	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// State of the workflow that adds search attributes to the system.
+	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetTaskQueueTasksResponse) Reset() {
-	*x = GetTaskQueueTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
+func (x *GetSearchAttributesResponse) Reset() {
+	*x = GetSearchAttributesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskQueueTasksResponse) String() string {
+func (x *GetSearchAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskQueueTasksResponse) ProtoMessage() {}
+func (*GetSearchAttributesResponse) ProtoMessage() {}
 
-func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[56]
+func (x *GetSearchAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3413,98 +3385,60 @@ func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetSearchAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetTaskQueueTasksResponse) GetTasks() []*v12.AllocatedTaskInfo {
+func (x *GetSearchAttributesResponse) GetCustomAttributes() map[string]v13.IndexedValueType {
 	if x != nil {
-		return x.Tasks
+		return x.CustomAttributes
 	}
 	return nil
 }
 
-func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
+func (x *GetSearchAttributesResponse) GetSystemAttributes() map[string]v13.IndexedValueType {
 	if x != nil {
-		return x.NextPageToken
+		return x.SystemAttributes
 	}
 	return nil
 }
 
-type DeleteWorkflowExecutionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteWorkflowExecutionRequest) Reset() {
-	*x = DeleteWorkflowExecutionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteWorkflowExecutionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
-
-func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
+func (x *GetSearchAttributesResponse) GetMapping() map[string]string {
 	if x != nil {
-		return x.Namespace
+		return x.Mapping
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+func (x *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
 	if x != nil {
-		return x.Execution
+		return x.AddWorkflowExecutionInfo
 	}
 	return nil
 }
 
-type DeleteWorkflowExecutionResponse struct {
+type DescribeClusterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionResponse) Reset() {
-	*x = DeleteWorkflowExecutionResponse{}
+func (x *DescribeClusterRequest) Reset() {
+	*x = DescribeClusterRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionResponse) String() string {
+func (x *DescribeClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DescribeClusterRequest) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeClusterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3516,42 +3450,52 @@ func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeClusterRequest.ProtoReflect.Descriptor instead.
+func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+func (x *DescribeClusterRequest) GetClusterName() string {
 	if x != nil {
-		return x.Warnings
+		return x.ClusterName
 	}
-	return nil
+	return ""
 }
 
-type StreamWorkflowReplicationMessagesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
-	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DescribeClusterResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	SupportedClients         map[string]string      `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ServerVersion            string                 `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	MembershipInfo           *v18.MembershipInfo    `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
+	ClusterId                string                 `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName              string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	HistoryShardCount        int32                  `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
+	PersistenceStore         string                 `protobuf:"bytes,7,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
+	VisibilityStore          string                 `protobuf:"bytes,8,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
+	VersionInfo              *v19.VersionInfo       `protobuf:"bytes,9,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	FailoverVersionIncrement int64                  `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
+	InitialFailoverVersion   int64                  `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
+	IsGlobalNamespaceEnabled bool                   `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
+	Tags                     map[string]string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HttpAddress              string                 `protobuf:"bytes,14,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
-	*x = StreamWorkflowReplicationMessagesRequest{}
+func (x *DescribeClusterResponse) Reset() {
+	*x = DescribeClusterResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) String() string {
+func (x *DescribeClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+func (*DescribeClusterResponse) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeClusterResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3563,63 +3507,2159 @@ func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeClusterResponse.ProtoReflect.Descriptor instead.
+func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
+func (x *DescribeClusterResponse) GetSupportedClients() map[string]string {
 	if x != nil {
-		return x.Attributes
+		return x.SupportedClients
 	}
 	return nil
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
+func (x *DescribeClusterResponse) GetServerVersion() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
-			return x.SyncReplicationState
-		}
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
+	if x != nil {
+		return x.MembershipInfo
 	}
 	return nil
 }
 
-type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
-	isStreamWorkflowReplicationMessagesRequest_Attributes()
+func (x *DescribeClusterResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetHistoryShardCount() int32 {
+	if x != nil {
+		return x.HistoryShardCount
+	}
+	return 0
+}
+
+func (x *DescribeClusterResponse) GetPersistenceStore() string {
+	if x != nil {
+		return x.PersistenceStore
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetVisibilityStore() string {
+	if x != nil {
+		return x.VisibilityStore
+	}
+	return ""
+}
+
+func (x *DescribeClusterResponse) GetVersionInfo() *v19.VersionInfo {
+	if x != nil {
+		return x.VersionInfo
+	}
+	return nil
+}
+
+func (x *DescribeClusterResponse) GetFailoverVersionIncrement() int64 {
+	if x != nil {
+		return x.FailoverVersionIncrement
+	}
+	return 0
+}
+
+func (x *DescribeClusterResponse) GetInitialFailoverVersion() int64 {
+	if x != nil {
+		return x.InitialFailoverVersion
+	}
+	return 0
+}
+
+func (x *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
+	if x != nil {
+		return x.IsGlobalNamespaceEnabled
+	}
+	return false
+}
+
+func (x *DescribeClusterResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DescribeClusterResponse) GetHttpAddress() string {
+	if x != nil {
+		return x.HttpAddress
+	}
+	return ""
+}
+
+type ListClustersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersRequest) ProtoMessage() {}
+
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListClustersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClustersRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListClustersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*v14.ClusterMetadata `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListClustersResponse) GetClusters() []*v14.ClusterMetadata {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ListClustersResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type AddOrUpdateRemoteClusterRequest struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	FrontendAddress               string                 `protobuf:"bytes,1,opt,name=frontend_address,json=frontendAddress,proto3" json:"frontend_address,omitempty"`
+	EnableRemoteClusterConnection bool                   `protobuf:"varint,2,opt,name=enable_remote_cluster_connection,json=enableRemoteClusterConnection,proto3" json:"enable_remote_cluster_connection,omitempty"`
+	// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
+	FrontendHttpAddress string `protobuf:"bytes,3,opt,name=frontend_http_address,json=frontendHttpAddress,proto3" json:"frontend_http_address,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) Reset() {
+	*x = AddOrUpdateRemoteClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
+
+func (x *AddOrUpdateRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrUpdateRemoteClusterRequest.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) GetFrontendAddress() string {
+	if x != nil {
+		return x.FrontendAddress
+	}
+	return ""
+}
+
+func (x *AddOrUpdateRemoteClusterRequest) GetEnableRemoteClusterConnection() bool {
+	if x != nil {
+		return x.EnableRemoteClusterConnection
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in temporal/server/api/adminservice/v1/request_response.proto.
+func (x *AddOrUpdateRemoteClusterRequest) GetFrontendHttpAddress() string {
+	if x != nil {
+		return x.FrontendHttpAddress
+	}
+	return ""
+}
+
+type AddOrUpdateRemoteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrUpdateRemoteClusterResponse) Reset() {
+	*x = AddOrUpdateRemoteClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrUpdateRemoteClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
+
+func (x *AddOrUpdateRemoteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrUpdateRemoteClusterResponse.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
+}
+
+type RemoveRemoteClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRemoteClusterRequest) Reset() {
+	*x = RemoveRemoteClusterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRemoteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRemoteClusterRequest) ProtoMessage() {}
+
+func (x *RemoveRemoteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRemoteClusterRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RemoveRemoteClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type RemoveRemoteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRemoteClusterResponse) Reset() {
+	*x = RemoveRemoteClusterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRemoteClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRemoteClusterResponse) ProtoMessage() {}
+
+func (x *RemoveRemoteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRemoteClusterResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
+}
+
+type ListClusterMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//
+	//	aip.dev/not-precedent: "within" is used to indicate a time range. --)
+	LastHeartbeatWithin *durationpb.Duration  `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3" json:"last_heartbeat_within,omitempty"`
+	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
+	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Role                v12.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//
+	//	aip.dev/not-precedent: "after" is used to indicate a time range. --)
+	SessionStartedAfterTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3" json:"session_started_after_time,omitempty"`
+	PageSize                int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken           []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ListClusterMembersRequest) Reset() {
+	*x = ListClusterMembersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterMembersRequest) ProtoMessage() {}
+
+func (x *ListClusterMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListClusterMembersRequest) GetLastHeartbeatWithin() *durationpb.Duration {
+	if x != nil {
+		return x.LastHeartbeatWithin
+	}
+	return nil
+}
+
+func (x *ListClusterMembersRequest) GetRpcAddress() string {
+	if x != nil {
+		return x.RpcAddress
+	}
+	return ""
+}
+
+func (x *ListClusterMembersRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ListClusterMembersRequest) GetRole() v12.ClusterMemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return v12.ClusterMemberRole(0)
+}
+
+func (x *ListClusterMembersRequest) GetSessionStartedAfterTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionStartedAfterTime
+	}
+	return nil
+}
+
+func (x *ListClusterMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClusterMembersRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListClusterMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveMembers []*v18.ClusterMember   `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterMembersResponse) Reset() {
+	*x = ListClusterMembersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterMembersResponse) ProtoMessage() {}
+
+func (x *ListClusterMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return nil
+}
+
+func (x *ListClusterMembersResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v12.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetDLQMessagesRequest) Reset() {
+	*x = GetDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQMessagesRequest) ProtoMessage() {}
+
+func (x *GetDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetDLQMessagesRequest) GetType() v12.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v12.DeadLetterQueueType(0)
+}
+
+func (x *GetDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *GetDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *GetDLQMessagesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQMessagesResponse struct {
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	Type                 v12.DeadLetterQueueType    `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ReplicationTasks     []*v16.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ReplicationTasksInfo []*v16.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetDLQMessagesResponse) Reset() {
+	*x = GetDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQMessagesResponse) ProtoMessage() {}
+
+func (x *GetDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetDLQMessagesResponse) GetType() v12.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v12.DeadLetterQueueType(0)
+}
+
+func (x *GetDLQMessagesResponse) GetReplicationTasks() []*v16.ReplicationTask {
+	if x != nil {
+		return x.ReplicationTasks
+	}
+	return nil
+}
+
+func (x *GetDLQMessagesResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v16.ReplicationTaskInfo {
+	if x != nil {
+		return x.ReplicationTasksInfo
+	}
+	return nil
+}
+
+type PurgeDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v12.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PurgeDLQMessagesRequest) Reset() {
+	*x = PurgeDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQMessagesRequest) ProtoMessage() {}
+
+func (x *PurgeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PurgeDLQMessagesRequest) GetType() v12.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v12.DeadLetterQueueType(0)
+}
+
+func (x *PurgeDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *PurgeDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
+}
+
+type PurgeDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQMessagesResponse) Reset() {
+	*x = PurgeDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQMessagesResponse) ProtoMessage() {}
+
+func (x *PurgeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
+}
+
+type MergeDLQMessagesRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Type                  v12.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	MaximumPageSize       int32                   `protobuf:"varint,5,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken         []byte                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MergeDLQMessagesRequest) Reset() {
+	*x = MergeDLQMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQMessagesRequest) ProtoMessage() {}
+
+func (x *MergeDLQMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQMessagesRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MergeDLQMessagesRequest) GetType() v12.DeadLetterQueueType {
+	if x != nil {
+		return x.Type
+	}
+	return v12.DeadLetterQueueType(0)
+}
+
+func (x *MergeDLQMessagesRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *MergeDLQMessagesRequest) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *MergeDLQMessagesRequest) GetInclusiveEndMessageId() int64 {
+	if x != nil {
+		return x.InclusiveEndMessageId
+	}
+	return 0
+}
+
+func (x *MergeDLQMessagesRequest) GetMaximumPageSize() int32 {
+	if x != nil {
+		return x.MaximumPageSize
+	}
+	return 0
+}
+
+func (x *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type MergeDLQMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NextPageToken []byte                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQMessagesResponse) Reset() {
+	*x = MergeDLQMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQMessagesResponse) ProtoMessage() {}
+
+func (x *MergeDLQMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQMessagesResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type RefreshWorkflowTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshWorkflowTasksRequest) Reset() {
+	*x = RefreshWorkflowTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshWorkflowTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
+
+func (x *RefreshWorkflowTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshWorkflowTasksRequest.ProtoReflect.Descriptor instead.
+func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RefreshWorkflowTasksRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *RefreshWorkflowTasksRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+type RefreshWorkflowTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshWorkflowTasksResponse) Reset() {
+	*x = RefreshWorkflowTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshWorkflowTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
+
+func (x *RefreshWorkflowTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshWorkflowTasksResponse.ProtoReflect.Descriptor instead.
+func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+}
+
+type ResendReplicationTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RemoteCluster string                 `protobuf:"bytes,4,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	StartEventId  int64                  `protobuf:"varint,5,opt,name=start_event_id,json=startEventId,proto3" json:"start_event_id,omitempty"`
+	StartVersion  int64                  `protobuf:"varint,6,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
+	EndEventId    int64                  `protobuf:"varint,7,opt,name=end_event_id,json=endEventId,proto3" json:"end_event_id,omitempty"`
+	EndVersion    int64                  `protobuf:"varint,8,opt,name=end_version,json=endVersion,proto3" json:"end_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendReplicationTasksRequest) Reset() {
+	*x = ResendReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendReplicationTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendReplicationTasksRequest) ProtoMessage() {}
+
+func (x *ResendReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ResendReplicationTasksRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetRemoteCluster() string {
+	if x != nil {
+		return x.RemoteCluster
+	}
+	return ""
+}
+
+func (x *ResendReplicationTasksRequest) GetStartEventId() int64 {
+	if x != nil {
+		return x.StartEventId
+	}
+	return 0
+}
+
+func (x *ResendReplicationTasksRequest) GetStartVersion() int64 {
+	if x != nil {
+		return x.StartVersion
+	}
+	return 0
+}
+
+func (x *ResendReplicationTasksRequest) GetEndEventId() int64 {
+	if x != nil {
+		return x.EndEventId
+	}
+	return 0
+}
+
+func (x *ResendReplicationTasksRequest) GetEndVersion() int64 {
+	if x != nil {
+		return x.EndVersion
+	}
+	return 0
+}
+
+type ResendReplicationTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendReplicationTasksResponse) Reset() {
+	*x = ResendReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendReplicationTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendReplicationTasksResponse) ProtoMessage() {}
+
+func (x *ResendReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
+}
+
+type GetTaskQueueTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v13.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	MinTaskId     int64                  `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
+	MaxTaskId     int64                  `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskQueueTasksRequest) Reset() {
+	*x = GetTaskQueueTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskQueueTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskQueueTasksRequest) ProtoMessage() {}
+
+func (x *GetTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetTaskQueueTasksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetTaskQueueTasksRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *GetTaskQueueTasksRequest) GetTaskQueueType() v13.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v13.TaskQueueType(0)
+}
+
+func (x *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
+	if x != nil {
+		return x.MinTaskId
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetMaxTaskId() int64 {
+	if x != nil {
+		return x.MaxTaskId
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *GetTaskQueueTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+type GetTaskQueueTasksResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Tasks         []*v14.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskQueueTasksResponse) Reset() {
+	*x = GetTaskQueueTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskQueueTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskQueueTasksResponse) ProtoMessage() {}
+
+func (x *GetTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetTaskQueueTasksResponse) GetTasks() []*v14.AllocatedTaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkflowExecutionRequest) Reset() {
+	*x = DeleteWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkflowExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+
+func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkflowExecutionResponse) Reset() {
+	*x = DeleteWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkflowExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+
+func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type StreamWorkflowReplicationMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
+	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
+	*x = StreamWorkflowReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+
+func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v16.SyncReplicationState {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
+			return x.SyncReplicationState
+		}
+	}
+	return nil
+}
+
+type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
+	isStreamWorkflowReplicationMessagesRequest_Attributes()
 }
 
 type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
-	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
+	SyncReplicationState *v16.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
+}
+
+func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+}
+
+type StreamWorkflowReplicationMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesResponse_Messages
+	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
+	*x = StreamWorkflowReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v16.WorkflowReplicationMessages {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
+			return x.Messages
+		}
+	}
+	return nil
+}
+
+type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
+	isStreamWorkflowReplicationMessagesResponse_Attributes()
+}
+
+type StreamWorkflowReplicationMessagesResponse_Messages struct {
+	Messages *v16.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
+}
+
+func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+}
+
+type GetNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*GetNamespaceRequest_Namespace
+	//	*GetNamespaceRequest_Id
+	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
+			return x.Namespace
+		}
+	}
+	return ""
+}
+
+func (x *GetNamespaceRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+type isGetNamespaceRequest_Attributes interface {
+	isGetNamespaceRequest_Attributes()
+}
+
+type GetNamespaceRequest_Namespace struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
+}
+
+type GetNamespaceRequest_Id struct {
+	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
+}
+
+func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
+
+func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
+
+type GetNamespaceResponse struct {
+	state             protoimpl.MessageState           `protogen:"open.v1"`
+	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceResponse) ProtoMessage() {}
+
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
+	if x != nil {
+		return x.ReplicationConfig
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetConfigVersion() int64 {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+	if x != nil {
+		return x.FailoverVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
+	if x != nil {
+		return x.FailoverHistory
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+	if x != nil {
+		return x.IsGlobalNamespace
+	}
+	return false
+}
+
+type GetDLQTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	// page_size must be positive. Up to this many tasks will be returned.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQTasksRequest) Reset() {
+	*x = GetDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQTasksRequest) ProtoMessage() {}
+
+func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *GetDLQTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQTasksResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
+	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
+	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
+	// there are more results.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQTasksResponse) Reset() {
+	*x = GetDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQTasksResponse) ProtoMessage() {}
+
+func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+	if x != nil {
+		return x.DlqTasks
+	}
+	return nil
+}
+
+func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type PurgeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PurgeDLQTasksRequest) Reset() {
+	*x = PurgeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQTasksRequest) ProtoMessage() {}
+
+func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
 }
 
-type StreamWorkflowReplicationMessagesResponse struct {
+func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.InclusiveMaxTaskMetadata
+	}
+	return nil
+}
+
+type PurgeDLQTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesResponse_Messages
-	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+	// job_token is a token that can be used to query the status of the purge operation.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQTasksResponse) Reset() {
+	*x = PurgeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQTasksResponse) ProtoMessage() {}
+
+func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
+type DLQJobToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQJobToken) Reset() {
+	*x = DLQJobToken{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQJobToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQJobToken) ProtoMessage() {}
+
+func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
+func (*DLQJobToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DLQJobToken) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *DLQJobToken) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type MergeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
+	// - If this is negative, an error will be returned.
+	// - If this is 0, the default will be used.
+	// - If this is greater than the maximum allowed batch size, an error will be returned.
+	// - Otherwise, the specified batch size will be used.
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksRequest) Reset() {
+	*x = MergeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQTasksRequest) ProtoMessage() {}
+
+func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.InclusiveMaxTaskMetadata
+	}
+	return nil
+}
+
+func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type MergeDLQTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksResponse) Reset() {
+	*x = MergeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQTasksResponse) ProtoMessage() {}
+
+func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+type DescribeDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
-	*x = StreamWorkflowReplicationMessagesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+func (x *DescribeDLQJobRequest) Reset() {
+	*x = DescribeDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDLQJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDLQJobRequest) ProtoMessage() {}
+
+func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+type DescribeDLQJobResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	OperationType  v12.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
+	OperationState v12.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
+	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
+	// last_processed_message_id is the ID of the last message that has been processed.
+	// For PurgeDLQTasks job, it the ID of the last message that was purged.
+	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
+	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
+	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeDLQJobResponse) Reset() {
+	*x = DescribeDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+func (x *DescribeDLQJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+func (*DescribeDLQJobResponse) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
+func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,64 +5670,92 @@ func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
+func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
 	if x != nil {
-		return x.Attributes
+		return x.DlqKey
 	}
 	return nil
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v15.WorkflowReplicationMessages {
+func (x *DescribeDLQJobResponse) GetOperationType() v12.DLQOperationType {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
-			return x.Messages
-		}
+		return x.OperationType
+	}
+	return v12.DLQOperationType(0)
+}
+
+func (x *DescribeDLQJobResponse) GetOperationState() v12.DLQOperationState {
+	if x != nil {
+		return x.OperationState
+	}
+	return v12.DLQOperationState(0)
+}
+
+func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
 	return nil
 }
 
-type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
-	isStreamWorkflowReplicationMessagesResponse_Attributes()
+func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-type StreamWorkflowReplicationMessagesResponse_Messages struct {
-	Messages *v15.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
+func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
+	if x != nil {
+		return x.MaxMessageId
+	}
+	return 0
 }
 
-func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
+	if x != nil {
+		return x.LastProcessedMessageId
+	}
+	return 0
 }
 
-type GetNamespaceRequest struct {
+func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
+	if x != nil {
+		return x.MessagesProcessed
+	}
+	return 0
+}
+
+type CancelDLQJobRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*GetNamespaceRequest_Namespace
-	//	*GetNamespaceRequest_Id
-	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
+	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	// The reason for cancellation.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceRequest) Reset() {
-	*x = GetNamespaceRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+func (x *CancelDLQJobRequest) Reset() {
+	*x = CancelDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceRequest) String() string {
+func (x *CancelDLQJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceRequest) ProtoMessage() {}
+func (*CancelDLQJobRequest) ProtoMessage() {}
 
-func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
+func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3698,80 +5766,49 @@ func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
+func (x *CancelDLQJobRequest) GetJobToken() []byte {
 	if x != nil {
-		return x.Attributes
+		return x.JobToken
 	}
 	return nil
 }
 
-func (x *GetNamespaceRequest) GetNamespace() string {
-	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
-			return x.Namespace
-		}
-	}
-	return ""
-}
-
-func (x *GetNamespaceRequest) GetId() string {
+func (x *CancelDLQJobRequest) GetReason() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
-			return x.Id
-		}
+		return x.Reason
 	}
 	return ""
 }
 
-type isGetNamespaceRequest_Attributes interface {
-	isGetNamespaceRequest_Attributes()
-}
-
-type GetNamespaceRequest_Namespace struct {
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
-}
-
-type GetNamespaceRequest_Id struct {
-	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
-}
-
-func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
-
-func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
-
-type GetNamespaceResponse struct {
-	state             protoimpl.MessageState           `protogen:"open.v1"`
-	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
-	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
-	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
-	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CancelDLQJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is true if the workflow was successfully terminated by this request and false if
+	// the workflow was already completed or terminated.
+	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceResponse) Reset() {
-	*x = GetNamespaceResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *CancelDLQJobResponse) Reset() {
+	*x = CancelDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceResponse) String() string {
+func (x *CancelDLQJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceResponse) ProtoMessage() {}
+func (*CancelDLQJobResponse) ProtoMessage() {}
 
-func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3782,85 +5819,94 @@ func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+func (x *CancelDLQJobResponse) GetCanceled() bool {
 	if x != nil {
-		return x.Info
+		return x.Canceled
 	}
-	return nil
+	return false
 }
 
-func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
+// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
+// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
+type AddTasksRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
-	if x != nil {
-		return x.ReplicationConfig
-	}
-	return nil
+func (x *AddTasksRequest) Reset() {
+	*x = AddTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceResponse) GetConfigVersion() int64 {
-	if x != nil {
-		return x.ConfigVersion
-	}
-	return 0
+func (x *AddTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+func (*AddTasksRequest) ProtoMessage() {}
+
+func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
 	if x != nil {
-		return x.FailoverVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
+// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AddTasksRequest) GetShardId() int32 {
 	if x != nil {
-		return x.FailoverHistory
+		return x.ShardId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
 	if x != nil {
-		return x.IsGlobalNamespace
+		return x.Tasks
 	}
-	return false
+	return nil
 }
 
-type GetDLQTasksRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	// page_size must be positive. Up to this many tasks will be returned.
-	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type AddTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *GetDLQTasksRequest) Reset() {
-	*x = GetDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+
+func (x *AddTasksResponse) Reset() {
+	*x = AddTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksRequest) String() string {
+func (x *AddTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksRequest) ProtoMessage() {}
+func (*AddTasksResponse) ProtoMessage() {}
 
-func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3871,58 +5917,35 @@ func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
-}
-
-func (x *GetDLQTasksRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return nil
+// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
+func (*AddTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
 }
 
-type GetDLQTasksResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
-	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
-	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
-	// there are more results.
-	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksResponse) Reset() {
-	*x = GetDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksResponse) String() string {
+func (x *ListQueuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksResponse) ProtoMessage() {}
+func (*ListQueuesRequest) ProtoMessage() {}
 
-func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3933,48 +5956,55 @@ func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+func (x *ListQueuesRequest) GetQueueType() int32 {
 	if x != nil {
-		return x.DlqTasks
+		return x.QueueType
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+func (x *ListQueuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQueuesRequest) GetNextPageToken() []byte {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return nil
 }
 
-type PurgeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksRequest) Reset() {
-	*x = PurgeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksRequest) String() string {
+func (x *ListQueuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksRequest) ProtoMessage() {}
+func (*ListQueuesResponse) ProtoMessage() {}
 
-func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3985,48 +6015,46 @@ func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
 	if x != nil {
-		return x.DlqKey
+		return x.Queues
 	}
 	return nil
 }
 
-func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *ListQueuesResponse) GetNextPageToken() []byte {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type PurgeDLQTasksResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// job_token is a token that can be used to query the status of the purge operation.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+type DeepHealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksResponse) Reset() {
-	*x = PurgeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *DeepHealthCheckRequest) Reset() {
+	*x = DeepHealthCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksResponse) String() string {
+func (x *DeepHealthCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksResponse) ProtoMessage() {}
+func (*DeepHealthCheckRequest) ProtoMessage() {}
 
-func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4037,42 +6065,33 @@ func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
-	if x != nil {
-		return x.JobToken
-	}
-	return nil
+// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
 }
 
-// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
-type DLQJobToken struct {
+type DeepHealthCheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	State         v12.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DLQJobToken) Reset() {
-	*x = DLQJobToken{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *DeepHealthCheckResponse) Reset() {
+	*x = DeepHealthCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DLQJobToken) String() string {
+func (x *DeepHealthCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DLQJobToken) ProtoMessage() {}
+func (*DeepHealthCheckResponse) ProtoMessage() {}
 
-func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4083,54 +6102,44 @@ func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
-func (*DLQJobToken) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *DLQJobToken) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
+// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *DLQJobToken) GetRunId() string {
+func (x *DeepHealthCheckResponse) GetState() v12.HealthState {
 	if x != nil {
-		return x.RunId
+		return x.State
 	}
-	return ""
+	return v12.HealthState(0)
 }
 
-type MergeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
-	// - If this is negative, an error will be returned.
-	// - If this is 0, the default will be used.
-	// - If this is greater than the maximum allowed batch size, an error will be returned.
-	// - Otherwise, the specified batch size will be used.
-	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SyncWorkflowStateRequest struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	VersionedTransition *v14.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
+	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *MergeDLQTasksRequest) Reset() {
-	*x = MergeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *SyncWorkflowStateRequest) Reset() {
+	*x = SyncWorkflowStateRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQTasksRequest) String() string {
+func (x *SyncWorkflowStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQTasksRequest) ProtoMessage() {}
+func (*SyncWorkflowStateRequest) ProtoMessage() {}
 
-func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4141,54 +6150,68 @@ func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
 	if x != nil {
-		return x.DlqKey
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
 	}
 	return nil
 }
 
-func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v14.VersionedTransition {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.VersionedTransition
 	}
 	return nil
 }
 
-func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
 	if x != nil {
-		return x.BatchSize
+		return x.VersionHistories
+	}
+	return nil
+}
+
+func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
+	if x != nil {
+		return x.TargetClusterId
 	}
 	return 0
 }
 
-type MergeDLQTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SyncWorkflowStateResponse struct {
+	state                       protoimpl.MessageState           `protogen:"open.v1"`
+	VersionedTransitionArtifact *v16.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *MergeDLQTasksResponse) Reset() {
-	*x = MergeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *SyncWorkflowStateResponse) Reset() {
+	*x = SyncWorkflowStateResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQTasksResponse) String() string {
+func (x *SyncWorkflowStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQTasksResponse) ProtoMessage() {}
+func (*SyncWorkflowStateResponse) ProtoMessage() {}
 
-func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4199,41 +6222,41 @@ func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v16.VersionedTransitionArtifact {
 	if x != nil {
-		return x.JobToken
+		return x.VersionedTransitionArtifact
 	}
 	return nil
 }
 
-type DescribeDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+type GenerateLastHistoryReplicationTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobRequest) Reset() {
-	*x = DescribeDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
+	*x = GenerateLastHistoryReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobRequest) String() string {
+func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobRequest) ProtoMessage() {}
+func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 
-func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4244,52 +6267,48 @@ func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.JobToken
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
 	}
 	return nil
 }
 
-type DescribeDLQJobResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	OperationType  v14.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
-	OperationState v14.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
-	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
-	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
-	// last_processed_message_id is the ID of the last message that has been processed.
-	// For PurgeDLQTasks job, it the ID of the last message that was purged.
-	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
-	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
-	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GenerateLastHistoryReplicationTasksResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
+	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobResponse) Reset() {
-	*x = DescribeDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
+	*x = GenerateLastHistoryReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobResponse) String() string {
+func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobResponse) ProtoMessage() {}
+func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 
-func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4300,92 +6319,50 @@ func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
-	if x != nil {
-		return x.DlqKey
-	}
-	return nil
-}
-
-func (x *DescribeDLQJobResponse) GetOperationType() v14.DLQOperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return v14.DLQOperationType(0)
-}
-
-func (x *DescribeDLQJobResponse) GetOperationState() v14.DLQOperationState {
-	if x != nil {
-		return x.OperationState
-	}
-	return v14.DLQOperationState(0)
-}
-
-func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
-}
-
-func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
-	if x != nil {
-		return x.MaxMessageId
-	}
-	return 0
+// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
+func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
 	if x != nil {
-		return x.LastProcessedMessageId
+		return x.StateTransitionCount
 	}
 	return 0
 }
 
-func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
+func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
 	if x != nil {
-		return x.MessagesProcessed
+		return x.HistoryLength
 	}
 	return 0
 }
 
-type CancelDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
-	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	// The reason for cancellation.
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+type DescribeTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Absent means unversioned queue. Ignored for sticky partitions.
+	BuildIds      *v114.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobRequest) Reset() {
-	*x = CancelDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *DescribeTaskQueuePartitionRequest) Reset() {
+	*x = DescribeTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobRequest) String() string {
+func (x *DescribeTaskQueuePartitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobRequest) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
 
-func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4396,49 +6373,57 @@ func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *CancelDLQJobRequest) GetJobToken() []byte {
+func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
 	if x != nil {
-		return x.JobToken
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
 	}
 	return nil
 }
 
-func (x *CancelDLQJobRequest) GetReason() string {
+func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v114.TaskQueueVersionSelection {
 	if x != nil {
-		return x.Reason
+		return x.BuildIds
 	}
-	return ""
+	return nil
 }
 
-type CancelDLQJobResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is true if the workflow was successfully terminated by this request and false if
-	// the workflow was already completed or terminated.
-	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type InternalTaskQueueStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReadLevel        int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	AckLevel         int64                  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	TaskIdBlock      *v114.TaskIdBlock      `protobuf:"bytes,3,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
+	ReadBufferLength int64                  `protobuf:"varint,4,opt,name=read_buffer_length,json=readBufferLength,proto3" json:"read_buffer_length,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobResponse) Reset() {
-	*x = CancelDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *InternalTaskQueueStatus) Reset() {
+	*x = InternalTaskQueueStatus{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobResponse) String() string {
+func (x *InternalTaskQueueStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobResponse) ProtoMessage() {}
+func (*InternalTaskQueueStatus) ProtoMessage() {}
 
-func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4449,44 +6434,62 @@ func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
+func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CancelDLQJobResponse) GetCanceled() bool {
+func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
 	if x != nil {
-		return x.Canceled
+		return x.ReadLevel
 	}
-	return false
+	return 0
 }
 
-// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
-// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
-// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
-type AddTasksRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InternalTaskQueueStatus) GetAckLevel() int64 {
+	if x != nil {
+		return x.AckLevel
+	}
+	return 0
 }
 
-func (x *AddTasksRequest) Reset() {
-	*x = AddTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *InternalTaskQueueStatus) GetTaskIdBlock() *v114.TaskIdBlock {
+	if x != nil {
+		return x.TaskIdBlock
+	}
+	return nil
+}
+
+func (x *InternalTaskQueueStatus) GetReadBufferLength() int64 {
+	if x != nil {
+		return x.ReadBufferLength
+	}
+	return 0
+}
+
+type DescribeTaskQueuePartitionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
+	VersionsInfoInternal map[string]*v113.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DescribeTaskQueuePartitionResponse) Reset() {
+	*x = DescribeTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest) String() string {
+func (x *DescribeTaskQueuePartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
 
-func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4497,46 +6500,41 @@ func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *AddTasksRequest) GetShardId() int32 {
-	if x != nil {
-		return x.ShardId
-	}
-	return 0
+// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
+func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v113.TaskQueueVersionInfoInternal {
 	if x != nil {
-		return x.Tasks
+		return x.VersionsInfoInternal
 	}
 	return nil
 }
 
-type AddTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ForceUnloadTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *AddTasksResponse) Reset() {
-	*x = AddTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
+	*x = ForceUnloadTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksResponse) String() string {
+func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksResponse) ProtoMessage() {}
+func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
 
-func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4547,35 +6545,47 @@ func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
-func (*AddTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
 }
 
-type ListQueuesRequest struct {
+func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
+	}
+	return nil
+}
+
+type ForceUnloadTaskQueuePartitionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesRequest) Reset() {
-	*x = ListQueuesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
+	*x = ForceUnloadTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesRequest) String() string {
+func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesRequest) ProtoMessage() {}
+func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
 
-func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4586,55 +6596,45 @@ func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *ListQueuesRequest) GetQueueType() int32 {
-	if x != nil {
-		return x.QueueType
-	}
-	return 0
-}
-
-func (x *ListQueuesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *ListQueuesRequest) GetNextPageToken() []byte {
+func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.WasLoaded
 	}
-	return nil
+	return false
 }
 
-type ListQueuesResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
-	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PauseTaskQueueRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Task queue types to pause. Empty means workflow and activity.
+	TaskQueueTypes []v13.TaskQueueType `protobuf:"varint,3,rep,packed,name=task_queue_types,json=taskQueueTypes,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_types,omitempty"`
+	Reason         string              `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity       string              `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse) Reset() {
-	*x = ListQueuesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *PauseTaskQueueRequest) Reset() {
+	*x = PauseTaskQueueRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse) String() string {
+func (x *PauseTaskQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse) ProtoMessage() {}
+func (*PauseTaskQueueRequest) ProtoMessage() {}
 
-func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *PauseTaskQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4645,83 +6645,67 @@ func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use PauseTaskQueueRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskQueueRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
+func (x *PauseTaskQueueRequest) GetNamespace() string {
 	if x != nil {
-		return x.Queues
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *ListQueuesResponse) GetNextPageToken() []byte {
+func (x *PauseTaskQueueRequest) GetTaskQueue() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.TaskQueue
 	}
-	return nil
-}
-
-type DeepHealthCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeepHealthCheckRequest) Reset() {
-	*x = DeepHealthCheckRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *DeepHealthCheckRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PauseTaskQueueRequest) GetTaskQueueTypes() []v13.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueTypes
+	}
+	return nil
 }
 
-func (*DeepHealthCheckRequest) ProtoMessage() {}
-
-func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *PauseTaskQueueRequest) GetReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Reason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+func (x *PauseTaskQueueRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
 }
 
-type DeepHealthCheckResponse struct {
+type PauseTaskQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         v14.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckResponse) Reset() {
-	*x = DeepHealthCheckResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *PauseTaskQueueResponse) Reset() {
+	*x = PauseTaskQueueResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckResponse) String() string {
+func (x *PauseTaskQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckResponse) ProtoMessage() {}
+func (*PauseTaskQueueResponse) ProtoMessage() {}
 
-func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *PauseTaskQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4732,44 +6716,37 @@ func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *DeepHealthCheckResponse) GetState() v14.HealthState {
-	if x != nil {
-		return x.State
-	}
-	return v14.HealthState(0)
+// Deprecated: Use PauseTaskQueueResponse.ProtoReflect.Descriptor instead.
+func (*PauseTaskQueueResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
 }
 
-type SyncWorkflowStateRequest struct {
-	state               protoimpl.MessageState   `protogen:"open.v1"`
-	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	VersionedTransition *v12.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
-	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ResumeTaskQueueRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Task queue types to resume. Empty means workflow and activity.
+	TaskQueueTypes []v13.TaskQueueType `protobuf:"varint,3,rep,packed,name=task_queue_types,json=taskQueueTypes,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_types,omitempty"`
+	Identity       string              `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateRequest) Reset() {
-	*x = SyncWorkflowStateRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *ResumeTaskQueueRequest) Reset() {
+	*x = ResumeTaskQueueRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateRequest) String() string {
+func (x *ResumeTaskQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateRequest) ProtoMessage() {}
+func (*ResumeTaskQueueRequest) ProtoMessage() {}
 
-func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *ResumeTaskQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,68 +6757,108 @@ func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use ResumeTaskQueueRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskQueueRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
+func (x *ResumeTaskQueueRequest) GetNamespace() string {
 	if x != nil {
-		return x.NamespaceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
+func (x *ResumeTaskQueueRequest) GetTaskQueue() string {
 	if x != nil {
-		return x.Execution
+		return x.TaskQueue
 	}
-	return nil
+	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v12.VersionedTransition {
+func (x *ResumeTaskQueueRequest) GetTaskQueueTypes() []v13.TaskQueueType {
 	if x != nil {
-		return x.VersionedTransition
+		return x.TaskQueueTypes
 	}
 	return nil
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+func (x *ResumeTaskQueueRequest) GetIdentity() string {
 	if x != nil {
-		return x.VersionHistories
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
+type ResumeTaskQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTaskQueueResponse) Reset() {
+	*x = ResumeTaskQueueResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTaskQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTaskQueueResponse) ProtoMessage() {}
+
+func (x *ResumeTaskQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
 	if x != nil {
-		return x.TargetClusterId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type SyncWorkflowStateResponse struct {
-	state                       protoimpl.MessageState           `protogen:"open.v1"`
-	VersionedTransitionArtifact *v15.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+// Deprecated: Use ResumeTaskQueueResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTaskQueueResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{115}
+}
+
+type PurgeTaskQueueTasksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Task queue types to purge. Empty means workflow and activity.
+	TaskQueueTypes []v13.TaskQueueType `protobuf:"varint,3,rep,packed,name=task_queue_types,json=taskQueueTypes,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_types,omitempty"`
+	// At least one of workflow_id_prefix, type_name and min_age must be set. Tasks matching all of
+	// them are deleted.
+	WorkflowIdPrefix string `protobuf:"bytes,4,opt,name=workflow_id_prefix,json=workflowIdPrefix,proto3" json:"workflow_id_prefix,omitempty"`
+	// Workflow type of workflow tasks or activity type of activity tasks.
+	TypeName      string               `protobuf:"bytes,5,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	MinAge        *durationpb.Duration `protobuf:"bytes,6,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	Reason        string               `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string               `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateResponse) Reset() {
-	*x = SyncWorkflowStateResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *PurgeTaskQueueTasksRequest) Reset() {
+	*x = PurgeTaskQueueTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateResponse) String() string {
+func (x *PurgeTaskQueueTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateResponse) ProtoMessage() {}
+func (*PurgeTaskQueueTasksRequest) ProtoMessage() {}
 
-func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *PurgeTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4852,93 +6869,88 @@ func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use PurgeTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v15.VersionedTransitionArtifact {
+func (x *PurgeTaskQueueTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.VersionedTransitionArtifact
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type GenerateLastHistoryReplicationTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PurgeTaskQueueTasksRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
-	*x = GenerateLastHistoryReplicationTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PurgeTaskQueueTasksRequest) GetTaskQueueTypes() []v13.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueTypes
+	}
+	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PurgeTaskQueueTasksRequest) GetWorkflowIdPrefix() string {
+	if x != nil {
+		return x.WorkflowIdPrefix
+	}
+	return ""
 }
 
-func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
-
-func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+func (x *PurgeTaskQueueTasksRequest) GetTypeName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TypeName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+func (x *PurgeTaskQueueTasksRequest) GetMinAge() *durationpb.Duration {
+	if x != nil {
+		return x.MinAge
+	}
+	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
+func (x *PurgeTaskQueueTasksRequest) GetReason() string {
 	if x != nil {
-		return x.Namespace
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
+func (x *PurgeTaskQueueTasksRequest) GetIdentity() string {
 	if x != nil {
-		return x.Execution
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-type GenerateLastHistoryReplicationTasksResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
-	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type PurgeTaskQueueTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
-	*x = GenerateLastHistoryReplicationTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+func (x *PurgeTaskQueueTasksResponse) Reset() {
+	*x = PurgeTaskQueueTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
+func (x *PurgeTaskQueueTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
+func (*PurgeTaskQueueTasksResponse) ProtoMessage() {}
 
-func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+func (x *PurgeTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4949,50 +6961,36 @@ func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
-	if x != nil {
-		return x.StateTransitionCount
-	}
-	return 0
-}
-
-func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
-	if x != nil {
-		return x.HistoryLength
-	}
-	return 0
+// Deprecated: Use PurgeTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117}
 }
 
-type DescribeTaskQueuePartitionRequest struct {
+type DescribeTaskQueueBacklogRequest struct {
 	state              protoimpl.MessageState   `protogen:"open.v1"`
 	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	// Absent means unversioned queue. Ignored for sticky partitions.
-	BuildIds      *v114.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	// Maximum number of tasks read for the summary. Defaults to 10000.
+	MaxTasks      int32 `protobuf:"varint,3,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionRequest) Reset() {
-	*x = DescribeTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *DescribeTaskQueueBacklogRequest) Reset() {
+	*x = DescribeTaskQueueBacklogRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionRequest) String() string {
+func (x *DescribeTaskQueueBacklogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
+func (*DescribeTaskQueueBacklogRequest) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *DescribeTaskQueueBacklogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5003,57 +7001,54 @@ func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use DescribeTaskQueueBacklogRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueueBacklogRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
+func (x *DescribeTaskQueueBacklogRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+func (x *DescribeTaskQueueBacklogRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
 	if x != nil {
 		return x.TaskQueuePartition
 	}
 	return nil
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v114.TaskQueueVersionSelection {
+func (x *DescribeTaskQueueBacklogRequest) GetMaxTasks() int32 {
 	if x != nil {
-		return x.BuildIds
+		return x.MaxTasks
 	}
-	return nil
+	return 0
 }
 
-type InternalTaskQueueStatus struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReadLevel        int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
-	AckLevel         int64                  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
-	TaskIdBlock      *v114.TaskIdBlock      `protobuf:"bytes,3,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
-	ReadBufferLength int64                  `protobuf:"varint,4,opt,name=read_buffer_length,json=readBufferLength,proto3" json:"read_buffer_length,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DescribeTaskQueueBacklogResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Summary       *v113.TaskQueueBacklogSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalTaskQueueStatus) Reset() {
-	*x = InternalTaskQueueStatus{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *DescribeTaskQueueBacklogResponse) Reset() {
+	*x = DescribeTaskQueueBacklogResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalTaskQueueStatus) String() string {
+func (x *DescribeTaskQueueBacklogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalTaskQueueStatus) ProtoMessage() {}
+func (*DescribeTaskQueueBacklogResponse) ProtoMessage() {}
 
-func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *DescribeTaskQueueBacklogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5064,62 +7059,42 @@ func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
-func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
-	if x != nil {
-		return x.ReadLevel
-	}
-	return 0
-}
-
-func (x *InternalTaskQueueStatus) GetAckLevel() int64 {
-	if x != nil {
-		return x.AckLevel
-	}
-	return 0
+// Deprecated: Use DescribeTaskQueueBacklogResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueueBacklogResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *InternalTaskQueueStatus) GetTaskIdBlock() *v114.TaskIdBlock {
+func (x *DescribeTaskQueueBacklogResponse) GetSummary() *v113.TaskQueueBacklogSummary {
 	if x != nil {
-		return x.TaskIdBlock
+		return x.Summary
 	}
 	return nil
 }
 
-func (x *InternalTaskQueueStatus) GetReadBufferLength() int64 {
-	if x != nil {
-		return x.ReadBufferLength
-	}
-	return 0
-}
-
-type DescribeTaskQueuePartitionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
-	VersionsInfoInternal map[string]*v113.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type AggregateWorkflowExecutionsRequest_Aggregation struct {
+	state    protoimpl.MessageState            `protogen:"open.v1"`
+	Function v12.VisibilityAggregationFunction `protobuf:"varint,1,opt,name=function,proto3,enum=temporal.server.api.enums.v1.VisibilityAggregationFunction" json:"function,omitempty"`
+	// Int, Double or Datetime search attribute to aggregate.
+	SearchAttribute string `protobuf:"bytes,2,opt,name=search_attribute,json=searchAttribute,proto3" json:"search_attribute,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionResponse) Reset() {
-	*x = DescribeTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *AggregateWorkflowExecutionsRequest_Aggregation) Reset() {
+	*x = AggregateWorkflowExecutionsRequest_Aggregation{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionResponse) String() string {
+func (x *AggregateWorkflowExecutionsRequest_Aggregation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsRequest_Aggregation) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *AggregateWorkflowExecutionsRequest_Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5130,41 +7105,52 @@ func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use AggregateWorkflowExecutionsRequest_Aggregation.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest_Aggregation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{6, 0}
 }
 
-func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v113.TaskQueueVersionInfoInternal {
+func (x *AggregateWorkflowExecutionsRequest_Aggregation) GetFunction() v12.VisibilityAggregationFunction {
 	if x != nil {
-		return x.VersionsInfoInternal
+		return x.Function
 	}
-	return nil
+	return v12.VisibilityAggregationFunction(0)
 }
 
-type ForceUnloadTaskQueuePartitionRequest struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *AggregateWorkflowExecutionsRequest_Aggregation) GetSearchAttribute() string {
+	if x != nil {
+		return x.SearchAttribute
+	}
+	return ""
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
-	*x = ForceUnloadTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+type AggregateWorkflowExecutionsResponse_AggregationGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values of the group_by search attributes, in the order of the request.
+	GroupValues []*v1.Payload `protobuf:"bytes,1,rep,name=group_values,json=groupValues,proto3" json:"group_values,omitempty"`
+	Count       int64         `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Results of the aggregations, in the order of the request. Null payload if no execution in the group
+	// has a value for the search attribute.
+	Values        []*v1.Payload `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) Reset() {
+	*x = AggregateWorkflowExecutionsResponse_AggregationGroup{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsResponse_AggregationGroup) ProtoMessage() {}
 
-func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5175,47 +7161,59 @@ func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use AggregateWorkflowExecutionsResponse_AggregationGroup.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse_AggregationGroup) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) GetGroupValues() []*v1.Payload {
 	if x != nil {
-		return x.Namespace
+		return x.GroupValues
 	}
-	return ""
+	return nil
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) GetCount() int64 {
 	if x != nil {
-		return x.TaskQueuePartition
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) GetValues() []*v1.Payload {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-type ForceUnloadTaskQueuePartitionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
+type CheckVisibilityConsistencyResponse_Mismatch struct {
+	state     protoimpl.MessageState                `protogen:"open.v1"`
+	Execution *v1.WorkflowExecution                 `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Type      v12.VisibilityConsistencyMismatchType `protobuf:"varint,2,opt,name=type,proto3,enum=temporal.server.api.enums.v1.VisibilityConsistencyMismatchType" json:"type,omitempty"`
+	// Names of the differing fields, ExecutionStatus, CloseTime or search attribute names, for FIELDS_DIFFER.
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Repair was requested and succeeded. Visibility records are updated asynchronously.
+	Repaired      bool `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
-	*x = ForceUnloadTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *CheckVisibilityConsistencyResponse_Mismatch) Reset() {
+	*x = CheckVisibilityConsistencyResponse_Mismatch{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
+func (x *CheckVisibilityConsistencyResponse_Mismatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
+func (*CheckVisibilityConsistencyResponse_Mismatch) ProtoMessage() {}
 
-func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *CheckVisibilityConsistencyResponse_Mismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5226,14 +7224,35 @@ func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use CheckVisibilityConsistencyResponse_Mismatch.ProtoReflect.Descriptor instead.
+func (*CheckVisibilityConsistencyResponse_Mismatch) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
+func (x *CheckVisibilityConsistencyResponse_Mismatch) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.WasLoaded
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *CheckVisibilityConsistencyResponse_Mismatch) GetType() v12.VisibilityConsistencyMismatchType {
+	if x != nil {
+		return x.Type
+	}
+	return v12.VisibilityConsistencyMismatchType(0)
+}
+
+func (x *CheckVisibilityConsistencyResponse_Mismatch) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CheckVisibilityConsistencyResponse_Mismatch) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
 	}
 	return false
 }
@@ -5248,7 +7267,7 @@ type AddTasksRequest_Task struct {
 
 func (x *AddTasksRequest_Task) Reset() {
 	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5260,7 +7279,7 @@ func (x *AddTasksRequest_Task) String() string {
 func (*AddTasksRequest_Task) ProtoMessage() {}
 
 func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5273,7 +7292,7 @@ func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
 func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97, 0}
 }
 
 func (x *AddTasksRequest_Task) GetCategoryId() int32 {
@@ -5300,7 +7319,7 @@ type ListQueuesResponse_QueueInfo struct {
 
 func (x *ListQueuesResponse_QueueInfo) Reset() {
 	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5312,7 +7331,7 @@ func (x *ListQueuesResponse_QueueInfo) String() string {
 func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
 
 func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5325,7 +7344,7 @@ func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
 func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100, 0}
 }
 
 func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
@@ -5346,7 +7365,7 @@ var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect
 
 const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a-temporal/server/api/enums/v1/visibility.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a3temporal/server/api/persistence/v1/namespaces.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
 	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
@@ -5358,7 +7377,124 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x0fversion_history\x18\x04 \x01(\v2..temporal.server.api.history.v1.VersionHistoryR\x0eversionHistory\x12\x14\n" +
 	"\x05token\x18\x05 \x01(\fR\x05token\"7\n" +
 	"\x1fImportWorkflowExecutionResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\fR\x05token\"\x84\x01\n" +
+	"\x05token\x18\x01 \x01(\fR\x05token\"\x90\x01\n" +
+	"'RestoreArchivedWorkflowExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"Q\n" +
+	"(RestoreArchivedWorkflowExecutionResponse\x12%\n" +
+	"\x0ehistory_length\x18\x01 \x01(\x03R\rhistoryLength\"\xc5\x03\n" +
+	"\"AggregateWorkflowExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x03(\tR\agroupBy\x12w\n" +
+	"\faggregations\x18\x04 \x03(\v2S.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.AggregationR\faggregations\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\fR\rnextPageToken\x1a\x91\x01\n" +
+	"\vAggregation\x12W\n" +
+	"\bfunction\x18\x01 \x01(\x0e2;.temporal.server.api.enums.v1.VisibilityAggregationFunctionR\bfunction\x12)\n" +
+	"\x10search_attribute\x18\x02 \x01(\tR\x0fsearchAttribute\"\xe8\x02\n" +
+	"#AggregateWorkflowExecutionsResponse\x12q\n" +
+	"\x06groups\x18\x01 \x03(\v2Y.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroupR\x06groups\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\x1a\xa5\x01\n" +
+	"\x10AggregationGroup\x12B\n" +
+	"\fgroup_values\x18\x01 \x03(\v2\x1f.temporal.api.common.v1.PayloadR\vgroupValues\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x127\n" +
+	"\x06values\x18\x03 \x03(\v2\x1f.temporal.api.common.v1.PayloadR\x06values\"\xd5\x01\n" +
+	"!CheckVisibilityConsistencyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1f\n" +
+	"\vsample_rate\x18\x03 \x01(\x01R\n" +
+	"sampleRate\x12\x16\n" +
+	"\x06repair\x18\x04 \x01(\bR\x06repair\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\fR\rnextPageToken\"\xc2\x03\n" +
+	"\"CheckVisibilityConsistencyResponse\x12#\n" +
+	"\rchecked_count\x18\x01 \x01(\x03R\fcheckedCount\x12p\n" +
+	"\n" +
+	"mismatches\x18\x02 \x03(\v2P.temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse.MismatchR\n" +
+	"mismatches\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\x1a\xdc\x01\n" +
+	"\bMismatch\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12S\n" +
+	"\x04type\x18\x02 \x01(\x0e2?.temporal.server.api.enums.v1.VisibilityConsistencyMismatchTypeR\x04type\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\bR\brepaired\"p\n" +
+	"\x1dExplainVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x84\x03\n" +
+	"\x1eExplainVisibilityQueryResponse\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x01 \x01(\tR\tstoreName\x12)\n" +
+	"\x10translated_query\x18\x02 \x01(\tR\x0ftranslatedQuery\x12\x1d\n" +
+	"\n" +
+	"query_args\x18\x03 \x03(\tR\tqueryArgs\x12\x99\x01\n" +
+	"\x18search_attribute_aliases\x18\x04 \x03(\v2_.temporal.server.api.adminservice.v1.ExplainVisibilityQueryResponse.SearchAttributeAliasesEntryR\x16searchAttributeAliases\x12\x12\n" +
+	"\x04plan\x18\x05 \x01(\tR\x04plan\x1aI\n" +
+	"\x1bSearchAttributeAliasesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"V\n" +
+	"\x1eStreamVisibilityChangesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\fR\x06cursor\"\x8a\x01\n" +
+	"\x1fStreamVisibilityChangesResponse\x12O\n" +
+	"\achanges\x18\x01 \x03(\v25.temporal.server.api.adminservice.v1.VisibilityChangeR\achanges\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\fR\x06cursor\"\xa5\x05\n" +
+	"\x10VisibilityChange\x12F\n" +
+	"\x04type\x18\x01 \x01(\x0e22.temporal.server.api.enums.v1.VisibilityChangeTypeR\x04type\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\x12G\n" +
+	"\texecution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12#\n" +
+	"\rworkflow_type\x18\x05 \x01(\tR\fworkflowType\x12F\n" +
+	"\x06status\x18\x06 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12A\n" +
+	"\x0eexecution_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rexecutionTime\x129\n" +
+	"\n" +
+	"close_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\n" +
+	" \x01(\tR\ttaskQueue\x12U\n" +
+	"\x11search_attributes\x18\v \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\x120\n" +
+	"\x04memo\x18\f \x01(\v2\x1c.temporal.api.common.v1.MemoR\x04memo\"\xf6\x01\n" +
+	"\x16VisibilityChangeCursor\x12_\n" +
+	"\x06shards\x18\x01 \x03(\v2G.temporal.server.api.adminservice.v1.VisibilityChangeCursor.ShardsEntryR\x06shards\x1a{\n" +
+	"\vShardsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12V\n" +
+	"\x05value\x18\x02 \x01(\v2@.temporal.server.api.adminservice.v1.VisibilityChangeShardCursorR\x05value:\x028\x01\"{\n" +
+	"\x1bVisibilityChangeShardCursor\x12 \n" +
+	"\vincarnation\x18\x01 \x01(\x03R\vincarnation\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x1e\n" +
+	"\vack_task_id\x18\x03 \x01(\x03R\tackTaskId\"\xb0\x01\n" +
+	"!UpsertSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12Y\n" +
+	"\vsaved_query\x18\x03 \x01(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\n" +
+	"savedQuery\"$\n" +
+	"\"UpsertSavedVisibilityQueryResponse\"U\n" +
+	"!DeleteSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"$\n" +
+	"\"DeleteSavedVisibilityQueryResponse\"A\n" +
+	"!ListSavedVisibilityQueriesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x9f\x02\n" +
+	"\"ListSavedVisibilityQueriesResponse\x12~\n" +
+	"\rsaved_queries\x18\x01 \x03(\v2Y.temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.SavedQueriesEntryR\fsavedQueries\x1ay\n" +
+	"\x11SavedQueriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12N\n" +
+	"\x05value\x18\x02 \x01(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\x05value:\x028\x01\"\xaf\x01\n" +
+	"\x1cUpdateSearchAttributeRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bnew_name\x18\x03 \x01(\tR\anewName\x12B\n" +
+	"\bnew_type\x18\x04 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\anewType\"h\n" +
+	"\x1dUpdateSearchAttributeResponse\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"N\n" +
+	"\x1aDropSearchAttributeRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"f\n" +
+	"\x1bDropSearchAttributeResponse\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x84\x01\n" +
 	"\x1bDescribeMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\xb6\x02\n" +
@@ -5731,7 +7867,39 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\"F\n" +
 	"%ForceUnloadTaskQueuePartitionResponse\x12\x1d\n" +
 	"\n" +
-	"was_loaded\x18\x01 \x01(\bR\twasLoadedB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"was_loaded\x18\x01 \x01(\bR\twasLoaded\"\xd8\x01\n" +
+	"\x15PauseTaskQueueRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12N\n" +
+	"\x10task_queue_types\x18\x03 \x03(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\x0etaskQueueTypes\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\"\x18\n" +
+	"\x16PauseTaskQueueResponse\"\xc1\x01\n" +
+	"\x16ResumeTaskQueueRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12N\n" +
+	"\x10task_queue_types\x18\x03 \x03(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\x0etaskQueueTypes\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\"\x19\n" +
+	"\x17ResumeTaskQueueResponse\"\xdc\x02\n" +
+	"\x1aPurgeTaskQueueTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12N\n" +
+	"\x10task_queue_types\x18\x03 \x03(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\x0etaskQueueTypes\x12,\n" +
+	"\x12workflow_id_prefix\x18\x04 \x01(\tR\x10workflowIdPrefix\x12\x1b\n" +
+	"\ttype_name\x18\x05 \x01(\tR\btypeName\x122\n" +
+	"\amin_age\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06minAge\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\b \x01(\tR\bidentity\"\x1d\n" +
+	"\x1bPurgeTaskQueueTasksResponse\"\xc4\x01\n" +
+	"\x1fDescribeTaskQueueBacklogRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
+	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x12\x1b\n" +
+	"\tmax_tasks\x18\x03 \x01(\x05R\bmaxTasks\"w\n" +
+	" DescribeTaskQueueBacklogResponse\x12S\n" +
+	"\asummary\x18\x01 \x01(\v29.temporal.server.api.taskqueue.v1.TaskQueueBacklogSummaryR\asummaryB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -5745,245 +7913,324 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescData
 }
 
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 136)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
-	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	(*RebuildMutableStateResponse)(nil),                 // 1: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionRequest)(nil),              // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	(*ImportWorkflowExecutionResponse)(nil),             // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateRequest)(nil),                 // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	(*DescribeMutableStateResponse)(nil),                // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostRequest)(nil),                  // 6: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	(*DescribeHistoryHostResponse)(nil),                 // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*CloseShardRequest)(nil),                           // 8: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*CloseShardResponse)(nil),                          // 9: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*GetShardRequest)(nil),                             // 10: temporal.server.api.adminservice.v1.GetShardRequest
-	(*GetShardResponse)(nil),                            // 11: temporal.server.api.adminservice.v1.GetShardResponse
-	(*ListHistoryTasksRequest)(nil),                     // 12: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*ListHistoryTasksResponse)(nil),                    // 13: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*Task)(nil),                                        // 14: temporal.server.api.adminservice.v1.Task
-	(*RemoveTaskRequest)(nil),                           // 15: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*RemoveTaskResponse)(nil),                          // 16: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesRequest)(nil),               // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetReplicationMessagesResponse)(nil),              // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesRequest)(nil),            // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*GetDLQReplicationMessagesResponse)(nil),           // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsRequest)(nil),                        // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*ReapplyEventsResponse)(nil),                       // 28: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesRequest)(nil),                  // 29: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*AddSearchAttributesResponse)(nil),                 // 30: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesRequest)(nil),               // 31: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*RemoveSearchAttributesResponse)(nil),              // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesRequest)(nil),                  // 33: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*GetSearchAttributesResponse)(nil),                 // 34: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterRequest)(nil),                      // 35: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*DescribeClusterResponse)(nil),                     // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersRequest)(nil),                         // 37: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),                        // 38: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 39: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterRequest)(nil),                  // 41: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*RemoveRemoteClusterResponse)(nil),                 // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*ListClusterMembersRequest)(nil),                   // 43: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*ListClusterMembersResponse)(nil),                  // 44: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*GetDLQMessagesRequest)(nil),                       // 45: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*GetDLQMessagesResponse)(nil),                      // 46: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesRequest)(nil),                     // 47: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*PurgeDLQMessagesResponse)(nil),                    // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesRequest)(nil),                     // 49: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*MergeDLQMessagesResponse)(nil),                    // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksRequest)(nil),                 // 51: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*RefreshWorkflowTasksResponse)(nil),                // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksRequest)(nil),               // 53: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*ResendReplicationTasksResponse)(nil),              // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksRequest)(nil),                    // 55: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*GetTaskQueueTasksResponse)(nil),                   // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionRequest)(nil),              // 57: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*DeleteWorkflowExecutionResponse)(nil),             // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 59: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceRequest)(nil),                         // 61: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),                        // 62: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksRequest)(nil),                          // 63: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*GetDLQTasksResponse)(nil),                         // 64: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksRequest)(nil),                        // 65: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*PurgeDLQTasksResponse)(nil),                       // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*DLQJobToken)(nil),                                 // 67: temporal.server.api.adminservice.v1.DLQJobToken
-	(*MergeDLQTasksRequest)(nil),                        // 68: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*MergeDLQTasksResponse)(nil),                       // 69: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobRequest)(nil),                       // 70: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*DescribeDLQJobResponse)(nil),                      // 71: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobRequest)(nil),                         // 72: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*CancelDLQJobResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksRequest)(nil),                             // 74: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*AddTasksResponse)(nil),                            // 75: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesRequest)(nil),                           // 76: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*ListQueuesResponse)(nil),                          // 77: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckRequest)(nil),                      // 78: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*DeepHealthCheckResponse)(nil),                     // 79: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateRequest)(nil),                    // 80: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*SyncWorkflowStateResponse)(nil),                   // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 82: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*InternalTaskQueueStatus)(nil),                     // 85: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	nil,                                       // 89: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                       // 90: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                       // 91: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                       // 92: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                       // 93: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                       // 94: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                       // 95: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),              // 96: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),      // 97: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                       // 98: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),              // 99: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                       // 100: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                // 101: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),          // 102: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),            // 103: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                     // 104: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                     // 105: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                         // 106: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),             // 107: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),              // 108: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),           // 109: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),           // 110: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),               // 111: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),         // 112: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                // 113: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                   // 114: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),               // 115: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),               // 116: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                // 117: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                 // 118: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),              // 119: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                    // 120: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),             // 121: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),          // 122: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),   // 123: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                // 124: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),              // 125: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),   // 126: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),               // 127: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                // 128: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),               // 129: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),       // 130: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                 // 131: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                // 132: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                      // 133: temporal.server.api.enums.v1.HealthState
-	(*v12.VersionedTransition)(nil),           // 134: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),              // 135: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),   // 136: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v113.TaskQueuePartition)(nil),           // 137: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v114.TaskQueueVersionSelection)(nil),    // 138: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v114.TaskIdBlock)(nil),                  // 139: temporal.api.taskqueue.v1.TaskIdBlock
-	(v16.IndexedValueType)(0),                 // 140: temporal.api.enums.v1.IndexedValueType
-	(*v113.TaskQueueVersionInfoInternal)(nil), // 141: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*RebuildMutableStateRequest)(nil),                           // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	(*RebuildMutableStateResponse)(nil),                          // 1: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionRequest)(nil),                       // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	(*ImportWorkflowExecutionResponse)(nil),                      // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*RestoreArchivedWorkflowExecutionRequest)(nil),              // 4: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest
+	(*RestoreArchivedWorkflowExecutionResponse)(nil),             // 5: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionResponse
+	(*AggregateWorkflowExecutionsRequest)(nil),                   // 6: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	(*AggregateWorkflowExecutionsResponse)(nil),                  // 7: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	(*CheckVisibilityConsistencyRequest)(nil),                    // 8: temporal.server.api.adminservice.v1.CheckVisibilityConsistencyRequest
+	(*CheckVisibilityConsistencyResponse)(nil),                   // 9: temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse
+	(*ExplainVisibilityQueryRequest)(nil),                        // 10: temporal.server.api.adminservice.v1.ExplainVisibilityQueryRequest
+	(*ExplainVisibilityQueryResponse)(nil),                       // 11: temporal.server.api.adminservice.v1.ExplainVisibilityQueryResponse
+	(*StreamVisibilityChangesRequest)(nil),                       // 12: temporal.server.api.adminservice.v1.StreamVisibilityChangesRequest
+	(*StreamVisibilityChangesResponse)(nil),                      // 13: temporal.server.api.adminservice.v1.StreamVisibilityChangesResponse
+	(*VisibilityChange)(nil),                                     // 14: temporal.server.api.adminservice.v1.VisibilityChange
+	(*VisibilityChangeCursor)(nil),                               // 15: temporal.server.api.adminservice.v1.VisibilityChangeCursor
+	(*VisibilityChangeShardCursor)(nil),                          // 16: temporal.server.api.adminservice.v1.VisibilityChangeShardCursor
+	(*UpsertSavedVisibilityQueryRequest)(nil),                    // 17: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryRequest
+	(*UpsertSavedVisibilityQueryResponse)(nil),                   // 18: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryResponse
+	(*DeleteSavedVisibilityQueryRequest)(nil),                    // 19: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	(*DeleteSavedVisibilityQueryResponse)(nil),                   // 20: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	(*ListSavedVisibilityQueriesRequest)(nil),                    // 21: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	(*ListSavedVisibilityQueriesResponse)(nil),                   // 22: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	(*UpdateSearchAttributeRequest)(nil),                         // 23: temporal.server.api.adminservice.v1.UpdateSearchAttributeRequest
+	(*UpdateSearchAttributeResponse)(nil),                        // 24: temporal.server.api.adminservice.v1.UpdateSearchAttributeResponse
+	(*DropSearchAttributeRequest)(nil),                           // 25: temporal.server.api.adminservice.v1.DropSearchAttributeRequest
+	(*DropSearchAttributeResponse)(nil),                          // 26: temporal.server.api.adminservice.v1.DropSearchAttributeResponse
+	(*DescribeMutableStateRequest)(nil),                          // 27: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	(*DescribeMutableStateResponse)(nil),                         // 28: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostRequest)(nil),                           // 29: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	(*DescribeHistoryHostResponse)(nil),                          // 30: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*CloseShardRequest)(nil),                                    // 31: temporal.server.api.adminservice.v1.CloseShardRequest
+	(*CloseShardResponse)(nil),                                   // 32: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*GetShardRequest)(nil),                                      // 33: temporal.server.api.adminservice.v1.GetShardRequest
+	(*GetShardResponse)(nil),                                     // 34: temporal.server.api.adminservice.v1.GetShardResponse
+	(*ListHistoryTasksRequest)(nil),                              // 35: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*ListHistoryTasksResponse)(nil),                             // 36: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*Task)(nil),                                                 // 37: temporal.server.api.adminservice.v1.Task
+	(*RemoveTaskRequest)(nil),                                    // 38: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*RemoveTaskResponse)(nil),                                   // 39: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),              // 40: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),             // 41: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),                // 42: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),               // 43: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesRequest)(nil),                        // 44: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetReplicationMessagesResponse)(nil),                       // 45: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesRequest)(nil),               // 46: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesResponse)(nil),              // 47: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesRequest)(nil),                     // 48: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*GetDLQReplicationMessagesResponse)(nil),                    // 49: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsRequest)(nil),                                 // 50: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*ReapplyEventsResponse)(nil),                                // 51: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesRequest)(nil),                           // 52: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*AddSearchAttributesResponse)(nil),                          // 53: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesRequest)(nil),                        // 54: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*RemoveSearchAttributesResponse)(nil),                       // 55: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesRequest)(nil),                           // 56: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*GetSearchAttributesResponse)(nil),                          // 57: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterRequest)(nil),                               // 58: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*DescribeClusterResponse)(nil),                              // 59: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersRequest)(nil),                                  // 60: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),                                 // 61: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*AddOrUpdateRemoteClusterRequest)(nil),                      // 62: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*AddOrUpdateRemoteClusterResponse)(nil),                     // 63: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterRequest)(nil),                           // 64: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*RemoveRemoteClusterResponse)(nil),                          // 65: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*ListClusterMembersRequest)(nil),                            // 66: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*ListClusterMembersResponse)(nil),                           // 67: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*GetDLQMessagesRequest)(nil),                                // 68: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*GetDLQMessagesResponse)(nil),                               // 69: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesRequest)(nil),                              // 70: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*PurgeDLQMessagesResponse)(nil),                             // 71: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesRequest)(nil),                              // 72: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*MergeDLQMessagesResponse)(nil),                             // 73: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksRequest)(nil),                          // 74: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*RefreshWorkflowTasksResponse)(nil),                         // 75: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksRequest)(nil),                        // 76: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*ResendReplicationTasksResponse)(nil),                       // 77: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksRequest)(nil),                             // 78: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*GetTaskQueueTasksResponse)(nil),                            // 79: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionRequest)(nil),                       // 80: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*DeleteWorkflowExecutionResponse)(nil),                      // 81: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesRequest)(nil),             // 82: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*StreamWorkflowReplicationMessagesResponse)(nil),            // 83: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceRequest)(nil),                                  // 84: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                                 // 85: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksRequest)(nil),                                   // 86: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*GetDLQTasksResponse)(nil),                                  // 87: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksRequest)(nil),                                 // 88: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*PurgeDLQTasksResponse)(nil),                                // 89: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*DLQJobToken)(nil),                                          // 90: temporal.server.api.adminservice.v1.DLQJobToken
+	(*MergeDLQTasksRequest)(nil),                                 // 91: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*MergeDLQTasksResponse)(nil),                                // 92: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobRequest)(nil),                                // 93: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*DescribeDLQJobResponse)(nil),                               // 94: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobRequest)(nil),                                  // 95: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*CancelDLQJobResponse)(nil),                                 // 96: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksRequest)(nil),                                      // 97: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*AddTasksResponse)(nil),                                     // 98: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesRequest)(nil),                                    // 99: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),                                   // 100: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckRequest)(nil),                               // 101: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*DeepHealthCheckResponse)(nil),                              // 102: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateRequest)(nil),                             // 103: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*SyncWorkflowStateResponse)(nil),                            // 104: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),           // 105: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*GenerateLastHistoryReplicationTasksResponse)(nil),          // 106: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionRequest)(nil),                    // 107: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*InternalTaskQueueStatus)(nil),                              // 108: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
+	(*DescribeTaskQueuePartitionResponse)(nil),                   // 109: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),                 // 110: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),                // 111: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*PauseTaskQueueRequest)(nil),                                // 112: temporal.server.api.adminservice.v1.PauseTaskQueueRequest
+	(*PauseTaskQueueResponse)(nil),                               // 113: temporal.server.api.adminservice.v1.PauseTaskQueueResponse
+	(*ResumeTaskQueueRequest)(nil),                               // 114: temporal.server.api.adminservice.v1.ResumeTaskQueueRequest
+	(*ResumeTaskQueueResponse)(nil),                              // 115: temporal.server.api.adminservice.v1.ResumeTaskQueueResponse
+	(*PurgeTaskQueueTasksRequest)(nil),                           // 116: temporal.server.api.adminservice.v1.PurgeTaskQueueTasksRequest
+	(*PurgeTaskQueueTasksResponse)(nil),                          // 117: temporal.server.api.adminservice.v1.PurgeTaskQueueTasksResponse
+	(*DescribeTaskQueueBacklogRequest)(nil),                      // 118: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogRequest
+	(*DescribeTaskQueueBacklogResponse)(nil),                     // 119: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogResponse
+	(*AggregateWorkflowExecutionsRequest_Aggregation)(nil),       // 120: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.Aggregation
+	(*AggregateWorkflowExecutionsResponse_AggregationGroup)(nil), // 121: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup
+	(*CheckVisibilityConsistencyResponse_Mismatch)(nil),          // 122: temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse.Mismatch
+	nil,                                        // 123: temporal.server.api.adminservice.v1.ExplainVisibilityQueryResponse.SearchAttributeAliasesEntry
+	nil,                                        // 124: temporal.server.api.adminservice.v1.VisibilityChangeCursor.ShardsEntry
+	nil,                                        // 125: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.SavedQueriesEntry
+	nil,                                        // 126: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                        // 127: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                        // 128: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                        // 129: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                        // 130: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                        // 131: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                        // 132: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	(*AddTasksRequest_Task)(nil),               // 133: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil),       // 134: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                        // 135: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	(*v1.WorkflowExecution)(nil),               // 136: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                        // 137: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                 // 138: temporal.server.api.history.v1.VersionHistory
+	(v12.VisibilityChangeType)(0),              // 139: temporal.server.api.enums.v1.VisibilityChangeType
+	(v13.WorkflowExecutionStatus)(0),           // 140: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*timestamppb.Timestamp)(nil),              // 141: google.protobuf.Timestamp
+	(*v1.SearchAttributes)(nil),                // 142: temporal.api.common.v1.SearchAttributes
+	(*v1.Memo)(nil),                            // 143: temporal.api.common.v1.Memo
+	(*v14.SavedVisibilityQuery)(nil),           // 144: temporal.server.api.persistence.v1.SavedVisibilityQuery
+	(v13.IndexedValueType)(0),                  // 145: temporal.api.enums.v1.IndexedValueType
+	(*v14.WorkflowMutableState)(nil),           // 146: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v15.NamespaceCacheInfo)(nil),             // 147: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*v14.ShardInfo)(nil),                      // 148: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                      // 149: temporal.server.api.history.v1.TaskRange
+	(v12.TaskType)(0),                          // 150: temporal.server.api.enums.v1.TaskType
+	(*v16.ReplicationToken)(nil),               // 151: temporal.server.api.replication.v1.ReplicationToken
+	(*v16.ReplicationMessages)(nil),            // 152: temporal.server.api.replication.v1.ReplicationMessages
+	(*v16.ReplicationTaskInfo)(nil),            // 153: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v16.ReplicationTask)(nil),                // 154: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),          // 155: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(*v18.MembershipInfo)(nil),                 // 156: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                    // 157: temporal.api.version.v1.VersionInfo
+	(*v14.ClusterMetadata)(nil),                // 158: temporal.server.api.persistence.v1.ClusterMetadata
+	(*durationpb.Duration)(nil),                // 159: google.protobuf.Duration
+	(v12.ClusterMemberRole)(0),                 // 160: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                  // 161: temporal.server.api.cluster.v1.ClusterMember
+	(v12.DeadLetterQueueType)(0),               // 162: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v13.TaskQueueType)(0),                     // 163: temporal.api.enums.v1.TaskQueueType
+	(*v14.AllocatedTaskInfo)(nil),              // 164: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(*v16.SyncReplicationState)(nil),           // 165: temporal.server.api.replication.v1.SyncReplicationState
+	(*v16.WorkflowReplicationMessages)(nil),    // 166: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                 // 167: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),               // 168: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),    // 169: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),                // 170: temporal.api.replication.v1.FailoverStatus
+	(*v112.HistoryDLQKey)(nil),                 // 171: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTask)(nil),                // 172: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),        // 173: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v12.DLQOperationType)(0),                  // 174: temporal.server.api.enums.v1.DLQOperationType
+	(v12.DLQOperationState)(0),                 // 175: temporal.server.api.enums.v1.DLQOperationState
+	(v12.HealthState)(0),                       // 176: temporal.server.api.enums.v1.HealthState
+	(*v14.VersionedTransition)(nil),            // 177: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),               // 178: temporal.server.api.history.v1.VersionHistories
+	(*v16.VersionedTransitionArtifact)(nil),    // 179: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v113.TaskQueuePartition)(nil),            // 180: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v114.TaskQueueVersionSelection)(nil),     // 181: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v114.TaskIdBlock)(nil),                   // 182: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v113.TaskQueueBacklogSummary)(nil),       // 183: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary
+	(v12.VisibilityAggregationFunction)(0),     // 184: temporal.server.api.enums.v1.VisibilityAggregationFunction
+	(*v1.Payload)(nil),                         // 185: temporal.api.common.v1.Payload
+	(v12.VisibilityConsistencyMismatchType)(0), // 186: temporal.server.api.enums.v1.VisibilityConsistencyMismatchType
+	(*v113.TaskQueueVersionInfoInternal)(nil),  // 187: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	99,  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	99,  // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	102, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	102, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	99,  // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	103, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	104, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	105, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
-	14,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	106, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	107, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	107, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	99,  // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	108, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	89,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	109, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	110, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	111, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	99,  // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	90,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	91,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	92,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	93,  // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	112, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	94,  // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	113, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	114, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	95,  // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	115, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	116, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	117, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	107, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	118, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	119, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	111, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	110, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	119, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	99,  // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	120, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	121, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	99,  // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	122, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	123, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	124, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	125, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	126, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	127, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	128, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	129, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	128, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	131, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	132, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	107, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	107, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	96,  // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	97,  // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	133, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	99,  // 72: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	134, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	135, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	136, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	99,  // 76: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	137, // 77: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	138, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	139, // 79: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
-	98,  // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	137, // 81: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	109, // 82: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	140, // 83: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 84: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 85: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	100, // 86: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	141, // 87: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	88,  // [88:88] is the sub-list for method output_type
-	88,  // [88:88] is the sub-list for method input_type
-	88,  // [88:88] is the sub-list for extension type_name
-	88,  // [88:88] is the sub-list for extension extendee
-	0,   // [0:88] is the sub-list for field type_name
+	136, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	136, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	137, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	138, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	136, // 4: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	120, // 5: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.aggregations:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.Aggregation
+	121, // 6: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.groups:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup
+	122, // 7: temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse.mismatches:type_name -> temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse.Mismatch
+	123, // 8: temporal.server.api.adminservice.v1.ExplainVisibilityQueryResponse.search_attribute_aliases:type_name -> temporal.server.api.adminservice.v1.ExplainVisibilityQueryResponse.SearchAttributeAliasesEntry
+	14,  // 9: temporal.server.api.adminservice.v1.StreamVisibilityChangesResponse.changes:type_name -> temporal.server.api.adminservice.v1.VisibilityChange
+	139, // 10: temporal.server.api.adminservice.v1.VisibilityChange.type:type_name -> temporal.server.api.enums.v1.VisibilityChangeType
+	136, // 11: temporal.server.api.adminservice.v1.VisibilityChange.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	140, // 12: temporal.server.api.adminservice.v1.VisibilityChange.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	141, // 13: temporal.server.api.adminservice.v1.VisibilityChange.start_time:type_name -> google.protobuf.Timestamp
+	141, // 14: temporal.server.api.adminservice.v1.VisibilityChange.execution_time:type_name -> google.protobuf.Timestamp
+	141, // 15: temporal.server.api.adminservice.v1.VisibilityChange.close_time:type_name -> google.protobuf.Timestamp
+	142, // 16: temporal.server.api.adminservice.v1.VisibilityChange.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	143, // 17: temporal.server.api.adminservice.v1.VisibilityChange.memo:type_name -> temporal.api.common.v1.Memo
+	124, // 18: temporal.server.api.adminservice.v1.VisibilityChangeCursor.shards:type_name -> temporal.server.api.adminservice.v1.VisibilityChangeCursor.ShardsEntry
+	144, // 19: temporal.server.api.adminservice.v1.UpsertSavedVisibilityQueryRequest.saved_query:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	125, // 20: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.saved_queries:type_name -> temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.SavedQueriesEntry
+	145, // 21: temporal.server.api.adminservice.v1.UpdateSearchAttributeRequest.new_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	136, // 22: temporal.server.api.adminservice.v1.UpdateSearchAttributeResponse.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	136, // 23: temporal.server.api.adminservice.v1.DropSearchAttributeResponse.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	136, // 24: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	146, // 25: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	146, // 26: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	136, // 27: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	147, // 28: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	148, // 29: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	149, // 30: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	37,  // 31: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
+	150, // 32: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	141, // 33: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	141, // 34: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	136, // 35: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	137, // 36: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	138, // 37: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	136, // 38: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	137, // 39: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	138, // 40: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	151, // 41: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	126, // 42: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	152, // 43: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	153, // 44: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	154, // 45: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	136, // 46: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	137, // 47: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	127, // 48: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	128, // 49: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	129, // 50: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	130, // 51: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	155, // 52: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	131, // 53: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	156, // 54: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	157, // 55: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	132, // 56: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	158, // 57: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	159, // 58: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	160, // 59: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	141, // 60: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	161, // 61: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	162, // 62: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	162, // 63: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	154, // 64: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	153, // 65: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	162, // 66: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	162, // 67: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	136, // 68: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	163, // 69: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	164, // 70: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	136, // 71: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	165, // 72: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	166, // 73: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	167, // 74: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	168, // 75: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	169, // 76: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	170, // 77: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	171, // 78: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	172, // 79: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	171, // 80: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	173, // 81: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	171, // 82: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	173, // 83: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	171, // 84: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	174, // 85: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	175, // 86: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	141, // 87: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	141, // 88: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	133, // 89: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	134, // 90: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	176, // 91: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	136, // 92: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	177, // 93: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	178, // 94: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	179, // 95: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	136, // 96: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	180, // 97: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	181, // 98: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	182, // 99: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	135, // 100: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	180, // 101: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	163, // 102: temporal.server.api.adminservice.v1.PauseTaskQueueRequest.task_queue_types:type_name -> temporal.api.enums.v1.TaskQueueType
+	163, // 103: temporal.server.api.adminservice.v1.ResumeTaskQueueRequest.task_queue_types:type_name -> temporal.api.enums.v1.TaskQueueType
+	163, // 104: temporal.server.api.adminservice.v1.PurgeTaskQueueTasksRequest.task_queue_types:type_name -> temporal.api.enums.v1.TaskQueueType
+	159, // 105: temporal.server.api.adminservice.v1.PurgeTaskQueueTasksRequest.min_age:type_name -> google.protobuf.Duration
+	180, // 106: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	183, // 107: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogResponse.summary:type_name -> temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary
+	184, // 108: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.Aggregation.function:type_name -> temporal.server.api.enums.v1.VisibilityAggregationFunction
+	185, // 109: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup.group_values:type_name -> temporal.api.common.v1.Payload
+	185, // 110: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup.values:type_name -> temporal.api.common.v1.Payload
+	136, // 111: temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse.Mismatch.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	186, // 112: temporal.server.api.adminservice.v1.CheckVisibilityConsistencyResponse.Mismatch.type:type_name -> temporal.server.api.enums.v1.VisibilityConsistencyMismatchType
+	16,  // 113: temporal.server.api.adminservice.v1.VisibilityChangeCursor.ShardsEntry.value:type_name -> temporal.server.api.adminservice.v1.VisibilityChangeShardCursor
+	144, // 114: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.SavedQueriesEntry.value:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	152, // 115: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	145, // 116: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	145, // 117: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	145, // 118: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	137, // 119: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	187, // 120: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	121, // [121:121] is the sub-list for method output_type
+	121, // [121:121] is the sub-list for method input_type
+	121, // [121:121] is the sub-list for extension type_name
+	121, // [121:121] is the sub-list for extension extendee
+	0,   // [0:121] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -5991,13 +8238,13 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 	if File_temporal_server_api_adminservice_v1_request_response_proto != nil {
 		return
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesResponse_Messages)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84].OneofWrappers = []any{
 		(*GetNamespaceRequest_Namespace)(nil),
 		(*GetNamespaceRequest_Id)(nil),
 	}
@@ -6007,7 +8254,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   136,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,16 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
 	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_RestoreArchivedWorkflowExecution_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/RestoreArchivedWorkflowExecution"
+	AdminService_AggregateWorkflowExecutions_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/AggregateWorkflowExecutions"
+	AdminService_CheckVisibilityConsistency_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/CheckVisibilityConsistency"
+	AdminService_ExplainVisibilityQuery_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ExplainVisibilityQuery"
+	AdminService_StreamVisibilityChanges_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/StreamVisibilityChanges"
+	AdminService_UpsertSavedVisibilityQuery_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/UpsertSavedVisibilityQuery"
+	AdminService_DeleteSavedVisibilityQuery_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DeleteSavedVisibilityQuery"
+	AdminService_ListSavedVisibilityQueries_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/ListSavedVisibilityQueries"
+	AdminService_UpdateSearchAttribute_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/UpdateSearchAttribute"
+	AdminService_DropSearchAttribute_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DropSearchAttribute"
 	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
 	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
 	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
@@ -63,6 +73,10 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_PauseTaskQueue_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/PauseTaskQueue"
+	AdminService_ResumeTaskQueue_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/ResumeTaskQueue"
+	AdminService_PurgeTaskQueueTasks_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/PurgeTaskQueueTasks"
+	AdminService_DescribeTaskQueueBacklog_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklog"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -75,6 +89,54 @@ type AdminServiceClient interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// RestoreArchivedWorkflowExecution reads the history of a workflow from the archival store of the namespace
+	// and imports it back into primary persistence, rebuilding the mutable state of the workflow.
+	// NOTE: this is experimental API
+	RestoreArchivedWorkflowExecution(ctx context.Context, in *RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreArchivedWorkflowExecutionResponse, error)
+	// AggregateWorkflowExecutions groups the workflow executions matching a visibility query by keyword search
+	// attributes and computes the count and min/max/avg of numeric and datetime search attributes of each group.
+	// NOTE: this is experimental API
+	AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error)
+	// CheckVisibilityConsistency compares the visibility records of the primary and secondary visibility stores
+	// of a namespace, and optionally repairs the mismatches by regenerating the visibility tasks of the workflow
+	// executions.
+	// NOTE: this is experimental API
+	CheckVisibilityConsistency(ctx context.Context, in *CheckVisibilityConsistencyRequest, opts ...grpc.CallOption) (*CheckVisibilityConsistencyResponse, error)
+	// ExplainVisibilityQuery returns the statement a visibility query is translated to for the visibility store of
+	// a namespace, the custom search attributes it resolves, and for SQL stores the plan of the statement.
+	// NOTE: this is experimental API
+	ExplainVisibilityQuery(ctx context.Context, in *ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*ExplainVisibilityQueryResponse, error)
+	// StreamVisibilityChanges streams the changes made to the visibility records of the workflow executions of a
+	// namespace by the visibility task queues of all the history shards. Changes are delivered at least once, in the
+	// order the visibility tasks were executed: the changes of a workflow execution have to be ordered by task ID.
+	// The stream can be resumed with the cursor of the last received response.
+	// NOTE: this is experimental API
+	StreamVisibilityChanges(ctx context.Context, in *StreamVisibilityChangesRequest, opts ...grpc.CallOption) (AdminService_StreamVisibilityChangesClient, error)
+	// UpsertSavedVisibilityQuery creates or replaces a saved visibility query of a namespace. The saved query is
+	// listed with "view:<name>" as the query of ListWorkflowExecutions.
+	// NOTE: this is experimental API
+	UpsertSavedVisibilityQuery(ctx context.Context, in *UpsertSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*UpsertSavedVisibilityQueryResponse, error)
+	// DeleteSavedVisibilityQuery deletes a saved visibility query of a namespace.
+	// NOTE: this is experimental API
+	DeleteSavedVisibilityQuery(ctx context.Context, in *DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*DeleteSavedVisibilityQueryResponse, error)
+	// ListSavedVisibilityQueries returns the saved visibility queries of a namespace.
+	// NOTE: this is experimental API
+	ListSavedVisibilityQueries(ctx context.Context, in *ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*ListSavedVisibilityQueriesResponse, error)
+	// UpdateSearchAttribute renames a custom search attribute of a namespace, or changes its type. A type change
+	// starts a system workflow which backfills the values of the search attribute into a new field of the visibility
+	// store, swaps the alias of the search attribute to the new field and drops the old field.
+	// The custom search attributes of Elasticsearch and OpenSearch are the fields of the index mappings, they are not
+	// aliases: a new name is required, the field with the new name is added to the index mappings and the values are
+	// backfilled into it. The request is rejected with InvalidArgument if only some of the visibility stores are
+	// Elasticsearch compatible.
+	// NOTE: this is experimental API
+	UpdateSearchAttribute(ctx context.Context, in *UpdateSearchAttributeRequest, opts ...grpc.CallOption) (*UpdateSearchAttributeResponse, error)
+	// DropSearchAttribute removes a custom search attribute of a namespace, and starts a system workflow which
+	// clears its values from the visibility store.
+	// Rejected with InvalidArgument if only some of the visibility stores are Elasticsearch compatible, like
+	// UpdateSearchAttribute.
+	// NOTE: this is experimental API
+	DropSearchAttribute(ctx context.Context, in *DropSearchAttributeRequest, opts ...grpc.CallOption) (*DropSearchAttributeResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -153,6 +215,17 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// PauseTaskQueue pauses the dispatch of tasks of a task queue in all its partitions. New tasks are still
+	// added to the backlog of the task queue, and dispatched when the task queue is resumed.
+	PauseTaskQueue(ctx context.Context, in *PauseTaskQueueRequest, opts ...grpc.CallOption) (*PauseTaskQueueResponse, error)
+	// ResumeTaskQueue resumes the dispatch of tasks of a task queue paused by PauseTaskQueue.
+	ResumeTaskQueue(ctx context.Context, in *ResumeTaskQueueRequest, opts ...grpc.CallOption) (*ResumeTaskQueueResponse, error)
+	// PurgeTaskQueueTasks deletes the backlog tasks of a task queue matching a filter, in all its partitions.
+	// Tasks are deleted asynchronously, when matching reads them from the backlog or by the task queue scavenger.
+	PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error)
+	// DescribeTaskQueueBacklog summarizes the tasks persisted in the backlog of a task queue partition by type,
+	// priority key, fairness key and age.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
 }
 
 type adminServiceClient struct {
@@ -181,6 +254,119 @@ func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreArchivedWorkflowExecution(ctx context.Context, in *RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreArchivedWorkflowExecutionResponse, error) {
+	out := new(RestoreArchivedWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreArchivedWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error) {
+	out := new(AggregateWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_AggregateWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CheckVisibilityConsistency(ctx context.Context, in *CheckVisibilityConsistencyRequest, opts ...grpc.CallOption) (*CheckVisibilityConsistencyResponse, error) {
+	out := new(CheckVisibilityConsistencyResponse)
+	err := c.cc.Invoke(ctx, AdminService_CheckVisibilityConsistency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExplainVisibilityQuery(ctx context.Context, in *ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*ExplainVisibilityQueryResponse, error) {
+	out := new(ExplainVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExplainVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StreamVisibilityChanges(ctx context.Context, in *StreamVisibilityChangesRequest, opts ...grpc.CallOption) (AdminService_StreamVisibilityChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_StreamVisibilityChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamVisibilityChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamVisibilityChangesClient interface {
+	Recv() (*StreamVisibilityChangesResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamVisibilityChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamVisibilityChangesClient) Recv() (*StreamVisibilityChangesResponse, error) {
+	m := new(StreamVisibilityChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) UpsertSavedVisibilityQuery(ctx context.Context, in *UpsertSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*UpsertSavedVisibilityQueryResponse, error) {
+	out := new(UpsertSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpsertSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSavedVisibilityQuery(ctx context.Context, in *DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*DeleteSavedVisibilityQueryResponse, error) {
+	out := new(DeleteSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSavedVisibilityQueries(ctx context.Context, in *ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*ListSavedVisibilityQueriesResponse, error) {
+	out := new(ListSavedVisibilityQueriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSavedVisibilityQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSearchAttribute(ctx context.Context, in *UpdateSearchAttributeRequest, opts ...grpc.CallOption) (*UpdateSearchAttributeResponse, error) {
+	out := new(UpdateSearchAttributeResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateSearchAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DropSearchAttribute(ctx context.Context, in *DropSearchAttributeRequest, opts ...grpc.CallOption) (*DropSearchAttributeResponse, error) {
+	out := new(DropSearchAttributeResponse)
+	err := c.cc.Invoke(ctx, AdminService_DropSearchAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error) {
 	out := new(DescribeMutableStateResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeMutableState_FullMethodName, in, out, opts...)
@@ -425,7 +611,7 @@ func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *De
 }
 
 func (c *adminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_StreamWorkflowReplicationMessages_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_StreamWorkflowReplicationMessages_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -572,6 +758,42 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) PauseTaskQueue(ctx context.Context, in *PauseTaskQueueRequest, opts ...grpc.CallOption) (*PauseTaskQueueResponse, error) {
+	out := new(PauseTaskQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeTaskQueue(ctx context.Context, in *ResumeTaskQueueRequest, opts ...grpc.CallOption) (*ResumeTaskQueueResponse, error) {
+	out := new(ResumeTaskQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error) {
+	out := new(PurgeTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_PurgeTaskQueueTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -582,6 +804,54 @@ type AdminServiceServer interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// RestoreArchivedWorkflowExecution reads the history of a workflow from the archival store of the namespace
+	// and imports it back into primary persistence, rebuilding the mutable state of the workflow.
+	// NOTE: this is experimental API
+	RestoreArchivedWorkflowExecution(context.Context, *RestoreArchivedWorkflowExecutionRequest) (*RestoreArchivedWorkflowExecutionResponse, error)
+	// AggregateWorkflowExecutions groups the workflow executions matching a visibility query by keyword search
+	// attributes and computes the count and min/max/avg of numeric and datetime search attributes of each group.
+	// NOTE: this is experimental API
+	AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
+	// CheckVisibilityConsistency compares the visibility records of the primary and secondary visibility stores
+	// of a namespace, and optionally repairs the mismatches by regenerating the visibility tasks of the workflow
+	// executions.
+	// NOTE: this is experimental API
+	CheckVisibilityConsistency(context.Context, *CheckVisibilityConsistencyRequest) (*CheckVisibilityConsistencyResponse, error)
+	// ExplainVisibilityQuery returns the statement a visibility query is translated to for the visibility store of
+	// a namespace, the custom search attributes it resolves, and for SQL stores the plan of the statement.
+	// NOTE: this is experimental API
+	ExplainVisibilityQuery(context.Context, *ExplainVisibilityQueryRequest) (*ExplainVisibilityQueryResponse, error)
+	// StreamVisibilityChanges streams the changes made to the visibility records of the workflow executions of a
+	// namespace by the visibility task queues of all the history shards. Changes are delivered at least once, in the
+	// order the visibility tasks were executed: the changes of a workflow execution have to be ordered by task ID.
+	// The stream can be resumed with the cursor of the last received response.
+	// NOTE: this is experimental API
+	StreamVisibilityChanges(*StreamVisibilityChangesRequest, AdminService_StreamVisibilityChangesServer) error
+	// UpsertSavedVisibilityQuery creates or replaces a saved visibility query of a namespace. The saved query is
+	// listed with "view:<name>" as the query of ListWorkflowExecutions.
+	// NOTE: this is experimental API
+	UpsertSavedVisibilityQuery(context.Context, *UpsertSavedVisibilityQueryRequest) (*UpsertSavedVisibilityQueryResponse, error)
+	// DeleteSavedVisibilityQuery deletes a saved visibility query of a namespace.
+	// NOTE: this is experimental API
+	DeleteSavedVisibilityQuery(context.Context, *DeleteSavedVisibilityQueryRequest) (*DeleteSavedVisibilityQueryResponse, error)
+	// ListSavedVisibilityQueries returns the saved visibility queries of a namespace.
+	// NOTE: this is experimental API
+	ListSavedVisibilityQueries(context.Context, *ListSavedVisibilityQueriesRequest) (*ListSavedVisibilityQueriesResponse, error)
+	// UpdateSearchAttribute renames a custom search attribute of a namespace, or changes its type. A type change
+	// starts a system workflow which backfills the values of the search attribute into a new field of the visibility
+	// store, swaps the alias of the search attribute to the new field and drops the old field.
+	// The custom search attributes of Elasticsearch and OpenSearch are the fields of the index mappings, they are not
+	// aliases: a new name is required, the field with the new name is added to the index mappings and the values are
+	// backfilled into it. The request is rejected with InvalidArgument if only some of the visibility stores are
+	// Elasticsearch compatible.
+	// NOTE: this is experimental API
+	UpdateSearchAttribute(context.Context, *UpdateSearchAttributeRequest) (*UpdateSearchAttributeResponse, error)
+	// DropSearchAttribute removes a custom search attribute of a namespace, and starts a system workflow which
+	// clears its values from the visibility store.
+	// Rejected with InvalidArgument if only some of the visibility stores are Elasticsearch compatible, like
+	// UpdateSearchAttribute.
+	// NOTE: this is experimental API
+	DropSearchAttribute(context.Context, *DropSearchAttributeRequest) (*DropSearchAttributeResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -660,6 +930,17 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// PauseTaskQueue pauses the dispatch of tasks of a task queue in all its partitions. New tasks are still
+	// added to the backlog of the task queue, and dispatched when the task queue is resumed.
+	PauseTaskQueue(context.Context, *PauseTaskQueueRequest) (*PauseTaskQueueResponse, error)
+	// ResumeTaskQueue resumes the dispatch of tasks of a task queue paused by PauseTaskQueue.
+	ResumeTaskQueue(context.Context, *ResumeTaskQueueRequest) (*ResumeTaskQueueResponse, error)
+	// PurgeTaskQueueTasks deletes the backlog tasks of a task queue matching a filter, in all its partitions.
+	// Tasks are deleted asynchronously, when matching reads them from the backlog or by the task queue scavenger.
+	PurgeTaskQueueTasks(context.Context, *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error)
+	// DescribeTaskQueueBacklog summarizes the tasks persisted in the backlog of a task queue partition by type,
+	// priority key, fairness key and age.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -673,6 +954,36 @@ func (UnimplementedAdminServiceServer) RebuildMutableState(context.Context, *Reb
 func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
 }
+func (UnimplementedAdminServiceServer) RestoreArchivedWorkflowExecution(context.Context, *RestoreArchivedWorkflowExecutionRequest) (*RestoreArchivedWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArchivedWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) CheckVisibilityConsistency(context.Context, *CheckVisibilityConsistencyRequest) (*CheckVisibilityConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckVisibilityConsistency not implemented")
+}
+func (UnimplementedAdminServiceServer) ExplainVisibilityQuery(context.Context, *ExplainVisibilityQueryRequest) (*ExplainVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) StreamVisibilityChanges(*StreamVisibilityChangesRequest, AdminService_StreamVisibilityChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamVisibilityChanges not implemented")
+}
+func (UnimplementedAdminServiceServer) UpsertSavedVisibilityQuery(context.Context, *UpsertSavedVisibilityQueryRequest) (*UpsertSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertSavedVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteSavedVisibilityQuery(context.Context, *DeleteSavedVisibilityQueryRequest) (*DeleteSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSavedVisibilityQueries(context.Context, *ListSavedVisibilityQueriesRequest) (*ListSavedVisibilityQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedVisibilityQueries not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateSearchAttribute(context.Context, *UpdateSearchAttributeRequest) (*UpdateSearchAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSearchAttribute not implemented")
+}
+func (UnimplementedAdminServiceServer) DropSearchAttribute(context.Context, *DropSearchAttributeRequest) (*DropSearchAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSearchAttribute not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
@@ -796,6 +1107,18 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) PauseTaskQueue(context.Context, *PauseTaskQueueRequest) (*PauseTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTaskQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeTaskQueue(context.Context, *ResumeTaskQueueRequest) (*ResumeTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTaskQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeTaskQueueTasks(context.Context, *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTaskQueueTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -845,6 +1168,189 @@ func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreArchivedWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArchivedWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreArchivedWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreArchivedWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreArchivedWorkflowExecution(ctx, req.(*RestoreArchivedWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AggregateWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AggregateWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, req.(*AggregateWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CheckVisibilityConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckVisibilityConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckVisibilityConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CheckVisibilityConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckVisibilityConsistency(ctx, req.(*CheckVisibilityConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExplainVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExplainVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExplainVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExplainVisibilityQuery(ctx, req.(*ExplainVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StreamVisibilityChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamVisibilityChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamVisibilityChanges(m, &adminServiceStreamVisibilityChangesServer{stream})
+}
+
+type AdminService_StreamVisibilityChangesServer interface {
+	Send(*StreamVisibilityChangesResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamVisibilityChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamVisibilityChangesServer) Send(m *StreamVisibilityChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_UpsertSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpsertSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpsertSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpsertSavedVisibilityQuery(ctx, req.(*UpsertSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteSavedVisibilityQuery(ctx, req.(*DeleteSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSavedVisibilityQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedVisibilityQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSavedVisibilityQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSavedVisibilityQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSavedVisibilityQueries(ctx, req.(*ListSavedVisibilityQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSearchAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSearchAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSearchAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateSearchAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSearchAttribute(ctx, req.(*UpdateSearchAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DropSearchAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSearchAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DropSearchAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DropSearchAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DropSearchAttribute(ctx, req.(*DropSearchAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeMutableStateRequest)
 	if err := dec(in); err != nil {
@@ -1591,6 +2097,78 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PauseTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseTaskQueue(ctx, req.(*PauseTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeTaskQueue(ctx, req.(*ResumeTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PurgeTaskQueueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeTaskQueueTasks(ctx, req.(*PurgeTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1606,6 +2184,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportWorkflowExecution",
 			Handler:    _AdminService_ImportWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RestoreArchivedWorkflowExecution",
+			Handler:    _AdminService_RestoreArchivedWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "AggregateWorkflowExecutions",
+			Handler:    _AdminService_AggregateWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "CheckVisibilityConsistency",
+			Handler:    _AdminService_CheckVisibilityConsistency_Handler,
+		},
+		{
+			MethodName: "ExplainVisibilityQuery",
+			Handler:    _AdminService_ExplainVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "UpsertSavedVisibilityQuery",
+			Handler:    _AdminService_UpsertSavedVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "DeleteSavedVisibilityQuery",
+			Handler:    _AdminService_DeleteSavedVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "ListSavedVisibilityQueries",
+			Handler:    _AdminService_ListSavedVisibilityQueries_Handler,
+		},
+		{
+			MethodName: "UpdateSearchAttribute",
+			Handler:    _AdminService_UpdateSearchAttribute_Handler,
+		},
+		{
+			MethodName: "DropSearchAttribute",
+			Handler:    _AdminService_DropSearchAttribute_Handler,
+		},
 		{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
@@ -1766,8 +2380,29 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "PauseTaskQueue",
+			Handler:    _AdminService_PauseTaskQueue_Handler,
+		},
+		{
+			MethodName: "ResumeTaskQueue",
+			Handler:    _AdminService_ResumeTaskQueue_Handler,
+		},
+		{
+			MethodName: "PurgeTaskQueueTasks",
+			Handler:    _AdminService_PurgeTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _AdminService_DescribeTaskQueueBacklog_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamVisibilityChanges",
+			Handler:       _AdminService_StreamVisibilityChanges_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamWorkflowReplicationMessages",
 			Handler:       _AdminService_StreamWorkflowReplicationMessages_Handler,
@@ -103,6 +103,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddTasks(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).AddTasks), varargs...)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *adminservice.AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) AggregateWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).AggregateWorkflowExecutions), varargs...)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceClient) CancelDLQJob(ctx context.Context, in *adminservice.CancelDLQJobRequest, opts ...grpc.CallOption) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +143,26 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CheckVisibilityConsistency mocks base method.
+func (m *MockAdminServiceClient) CheckVisibilityConsistency(ctx context.Context, in *adminservice.CheckVisibilityConsistencyRequest, opts ...grpc.CallOption) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckVisibilityConsistency", varargs...)
+	ret0, _ := ret[0].(*adminservice.CheckVisibilityConsistencyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVisibilityConsistency indicates an expected call of CheckVisibilityConsistency.
+func (mr *MockAdminServiceClientMockRecorder) CheckVisibilityConsistency(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVisibilityConsistency", reflect.TypeOf((*MockAdminServiceClient)(nil).CheckVisibilityConsistency), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +203,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) DeleteSavedVisibilityQuery(ctx context.Context, in *adminservice.DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedVisibilityQuery indicates an expected call of DeleteSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) DeleteSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteSavedVisibilityQuery), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +323,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *adminservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueueBacklog), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +363,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DropSearchAttribute mocks base method.
+func (m *MockAdminServiceClient) DropSearchAttribute(ctx context.Context, in *adminservice.DropSearchAttributeRequest, opts ...grpc.CallOption) (*adminservice.DropSearchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DropSearchAttribute", varargs...)
+	ret0, _ := ret[0].(*adminservice.DropSearchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DropSearchAttribute indicates an expected call of DropSearchAttribute.
+func (mr *MockAdminServiceClientMockRecorder) DropSearchAttribute(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropSearchAttribute", reflect.TypeOf((*MockAdminServiceClient)(nil).DropSearchAttribute), varargs...)
+}
+
+// ExplainVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) ExplainVisibilityQuery(ctx context.Context, in *adminservice.ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExplainVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainVisibilityQuery indicates an expected call of ExplainVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) ExplainVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).ExplainVisibilityQuery), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +763,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListSavedVisibilityQueries mocks base method.
+func (m *MockAdminServiceClient) ListSavedVisibilityQueries(ctx context.Context, in *adminservice.ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSavedVisibilityQueries", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListSavedVisibilityQueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedVisibilityQueries indicates an expected call of ListSavedVisibilityQueries.
+func (mr *MockAdminServiceClientMockRecorder) ListSavedVisibilityQueries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedVisibilityQueries", reflect.TypeOf((*MockAdminServiceClient)(nil).ListSavedVisibilityQueries), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +823,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// PauseTaskQueue mocks base method.
+func (m *MockAdminServiceClient) PauseTaskQueue(ctx context.Context, in *adminservice.PauseTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.PauseTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseTaskQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueue indicates an expected call of PauseTaskQueue.
+func (mr *MockAdminServiceClientMockRecorder) PauseTaskQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseTaskQueue), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +883,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQTasks), varargs...)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockAdminServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *adminservice.PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockAdminServiceClientMockRecorder) PurgeTaskQueueTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeTaskQueueTasks), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1043,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RestoreArchivedWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RestoreArchivedWorkflowExecution(ctx context.Context, in *adminservice.RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreArchivedWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArchivedWorkflowExecution indicates an expected call of RestoreArchivedWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RestoreArchivedWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreArchivedWorkflowExecution), varargs...)
+}
+
+// ResumeTaskQueue mocks base method.
+func (m *MockAdminServiceClient) ResumeTaskQueue(ctx context.Context, in *adminservice.ResumeTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.ResumeTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeTaskQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueue indicates an expected call of ResumeTaskQueue.
+func (mr *MockAdminServiceClientMockRecorder) ResumeTaskQueue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).ResumeTaskQueue), varargs...)
+}
+
+// StreamVisibilityChanges mocks base method.
+func (m *MockAdminServiceClient) StreamVisibilityChanges(ctx context.Context, in *adminservice.StreamVisibilityChangesRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamVisibilityChangesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamVisibilityChanges", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamVisibilityChangesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamVisibilityChanges indicates an expected call of StreamVisibilityChanges.
+func (mr *MockAdminServiceClientMockRecorder) StreamVisibilityChanges(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamVisibilityChanges", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamVisibilityChanges), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1143,170 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpdateSearchAttribute mocks base method.
+func (m *MockAdminServiceClient) UpdateSearchAttribute(ctx context.Context, in *adminservice.UpdateSearchAttributeRequest, opts ...grpc.CallOption) (*adminservice.UpdateSearchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSearchAttribute", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateSearchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSearchAttribute indicates an expected call of UpdateSearchAttribute.
+func (mr *MockAdminServiceClientMockRecorder) UpdateSearchAttribute(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchAttribute", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateSearchAttribute), varargs...)
+}
+
+// UpsertSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) UpsertSavedVisibilityQuery(ctx context.Context, in *adminservice.UpsertSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpsertSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSavedVisibilityQuery indicates an expected call of UpsertSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) UpsertSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).UpsertSavedVisibilityQuery), varargs...)
+}
+
+// MockAdminService_StreamVisibilityChangesClient is a mock of AdminService_StreamVisibilityChangesClient interface.
+type MockAdminService_StreamVisibilityChangesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamVisibilityChangesClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminService_StreamVisibilityChangesClientMockRecorder is the mock recorder for MockAdminService_StreamVisibilityChangesClient.
+type MockAdminService_StreamVisibilityChangesClientMockRecorder struct {
+	mock *MockAdminService_StreamVisibilityChangesClient
+}
+
+// NewMockAdminService_StreamVisibilityChangesClient creates a new mock instance.
+func NewMockAdminService_StreamVisibilityChangesClient(ctrl *gomock.Controller) *MockAdminService_StreamVisibilityChangesClient {
+	mock := &MockAdminService_StreamVisibilityChangesClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamVisibilityChangesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamVisibilityChangesClient) EXPECT() *MockAdminService_StreamVisibilityChangesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesClient) Recv() (*adminservice.StreamVisibilityChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamVisibilityChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamVisibilityChangesClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamVisibilityChangesClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamVisibilityChangesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesClient)(nil).Trailer))
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1110,6 +1514,21 @@ func (mr *MockAdminServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) AggregateWorkflowExecutions(arg0 context.Context, arg1 *adminservice.AggregateWorkflowExecutionsRequest) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) AggregateWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).AggregateWorkflowExecutions), arg0, arg1)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceServer) CancelDLQJob(arg0 context.Context, arg1 *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1125,6 +1544,21 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CheckVisibilityConsistency mocks base method.
+func (m *MockAdminServiceServer) CheckVisibilityConsistency(arg0 context.Context, arg1 *adminservice.CheckVisibilityConsistencyRequest) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVisibilityConsistency", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CheckVisibilityConsistencyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVisibilityConsistency indicates an expected call of CheckVisibilityConsistency.
+func (mr *MockAdminServiceServerMockRecorder) CheckVisibilityConsistency(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVisibilityConsistency", reflect.TypeOf((*MockAdminServiceServer)(nil).CheckVisibilityConsistency), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1155,6 +1589,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) DeleteSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.DeleteSavedVisibilityQueryRequest) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedVisibilityQuery indicates an expected call of DeleteSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) DeleteSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteSavedVisibilityQuery), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1679,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueBacklogRequest) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueueBacklog), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1245,6 +1709,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DropSearchAttribute mocks base method.
+func (m *MockAdminServiceServer) DropSearchAttribute(arg0 context.Context, arg1 *adminservice.DropSearchAttributeRequest) (*adminservice.DropSearchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropSearchAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DropSearchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DropSearchAttribute indicates an expected call of DropSearchAttribute.
+func (mr *MockAdminServiceServerMockRecorder) DropSearchAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropSearchAttribute", reflect.TypeOf((*MockAdminServiceServer)(nil).DropSearchAttribute), arg0, arg1)
+}
+
+// ExplainVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) ExplainVisibilityQuery(arg0 context.Context, arg1 *adminservice.ExplainVisibilityQueryRequest) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExplainVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainVisibilityQuery indicates an expected call of ExplainVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) ExplainVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).ExplainVisibilityQuery), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1515,6 +2009,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListSavedVisibilityQueries mocks base method.
+func (m *MockAdminServiceServer) ListSavedVisibilityQueries(arg0 context.Context, arg1 *adminservice.ListSavedVisibilityQueriesRequest) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedVisibilityQueries", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListSavedVisibilityQueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedVisibilityQueries indicates an expected call of ListSavedVisibilityQueries.
+func (mr *MockAdminServiceServerMockRecorder) ListSavedVisibilityQueries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedVisibilityQueries", reflect.TypeOf((*MockAdminServiceServer)(nil).ListSavedVisibilityQueries), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1545,6 +2054,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// PauseTaskQueue mocks base method.
+func (m *MockAdminServiceServer) PauseTaskQueue(arg0 context.Context, arg1 *adminservice.PauseTaskQueueRequest) (*adminservice.PauseTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueue indicates an expected call of PauseTaskQueue.
+func (mr *MockAdminServiceServerMockRecorder) PauseTaskQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseTaskQueue), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1575,6 +2099,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQTasks), arg0, arg1)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockAdminServiceServer) PurgeTaskQueueTasks(arg0 context.Context, arg1 *adminservice.PurgeTaskQueueTasksRequest) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockAdminServiceServerMockRecorder) PurgeTaskQueueTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeTaskQueueTasks), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1680,6 +2219,50 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RestoreArchivedWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RestoreArchivedWorkflowExecution(arg0 context.Context, arg1 *adminservice.RestoreArchivedWorkflowExecutionRequest) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreArchivedWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArchivedWorkflowExecution indicates an expected call of RestoreArchivedWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RestoreArchivedWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreArchivedWorkflowExecution), arg0, arg1)
+}
+
+// ResumeTaskQueue mocks base method.
+func (m *MockAdminServiceServer) ResumeTaskQueue(arg0 context.Context, arg1 *adminservice.ResumeTaskQueueRequest) (*adminservice.ResumeTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueue indicates an expected call of ResumeTaskQueue.
+func (mr *MockAdminServiceServerMockRecorder) ResumeTaskQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).ResumeTaskQueue), arg0, arg1)
+}
+
+// StreamVisibilityChanges mocks base method.
+func (m *MockAdminServiceServer) StreamVisibilityChanges(arg0 *adminservice.StreamVisibilityChangesRequest, arg1 adminservice.AdminService_StreamVisibilityChangesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamVisibilityChanges", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamVisibilityChanges indicates an expected call of StreamVisibilityChanges.
+func (mr *MockAdminServiceServerMockRecorder) StreamVisibilityChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamVisibilityChanges", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamVisibilityChanges), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1709,6 +2292,36 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateSearchAttribute mocks base method.
+func (m *MockAdminServiceServer) UpdateSearchAttribute(arg0 context.Context, arg1 *adminservice.UpdateSearchAttributeRequest) (*adminservice.UpdateSearchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSearchAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateSearchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSearchAttribute indicates an expected call of UpdateSearchAttribute.
+func (mr *MockAdminServiceServerMockRecorder) UpdateSearchAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchAttribute", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateSearchAttribute), arg0, arg1)
+}
+
+// UpsertSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) UpsertSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.UpsertSavedVisibilityQueryRequest) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpsertSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSavedVisibilityQuery indicates an expected call of UpsertSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) UpsertSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).UpsertSavedVisibilityQuery), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -1757,6 +2370,126 @@ func (mr *MockUnsafeAdminServiceServerMockRecorder) mustEmbedUnimplementedAdminS
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAdminServiceServer", reflect.TypeOf((*MockUnsafeAdminServiceServer)(nil).mustEmbedUnimplementedAdminServiceServer))
 }
 
+// MockAdminService_StreamVisibilityChangesServer is a mock of AdminService_StreamVisibilityChangesServer interface.
+type MockAdminService_StreamVisibilityChangesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamVisibilityChangesServerMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminService_StreamVisibilityChangesServerMockRecorder is the mock recorder for MockAdminService_StreamVisibilityChangesServer.
+type MockAdminService_StreamVisibilityChangesServerMockRecorder struct {
+	mock *MockAdminService_StreamVisibilityChangesServer
+}
+
+// NewMockAdminService_StreamVisibilityChangesServer creates a new mock instance.
+func NewMockAdminService_StreamVisibilityChangesServer(ctrl *gomock.Controller) *MockAdminService_StreamVisibilityChangesServer {
+	mock := &MockAdminService_StreamVisibilityChangesServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamVisibilityChangesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamVisibilityChangesServer) EXPECT() *MockAdminService_StreamVisibilityChangesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamVisibilityChangesServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesServer) Send(arg0 *adminservice.StreamVisibilityChangesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamVisibilityChangesServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamVisibilityChangesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamVisibilityChangesServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamVisibilityChangesServer)(nil).SetTrailer), arg0)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesServer is a mock of AdminService_StreamWorkflowReplicationMessagesServer interface.
 type MockAdminService_StreamWorkflowReplicationMessagesServer struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,67 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package enums
+
+import (
+	"fmt"
+)
+
+var (
+	VisibilityAggregationFunction_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Min":         1,
+		"Max":         2,
+		"Avg":         3,
+	}
+)
+
+// VisibilityAggregationFunctionFromString parses a VisibilityAggregationFunction value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to VisibilityAggregationFunction
+func VisibilityAggregationFunctionFromString(s string) (VisibilityAggregationFunction, error) {
+	if v, ok := VisibilityAggregationFunction_value[s]; ok {
+		return VisibilityAggregationFunction(v), nil
+	} else if v, ok := VisibilityAggregationFunction_shorthandValue[s]; ok {
+		return VisibilityAggregationFunction(v), nil
+	}
+	return VisibilityAggregationFunction(0), fmt.Errorf("%s is not a valid VisibilityAggregationFunction", s)
+}
+
+var (
+	VisibilityConsistencyMismatchType_shorthandValue = map[string]int32{
+		"Unspecified":        0,
+		"MissingInSecondary": 1,
+		"MissingInPrimary":   2,
+		"FieldsDiffer":       3,
+	}
+)
+
+// VisibilityConsistencyMismatchTypeFromString parses a VisibilityConsistencyMismatchType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to VisibilityConsistencyMismatchType
+func VisibilityConsistencyMismatchTypeFromString(s string) (VisibilityConsistencyMismatchType, error) {
+	if v, ok := VisibilityConsistencyMismatchType_value[s]; ok {
+		return VisibilityConsistencyMismatchType(v), nil
+	} else if v, ok := VisibilityConsistencyMismatchType_shorthandValue[s]; ok {
+		return VisibilityConsistencyMismatchType(v), nil
+	}
+	return VisibilityConsistencyMismatchType(0), fmt.Errorf("%s is not a valid VisibilityConsistencyMismatchType", s)
+}
+
+var (
+	VisibilityChangeType_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Started":     1,
+		"Upserted":    2,
+		"Closed":      3,
+		"Deleted":     4,
+	}
+)
+
+// VisibilityChangeTypeFromString parses a VisibilityChangeType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to VisibilityChangeType
+func VisibilityChangeTypeFromString(s string) (VisibilityChangeType, error) {
+	if v, ok := VisibilityChangeType_value[s]; ok {
+		return VisibilityChangeType(v), nil
+	} else if v, ok := VisibilityChangeType_shorthandValue[s]; ok {
+		return VisibilityChangeType(v), nil
+	}
+	return VisibilityChangeType(0), fmt.Errorf("%s is not a valid VisibilityChangeType", s)
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/enums/v1/visibility.proto
+
+package enums
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VisibilityAggregationFunction int32
+
+const (
+	VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED VisibilityAggregationFunction = 0
+	// Minimum value of an Int, Double or Datetime search attribute.
+	VISIBILITY_AGGREGATION_FUNCTION_MIN VisibilityAggregationFunction = 1
+	// Maximum value of an Int, Double or Datetime search attribute.
+	VISIBILITY_AGGREGATION_FUNCTION_MAX VisibilityAggregationFunction = 2
+	// Average value of an Int or Double search attribute.
+	VISIBILITY_AGGREGATION_FUNCTION_AVG VisibilityAggregationFunction = 3
+)
+
+// Enum value maps for VisibilityAggregationFunction.
+var (
+	VisibilityAggregationFunction_name = map[int32]string{
+		0: "VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED",
+		1: "VISIBILITY_AGGREGATION_FUNCTION_MIN",
+		2: "VISIBILITY_AGGREGATION_FUNCTION_MAX",
+		3: "VISIBILITY_AGGREGATION_FUNCTION_AVG",
+	}
+	VisibilityAggregationFunction_value = map[string]int32{
+		"VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED": 0,
+		"VISIBILITY_AGGREGATION_FUNCTION_MIN":         1,
+		"VISIBILITY_AGGREGATION_FUNCTION_MAX":         2,
+		"VISIBILITY_AGGREGATION_FUNCTION_AVG":         3,
+	}
+)
+
+func (x VisibilityAggregationFunction) Enum() *VisibilityAggregationFunction {
+	p := new(VisibilityAggregationFunction)
+	*p = x
+	return p
+}
+
+func (x VisibilityAggregationFunction) String() string {
+	switch x {
+	case VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED:
+		return "Unspecified"
+	case VISIBILITY_AGGREGATION_FUNCTION_MIN:
+		return "Min"
+	case VISIBILITY_AGGREGATION_FUNCTION_MAX:
+		return "Max"
+	case VISIBILITY_AGGREGATION_FUNCTION_AVG:
+		return "Avg"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (VisibilityAggregationFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_visibility_proto_enumTypes[0].Descriptor()
+}
+
+func (VisibilityAggregationFunction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_visibility_proto_enumTypes[0]
+}
+
+func (x VisibilityAggregationFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityAggregationFunction.Descriptor instead.
+func (VisibilityAggregationFunction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_visibility_proto_rawDescGZIP(), []int{0}
+}
+
+type VisibilityConsistencyMismatchType int32
+
+const (
+	VISIBILITY_CONSISTENCY_MISMATCH_TYPE_UNSPECIFIED VisibilityConsistencyMismatchType = 0
+	// The workflow execution has a record in the primary visibility store only.
+	VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY VisibilityConsistencyMismatchType = 1
+	// The workflow execution has a record in the secondary visibility store only.
+	VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY VisibilityConsistencyMismatchType = 2
+	// The records of the workflow execution differ between the visibility stores.
+	VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER VisibilityConsistencyMismatchType = 3
+)
+
+// Enum value maps for VisibilityConsistencyMismatchType.
+var (
+	VisibilityConsistencyMismatchType_name = map[int32]string{
+		0: "VISIBILITY_CONSISTENCY_MISMATCH_TYPE_UNSPECIFIED",
+		1: "VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY",
+		2: "VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY",
+		3: "VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER",
+	}
+	VisibilityConsistencyMismatchType_value = map[string]int32{
+		"VISIBILITY_CONSISTENCY_MISMATCH_TYPE_UNSPECIFIED":          0,
+		"VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY": 1,
+		"VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY":   2,
+		"VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER":        3,
+	}
+)
+
+func (x VisibilityConsistencyMismatchType) Enum() *VisibilityConsistencyMismatchType {
+	p := new(VisibilityConsistencyMismatchType)
+	*p = x
+	return p
+}
+
+func (x VisibilityConsistencyMismatchType) String() string {
+	switch x {
+	case VISIBILITY_CONSISTENCY_MISMATCH_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY:
+		return "MissingInSecondary"
+	case VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY:
+		return "MissingInPrimary"
+	case VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER:
+		return "FieldsDiffer"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (VisibilityConsistencyMismatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_visibility_proto_enumTypes[1].Descriptor()
+}
+
+func (VisibilityConsistencyMismatchType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_visibility_proto_enumTypes[1]
+}
+
+func (x VisibilityConsistencyMismatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityConsistencyMismatchType.Descriptor instead.
+func (VisibilityConsistencyMismatchType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_visibility_proto_rawDescGZIP(), []int{1}
+}
+
+type VisibilityChangeType int32
+
+const (
+	VISIBILITY_CHANGE_TYPE_UNSPECIFIED VisibilityChangeType = 0
+	// The workflow execution started.
+	VISIBILITY_CHANGE_TYPE_STARTED VisibilityChangeType = 1
+	// The search attributes or the memo of the running workflow execution were upserted.
+	VISIBILITY_CHANGE_TYPE_UPSERTED VisibilityChangeType = 2
+	// The workflow execution closed.
+	VISIBILITY_CHANGE_TYPE_CLOSED VisibilityChangeType = 3
+	// The visibility record of the workflow execution was deleted.
+	VISIBILITY_CHANGE_TYPE_DELETED VisibilityChangeType = 4
+)
+
+// Enum value maps for VisibilityChangeType.
+var (
+	VisibilityChangeType_name = map[int32]string{
+		0: "VISIBILITY_CHANGE_TYPE_UNSPECIFIED",
+		1: "VISIBILITY_CHANGE_TYPE_STARTED",
+		2: "VISIBILITY_CHANGE_TYPE_UPSERTED",
+		3: "VISIBILITY_CHANGE_TYPE_CLOSED",
+		4: "VISIBILITY_CHANGE_TYPE_DELETED",
+	}
+	VisibilityChangeType_value = map[string]int32{
+		"VISIBILITY_CHANGE_TYPE_UNSPECIFIED": 0,
+		"VISIBILITY_CHANGE_TYPE_STARTED":     1,
+		"VISIBILITY_CHANGE_TYPE_UPSERTED":    2,
+		"VISIBILITY_CHANGE_TYPE_CLOSED":      3,
+		"VISIBILITY_CHANGE_TYPE_DELETED":     4,
+	}
+)
+
+func (x VisibilityChangeType) Enum() *VisibilityChangeType {
+	p := new(VisibilityChangeType)
+	*p = x
+	return p
+}
+
+func (x VisibilityChangeType) String() string {
+	switch x {
+	case VISIBILITY_CHANGE_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case VISIBILITY_CHANGE_TYPE_STARTED:
+		return "Started"
+	case VISIBILITY_CHANGE_TYPE_UPSERTED:
+		return "Upserted"
+	case VISIBILITY_CHANGE_TYPE_CLOSED:
+		return "Closed"
+	case VISIBILITY_CHANGE_TYPE_DELETED:
+		return "Deleted"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (VisibilityChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_visibility_proto_enumTypes[2].Descriptor()
+}
+
+func (VisibilityChangeType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_visibility_proto_enumTypes[2]
+}
+
+func (x VisibilityChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityChangeType.Descriptor instead.
+func (VisibilityChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_visibility_proto_rawDescGZIP(), []int{2}
+}
+
+var File_temporal_server_api_enums_v1_visibility_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_enums_v1_visibility_proto_rawDesc = "" +
+	"\n" +
+	"-temporal/server/api/enums/v1/visibility.proto\x12\x1ctemporal.server.api.enums.v1*\xcb\x01\n" +
+	"\x1dVisibilityAggregationFunction\x12/\n" +
+	"+VISIBILITY_AGGREGATION_FUNCTION_UNSPECIFIED\x10\x00\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_MIN\x10\x01\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_MAX\x10\x02\x12'\n" +
+	"#VISIBILITY_AGGREGATION_FUNCTION_AVG\x10\x03*\x8d\x02\n" +
+	"!VisibilityConsistencyMismatchType\x124\n" +
+	"0VISIBILITY_CONSISTENCY_MISMATCH_TYPE_UNSPECIFIED\x10\x00\x12=\n" +
+	"9VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY\x10\x01\x12;\n" +
+	"7VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY\x10\x02\x126\n" +
+	"2VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER\x10\x03*\xce\x01\n" +
+	"\x14VisibilityChangeType\x12&\n" +
+	"\"VISIBILITY_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eVISIBILITY_CHANGE_TYPE_STARTED\x10\x01\x12#\n" +
+	"\x1fVISIBILITY_CHANGE_TYPE_UPSERTED\x10\x02\x12!\n" +
+	"\x1dVISIBILITY_CHANGE_TYPE_CLOSED\x10\x03\x12\"\n" +
+	"\x1eVISIBILITY_CHANGE_TYPE_DELETED\x10\x04B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+
+var (
+	file_temporal_server_api_enums_v1_visibility_proto_rawDescOnce sync.Once
+	file_temporal_server_api_enums_v1_visibility_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_enums_v1_visibility_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_enums_v1_visibility_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_enums_v1_visibility_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_visibility_proto_rawDesc), len(file_temporal_server_api_enums_v1_visibility_proto_rawDesc)))
+	})
+	return file_temporal_server_api_enums_v1_visibility_proto_rawDescData
+}
+
+var file_temporal_server_api_enums_v1_visibility_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_visibility_proto_goTypes = []any{
+	(VisibilityAggregationFunction)(0),     // 0: temporal.server.api.enums.v1.VisibilityAggregationFunction
+	(VisibilityConsistencyMismatchType)(0), // 1: temporal.server.api.enums.v1.VisibilityConsistencyMismatchType
+	(VisibilityChangeType)(0),              // 2: temporal.server.api.enums.v1.VisibilityChangeType
+}
+var file_temporal_server_api_enums_v1_visibility_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_enums_v1_visibility_proto_init() }
+func file_temporal_server_api_enums_v1_visibility_proto_init() {
+	if File_temporal_server_api_enums_v1_visibility_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_visibility_proto_rawDesc), len(file_temporal_server_api_enums_v1_visibility_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_enums_v1_visibility_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_enums_v1_visibility_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_enums_v1_visibility_proto_enumTypes,
+	}.Build()
+	File_temporal_server_api_enums_v1_visibility_proto = out.File
+	file_temporal_server_api_enums_v1_visibility_proto_goTypes = nil
+	file_temporal_server_api_enums_v1_visibility_proto_depIdxs = nil
+}
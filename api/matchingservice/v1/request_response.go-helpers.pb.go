@@ -1189,6 +1189,228 @@ func (this *SyncDeploymentUserDataResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateTaskQueuePauseStateRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseStateRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePauseStateRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseStateRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePauseStateRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePauseStateRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePauseStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePauseStateRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueuePauseStateRequest:
+		that1 = t
+	case UpdateTaskQueuePauseStateRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueuePauseStateResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseStateResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePauseStateResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseStateResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePauseStateResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePauseStateResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePauseStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePauseStateResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueuePauseStateResponse:
+		that1 = t
+	case UpdateTaskQueuePauseStateResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeTaskQueueTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeTaskQueueTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeTaskQueueTasksRequest
+	switch t := that.(type) {
+	case *PurgeTaskQueueTasksRequest:
+		that1 = t
+	case PurgeTaskQueueTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *PurgeTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeTaskQueueTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeTaskQueueTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeTaskQueueTasksResponse
+	switch t := that.(type) {
+	case *PurgeTaskQueueTasksResponse:
+		that1 = t
+	case PurgeTaskQueueTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogRequest:
+		that1 = t
+	case DescribeTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogResponse:
+		that1 = t
+	case DescribeTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ApplyTaskQueueUserDataReplicationEventRequest to the protobuf v3 wire format
 func (val *ApplyTaskQueueUserDataReplicationEventRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
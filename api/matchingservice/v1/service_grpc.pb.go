@@ -40,6 +40,9 @@ const (
 	MatchingService_UpdateWorkerVersioningRules_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerVersioningRules"
 	MatchingService_GetWorkerVersioningRules_FullMethodName               = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules"
 	MatchingService_SyncDeploymentUserData_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData"
+	MatchingService_UpdateTaskQueuePauseState_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueuePauseState"
+	MatchingService_PurgeTaskQueueTasks_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/PurgeTaskQueueTasks"
+	MatchingService_DescribeTaskQueueBacklog_FullMethodName               = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueueBacklog"
 	MatchingService_ApplyTaskQueueUserDataReplicationEvent_FullMethodName = "/temporal.server.api.matchingservice.v1.MatchingService/ApplyTaskQueueUserDataReplicationEvent"
 	MatchingService_GetBuildIdTaskQueueMapping_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdTaskQueueMapping"
 	MatchingService_ForceLoadTaskQueuePartition_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition"
@@ -128,6 +131,14 @@ type MatchingServiceClient interface {
 	GetWorkerVersioningRules(ctx context.Context, in *GetWorkerVersioningRulesRequest, opts ...grpc.CallOption) (*GetWorkerVersioningRulesResponse, error)
 	// This request should always be routed to the node holding the root partition of the workflow task queue.
 	SyncDeploymentUserData(ctx context.Context, in *SyncDeploymentUserDataRequest, opts ...grpc.CallOption) (*SyncDeploymentUserDataResponse, error)
+	// Pause or resume the dispatch of tasks of a task queue, in all its partitions.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	UpdateTaskQueuePauseState(ctx context.Context, in *UpdateTaskQueuePauseStateRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePauseStateResponse, error)
+	// Delete the backlog tasks of a task queue matching a filter, in all its partitions.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error)
+	// Summarize the tasks persisted in the backlog of a task queue partition.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
 	// Apply a user data replication event.
 	ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, in *ApplyTaskQueueUserDataReplicationEventRequest, opts ...grpc.CallOption) (*ApplyTaskQueueUserDataReplicationEventResponse, error)
 	// Gets all task queue names mapped to a given build ID
@@ -381,6 +392,33 @@ func (c *matchingServiceClient) SyncDeploymentUserData(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateTaskQueuePauseState(ctx context.Context, in *UpdateTaskQueuePauseStateRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePauseStateResponse, error) {
+	out := new(UpdateTaskQueuePauseStateResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueuePauseState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error) {
+	out := new(PurgeTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_PurgeTaskQueueTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DescribeTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, in *ApplyTaskQueueUserDataReplicationEventRequest, opts ...grpc.CallOption) (*ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	out := new(ApplyTaskQueueUserDataReplicationEventResponse)
 	err := c.cc.Invoke(ctx, MatchingService_ApplyTaskQueueUserDataReplicationEvent_FullMethodName, in, out, opts...)
@@ -563,6 +601,14 @@ type MatchingServiceServer interface {
 	GetWorkerVersioningRules(context.Context, *GetWorkerVersioningRulesRequest) (*GetWorkerVersioningRulesResponse, error)
 	// This request should always be routed to the node holding the root partition of the workflow task queue.
 	SyncDeploymentUserData(context.Context, *SyncDeploymentUserDataRequest) (*SyncDeploymentUserDataResponse, error)
+	// Pause or resume the dispatch of tasks of a task queue, in all its partitions.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	UpdateTaskQueuePauseState(context.Context, *UpdateTaskQueuePauseStateRequest) (*UpdateTaskQueuePauseStateResponse, error)
+	// Delete the backlog tasks of a task queue matching a filter, in all its partitions.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	PurgeTaskQueueTasks(context.Context, *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error)
+	// Summarize the tasks persisted in the backlog of a task queue partition.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
 	// Apply a user data replication event.
 	ApplyTaskQueueUserDataReplicationEvent(context.Context, *ApplyTaskQueueUserDataReplicationEventRequest) (*ApplyTaskQueueUserDataReplicationEventResponse, error)
 	// Gets all task queue names mapped to a given build ID
@@ -693,6 +739,15 @@ func (UnimplementedMatchingServiceServer) GetWorkerVersioningRules(context.Conte
 func (UnimplementedMatchingServiceServer) SyncDeploymentUserData(context.Context, *SyncDeploymentUserDataRequest) (*SyncDeploymentUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncDeploymentUserData not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateTaskQueuePauseState(context.Context, *UpdateTaskQueuePauseStateRequest) (*UpdateTaskQueuePauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueuePauseState not implemented")
+}
+func (UnimplementedMatchingServiceServer) PurgeTaskQueueTasks(context.Context, *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTaskQueueTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
 func (UnimplementedMatchingServiceServer) ApplyTaskQueueUserDataReplicationEvent(context.Context, *ApplyTaskQueueUserDataReplicationEventRequest) (*ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyTaskQueueUserDataReplicationEvent not implemented")
 }
@@ -1102,6 +1157,60 @@ func _MatchingService_SyncDeploymentUserData_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateTaskQueuePauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueuePauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueuePauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateTaskQueuePauseState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueuePauseState(ctx, req.(*UpdateTaskQueuePauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_PurgeTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).PurgeTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_PurgeTaskQueueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).PurgeTaskQueueTasks(ctx, req.(*PurgeTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DescribeTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_ApplyTaskQueueUserDataReplicationEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyTaskQueueUserDataReplicationEventRequest)
 	if err := dec(in); err != nil {
@@ -1405,6 +1514,18 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncDeploymentUserData",
 			Handler:    _MatchingService_SyncDeploymentUserData_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueuePauseState",
+			Handler:    _MatchingService_UpdateTaskQueuePauseState_Handler,
+		},
+		{
+			MethodName: "PurgeTaskQueueTasks",
+			Handler:    _MatchingService_PurgeTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _MatchingService_DescribeTaskQueueBacklog_Handler,
+		},
 		{
 			MethodName: "ApplyTaskQueueUserDataReplicationEvent",
 			Handler:    _MatchingService_ApplyTaskQueueUserDataReplicationEvent_Handler,
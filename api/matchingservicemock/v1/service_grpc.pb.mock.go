@@ -202,6 +202,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueue(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueue), varargs...)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *matchingservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueueBacklog), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockMatchingServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *matchingservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -482,6 +502,26 @@ func (mr *MockMatchingServiceClientMockRecorder) PollWorkflowTaskQueue(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).PollWorkflowTaskQueue), varargs...)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *matchingservice.PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockMatchingServiceClientMockRecorder) PurgeTaskQueueTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).PurgeTaskQueueTasks), varargs...)
+}
+
 // QueryWorkflow mocks base method.
 func (m *MockMatchingServiceClient) QueryWorkflow(ctx context.Context, in *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -622,6 +662,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateNexusEndpoint(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpoint", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateNexusEndpoint), varargs...)
 }
 
+// UpdateTaskQueuePauseState mocks base method.
+func (m *MockMatchingServiceClient) UpdateTaskQueuePauseState(ctx context.Context, in *matchingservice.UpdateTaskQueuePauseStateRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueuePauseStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePauseState", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueuePauseStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePauseState indicates an expected call of UpdateTaskQueuePauseState.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueuePauseState(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePauseState", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueuePauseState), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -826,6 +886,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueue(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueue), arg0, arg1)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceServer) DescribeTaskQueueBacklog(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueBacklogRequest) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueueBacklog), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockMatchingServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueuePartitionRequest) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1036,6 +1111,21 @@ func (mr *MockMatchingServiceServerMockRecorder) PollWorkflowTaskQueue(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).PollWorkflowTaskQueue), arg0, arg1)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceServer) PurgeTaskQueueTasks(arg0 context.Context, arg1 *matchingservice.PurgeTaskQueueTasksRequest) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockMatchingServiceServerMockRecorder) PurgeTaskQueueTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).PurgeTaskQueueTasks), arg0, arg1)
+}
+
 // QueryWorkflow mocks base method.
 func (m *MockMatchingServiceServer) QueryWorkflow(arg0 context.Context, arg1 *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -1141,6 +1231,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateNexusEndpoint(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpoint", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateNexusEndpoint), arg0, arg1)
 }
 
+// UpdateTaskQueuePauseState mocks base method.
+func (m *MockMatchingServiceServer) UpdateTaskQueuePauseState(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueuePauseStateRequest) (*matchingservice.UpdateTaskQueuePauseStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePauseState", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueuePauseStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePauseState indicates an expected call of UpdateTaskQueuePauseState.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueuePauseState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePauseState", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueuePauseState), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +116,80 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type SavedVisibilityQuery to the protobuf v3 wire format
+func (val *SavedVisibilityQuery) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SavedVisibilityQuery from the protobuf v3 wire format
+func (val *SavedVisibilityQuery) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SavedVisibilityQuery) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SavedVisibilityQuery values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SavedVisibilityQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SavedVisibilityQuery
+	switch t := that.(type) {
+	case *SavedVisibilityQuery:
+		that1 = t
+	case SavedVisibilityQuery:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CustomSearchAttributeFieldMigration to the protobuf v3 wire format
+func (val *CustomSearchAttributeFieldMigration) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CustomSearchAttributeFieldMigration from the protobuf v3 wire format
+func (val *CustomSearchAttributeFieldMigration) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CustomSearchAttributeFieldMigration) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CustomSearchAttributeFieldMigration values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CustomSearchAttributeFieldMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CustomSearchAttributeFieldMigration
+	switch t := that.(type) {
+	case *CustomSearchAttributeFieldMigration:
+		that1 = t
+	case CustomSearchAttributeFieldMigration:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NamespaceReplicationConfig to the protobuf v3 wire format
 func (val *NamespaceReplicationConfig) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
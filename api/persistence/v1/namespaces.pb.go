@@ -215,8 +215,13 @@ type NamespaceConfig struct {
 	VisibilityArchivalUri        string                       `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
 	CustomSearchAttributeAliases map[string]string            `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	WorkflowRules                map[string]*v12.WorkflowRule `protobuf:"bytes,9,rep,name=workflow_rules,json=workflowRules,proto3" json:"workflow_rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+	// Saved visibility queries of the namespace, by name. ListWorkflowExecutions expands "view:<name>" queries.
+	SavedVisibilityQueries map[string]*SavedVisibilityQuery `protobuf:"bytes,10,rep,name=saved_visibility_queries,json=savedVisibilityQueries,proto3" json:"saved_visibility_queries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Migrations of custom search attribute fields, by source field name. Fields referenced by a migration are not
+	// allocated to other search attributes.
+	CustomSearchAttributeFieldMigrations map[string]*CustomSearchAttributeFieldMigration `protobuf:"bytes,11,rep,name=custom_search_attribute_field_migrations,json=customSearchAttributeFieldMigrations,proto3" json:"custom_search_attribute_field_migrations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
 }
 
 func (x *NamespaceConfig) Reset() {
@@ -312,6 +317,147 @@ func (x *NamespaceConfig) GetWorkflowRules() map[string]*v12.WorkflowRule {
 	return nil
 }
 
+func (x *NamespaceConfig) GetSavedVisibilityQueries() map[string]*SavedVisibilityQuery {
+	if x != nil {
+		return x.SavedVisibilityQueries
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetCustomSearchAttributeFieldMigrations() map[string]*CustomSearchAttributeFieldMigration {
+	if x != nil {
+		return x.CustomSearchAttributeFieldMigrations
+	}
+	return nil
+}
+
+type SavedVisibilityQuery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Visibility query in the ListWorkflowExecutions syntax.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Search attributes displayed by default as the columns of the workflow executions listed with the query.
+	DefaultColumns []string `protobuf:"bytes,2,rep,name=default_columns,json=defaultColumns,proto3" json:"default_columns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SavedVisibilityQuery) Reset() {
+	*x = SavedVisibilityQuery{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedVisibilityQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedVisibilityQuery) ProtoMessage() {}
+
+func (x *SavedVisibilityQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedVisibilityQuery.ProtoReflect.Descriptor instead.
+func (*SavedVisibilityQuery) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SavedVisibilityQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedVisibilityQuery) GetDefaultColumns() []string {
+	if x != nil {
+		return x.DefaultColumns
+	}
+	return nil
+}
+
+// Migration of the values of a custom search attribute field to another field, to change the type of the search
+// attribute or to drop it. The mutable states of workflow executions keep the values of the source field, so these
+// are converted whenever visibility records are written.
+type CustomSearchAttributeFieldMigration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field the values are copied to. Empty if the search attribute is dropped.
+	TargetField string              `protobuf:"bytes,1,opt,name=target_field,json=targetField,proto3" json:"target_field,omitempty"`
+	SourceType  v1.IndexedValueType `protobuf:"varint,2,opt,name=source_type,json=sourceType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"source_type,omitempty"`
+	TargetType  v1.IndexedValueType `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"target_type,omitempty"`
+	// Values of the source field are removed from visibility records.
+	SourceDropped bool `protobuf:"varint,4,opt,name=source_dropped,json=sourceDropped,proto3" json:"source_dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomSearchAttributeFieldMigration) Reset() {
+	*x = CustomSearchAttributeFieldMigration{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomSearchAttributeFieldMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomSearchAttributeFieldMigration) ProtoMessage() {}
+
+func (x *CustomSearchAttributeFieldMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomSearchAttributeFieldMigration.ProtoReflect.Descriptor instead.
+func (*CustomSearchAttributeFieldMigration) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CustomSearchAttributeFieldMigration) GetTargetField() string {
+	if x != nil {
+		return x.TargetField
+	}
+	return ""
+}
+
+func (x *CustomSearchAttributeFieldMigration) GetSourceType() v1.IndexedValueType {
+	if x != nil {
+		return x.SourceType
+	}
+	return v1.IndexedValueType(0)
+}
+
+func (x *CustomSearchAttributeFieldMigration) GetTargetType() v1.IndexedValueType {
+	if x != nil {
+		return x.TargetType
+	}
+	return v1.IndexedValueType(0)
+}
+
+func (x *CustomSearchAttributeFieldMigration) GetSourceDropped() bool {
+	if x != nil {
+		return x.SourceDropped
+	}
+	return false
+}
+
 type NamespaceReplicationConfig struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	ActiveClusterName string                 `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
@@ -324,7 +470,7 @@ type NamespaceReplicationConfig struct {
 
 func (x *NamespaceReplicationConfig) Reset() {
 	*x = NamespaceReplicationConfig{}
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +482,7 @@ func (x *NamespaceReplicationConfig) String() string {
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 
 func (x *NamespaceReplicationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +495,7 @@ func (x *NamespaceReplicationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceReplicationConfig.ProtoReflect.Descriptor instead.
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NamespaceReplicationConfig) GetActiveClusterName() string {
@@ -391,7 +537,7 @@ type FailoverStatus struct {
 
 func (x *FailoverStatus) Reset() {
 	*x = FailoverStatus{}
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +549,7 @@ func (x *FailoverStatus) String() string {
 func (*FailoverStatus) ProtoMessage() {}
 
 func (x *FailoverStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +562,7 @@ func (x *FailoverStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailoverStatus.ProtoReflect.Descriptor instead.
 func (*FailoverStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FailoverStatus) GetFailoverTime() *timestamppb.Timestamp {
@@ -437,7 +583,7 @@ var File_temporal_server_api_persistence_v1_namespaces_proto protoreflect.FileDe
 
 const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\n" +
-	"3temporal/server/api/persistence/v1/namespaces.proto\x12\"temporal.server.api.persistence.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%temporal/api/enums/v1/namespace.proto\x1a'temporal/api/namespace/v1/message.proto\x1a#temporal/api/rules/v1/message.proto\"\xf2\x03\n" +
+	"3temporal/server/api/persistence/v1/namespaces.proto\x12\"temporal.server.api.persistence.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"temporal/api/enums/v1/common.proto\x1a%temporal/api/enums/v1/namespace.proto\x1a'temporal/api/namespace/v1/message.proto\x1a#temporal/api/rules/v1/message.proto\"\xf2\x03\n" +
 	"\x0fNamespaceDetail\x12E\n" +
 	"\x04info\x18\x01 \x01(\v21.temporal.server.api.persistence.v1.NamespaceInfoR\x04info\x12K\n" +
 	"\x06config\x18\x02 \x01(\v23.temporal.server.api.persistence.v1.NamespaceConfigR\x06config\x12m\n" +
@@ -455,7 +601,7 @@ const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\x04data\x18\x06 \x03(\v2;.temporal.server.api.persistence.v1.NamespaceInfo.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\a\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\f\n" +
 	"\x0fNamespaceConfig\x127\n" +
 	"\tretention\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12'\n" +
 	"\x0farchival_bucket\x18\x02 \x01(\tR\x0earchivalBucket\x12I\n" +
@@ -465,13 +611,32 @@ const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\x19visibility_archival_state\x18\x06 \x01(\x0e2$.temporal.api.enums.v1.ArchivalStateR\x17visibilityArchivalState\x126\n" +
 	"\x17visibility_archival_uri\x18\a \x01(\tR\x15visibilityArchivalUri\x12\x9c\x01\n" +
 	"\x1fcustom_search_attribute_aliases\x18\b \x03(\v2U.temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntryR\x1ccustomSearchAttributeAliases\x12m\n" +
-	"\x0eworkflow_rules\x18\t \x03(\v2F.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntryR\rworkflowRules\x1aO\n" +
+	"\x0eworkflow_rules\x18\t \x03(\v2F.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntryR\rworkflowRules\x12\x89\x01\n" +
+	"\x18saved_visibility_queries\x18\n" +
+	" \x03(\v2O.temporal.server.api.persistence.v1.NamespaceConfig.SavedVisibilityQueriesEntryR\x16savedVisibilityQueries\x12\xb5\x01\n" +
+	"(custom_search_attribute_field_migrations\x18\v \x03(\v2].temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeFieldMigrationsEntryR$customSearchAttributeFieldMigrations\x1aO\n" +
 	"!CustomSearchAttributeAliasesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ae\n" +
 	"\x12WorkflowRulesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
-	"\x05value\x18\x02 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x05value:\x028\x01\"\x86\x02\n" +
+	"\x05value\x18\x02 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x05value:\x028\x01\x1a\x83\x01\n" +
+	"\x1bSavedVisibilityQueriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12N\n" +
+	"\x05value\x18\x02 \x01(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\x05value:\x028\x01\x1a\xa0\x01\n" +
+	")CustomSearchAttributeFieldMigrationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12]\n" +
+	"\x05value\x18\x02 \x01(\v2G.temporal.server.api.persistence.v1.CustomSearchAttributeFieldMigrationR\x05value:\x028\x01\"U\n" +
+	"\x14SavedVisibilityQuery\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12'\n" +
+	"\x0fdefault_columns\x18\x02 \x03(\tR\x0edefaultColumns\"\x83\x02\n" +
+	"#CustomSearchAttributeFieldMigration\x12!\n" +
+	"\ftarget_field\x18\x01 \x01(\tR\vtargetField\x12H\n" +
+	"\vsource_type\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\n" +
+	"sourceType\x12H\n" +
+	"\vtarget_type\x18\x03 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\n" +
+	"targetType\x12%\n" +
+	"\x0esource_dropped\x18\x04 \x01(\bR\rsourceDropped\"\x86\x02\n" +
 	"\x1aNamespaceReplicationConfig\x12.\n" +
 	"\x13active_cluster_name\x18\x01 \x01(\tR\x11activeClusterName\x12\x1a\n" +
 	"\bclusters\x18\x02 \x03(\tR\bclusters\x12=\n" +
@@ -493,46 +658,57 @@ func file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP() []by
 	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_temporal_server_api_persistence_v1_namespaces_proto_goTypes = []any{
-	(*NamespaceDetail)(nil),            // 0: temporal.server.api.persistence.v1.NamespaceDetail
-	(*NamespaceInfo)(nil),              // 1: temporal.server.api.persistence.v1.NamespaceInfo
-	(*NamespaceConfig)(nil),            // 2: temporal.server.api.persistence.v1.NamespaceConfig
-	(*NamespaceReplicationConfig)(nil), // 3: temporal.server.api.persistence.v1.NamespaceReplicationConfig
-	(*FailoverStatus)(nil),             // 4: temporal.server.api.persistence.v1.FailoverStatus
-	nil,                                // 5: temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
-	nil,                                // 6: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
-	nil,                                // 7: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(v1.NamespaceState)(0),             // 9: temporal.api.enums.v1.NamespaceState
-	(*durationpb.Duration)(nil),        // 10: google.protobuf.Duration
-	(*v11.BadBinaries)(nil),            // 11: temporal.api.namespace.v1.BadBinaries
-	(v1.ArchivalState)(0),              // 12: temporal.api.enums.v1.ArchivalState
-	(v1.ReplicationState)(0),           // 13: temporal.api.enums.v1.ReplicationState
-	(*v12.WorkflowRule)(nil),           // 14: temporal.api.rules.v1.WorkflowRule
+	(*NamespaceDetail)(nil),                     // 0: temporal.server.api.persistence.v1.NamespaceDetail
+	(*NamespaceInfo)(nil),                       // 1: temporal.server.api.persistence.v1.NamespaceInfo
+	(*NamespaceConfig)(nil),                     // 2: temporal.server.api.persistence.v1.NamespaceConfig
+	(*SavedVisibilityQuery)(nil),                // 3: temporal.server.api.persistence.v1.SavedVisibilityQuery
+	(*CustomSearchAttributeFieldMigration)(nil), // 4: temporal.server.api.persistence.v1.CustomSearchAttributeFieldMigration
+	(*NamespaceReplicationConfig)(nil),          // 5: temporal.server.api.persistence.v1.NamespaceReplicationConfig
+	(*FailoverStatus)(nil),                      // 6: temporal.server.api.persistence.v1.FailoverStatus
+	nil,                                         // 7: temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
+	nil,                                         // 8: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
+	nil,                                         // 9: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
+	nil,                                         // 10: temporal.server.api.persistence.v1.NamespaceConfig.SavedVisibilityQueriesEntry
+	nil,                                         // 11: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeFieldMigrationsEntry
+	(*timestamppb.Timestamp)(nil),               // 12: google.protobuf.Timestamp
+	(v1.NamespaceState)(0),                      // 13: temporal.api.enums.v1.NamespaceState
+	(*durationpb.Duration)(nil),                 // 14: google.protobuf.Duration
+	(*v11.BadBinaries)(nil),                     // 15: temporal.api.namespace.v1.BadBinaries
+	(v1.ArchivalState)(0),                       // 16: temporal.api.enums.v1.ArchivalState
+	(v1.IndexedValueType)(0),                    // 17: temporal.api.enums.v1.IndexedValueType
+	(v1.ReplicationState)(0),                    // 18: temporal.api.enums.v1.ReplicationState
+	(*v12.WorkflowRule)(nil),                    // 19: temporal.api.rules.v1.WorkflowRule
 }
 var file_temporal_server_api_persistence_v1_namespaces_proto_depIdxs = []int32{
 	1,  // 0: temporal.server.api.persistence.v1.NamespaceDetail.info:type_name -> temporal.server.api.persistence.v1.NamespaceInfo
 	2,  // 1: temporal.server.api.persistence.v1.NamespaceDetail.config:type_name -> temporal.server.api.persistence.v1.NamespaceConfig
-	3,  // 2: temporal.server.api.persistence.v1.NamespaceDetail.replication_config:type_name -> temporal.server.api.persistence.v1.NamespaceReplicationConfig
-	8,  // 3: temporal.server.api.persistence.v1.NamespaceDetail.failover_end_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: temporal.server.api.persistence.v1.NamespaceInfo.state:type_name -> temporal.api.enums.v1.NamespaceState
-	5,  // 5: temporal.server.api.persistence.v1.NamespaceInfo.data:type_name -> temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
-	10, // 6: temporal.server.api.persistence.v1.NamespaceConfig.retention:type_name -> google.protobuf.Duration
-	11, // 7: temporal.server.api.persistence.v1.NamespaceConfig.bad_binaries:type_name -> temporal.api.namespace.v1.BadBinaries
-	12, // 8: temporal.server.api.persistence.v1.NamespaceConfig.history_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
-	12, // 9: temporal.server.api.persistence.v1.NamespaceConfig.visibility_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
-	6,  // 10: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_aliases:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
-	7,  // 11: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rules:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
-	13, // 12: temporal.server.api.persistence.v1.NamespaceReplicationConfig.state:type_name -> temporal.api.enums.v1.ReplicationState
-	4,  // 13: temporal.server.api.persistence.v1.NamespaceReplicationConfig.failover_history:type_name -> temporal.server.api.persistence.v1.FailoverStatus
-	8,  // 14: temporal.server.api.persistence.v1.FailoverStatus.failover_time:type_name -> google.protobuf.Timestamp
-	14, // 15: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry.value:type_name -> temporal.api.rules.v1.WorkflowRule
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	5,  // 2: temporal.server.api.persistence.v1.NamespaceDetail.replication_config:type_name -> temporal.server.api.persistence.v1.NamespaceReplicationConfig
+	12, // 3: temporal.server.api.persistence.v1.NamespaceDetail.failover_end_time:type_name -> google.protobuf.Timestamp
+	13, // 4: temporal.server.api.persistence.v1.NamespaceInfo.state:type_name -> temporal.api.enums.v1.NamespaceState
+	7,  // 5: temporal.server.api.persistence.v1.NamespaceInfo.data:type_name -> temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
+	14, // 6: temporal.server.api.persistence.v1.NamespaceConfig.retention:type_name -> google.protobuf.Duration
+	15, // 7: temporal.server.api.persistence.v1.NamespaceConfig.bad_binaries:type_name -> temporal.api.namespace.v1.BadBinaries
+	16, // 8: temporal.server.api.persistence.v1.NamespaceConfig.history_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
+	16, // 9: temporal.server.api.persistence.v1.NamespaceConfig.visibility_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
+	8,  // 10: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_aliases:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
+	9,  // 11: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rules:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
+	10, // 12: temporal.server.api.persistence.v1.NamespaceConfig.saved_visibility_queries:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.SavedVisibilityQueriesEntry
+	11, // 13: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_field_migrations:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeFieldMigrationsEntry
+	17, // 14: temporal.server.api.persistence.v1.CustomSearchAttributeFieldMigration.source_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	17, // 15: temporal.server.api.persistence.v1.CustomSearchAttributeFieldMigration.target_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	18, // 16: temporal.server.api.persistence.v1.NamespaceReplicationConfig.state:type_name -> temporal.api.enums.v1.ReplicationState
+	6,  // 17: temporal.server.api.persistence.v1.NamespaceReplicationConfig.failover_history:type_name -> temporal.server.api.persistence.v1.FailoverStatus
+	12, // 18: temporal.server.api.persistence.v1.FailoverStatus.failover_time:type_name -> google.protobuf.Timestamp
+	19, // 19: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry.value:type_name -> temporal.api.rules.v1.WorkflowRule
+	3,  // 20: temporal.server.api.persistence.v1.NamespaceConfig.SavedVisibilityQueriesEntry.value:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	4,  // 21: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeFieldMigrationsEntry.value:type_name -> temporal.server.api.persistence.v1.CustomSearchAttributeFieldMigration
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_namespaces_proto_init() }
@@ -546,7 +722,7 @@ func file_temporal_server_api_persistence_v1_namespaces_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc), len(file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
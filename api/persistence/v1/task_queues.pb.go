@@ -12,10 +12,11 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
-	v13 "go.temporal.io/api/deployment/v1"
+	v14 "go.temporal.io/api/deployment/v1"
 	v11 "go.temporal.io/api/taskqueue/v1"
 	v1 "go.temporal.io/server/api/clock/v1"
 	v12 "go.temporal.io/server/api/deployment/v1"
+	v13 "go.temporal.io/server/api/taskqueue/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -525,8 +526,15 @@ func (x *DeploymentData) GetUnversionedRampData() *v12.DeploymentVersionData {
 type TaskQueueTypeUserData struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	DeploymentData *DeploymentData        `protobuf:"bytes,1,opt,name=deployment_data,json=deploymentData,proto3" json:"deployment_data,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Present when dispatch of the task queue type is paused in all partitions.
+	PauseInfo *v13.TaskQueuePauseInfo `protobuf:"bytes,2,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	// Filters of the recent purges of the task queue type. Tasks matching any of them are deleted
+	// when read from the backlog, or by the task queue scavenger.
+	PurgeFilters []*v13.TaskQueuePurgeFilter `protobuf:"bytes,3,rep,name=purge_filters,json=purgeFilters,proto3" json:"purge_filters,omitempty"`
+	// Present when the partition counts of the task queue type are managed by partition auto scaling.
+	PartitionCounts *v13.TaskQueuePartitionCounts `protobuf:"bytes,4,opt,name=partition_counts,json=partitionCounts,proto3" json:"partition_counts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TaskQueueTypeUserData) Reset() {
@@ -566,6 +574,27 @@ func (x *TaskQueueTypeUserData) GetDeploymentData() *DeploymentData {
 	return nil
 }
 
+func (x *TaskQueueTypeUserData) GetPauseInfo() *v13.TaskQueuePauseInfo {
+	if x != nil {
+		return x.PauseInfo
+	}
+	return nil
+}
+
+func (x *TaskQueueTypeUserData) GetPurgeFilters() []*v13.TaskQueuePurgeFilter {
+	if x != nil {
+		return x.PurgeFilters
+	}
+	return nil
+}
+
+func (x *TaskQueueTypeUserData) GetPartitionCounts() *v13.TaskQueuePartitionCounts {
+	if x != nil {
+		return x.PartitionCounts
+	}
+	return nil
+}
+
 // Container for all persistent user provided data for a task queue family.
 // "Task queue" as a named concept here is a task queue family, i.e. the set of task queues
 // that share a name, at most one of each type (workflow, activity, etc.).
@@ -692,7 +721,7 @@ func (x *VersionedTaskQueueUserData) GetVersion() int64 {
 // Deprecated.
 type DeploymentData_DeploymentDataItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deployment    *v13.Deployment        `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	Deployment    *v14.Deployment        `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
 	Data          *v12.TaskQueueData     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -728,7 +757,7 @@ func (*DeploymentData_DeploymentDataItem) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *DeploymentData_DeploymentDataItem) GetDeployment() *v13.Deployment {
+func (x *DeploymentData_DeploymentDataItem) GetDeployment() *v14.Deployment {
 	if x != nil {
 		return x.Deployment
 	}
@@ -746,7 +775,7 @@ var File_temporal_server_api_persistence_v1_task_queues_proto protoreflect.FileD
 
 const file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc = "" +
 	"\n" +
-	"4temporal/server/api/persistence/v1/task_queues.proto\x12\"temporal.server.api.persistence.v1\x1a(temporal/api/deployment/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\xfb\x02\n" +
+	"4temporal/server/api/persistence/v1/task_queues.proto\x12\"temporal.server.api.persistence.v1\x1a(temporal/api/deployment/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a/temporal/server/api/deployment/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\xfb\x02\n" +
 	"\aBuildId\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
 	"\x05state\x18\x02 \x01(\x0e21.temporal.server.api.persistence.v1.BuildId.StateR\x05state\x12f\n" +
@@ -780,9 +809,13 @@ const file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc = "" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2&.temporal.api.deployment.v1.DeploymentR\n" +
 	"deployment\x12D\n" +
-	"\x04data\x18\x02 \x01(\v20.temporal.server.api.deployment.v1.TaskQueueDataR\x04data\"t\n" +
+	"\x04data\x18\x02 \x01(\v20.temporal.server.api.deployment.v1.TaskQueueDataR\x04data\"\x8d\x03\n" +
 	"\x15TaskQueueTypeUserData\x12[\n" +
-	"\x0fdeployment_data\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.DeploymentDataR\x0edeploymentData\"\x8e\x03\n" +
+	"\x0fdeployment_data\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.DeploymentDataR\x0edeploymentData\x12S\n" +
+	"\n" +
+	"pause_info\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePauseInfoR\tpauseInfo\x12[\n" +
+	"\rpurge_filters\x18\x03 \x03(\v26.temporal.server.api.taskqueue.v1.TaskQueuePurgeFilterR\fpurgeFilters\x12e\n" +
+	"\x10partition_counts\x18\x04 \x01(\v2:.temporal.server.api.taskqueue.v1.TaskQueuePartitionCountsR\x0fpartitionCounts\"\x8e\x03\n" +
 	"\x11TaskQueueUserData\x12F\n" +
 	"\x05clock\x18\x01 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\x05clock\x12[\n" +
 	"\x0fversioning_data\x18\x02 \x01(\v22.temporal.server.api.persistence.v1.VersioningDataR\x0eversioningData\x12]\n" +
@@ -825,8 +858,11 @@ var file_temporal_server_api_persistence_v1_task_queues_proto_goTypes = []any{
 	(*v11.BuildIdAssignmentRule)(nil), // 13: temporal.api.taskqueue.v1.BuildIdAssignmentRule
 	(*v11.CompatibleBuildIdRedirectRule)(nil), // 14: temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
 	(*v12.DeploymentVersionData)(nil),         // 15: temporal.server.api.deployment.v1.DeploymentVersionData
-	(*v13.Deployment)(nil),                    // 16: temporal.api.deployment.v1.Deployment
-	(*v12.TaskQueueData)(nil),                 // 17: temporal.server.api.deployment.v1.TaskQueueData
+	(*v13.TaskQueuePauseInfo)(nil),            // 16: temporal.server.api.taskqueue.v1.TaskQueuePauseInfo
+	(*v13.TaskQueuePurgeFilter)(nil),          // 17: temporal.server.api.taskqueue.v1.TaskQueuePurgeFilter
+	(*v13.TaskQueuePartitionCounts)(nil),      // 18: temporal.server.api.taskqueue.v1.TaskQueuePartitionCounts
+	(*v14.Deployment)(nil),                    // 19: temporal.api.deployment.v1.Deployment
+	(*v12.TaskQueueData)(nil),                 // 20: temporal.server.api.deployment.v1.TaskQueueData
 }
 var file_temporal_server_api_persistence_v1_task_queues_proto_depIdxs = []int32{
 	0,  // 0: temporal.server.api.persistence.v1.BuildId.state:type_name -> temporal.server.api.persistence.v1.BuildId.State
@@ -847,18 +883,21 @@ var file_temporal_server_api_persistence_v1_task_queues_proto_depIdxs = []int32{
 	15, // 15: temporal.server.api.persistence.v1.DeploymentData.versions:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
 	15, // 16: temporal.server.api.persistence.v1.DeploymentData.unversioned_ramp_data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
 	6,  // 17: temporal.server.api.persistence.v1.TaskQueueTypeUserData.deployment_data:type_name -> temporal.server.api.persistence.v1.DeploymentData
-	12, // 18: temporal.server.api.persistence.v1.TaskQueueUserData.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	5,  // 19: temporal.server.api.persistence.v1.TaskQueueUserData.versioning_data:type_name -> temporal.server.api.persistence.v1.VersioningData
-	11, // 20: temporal.server.api.persistence.v1.TaskQueueUserData.per_type:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
-	8,  // 21: temporal.server.api.persistence.v1.VersionedTaskQueueUserData.data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
-	16, // 22: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.deployment:type_name -> temporal.api.deployment.v1.Deployment
-	17, // 23: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.data:type_name -> temporal.server.api.deployment.v1.TaskQueueData
-	7,  // 24: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	16, // 18: temporal.server.api.persistence.v1.TaskQueueTypeUserData.pause_info:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePauseInfo
+	17, // 19: temporal.server.api.persistence.v1.TaskQueueTypeUserData.purge_filters:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePurgeFilter
+	18, // 20: temporal.server.api.persistence.v1.TaskQueueTypeUserData.partition_counts:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartitionCounts
+	12, // 21: temporal.server.api.persistence.v1.TaskQueueUserData.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	5,  // 22: temporal.server.api.persistence.v1.TaskQueueUserData.versioning_data:type_name -> temporal.server.api.persistence.v1.VersioningData
+	11, // 23: temporal.server.api.persistence.v1.TaskQueueUserData.per_type:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
+	8,  // 24: temporal.server.api.persistence.v1.VersionedTaskQueueUserData.data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
+	19, // 25: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.deployment:type_name -> temporal.api.deployment.v1.Deployment
+	20, // 26: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.data:type_name -> temporal.server.api.deployment.v1.TaskQueueData
+	7,  // 27: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_task_queues_proto_init() }
@@ -263,3 +263,299 @@ func (this *TaskForwardInfo) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type TaskFairness to the protobuf v3 wire format
+func (val *TaskFairness) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskFairness from the protobuf v3 wire format
+func (val *TaskFairness) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskFairness) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskFairness values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskFairness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskFairness
+	switch t := that.(type) {
+	case *TaskFairness:
+		that1 = t
+	case TaskFairness:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskDispatchRateLimits to the protobuf v3 wire format
+func (val *TaskDispatchRateLimits) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskDispatchRateLimits from the protobuf v3 wire format
+func (val *TaskDispatchRateLimits) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskDispatchRateLimits) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskDispatchRateLimits values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskDispatchRateLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskDispatchRateLimits
+	switch t := that.(type) {
+	case *TaskDispatchRateLimits:
+		that1 = t
+	case TaskDispatchRateLimits:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueuePauseInfo to the protobuf v3 wire format
+func (val *TaskQueuePauseInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePauseInfo from the protobuf v3 wire format
+func (val *TaskQueuePauseInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePauseInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePauseInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePauseInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePauseInfo
+	switch t := that.(type) {
+	case *TaskQueuePauseInfo:
+		that1 = t
+	case TaskQueuePauseInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueuePurgeFilter to the protobuf v3 wire format
+func (val *TaskQueuePurgeFilter) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePurgeFilter from the protobuf v3 wire format
+func (val *TaskQueuePurgeFilter) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePurgeFilter) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePurgeFilter values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePurgeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePurgeFilter
+	switch t := that.(type) {
+	case *TaskQueuePurgeFilter:
+		that1 = t
+	case TaskQueuePurgeFilter:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueuePartitionCounts to the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePartitionCounts from the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePartitionCounts) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePartitionCounts values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePartitionCounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePartitionCounts
+	switch t := that.(type) {
+	case *TaskQueuePartitionCounts:
+		that1 = t
+	case TaskQueuePartitionCounts:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueueBacklogSummary to the protobuf v3 wire format
+func (val *TaskQueueBacklogSummary) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueBacklogSummary from the protobuf v3 wire format
+func (val *TaskQueueBacklogSummary) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueBacklogSummary) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueBacklogSummary values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueBacklogSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueBacklogSummary
+	switch t := that.(type) {
+	case *TaskQueueBacklogSummary:
+		that1 = t
+	case TaskQueueBacklogSummary:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueueBacklogGroup to the protobuf v3 wire format
+func (val *TaskQueueBacklogGroup) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueBacklogGroup from the protobuf v3 wire format
+func (val *TaskQueueBacklogGroup) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueBacklogGroup) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueBacklogGroup values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueBacklogGroup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueBacklogGroup
+	switch t := that.(type) {
+	case *TaskQueueBacklogGroup:
+		that1 = t
+	case TaskQueueBacklogGroup:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueueBacklogAgeBucket to the protobuf v3 wire format
+func (val *TaskQueueBacklogAgeBucket) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueBacklogAgeBucket from the protobuf v3 wire format
+func (val *TaskQueueBacklogAgeBucket) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueBacklogAgeBucket) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueBacklogAgeBucket values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueBacklogAgeBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueBacklogAgeBucket
+	switch t := that.(type) {
+	case *TaskQueueBacklogAgeBucket:
+		that1 = t
+	case TaskQueueBacklogAgeBucket:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -18,7 +18,9 @@ import (
 	v14 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -284,8 +286,11 @@ type PhysicalTaskQueueInfo struct {
 	Pollers                 []*v13.PollerInfo          `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStats          *v13.TaskQueueStats        `protobuf:"bytes,2,opt,name=task_queue_stats,json=taskQueueStats,proto3" json:"task_queue_stats,omitempty"`
 	InternalTaskQueueStatus []*InternalTaskQueueStatus `protobuf:"bytes,3,rep,name=internal_task_queue_status,json=internalTaskQueueStatus,proto3" json:"internal_task_queue_status,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	DispatchRateLimits      *TaskDispatchRateLimits    `protobuf:"bytes,4,opt,name=dispatch_rate_limits,json=dispatchRateLimits,proto3" json:"dispatch_rate_limits,omitempty"`
+	// Present when dispatch of the task queue type is paused.
+	PauseInfo     *TaskQueuePauseInfo `protobuf:"bytes,5,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PhysicalTaskQueueInfo) Reset() {
@@ -339,6 +344,20 @@ func (x *PhysicalTaskQueueInfo) GetInternalTaskQueueStatus() []*InternalTaskQueu
 	return nil
 }
 
+func (x *PhysicalTaskQueueInfo) GetDispatchRateLimits() *TaskDispatchRateLimits {
+	if x != nil {
+		return x.DispatchRateLimits
+	}
+	return nil
+}
+
+func (x *PhysicalTaskQueueInfo) GetPauseInfo() *TaskQueuePauseInfo {
+	if x != nil {
+		return x.PauseInfo
+	}
+	return nil
+}
+
 // Represents a normal or sticky partition of a task queue.
 type TaskQueuePartition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -578,11 +597,582 @@ func (x *TaskForwardInfo) GetDispatchVersionSet() string {
 	return ""
 }
 
+// TaskFairness is used by matching to dispatch fairly the tasks of different keys (e.g. tenants)
+// sharing a task queue.
+type TaskFairness struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tasks with the same key share the dispatch rate of the key. Missing means the default key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Share of the dispatch rate of the key relative to the other keys with tasks in the same
+	// priority level. Missing means 1.
+	Weight        float32 `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFairness) Reset() {
+	*x = TaskFairness{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFairness) ProtoMessage() {}
+
+func (x *TaskFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFairness.ProtoReflect.Descriptor instead.
+func (*TaskFairness) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TaskFairness) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TaskFairness) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Dispatch rate limits of the tasks of a task queue by fairness key and by workflow or activity
+// type. Rates are tasks per second for the whole task queue, not per partition.
+type TaskDispatchRateLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rates of the fairness keys with their own limit.
+	FairnessKeyRates map[string]float64 `protobuf:"bytes,1,rep,name=fairness_key_rates,json=fairnessKeyRates,proto3" json:"fairness_key_rates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Rate of the other fairness keys. 0 means unlimited.
+	FairnessKeyDefaultRate float64 `protobuf:"fixed64,2,opt,name=fairness_key_default_rate,json=fairnessKeyDefaultRate,proto3" json:"fairness_key_default_rate,omitempty"`
+	// Rates of workflow types (for workflow task queues) or activity types (for activity task
+	// queues).
+	TypeNameRates map[string]float64 `protobuf:"bytes,3,rep,name=type_name_rates,json=typeNameRates,proto3" json:"type_name_rates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDispatchRateLimits) Reset() {
+	*x = TaskDispatchRateLimits{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDispatchRateLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDispatchRateLimits) ProtoMessage() {}
+
+func (x *TaskDispatchRateLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDispatchRateLimits.ProtoReflect.Descriptor instead.
+func (*TaskDispatchRateLimits) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TaskDispatchRateLimits) GetFairnessKeyRates() map[string]float64 {
+	if x != nil {
+		return x.FairnessKeyRates
+	}
+	return nil
+}
+
+func (x *TaskDispatchRateLimits) GetFairnessKeyDefaultRate() float64 {
+	if x != nil {
+		return x.FairnessKeyDefaultRate
+	}
+	return 0
+}
+
+func (x *TaskDispatchRateLimits) GetTypeNameRates() map[string]float64 {
+	if x != nil {
+		return x.TypeNameRates
+	}
+	return nil
+}
+
+// TaskQueuePauseInfo describes a pause of the dispatch of the tasks of a task queue type. Tasks
+// are still added to the backlog while the dispatch is paused, and dispatched on resume.
+type TaskQueuePauseInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PauseTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=pause_time,json=pauseTime,proto3" json:"pause_time,omitempty"`
+	Reason    string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Identity of the operator who paused the task queue.
+	Identity      string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueuePauseInfo) Reset() {
+	*x = TaskQueuePauseInfo{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueuePauseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePauseInfo) ProtoMessage() {}
+
+func (x *TaskQueuePauseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePauseInfo.ProtoReflect.Descriptor instead.
+func (*TaskQueuePauseInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TaskQueuePauseInfo) GetPauseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PauseTime
+	}
+	return nil
+}
+
+func (x *TaskQueuePauseInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TaskQueuePauseInfo) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+// TaskQueuePurgeFilter selects the backlog tasks of a task queue type deleted by a purge. A task is
+// deleted when it matches all the set fields.
+type TaskQueuePurgeFilter struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowIdPrefix string                 `protobuf:"bytes,1,opt,name=workflow_id_prefix,json=workflowIdPrefix,proto3" json:"workflow_id_prefix,omitempty"`
+	// Workflow type of workflow tasks or activity type of activity tasks. Tasks added before type
+	// names were recorded in tasks never match a type name.
+	TypeName string `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// Only tasks created before this time are deleted. It's never after purge_time, so that tasks
+	// added after the purge are kept.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	PurgeTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Identity of the operator who purged the task queue.
+	Identity      string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueuePurgeFilter) Reset() {
+	*x = TaskQueuePurgeFilter{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueuePurgeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePurgeFilter) ProtoMessage() {}
+
+func (x *TaskQueuePurgeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePurgeFilter.ProtoReflect.Descriptor instead.
+func (*TaskQueuePurgeFilter) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaskQueuePurgeFilter) GetWorkflowIdPrefix() string {
+	if x != nil {
+		return x.WorkflowIdPrefix
+	}
+	return ""
+}
+
+func (x *TaskQueuePurgeFilter) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *TaskQueuePurgeFilter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *TaskQueuePurgeFilter) GetPurgeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return nil
+}
+
+func (x *TaskQueuePurgeFilter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TaskQueuePurgeFilter) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+// TaskQueuePartitionCounts holds the partition counts of a task queue type chosen by partition auto
+// scaling. Read partitions are never fewer than write partitions, so that every partition receiving
+// tasks is polled.
+type TaskQueuePartitionCounts struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReadPartitions  int32                  `protobuf:"varint,1,opt,name=read_partitions,json=readPartitions,proto3" json:"read_partitions,omitempty"`
+	WritePartitions int32                  `protobuf:"varint,2,opt,name=write_partitions,json=writePartitions,proto3" json:"write_partitions,omitempty"`
+	// Time of the last change of the counts.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueuePartitionCounts) Reset() {
+	*x = TaskQueuePartitionCounts{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueuePartitionCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePartitionCounts) ProtoMessage() {}
+
+func (x *TaskQueuePartitionCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePartitionCounts.ProtoReflect.Descriptor instead.
+func (*TaskQueuePartitionCounts) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TaskQueuePartitionCounts) GetReadPartitions() int32 {
+	if x != nil {
+		return x.ReadPartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionCounts) GetWritePartitions() int32 {
+	if x != nil {
+		return x.WritePartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionCounts) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// TaskQueueBacklogSummary summarizes the tasks persisted in the backlog of a physical task queue.
+type TaskQueueBacklogSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of tasks summarized. Expired and purged tasks are not included.
+	TaskCount int64 `protobuf:"varint,1,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	// Number of expired tasks not deleted yet.
+	ExpiredTaskCount int64 `protobuf:"varint,2,opt,name=expired_task_count,json=expiredTaskCount,proto3" json:"expired_task_count,omitempty"`
+	// Number of tasks deleted by a purge but not removed from persistence yet.
+	PurgedTaskCount int64 `protobuf:"varint,3,opt,name=purged_task_count,json=purgedTaskCount,proto3" json:"purged_task_count,omitempty"`
+	// True when the backlog has more tasks than were read for the summary.
+	Truncated        bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	OldestCreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=oldest_create_time,json=oldestCreateTime,proto3" json:"oldest_create_time,omitempty"`
+	// Tasks grouped by workflow type of workflow tasks or activity type of activity tasks.
+	TypeNames []*TaskQueueBacklogGroup `protobuf:"bytes,6,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"`
+	// Tasks grouped by priority key. The key of tasks with the default priority is empty.
+	PriorityKeys []*TaskQueueBacklogGroup `protobuf:"bytes,7,rep,name=priority_keys,json=priorityKeys,proto3" json:"priority_keys,omitempty"`
+	// Tasks grouped by fairness key.
+	FairnessKeys []*TaskQueueBacklogGroup `protobuf:"bytes,8,rep,name=fairness_keys,json=fairnessKeys,proto3" json:"fairness_keys,omitempty"`
+	// Tasks grouped by age, from the youngest to the oldest.
+	AgeBuckets    []*TaskQueueBacklogAgeBucket `protobuf:"bytes,9,rep,name=age_buckets,json=ageBuckets,proto3" json:"age_buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueueBacklogSummary) Reset() {
+	*x = TaskQueueBacklogSummary{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueueBacklogSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueBacklogSummary) ProtoMessage() {}
+
+func (x *TaskQueueBacklogSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueBacklogSummary.ProtoReflect.Descriptor instead.
+func (*TaskQueueBacklogSummary) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TaskQueueBacklogSummary) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *TaskQueueBacklogSummary) GetExpiredTaskCount() int64 {
+	if x != nil {
+		return x.ExpiredTaskCount
+	}
+	return 0
+}
+
+func (x *TaskQueueBacklogSummary) GetPurgedTaskCount() int64 {
+	if x != nil {
+		return x.PurgedTaskCount
+	}
+	return 0
+}
+
+func (x *TaskQueueBacklogSummary) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *TaskQueueBacklogSummary) GetOldestCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestCreateTime
+	}
+	return nil
+}
+
+func (x *TaskQueueBacklogSummary) GetTypeNames() []*TaskQueueBacklogGroup {
+	if x != nil {
+		return x.TypeNames
+	}
+	return nil
+}
+
+func (x *TaskQueueBacklogSummary) GetPriorityKeys() []*TaskQueueBacklogGroup {
+	if x != nil {
+		return x.PriorityKeys
+	}
+	return nil
+}
+
+func (x *TaskQueueBacklogSummary) GetFairnessKeys() []*TaskQueueBacklogGroup {
+	if x != nil {
+		return x.FairnessKeys
+	}
+	return nil
+}
+
+func (x *TaskQueueBacklogSummary) GetAgeBuckets() []*TaskQueueBacklogAgeBucket {
+	if x != nil {
+		return x.AgeBuckets
+	}
+	return nil
+}
+
+type TaskQueueBacklogGroup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TaskCount        int64                  `protobuf:"varint,2,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	OldestCreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=oldest_create_time,json=oldestCreateTime,proto3" json:"oldest_create_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaskQueueBacklogGroup) Reset() {
+	*x = TaskQueueBacklogGroup{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueueBacklogGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueBacklogGroup) ProtoMessage() {}
+
+func (x *TaskQueueBacklogGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueBacklogGroup.ProtoReflect.Descriptor instead.
+func (*TaskQueueBacklogGroup) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TaskQueueBacklogGroup) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TaskQueueBacklogGroup) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *TaskQueueBacklogGroup) GetOldestCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestCreateTime
+	}
+	return nil
+}
+
+type TaskQueueBacklogAgeBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tasks of the bucket are younger than max_age. Absent for the bucket of the oldest tasks.
+	MaxAge        *durationpb.Duration `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	TaskCount     int64                `protobuf:"varint,2,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueueBacklogAgeBucket) Reset() {
+	*x = TaskQueueBacklogAgeBucket{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueueBacklogAgeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueBacklogAgeBucket) ProtoMessage() {}
+
+func (x *TaskQueueBacklogAgeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueBacklogAgeBucket.ProtoReflect.Descriptor instead.
+func (*TaskQueueBacklogAgeBucket) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TaskQueueBacklogAgeBucket) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *TaskQueueBacklogAgeBucket) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
 var File_temporal_server_api_taskqueue_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"\n" +
-	".temporal/server/api/taskqueue/v1/message.proto\x12 temporal.server.api.taskqueue.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\x96\x03\n" +
+	".temporal/server/api/taskqueue/v1/message.proto\x12 temporal.server.api.taskqueue.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a/temporal/server/api/deployment/v1/message.proto\"\x96\x03\n" +
 	"\x14TaskVersionDirective\x12J\n" +
 	"\x14use_assignment_rules\x18\x01 \x01(\v2\x16.google.protobuf.EmptyH\x00R\x12useAssignmentRules\x12,\n" +
 	"\x11assigned_build_id\x18\x02 \x01(\tH\x00R\x0fassignedBuildId\x12E\n" +
@@ -602,11 +1192,14 @@ const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"\x19approximate_backlog_count\x18\x05 \x01(\x03R\x17approximateBacklogCount\x12$\n" +
 	"\x0emax_read_level\x18\x06 \x01(\x03R\fmaxReadLevel\"\x90\x01\n" +
 	"\x1cTaskQueueVersionInfoInternal\x12p\n" +
-	"\x18physical_task_queue_info\x18\x02 \x01(\v27.temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfoR\x15physicalTaskQueueInfo\"\xa5\x02\n" +
+	"\x18physical_task_queue_info\x18\x02 \x01(\v27.temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfoR\x15physicalTaskQueueInfo\"\xe6\x03\n" +
 	"\x15PhysicalTaskQueueInfo\x12?\n" +
 	"\apollers\x18\x01 \x03(\v2%.temporal.api.taskqueue.v1.PollerInfoR\apollers\x12S\n" +
 	"\x10task_queue_stats\x18\x02 \x01(\v2).temporal.api.taskqueue.v1.TaskQueueStatsR\x0etaskQueueStats\x12v\n" +
-	"\x1ainternal_task_queue_status\x18\x03 \x03(\v29.temporal.server.api.taskqueue.v1.InternalTaskQueueStatusR\x17internalTaskQueueStatus\"\xe6\x01\n" +
+	"\x1ainternal_task_queue_status\x18\x03 \x03(\v29.temporal.server.api.taskqueue.v1.InternalTaskQueueStatusR\x17internalTaskQueueStatus\x12j\n" +
+	"\x14dispatch_rate_limits\x18\x04 \x01(\v28.temporal.server.api.taskqueue.v1.TaskDispatchRateLimitsR\x12dispatchRateLimits\x12S\n" +
+	"\n" +
+	"pause_info\x18\x05 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePauseInfoR\tpauseInfo\"\xe6\x01\n" +
 	"\x12TaskQueuePartition\x12\x1d\n" +
 	"\n" +
 	"task_queue\x18\x01 \x01(\tR\ttaskQueue\x12L\n" +
@@ -623,7 +1216,60 @@ const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"taskSource\x12Z\n" +
 	"\rredirect_info\x18\x03 \x01(\v25.temporal.server.api.taskqueue.v1.BuildIdRedirectInfoR\fredirectInfo\x12*\n" +
 	"\x11dispatch_build_id\x18\x04 \x01(\tR\x0fdispatchBuildId\x120\n" +
-	"\x14dispatch_version_set\x18\x05 \x01(\tR\x12dispatchVersionSetB2Z0go.temporal.io/server/api/taskqueue/v1;taskqueueb\x06proto3"
+	"\x14dispatch_version_set\x18\x05 \x01(\tR\x12dispatchVersionSet\"8\n" +
+	"\fTaskFairness\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x02R\x06weight\"\xcd\x03\n" +
+	"\x16TaskDispatchRateLimits\x12|\n" +
+	"\x12fairness_key_rates\x18\x01 \x03(\v2N.temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.FairnessKeyRatesEntryR\x10fairnessKeyRates\x129\n" +
+	"\x19fairness_key_default_rate\x18\x02 \x01(\x01R\x16fairnessKeyDefaultRate\x12s\n" +
+	"\x0ftype_name_rates\x18\x03 \x03(\v2K.temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.TypeNameRatesEntryR\rtypeNameRates\x1aC\n" +
+	"\x15FairnessKeyRatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a@\n" +
+	"\x12TypeNameRatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x83\x01\n" +
+	"\x12TaskQueuePauseInfo\x129\n" +
+	"\n" +
+	"pause_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tpauseTime\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\"\x93\x02\n" +
+	"\x14TaskQueuePurgeFilter\x12,\n" +
+	"\x12workflow_id_prefix\x18\x01 \x01(\tR\x10workflowIdPrefix\x12\x1b\n" +
+	"\ttype_name\x18\x02 \x01(\tR\btypeName\x12A\n" +
+	"\x0ecreated_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x129\n" +
+	"\n" +
+	"purge_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tpurgeTime\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\"\xab\x01\n" +
+	"\x18TaskQueuePartitionCounts\x12'\n" +
+	"\x0fread_partitions\x18\x01 \x01(\x05R\x0ereadPartitions\x12)\n" +
+	"\x10write_partitions\x18\x02 \x01(\x05R\x0fwritePartitions\x12;\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xec\x04\n" +
+	"\x17TaskQueueBacklogSummary\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x01 \x01(\x03R\ttaskCount\x12,\n" +
+	"\x12expired_task_count\x18\x02 \x01(\x03R\x10expiredTaskCount\x12*\n" +
+	"\x11purged_task_count\x18\x03 \x01(\x03R\x0fpurgedTaskCount\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\x12H\n" +
+	"\x12oldest_create_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10oldestCreateTime\x12V\n" +
+	"\n" +
+	"type_names\x18\x06 \x03(\v27.temporal.server.api.taskqueue.v1.TaskQueueBacklogGroupR\ttypeNames\x12\\\n" +
+	"\rpriority_keys\x18\a \x03(\v27.temporal.server.api.taskqueue.v1.TaskQueueBacklogGroupR\fpriorityKeys\x12\\\n" +
+	"\rfairness_keys\x18\b \x03(\v27.temporal.server.api.taskqueue.v1.TaskQueueBacklogGroupR\ffairnessKeys\x12\\\n" +
+	"\vage_buckets\x18\t \x03(\v2;.temporal.server.api.taskqueue.v1.TaskQueueBacklogAgeBucketR\n" +
+	"ageBuckets\"\x92\x01\n" +
+	"\x15TaskQueueBacklogGroup\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x02 \x01(\x03R\ttaskCount\x12H\n" +
+	"\x12oldest_create_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x10oldestCreateTime\"n\n" +
+	"\x19TaskQueueBacklogAgeBucket\x122\n" +
+	"\amax_age\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x02 \x01(\x03R\ttaskCountB2Z0go.temporal.io/server/api/taskqueue/v1;taskqueueb\x06proto3"
 
 var (
 	file_temporal_server_api_taskqueue_v1_message_proto_rawDescOnce sync.Once
@@ -637,7 +1283,7 @@ func file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_taskqueue_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_temporal_server_api_taskqueue_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_temporal_server_api_taskqueue_v1_message_proto_goTypes = []any{
 	(*TaskVersionDirective)(nil),         // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective
 	(*InternalTaskQueueStatus)(nil),      // 1: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
@@ -646,34 +1292,61 @@ var file_temporal_server_api_taskqueue_v1_message_proto_goTypes = []any{
 	(*TaskQueuePartition)(nil),           // 4: temporal.server.api.taskqueue.v1.TaskQueuePartition
 	(*BuildIdRedirectInfo)(nil),          // 5: temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
 	(*TaskForwardInfo)(nil),              // 6: temporal.server.api.taskqueue.v1.TaskForwardInfo
-	(*emptypb.Empty)(nil),                // 7: google.protobuf.Empty
-	(v1.VersioningBehavior)(0),           // 8: temporal.api.enums.v1.VersioningBehavior
-	(*v11.Deployment)(nil),               // 9: temporal.api.deployment.v1.Deployment
-	(*v12.WorkerDeploymentVersion)(nil),  // 10: temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	(*v13.TaskIdBlock)(nil),              // 11: temporal.api.taskqueue.v1.TaskIdBlock
-	(*v13.PollerInfo)(nil),               // 12: temporal.api.taskqueue.v1.PollerInfo
-	(*v13.TaskQueueStats)(nil),           // 13: temporal.api.taskqueue.v1.TaskQueueStats
-	(v1.TaskQueueType)(0),                // 14: temporal.api.enums.v1.TaskQueueType
-	(v14.TaskSource)(0),                  // 15: temporal.server.api.enums.v1.TaskSource
+	(*TaskFairness)(nil),                 // 7: temporal.server.api.taskqueue.v1.TaskFairness
+	(*TaskDispatchRateLimits)(nil),       // 8: temporal.server.api.taskqueue.v1.TaskDispatchRateLimits
+	(*TaskQueuePauseInfo)(nil),           // 9: temporal.server.api.taskqueue.v1.TaskQueuePauseInfo
+	(*TaskQueuePurgeFilter)(nil),         // 10: temporal.server.api.taskqueue.v1.TaskQueuePurgeFilter
+	(*TaskQueuePartitionCounts)(nil),     // 11: temporal.server.api.taskqueue.v1.TaskQueuePartitionCounts
+	(*TaskQueueBacklogSummary)(nil),      // 12: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary
+	(*TaskQueueBacklogGroup)(nil),        // 13: temporal.server.api.taskqueue.v1.TaskQueueBacklogGroup
+	(*TaskQueueBacklogAgeBucket)(nil),    // 14: temporal.server.api.taskqueue.v1.TaskQueueBacklogAgeBucket
+	nil,                                  // 15: temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.FairnessKeyRatesEntry
+	nil,                                  // 16: temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.TypeNameRatesEntry
+	(*emptypb.Empty)(nil),                // 17: google.protobuf.Empty
+	(v1.VersioningBehavior)(0),           // 18: temporal.api.enums.v1.VersioningBehavior
+	(*v11.Deployment)(nil),               // 19: temporal.api.deployment.v1.Deployment
+	(*v12.WorkerDeploymentVersion)(nil),  // 20: temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	(*v13.TaskIdBlock)(nil),              // 21: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v13.PollerInfo)(nil),               // 22: temporal.api.taskqueue.v1.PollerInfo
+	(*v13.TaskQueueStats)(nil),           // 23: temporal.api.taskqueue.v1.TaskQueueStats
+	(v1.TaskQueueType)(0),                // 24: temporal.api.enums.v1.TaskQueueType
+	(v14.TaskSource)(0),                  // 25: temporal.server.api.enums.v1.TaskSource
+	(*timestamppb.Timestamp)(nil),        // 26: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 27: google.protobuf.Duration
 }
 var file_temporal_server_api_taskqueue_v1_message_proto_depIdxs = []int32{
-	7,  // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective.use_assignment_rules:type_name -> google.protobuf.Empty
-	8,  // 1: temporal.server.api.taskqueue.v1.TaskVersionDirective.behavior:type_name -> temporal.api.enums.v1.VersioningBehavior
-	9,  // 2: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment:type_name -> temporal.api.deployment.v1.Deployment
-	10, // 3: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	11, // 4: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	17, // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective.use_assignment_rules:type_name -> google.protobuf.Empty
+	18, // 1: temporal.server.api.taskqueue.v1.TaskVersionDirective.behavior:type_name -> temporal.api.enums.v1.VersioningBehavior
+	19, // 2: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment:type_name -> temporal.api.deployment.v1.Deployment
+	20, // 3: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	21, // 4: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
 	3,  // 5: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal.physical_task_queue_info:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo
-	12, // 6: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pollers:type_name -> temporal.api.taskqueue.v1.PollerInfo
-	13, // 7: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
+	22, // 6: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pollers:type_name -> temporal.api.taskqueue.v1.PollerInfo
+	23, // 7: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
 	1,  // 8: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.internal_task_queue_status:type_name -> temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
-	14, // 9: temporal.server.api.taskqueue.v1.TaskQueuePartition.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	15, // 10: temporal.server.api.taskqueue.v1.TaskForwardInfo.task_source:type_name -> temporal.server.api.enums.v1.TaskSource
-	5,  // 11: temporal.server.api.taskqueue.v1.TaskForwardInfo.redirect_info:type_name -> temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	8,  // 9: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.dispatch_rate_limits:type_name -> temporal.server.api.taskqueue.v1.TaskDispatchRateLimits
+	9,  // 10: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pause_info:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePauseInfo
+	24, // 11: temporal.server.api.taskqueue.v1.TaskQueuePartition.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	25, // 12: temporal.server.api.taskqueue.v1.TaskForwardInfo.task_source:type_name -> temporal.server.api.enums.v1.TaskSource
+	5,  // 13: temporal.server.api.taskqueue.v1.TaskForwardInfo.redirect_info:type_name -> temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
+	15, // 14: temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.fairness_key_rates:type_name -> temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.FairnessKeyRatesEntry
+	16, // 15: temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.type_name_rates:type_name -> temporal.server.api.taskqueue.v1.TaskDispatchRateLimits.TypeNameRatesEntry
+	26, // 16: temporal.server.api.taskqueue.v1.TaskQueuePauseInfo.pause_time:type_name -> google.protobuf.Timestamp
+	26, // 17: temporal.server.api.taskqueue.v1.TaskQueuePurgeFilter.created_before:type_name -> google.protobuf.Timestamp
+	26, // 18: temporal.server.api.taskqueue.v1.TaskQueuePurgeFilter.purge_time:type_name -> google.protobuf.Timestamp
+	26, // 19: temporal.server.api.taskqueue.v1.TaskQueuePartitionCounts.update_time:type_name -> google.protobuf.Timestamp
+	26, // 20: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary.oldest_create_time:type_name -> google.protobuf.Timestamp
+	13, // 21: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary.type_names:type_name -> temporal.server.api.taskqueue.v1.TaskQueueBacklogGroup
+	13, // 22: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary.priority_keys:type_name -> temporal.server.api.taskqueue.v1.TaskQueueBacklogGroup
+	13, // 23: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary.fairness_keys:type_name -> temporal.server.api.taskqueue.v1.TaskQueueBacklogGroup
+	14, // 24: temporal.server.api.taskqueue.v1.TaskQueueBacklogSummary.age_buckets:type_name -> temporal.server.api.taskqueue.v1.TaskQueueBacklogAgeBucket
+	26, // 25: temporal.server.api.taskqueue.v1.TaskQueueBacklogGroup.oldest_create_time:type_name -> google.protobuf.Timestamp
+	27, // 26: temporal.server.api.taskqueue.v1.TaskQueueBacklogAgeBucket.max_age:type_name -> google.protobuf.Duration
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_taskqueue_v1_message_proto_init() }
@@ -695,7 +1368,7 @@ func file_temporal_server_api_taskqueue_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_taskqueue_v1_message_proto_rawDesc), len(file_temporal_server_api_taskqueue_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
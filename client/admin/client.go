@@ -58,3 +58,12 @@ func (c *clientImpl) StreamWorkflowReplicationMessages(
 	// do not use createContext function, let caller manage stream API lifecycle
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
+
+func (c *clientImpl) StreamVisibilityChanges(
+	ctx context.Context,
+	request *adminservice.StreamVisibilityChangesRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamVisibilityChangesClient, error) {
+	// do not use createContext function, let caller manage stream API lifecycle
+	return c.client.StreamVisibilityChanges(ctx, request, opts...)
+}
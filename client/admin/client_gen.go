@@ -39,6 +39,16 @@ func (c *clientImpl) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -49,6 +59,16 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CheckVisibilityConsistency(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -69,6 +89,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -119,6 +149,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -129,6 +169,26 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DropSearchAttribute(
+	ctx context.Context,
+	request *adminservice.DropSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DropSearchAttributeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DropSearchAttribute(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExplainVisibilityQuery(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -309,6 +369,16 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -329,6 +399,16 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseTaskQueue(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseTaskQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -349,6 +429,16 @@ func (c *clientImpl) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -419,6 +509,26 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskQueue(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResumeTaskQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -428,3 +538,23 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateSearchAttribute(
+	ctx context.Context,
+	request *adminservice.UpdateSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSearchAttributeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateSearchAttribute(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpsertSavedVisibilityQuery(ctx, request, opts...)
+}
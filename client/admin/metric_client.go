@@ -79,3 +79,17 @@ func (c *metricClient) StreamWorkflowReplicationMessages(
 
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
+
+func (c *metricClient) StreamVisibilityChanges(
+	ctx context.Context,
+	request *adminservice.StreamVisibilityChangesRequest,
+	opts ...grpc.CallOption,
+) (_ adminservice.AdminService_StreamVisibilityChangesClient, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStreamVisibilityChangesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StreamVisibilityChanges(ctx, request, opts...)
+}
@@ -56,6 +56,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	var resp *adminservice.AggregateWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -71,6 +86,21 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	var resp *adminservice.CheckVisibilityConsistencyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CheckVisibilityConsistency(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -101,6 +131,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.DeleteSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -176,6 +221,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	var resp *adminservice.DescribeTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -191,6 +251,36 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DropSearchAttribute(
+	ctx context.Context,
+	request *adminservice.DropSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DropSearchAttributeResponse, error) {
+	var resp *adminservice.DropSearchAttributeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DropSearchAttribute(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	var resp *adminservice.ExplainVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -461,6 +551,21 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	var resp *adminservice.ListSavedVisibilityQueriesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -491,6 +596,21 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PauseTaskQueue(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueResponse, error) {
+	var resp *adminservice.PauseTaskQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseTaskQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -521,6 +641,21 @@ func (c *retryableClient) PurgeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	var resp *adminservice.PurgeTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -626,6 +761,36 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	var resp *adminservice.RestoreArchivedWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResumeTaskQueue(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueResponse, error) {
+	var resp *adminservice.ResumeTaskQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeTaskQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -640,3 +805,33 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateSearchAttribute(
+	ctx context.Context,
+	request *adminservice.UpdateSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSearchAttributeResponse, error) {
+	var resp *adminservice.UpdateSearchAttributeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateSearchAttribute(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.UpsertSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
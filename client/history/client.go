@@ -245,6 +245,24 @@ func (c *clientImpl) StreamWorkflowReplicationMessages(
 	return streamClient, nil
 }
 
+func (c *clientImpl) StreamVisibilityChanges(
+	ctx context.Context,
+	request *historyservice.StreamVisibilityChangesRequest,
+	opts ...grpc.CallOption,
+) (historyservice.HistoryService_StreamVisibilityChangesClient, error) {
+	var streamClient historyservice.HistoryService_StreamVisibilityChangesClient
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		// do not use createContext function, let caller manage stream API lifecycle
+		streamClient, err = client.StreamVisibilityChanges(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, request.GetShardId(), op); err != nil {
+		return nil, err
+	}
+	return streamClient, nil
+}
+
 // getRandomShard returns a random shard ID for history APIs that are shard-agnostic (e.g. namespace or DLQ v2 APIs).
 func (c *clientImpl) getRandomShard() int32 {
 	// Add 1 at the end because shard IDs are 1-indexed.
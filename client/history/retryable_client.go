@@ -38,3 +38,18 @@ func (c *retryableClient) StreamWorkflowReplicationMessages(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) StreamVisibilityChanges(
+	ctx context.Context,
+	request *historyservice.StreamVisibilityChangesRequest,
+	opts ...grpc.CallOption,
+) (historyservice.HistoryService_StreamVisibilityChangesClient, error) {
+	var resp historyservice.HistoryService_StreamVisibilityChangesClient
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StreamVisibilityChanges(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
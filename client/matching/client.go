@@ -61,7 +61,7 @@ func (c *clientImpl) AddActivityTask(
 	request *matchingservice.AddActivityTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddActivityTaskResponse, error) {
 	request = common.CloneProto(request)
-	client, err := c.pickClientForWrite(
+	client, tq, err := c.pickClientForWrite(
 		request.GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -71,7 +71,11 @@ func (c *clientImpl) AddActivityTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, request, opts...)
+	resp, err := client.AddActivityTask(ctx, request, opts...)
+	if err == nil && tq != nil {
+		c.loadBalancer.UpdatePartitionCounts(tq, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) AddWorkflowTask(
@@ -79,7 +83,7 @@ func (c *clientImpl) AddWorkflowTask(
 	request *matchingservice.AddWorkflowTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
 	request = common.CloneProto(request)
-	client, err := c.pickClientForWrite(
+	client, tq, err := c.pickClientForWrite(
 		request.GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -89,7 +93,11 @@ func (c *clientImpl) AddWorkflowTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddWorkflowTask(ctx, request, opts...)
+	resp, err := client.AddWorkflowTask(ctx, request, opts...)
+	if err == nil && tq != nil {
+		c.loadBalancer.UpdatePartitionCounts(tq, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) PollActivityTaskQueue(
@@ -97,7 +105,7 @@ func (c *clientImpl) PollActivityTaskQueue(
 	request *matchingservice.PollActivityTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	request = common.CloneProto(request)
-	client, release, err := c.pickClientForRead(
+	client, tq, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -110,7 +118,11 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollActivityTaskQueue(ctx, request, opts...)
+	resp, err := client.PollActivityTaskQueue(ctx, request, opts...)
+	if err == nil && tq != nil {
+		c.loadBalancer.UpdatePartitionCounts(tq, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) PollWorkflowTaskQueue(
@@ -118,7 +130,7 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	request *matchingservice.PollWorkflowTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollWorkflowTaskQueueResponse, error) {
 	request = common.CloneProto(request)
-	client, release, err := c.pickClientForRead(
+	client, tq, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -131,7 +143,11 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollWorkflowTaskQueue(ctx, request, opts...)
+	resp, err := client.PollWorkflowTaskQueue(ctx, request, opts...)
+	if err == nil && tq != nil {
+		c.loadBalancer.UpdatePartitionCounts(tq, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
@@ -144,7 +160,7 @@ func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice
 		ForwardInfo:      request.ForwardInfo,
 		Priority:         request.Priority,
 	}
-	client, err := c.pickClientForWrite(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo().GetSourcePartition())
+	client, _, err := c.pickClientForWrite(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo().GetSourcePartition())
 	if err != nil {
 		return nil, err
 	}
@@ -178,18 +194,22 @@ func (c *clientImpl) processInputPartition(proto *taskqueuepb.TaskQueue, nsid st
 }
 
 // pickClientForWrite mutates the given proto. Callers should copy the proto before if necessary.
-func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (matchingservice.MatchingServiceClient, error) {
+// The returned task queue is non-nil when the partition was picked by the load balancer.
+func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (matchingservice.MatchingServiceClient, *tqid.TaskQueue, error) {
 	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		p = c.loadBalancer.PickWritePartition(tq)
 	}
 	proto.Name = p.RpcName()
-	return c.getClientForTaskQueuePartition(p)
+	client, err := c.getClientForTaskQueuePartition(p)
+	return client, tq, err
 }
 
 // pickClientForRead mutates the given proto. Callers should copy the proto before if necessary.
-func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, release func(), err error) {
-	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
+// The returned task queue is non-nil when the partition was picked by the load balancer.
+func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, tq *tqid.TaskQueue, release func(), err error) {
+	var p tqid.Partition
+	p, tq = c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		token := c.loadBalancer.PickReadPartition(tq)
 		p = token.TQPartition
@@ -198,7 +218,7 @@ func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string
 
 	proto.Name = p.RpcName()
 	client, err = c.getClientForTaskQueuePartition(p)
-	return client, release, err
+	return client, tq, release, err
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
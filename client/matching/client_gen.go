@@ -133,6 +133,23 @@ func (c *clientImpl) DescribeTaskQueue(
 	return client.DescribeTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueuePartitionRequest,
@@ -364,6 +381,26 @@ func (c *clientImpl) PollNexusTaskQueue(
 	return client.PollNexusTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -484,6 +521,26 @@ func (c *clientImpl) UpdateNexusEndpoint(
 	return client.UpdateNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateTaskQueuePauseState(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePauseStateRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueuePauseStateResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskQueuePauseState(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
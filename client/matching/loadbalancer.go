@@ -3,7 +3,9 @@ package matching
 import (
 	"math/rand"
 	"sync"
+	"time"
 
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/testing/testhooks"
@@ -31,12 +33,22 @@ type (
 		PickReadPartition(
 			taskQueue *tqid.TaskQueue,
 		) *pollToken
+
+		// UpdatePartitionCounts records the partition counts chosen by partition auto scaling
+		// for the given task queue, as returned by matching. They are used instead of the
+		// configured counts until they expire.
+		UpdatePartitionCounts(
+			taskQueue *tqid.TaskQueue,
+			counts *taskqueuespb.TaskQueuePartitionCounts,
+		)
 	}
 
 	defaultLoadBalancer struct {
 		namespaceIDToName func(id namespace.ID) (namespace.Name, error)
 		nReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		nWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		autoScaling       dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		countsCacheTTL    dynamicconfig.DurationPropertyFn
 		testHooks         testhooks.TestHooks
 
 		lock         sync.RWMutex
@@ -48,6 +60,9 @@ type (
 		taskQueue    *tqid.TaskQueue
 		pollerCounts []int // keep track of poller count of each partition
 		lock         sync.Mutex
+
+		partitionCounts       *taskqueuespb.TaskQueuePartitionCounts // last counts learned from matching
+		partitionCountsExpiry time.Time
 	}
 
 	pollToken struct {
@@ -67,6 +82,8 @@ func NewLoadBalancer(
 		namespaceIDToName: namespaceIDToName,
 		nReadPartitions:   dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
 		nWritePartitions:  dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
+		autoScaling:       dynamicconfig.MatchingEnablePartitionAutoScaling.Get(dc),
+		countsCacheTTL:    dynamicconfig.MatchingPartitionCountsCacheTTL.Get(dc),
 		testHooks:         testHooks,
 		taskQueueLBs:      make(map[tqid.TaskQueue]*tqLoadBalancer),
 	}
@@ -85,6 +102,15 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 		return taskQueue.RootPartition()
 	}
 
+	if lb.autoScaling(nsName.String(), taskQueue.Name(), taskQueue.TaskType()) {
+		counts := lb.getTaskQueueLoadBalancer(taskQueue).getPartitionCounts()
+		if counts == nil {
+			// The root partition always exists, whatever the current partition counts are.
+			return taskQueue.RootPartition()
+		}
+		return taskQueue.NormalPartition(rand.Intn(max(1, int(counts.GetWritePartitions()))))
+	}
+
 	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
@@ -103,6 +129,14 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	namespaceName, err := lb.namespaceIDToName(namespace.ID(taskQueue.NamespaceId()))
 	if err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
+		if lb.autoScaling(string(namespaceName), taskQueue.Name(), taskQueue.TaskType()) {
+			// Until the partition counts are known only the root partition is polled, tasks of the other
+			// partitions are forwarded to it.
+			partitionCount = 1
+			if counts := tqlb.getPartitionCounts(); counts != nil {
+				partitionCount = max(1, int(counts.GetReadPartitions()))
+			}
+		}
 	}
 
 	if n, ok := testhooks.Get[int](lb.testHooks, testhooks.MatchingLBForceWritePartition); ok {
@@ -112,6 +146,16 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	return tqlb.pickReadPartition(partitionCount)
 }
 
+func (lb *defaultLoadBalancer) UpdatePartitionCounts(
+	taskQueue *tqid.TaskQueue,
+	counts *taskqueuespb.TaskQueuePartitionCounts,
+) {
+	if counts == nil {
+		return
+	}
+	lb.getTaskQueueLoadBalancer(taskQueue).setPartitionCounts(counts, time.Now().Add(lb.countsCacheTTL()))
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	lb.lock.RLock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
@@ -136,6 +180,30 @@ func newTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	}
 }
 
+// setPartitionCounts caches the given counts unless older counts are given, since partitions learn
+// about new counts at different times.
+func (b *tqLoadBalancer) setPartitionCounts(counts *taskqueuespb.TaskQueuePartitionCounts, expiry time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.partitionCounts != nil && counts.GetUpdateTime().AsTime().Before(b.partitionCounts.GetUpdateTime().AsTime()) {
+		return
+	}
+	b.partitionCounts = counts
+	b.partitionCountsExpiry = expiry
+}
+
+// getPartitionCounts returns the cached partition counts, or nil if they are unknown or expired.
+func (b *tqLoadBalancer) getPartitionCounts() *taskqueuespb.TaskQueuePartitionCounts {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.partitionCounts == nil || time.Now().After(b.partitionCountsExpiry) {
+		return nil
+	}
+	return b.partitionCounts
+}
+
 func (b *tqLoadBalancer) pickReadPartition(partitionCount int) *pollToken {
 	b.lock.Lock()
 	defer b.lock.Unlock()
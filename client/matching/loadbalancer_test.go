@@ -3,10 +3,14 @@ package matching
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestTQLoadBalancerMapping(t *testing.T) {
@@ -154,6 +158,60 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancer_PartitionAutoScaling(t *testing.T) {
+	autoScaling := true
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(id namespace.ID) (namespace.Name, error) { return "fake-namespace", nil },
+		nReadPartitions:   func(string, string, enumspb.TaskQueueType) int { return 4 },
+		nWritePartitions:  func(string, string, enumspb.TaskQueueType) int { return 4 },
+		autoScaling:       func(string, string, enumspb.TaskQueueType) bool { return autoScaling },
+		countsCacheTTL:    func() time.Duration { return time.Minute },
+		taskQueueLBs:      make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	taskQueue := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	// only the root partition is used until the partition counts are known
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, 0, lb.PickWritePartition(taskQueue).PartitionId())
+		token := lb.PickReadPartition(taskQueue)
+		assert.Equal(t, 0, token.TQPartition.PartitionId())
+		token.Release()
+	}
+
+	now := time.Now()
+	lb.UpdatePartitionCounts(taskQueue, &taskqueuespb.TaskQueuePartitionCounts{
+		ReadPartitions:  3,
+		WritePartitions: 2,
+		UpdateTime:      timestamppb.New(now),
+	})
+	// older counts are ignored
+	lb.UpdatePartitionCounts(taskQueue, &taskqueuespb.TaskQueuePartitionCounts{
+		ReadPartitions:  8,
+		WritePartitions: 8,
+		UpdateTime:      timestamppb.New(now.Add(-time.Minute)),
+	})
+	for i := 0; i < 30; i++ {
+		assert.Less(t, lb.PickWritePartition(taskQueue).PartitionId(), 2)
+		lb.PickReadPartition(taskQueue)
+	}
+	assert.Len(t, lb.getTaskQueueLoadBalancer(taskQueue).pollerCounts, 3)
+	assert.Equal(t, 10, maxPollerCount(lb.getTaskQueueLoadBalancer(taskQueue)))
+
+	// expired counts are not used
+	lb.getTaskQueueLoadBalancer(taskQueue).partitionCountsExpiry = now.Add(-time.Second)
+	assert.Equal(t, 0, lb.PickWritePartition(taskQueue).PartitionId())
+
+	// the configured counts are used when partition auto scaling is disabled
+	autoScaling = false
+	partitions := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		partitions[lb.PickWritePartition(taskQueue).PartitionId()] = true
+	}
+	assert.Len(t, partitions, 4)
+}
+
 func maxPollerCount(tqlb *tqLoadBalancer) int {
 	res := -1
 	for _, c := range tqlb.pollerCounts {
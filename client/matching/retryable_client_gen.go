@@ -131,6 +131,21 @@ func (c *retryableClient) DescribeTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	var resp *matchingservice.DescribeTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueuePartitionRequest,
@@ -341,6 +356,21 @@ func (c *retryableClient) PollWorkflowTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	var resp *matchingservice.PurgeTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) QueryWorkflow(
 	ctx context.Context,
 	request *matchingservice.QueryWorkflowRequest,
@@ -446,6 +476,21 @@ func (c *retryableClient) UpdateNexusEndpoint(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskQueuePauseState(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePauseStateRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueuePauseStateResponse, error) {
+	var resp *matchingservice.UpdateTaskQueuePauseStateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueuePauseState(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -96,6 +96,10 @@ func getGrpcServerData(grpcServerT reflect.Type) grpcServerData {
 		if rpcT.NumIn() < 2 {
 			continue
 		}
+		if rpcT.NumOut() < 2 {
+			// Server streaming methods only return an error.
+			continue
+		}
 
 		requestT := rpcT.In(1) // Assume request is always the second parameter.
 		requestMd := workflowTagGetters(requestT, 0)
@@ -77,10 +77,16 @@ var (
 		"client.admin.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.admin.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.admin.StreamWorkflowReplicationMessages": true,
+		"client.admin.StreamVisibilityChanges":                    true,
+		"metricsClient.admin.StreamVisibilityChanges":             true,
+		"retryableClient.admin.StreamVisibilityChanges":           true,
 		// TODO(bergundy): Allow specifying custom routing for streaming messages.
 		"client.history.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.history.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.history.StreamWorkflowReplicationMessages": true,
+		"client.history.StreamVisibilityChanges":                    true,
+		"metricsClient.history.StreamVisibilityChanges":             true,
+		"retryableClient.history.StreamVisibilityChanges":           true,
 
 		// these need to pick a partition. too complicated.
 		"client.matching.AddActivityTask":       true,
@@ -299,6 +305,8 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"RespondQueryTaskCompletedRequest",
 		"ListTaskQueuePartitionsRequest",
 		"SyncDeploymentUserDataRequest",
+		"UpdateTaskQueuePauseStateRequest",
+		"PurgeTaskQueueTasksRequest",
 		"CheckTaskQueueUserDataPropagationRequest",
 		"ApplyTaskQueueUserDataReplicationEventRequest",
 		"GetWorkerVersioningRulesRequest",
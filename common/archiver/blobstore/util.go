@@ -0,0 +1,317 @@
+package blobstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/writer"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/config"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	namespacePartitionKey = "namespace_id"
+	closeDatePartitionKey = "close_date"
+	closeDateLayout       = "2006-01-02"
+
+	parquetFileExtension = ".parquet"
+	tempFileExtension    = ".tmp"
+)
+
+var (
+	errDirectoryExpected  = errors.New("a path to a directory was expected")
+	errEmptyDirectoryPath = errors.New("directory path is empty")
+	errInvalidFileMode    = errors.New("invalid file mode")
+	errInvalidDirMode     = errors.New("invalid directory mode")
+	errInvalidCompression = errors.New("invalid parquet compression codec")
+)
+
+type (
+	// visibilityRow is the columnar layout of an archived visibility record. Besides the flattened columns,
+	// which are meant for external analytics tools, each row carries the full proto encoded record so that
+	// Query can return it without any loss of precision.
+	visibilityRow struct {
+		NamespaceID            string `parquet:"name=namespace_id, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+		Namespace              string `parquet:"name=namespace, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+		WorkflowID             string `parquet:"name=workflow_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+		RunID                  string `parquet:"name=run_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+		WorkflowTypeName       string `parquet:"name=workflow_type_name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+		StartTime              int64  `parquet:"name=start_time, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+		ExecutionTime          int64  `parquet:"name=execution_time, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+		CloseTime              int64  `parquet:"name=close_time, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+		ExecutionDurationNanos int64  `parquet:"name=execution_duration_nanos, type=INT64"`
+		Status                 string `parquet:"name=status, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+		HistoryLength          int64  `parquet:"name=history_length, type=INT64"`
+		SearchAttributes       string `parquet:"name=search_attributes, type=BYTE_ARRAY, convertedtype=UTF8"`
+		Record                 string `parquet:"name=record, type=BYTE_ARRAY"`
+	}
+)
+
+// Partition layout
+
+// constructPartitionPath returns the hive style partition directory of a record:
+// <root>/namespace_id=<namespaceID>/close_date=<yyyy-mm-dd>
+func constructPartitionPath(rootPath string, namespaceID string, closeTime time.Time) string {
+	return path.Join(constructNamespacePath(rootPath, namespaceID), constructCloseDatePartition(closeTime))
+}
+
+func constructNamespacePath(rootPath string, namespaceID string) string {
+	return path.Join(rootPath, namespacePartitionKey+"="+namespaceID)
+}
+
+func constructCloseDatePartition(closeTime time.Time) string {
+	return closeDatePartitionKey + "=" + closeTime.UTC().Format(closeDateLayout)
+}
+
+func parseCloseDatePartition(name string) (time.Time, error) {
+	value, ok := strings.CutPrefix(name, closeDatePartitionKey+"=")
+	if !ok {
+		return time.Time{}, fmt.Errorf("failed to parse partition directory %s", name)
+	}
+	return time.Parse(closeDateLayout, value)
+}
+
+// constructVisibilityFilename returns a deterministic filename for a record, so archiving the same record
+// more than once overwrites the previous file instead of creating a duplicate.
+func constructVisibilityFilename(closeTime time.Time, runID string) string {
+	return fmt.Sprintf("%v_%s%s", closeTime.UnixNano(), hash(runID), parquetFileExtension)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+// listPartitions returns the close dates of all partitions of a namespace which may contain records closed within
+// [earliestCloseTime, latestCloseTime], latest first. Partitions outside of the range are never opened.
+func listPartitions(namespacePath string, earliestCloseTime time.Time, latestCloseTime time.Time) ([]time.Time, error) {
+	entries, err := os.ReadDir(namespacePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	earliestDate := earliestCloseTime.UTC().Truncate(24 * time.Hour)
+	var partitions []time.Time
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		closeDate, err := parseCloseDatePartition(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if closeDate.Before(earliestDate) || closeDate.After(latestCloseTime) {
+			continue
+		}
+		partitions = append(partitions, closeDate)
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].After(partitions[j])
+	})
+	return partitions, nil
+}
+
+// listParquetFiles lists all parquet files of a partition. Files may hold any number of rows, which allows
+// partitions to be compacted by external tooling without affecting queries.
+func listParquetFiles(partitionPath string) ([]string, error) {
+	entries, err := os.ReadDir(partitionPath)
+	if err != nil {
+		return nil, err
+	}
+	var filenames []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), parquetFileExtension) {
+			continue
+		}
+		filenames = append(filenames, entry.Name())
+	}
+	return filenames, nil
+}
+
+// File I/O util
+
+func mkdirAll(path string, dirMode os.FileMode) error {
+	return os.MkdirAll(path, dirMode)
+}
+
+// writeParquetFile writes rows into a parquet file. The file is written under a temporary name first and then
+// renamed, so concurrent queries never observe a partially written file.
+func writeParquetFile(
+	filepath string,
+	rows []*visibilityRow,
+	compression parquet.CompressionCodec,
+	fileMode os.FileMode,
+) (retErr error) {
+	tempFilepath := filepath + tempFileExtension
+	f, err := os.OpenFile(tempFilepath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = f.Close()
+			_ = os.Remove(tempFilepath)
+		}
+	}()
+
+	pw, err := writer.NewParquetWriterFromWriter(f, new(visibilityRow), 1)
+	if err != nil {
+		return err
+	}
+	pw.CompressionType = compression
+	for _, row := range rows {
+		if err := pw.Write(row); err != nil {
+			return err
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempFilepath, filepath)
+}
+
+// readParquetFile reads all rows of a parquet file.
+// WARNING: callers of this method should be extremely careful not to use it in a context where filepath is supplied by
+// the user.
+func readParquetFile(filepath string) (rows []*visibilityRow, retErr error) {
+	f, err := local.NewLocalFileReader(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	// the parquet reader panics on some malformed files instead of returning an error
+	defer func() {
+		if r := recover(); r != nil {
+			retErr = fmt.Errorf("failed to read parquet file %s: %v", filepath, r)
+		}
+	}()
+
+	pr, err := reader.NewParquetReader(f, new(visibilityRow), 1)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.ReadStop()
+
+	values := make([]visibilityRow, pr.GetNumRows())
+	if err := pr.Read(&values); err != nil {
+		return nil, err
+	}
+	rows = make([]*visibilityRow, len(values))
+	for i := range values {
+		rows[i] = &values[i]
+	}
+	return rows, nil
+}
+
+// encoding & decoding util
+
+func toVisibilityRow(record *archiverspb.VisibilityRecord) (*visibilityRow, error) {
+	encodedRecord, err := proto.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	searchAttributes, err := json.Marshal(record.GetSearchAttributes())
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityRow{
+		NamespaceID:            record.GetNamespaceId(),
+		Namespace:              record.GetNamespace(),
+		WorkflowID:             record.GetWorkflowId(),
+		RunID:                  record.GetRunId(),
+		WorkflowTypeName:       record.GetWorkflowTypeName(),
+		StartTime:              toMicros(record.GetStartTime().AsTime()),
+		ExecutionTime:          toMicros(record.GetExecutionTime().AsTime()),
+		CloseTime:              toMicros(record.GetCloseTime().AsTime()),
+		ExecutionDurationNanos: record.GetExecutionDuration().AsDuration().Nanoseconds(),
+		Status:                 record.GetStatus().String(),
+		HistoryLength:          record.GetHistoryLength(),
+		SearchAttributes:       string(searchAttributes),
+		Record:                 string(encodedRecord),
+	}, nil
+}
+
+func fromVisibilityRow(row *visibilityRow) (*archiverspb.VisibilityRecord, error) {
+	record := &archiverspb.VisibilityRecord{}
+	if err := proto.Unmarshal([]byte(row.Record), record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func toMicros(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMicro()
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Validation
+
+func validateDirPath(dirPath string) error {
+	if len(dirPath) == 0 {
+		return errEmptyDirectoryPath
+	}
+	info, err := os.Stat(dirPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errDirectoryExpected
+	}
+	return nil
+}
+
+func parseCompression(cfg *config.BlobstoreArchiver) (parquet.CompressionCodec, error) {
+	switch strings.ToLower(cfg.Compression) {
+	case "", "snappy":
+		return parquet.CompressionCodec_SNAPPY, nil
+	case "gzip":
+		return parquet.CompressionCodec_GZIP, nil
+	case "zstd":
+		return parquet.CompressionCodec_ZSTD, nil
+	case "none", "uncompressed":
+		return parquet.CompressionCodec_UNCOMPRESSED, nil
+	default:
+		return 0, errInvalidCompression
+	}
+}
@@ -0,0 +1,367 @@
+// Blobstore Visibility Archiver will archive workflow visibility records to local disk as Parquet files,
+// so archived executions can be analyzed with any columnar query engine. The blobstore scheme only supports
+// visibility archival, history archival is left to the filestore archiver.
+
+// Records are laid out in hive style partitions keyed by namespace and close date:
+// <URI path>/namespace_id=<namespaceID>/close_date=<yyyy-mm-dd>/<closeTimestamp>_<hash(runID)>.parquet
+// Each Archive() request writes a single file. Since the filename is derived from the record,
+// archiving the same record twice overwrites the previous file. Query() accepts partitions holding
+// files with any number of rows, so partitions may be compacted out of band.
+
+// The Query() method prunes partitions using the namespace and the CloseTime range of the query, plus
+// the NextPageToken, before any file is read. Remaining records are filtered in memory and returned
+// sorted by close time (desc), using the hashed runID to break ties.
+
+package blobstore
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+)
+
+const (
+	// URIScheme is the scheme for the blobstore implementation
+	URIScheme = "blobstore"
+
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errMakeDirectory          = "failed to make directory"
+	errWriteFile              = "failed to write parquet file"
+)
+
+type (
+	visibilityArchiver struct {
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		fileMode       os.FileMode
+		dirMode        os.FileMode
+		compression    parquet.CompressionCodec
+		queryParser    QueryParser
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime time.Time
+		LastRunID     string
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *parsedQuery
+	}
+
+	sortableRecord struct {
+		record      *archiverspb.VisibilityRecord
+		closeTime   time.Time
+		hashedRunID string
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on blobstore
+func NewVisibilityArchiver(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	config *config.BlobstoreArchiver,
+) (archiver.VisibilityArchiver, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	compression, err := parseCompression(config)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityArchiver{
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		fileMode:       os.FileMode(fileMode),
+		dirMode:        os.FileMode(dirMode),
+		compression:    compression,
+		queryParser:    NewQueryParser(),
+	}, nil
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	closeTime := request.CloseTime.AsTime()
+	dirPath := constructPartitionPath(URI.Path(), request.GetNamespaceId(), closeTime)
+	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	row, err := toVisibilityRow(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	filename := constructVisibilityFilename(closeTime, request.GetRunId())
+	if err := writeParquetFile(path.Join(dirPath, filename), []*visibilityRow{row}, v.compression, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			parsedQuery:   parsedQuery,
+		},
+		saTypeMap,
+	)
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *queryVisibilityToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	// Records after the token can't be closed later than the token, so the token narrows the
+	// partitions to scan in the same way as the CloseTime range of the query does.
+	latestCloseTime := request.parsedQuery.latestCloseTime
+	if token != nil {
+		latestCloseTime = util.MinTime(latestCloseTime, token.LastCloseTime)
+	}
+
+	namespacePath := constructNamespacePath(URI.Path(), request.namespaceID)
+	partitions, err := listPartitions(namespacePath, request.parsedQuery.earliestCloseTime, latestCloseTime)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, partition := range partitions {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		records, err := v.readPartition(path.Join(namespacePath, constructCloseDatePartition(partition)), request.parsedQuery, token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, record := range records {
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.pageSize {
+				newToken := &queryVisibilityToken{
+					LastCloseTime: timestamp.TimeValue(record.CloseTime),
+					LastRunID:     record.GetRunId(),
+				}
+				encodedToken, err := serializeToken(newToken)
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// readPartition returns the records of a partition which match the query and come after the token,
+// sorted by close timestamp (desc) and hashed runID (desc).
+func (v *visibilityArchiver) readPartition(
+	partitionPath string,
+	query *parsedQuery,
+	token *queryVisibilityToken,
+) ([]*archiverspb.VisibilityRecord, error) {
+	filenames, err := listParquetFiles(partitionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastHashedRunID string
+	if token != nil {
+		lastHashedRunID = hash(token.LastRunID)
+	}
+
+	var sortableRecords []*sortableRecord
+	for _, filename := range filenames {
+		rows, err := readParquetFile(path.Join(partitionPath, filename))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			record, err := fromVisibilityRow(row)
+			if err != nil {
+				return nil, err
+			}
+			if !matchQuery(record, query) {
+				continue
+			}
+			r := &sortableRecord{
+				record:      record,
+				closeTime:   record.CloseTime.AsTime(),
+				hashedRunID: hash(record.GetRunId()),
+			}
+			if token != nil && !r.before(token.LastCloseTime, lastHashedRunID) {
+				continue
+			}
+			sortableRecords = append(sortableRecords, r)
+		}
+	}
+
+	sort.Slice(sortableRecords, func(i, j int) bool {
+		return sortableRecords[j].before(sortableRecords[i].closeTime, sortableRecords[i].hashedRunID)
+	})
+
+	records := make([]*archiverspb.VisibilityRecord, 0, len(sortableRecords))
+	for _, r := range sortableRecords {
+		records = append(records, r.record)
+	}
+	return records, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateDirPath(URI.Path())
+}
+
+// before reports whether the record is ordered after the given position in query results,
+// i.e. it was closed earlier or, for the same close time, has a smaller hashed runID.
+func (r *sortableRecord) before(closeTime time.Time, hashedRunID string) bool {
+	if r.closeTime.Equal(closeTime) {
+		return r.hashedRunID < hashedRunID
+	}
+	return r.closeTime.Before(closeTime)
+}
+
+func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
+		return false
+	}
+	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
+		return false
+	}
+	if query.runID != nil && record.GetRunId() != *query.runID {
+		return false
+	}
+	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	if query.status != nil && record.Status != *query.status {
+		return false
+	}
+	return true
+}
+
+func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:         record.StartTime,
+		ExecutionTime:     record.ExecutionTime,
+		CloseTime:         record.CloseTime,
+		ExecutionDuration: record.ExecutionDuration,
+		Status:            record.Status,
+		HistoryLength:     record.HistoryLength,
+		Memo:              record.Memo,
+		SearchAttributes:  searchAttributes,
+	}, nil
+}
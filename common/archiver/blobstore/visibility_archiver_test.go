@@ -0,0 +1,583 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/xitongsys/parquet-go/parquet"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/tests/testutils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespaceID      = "test-namespace-id"
+	testNamespace        = "test-namespace"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+	testWorkflowTypeName = "test-workflow-type"
+	testFileModeStr      = "0666"
+	testDirModeStr       = "0766"
+)
+
+var (
+	testBaseTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	logger             log.Logger
+	metricsHandler     metrics.Handler
+	testArchivalURI    archiver.URI
+	testQueryDirectory string
+	visibilityRecords  []*archiverspb.VisibilityRecord
+
+	controller *gomock.Controller
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewNoopLogger()
+	s.metricsHandler = metrics.NoopMetricsHandler
+	var err error
+	s.testQueryDirectory, err = os.MkdirTemp("", "TestQuery")
+	s.Require().NoError(err)
+	s.setupVisibilityDirectory()
+	s.testArchivalURI, err = archiver.NewURI("blobstore:///a/b/c")
+	s.Require().NoError(err)
+}
+
+func (s *visibilityArchiverSuite) TearDownSuite() {
+	if err := os.RemoveAll(s.testQueryDirectory); err != nil {
+		s.Fail("Failed to remove test query directory %v: %v", s.testQueryDirectory, err)
+	}
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewNoopLogger()
+	s.metricsHandler = metrics.NoopMetricsHandler
+	s.controller = gomock.NewController(s.T())
+}
+
+func (s *visibilityArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityArchiverSuite) TestNewVisibilityArchiver_InvalidConfig() {
+	_, err := NewVisibilityArchiver(s.logger, s.metricsHandler, &config.BlobstoreArchiver{
+		FileMode: "invalid",
+		DirMode:  testDirModeStr,
+	})
+	s.Equal(errInvalidFileMode, err)
+
+	_, err = NewVisibilityArchiver(s.logger, s.metricsHandler, &config.BlobstoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: "lz4",
+	})
+	s.Equal(errInvalidCompression, err)
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "blobstore://",
+			expectedErr: errEmptyDirectoryPath,
+		},
+		{
+			URI:         "blobstore:///a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0])
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiverspb.VisibilityRecord{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetryableErrorOption() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.testArchivalURI,
+		&archiverspb.VisibilityRecord{},
+		archiver.GetNonRetryableErrorOption(nonRetryableErr),
+	)
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestVisibilityArchive")
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	closeTimestamp := timestamppb.New(testBaseTime.Add(123456789 * time.Nanosecond))
+	request := &archiverspb.VisibilityRecord{
+		NamespaceId:       testNamespaceID,
+		Namespace:         testNamespace,
+		WorkflowId:        testWorkflowID,
+		RunId:             testRunID,
+		WorkflowTypeName:  testWorkflowTypeName,
+		StartTime:         timestamppb.New(closeTimestamp.AsTime().Add(-time.Hour)),
+		ExecutionTime:     nil, // workflow without backoff
+		CloseTime:         closeTimestamp,
+		ExecutionDuration: durationpb.New(time.Hour),
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		HistoryLength:     int64(101),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{
+				"testFields": payload.EncodeBytes([]byte{1, 2, 3}),
+			},
+		},
+		SearchAttributes: map[string]string{
+			"testAttribute": "456",
+		},
+	}
+	URI, err := archiver.NewURI("blobstore://" + dir)
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, request)
+	s.NoError(err)
+	// archiving the same record again overwrites the previous file
+	err = visibilityArchiver.Archive(context.Background(), URI, request)
+	s.NoError(err)
+
+	partitionPath := path.Join(dir, "namespace_id="+testNamespaceID, "close_date=2024-03-01")
+	filenames, err := listParquetFiles(partitionPath)
+	s.NoError(err)
+	s.Equal([]string{constructVisibilityFilename(closeTimestamp.AsTime(), testRunID)}, filenames)
+
+	rows, err := readParquetFile(path.Join(partitionPath, filenames[0]))
+	s.NoError(err)
+	s.Len(rows, 1)
+	s.Equal(testWorkflowID, rows[0].WorkflowID)
+	s.Equal(testWorkflowTypeName, rows[0].WorkflowTypeName)
+	s.Equal(closeTimestamp.AsTime().UnixMicro(), rows[0].CloseTime)
+	s.Equal(int64(0), rows[0].ExecutionTime)
+	s.Equal(time.Hour.Nanoseconds(), rows[0].ExecutionDurationNanos)
+	s.Equal("Failed", rows[0].Status)
+	s.JSONEq(`{"testAttribute":"456"}`, rows[0].SearchAttributes)
+
+	archivedRecord, err := fromVisibilityRow(rows[0])
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), request, archivedRecord)
+}
+
+func (s *visibilityArchiverSuite) TestMatchQuery() {
+	testCases := []struct {
+		query       *parsedQuery
+		record      *archiverspb.VisibilityRecord
+		shouldMatch bool
+	}{
+		{
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 1000),
+				latestCloseTime:   time.Unix(0, 12345),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamppb.New(time.Unix(0, 1999)),
+			},
+			shouldMatch: true,
+		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 1000),
+				latestCloseTime:   time.Unix(0, 12345),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamppb.New(time.Unix(0, 999)),
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 1000),
+				latestCloseTime:   time.Unix(0, 12345),
+				workflowID:        util.Ptr("random workflowID"),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamppb.New(time.Unix(0, 2000)),
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 1000),
+				latestCloseTime:   time.Unix(0, 12345),
+				workflowID:        util.Ptr("random workflowID"),
+				runID:             util.Ptr("random runID"),
+				status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime:        timestamppb.New(time.Unix(0, 12345)),
+				WorkflowId:       "random workflowID",
+				RunId:            "random runID",
+				WorkflowTypeName: "random type name",
+				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			},
+			shouldMatch: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{}, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	visibilityArchiver.queryParser = mockParser
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: "some random namespaceID",
+		PageSize:    10,
+		Query:       "some invalid query",
+	}, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      1,
+		NextPageToken: []byte{1, 2, 3},
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+	var svcErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &svcErr)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Empty(response.Executions)
+	s.Empty(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: testBaseTime.Add(-10 * 24 * time.Hour),
+		latestCloseTime:   testBaseTime.Add(10 * 24 * time.Hour),
+		workflowID:        util.Ptr(testWorkflowID),
+	}, nil)
+	visibilityArchiver.queryParser = mockParser
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testQueryURI(), request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.assertExecutionsEqual(s.visibilityRecords[:1], response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: testBaseTime.Add(-10 * 24 * time.Hour),
+		latestCloseTime:   testBaseTime.Add(10 * 24 * time.Hour),
+		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    2,
+		Query:       "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testQueryURI(), request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.NotNil(response)
+	s.NotNil(response.NextPageToken)
+	s.assertExecutionsEqual(s.visibilityRecords[:2], response.Executions)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(context.Background(), s.testQueryURI(), request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.assertExecutionsEqual(s.visibilityRecords[3:4], response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_PartitionPruning() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_PartitionPruning")
+	URI, err := archiver.NewURI("blobstore://" + dir)
+	s.NoError(err)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+	// a corrupted file in a partition outside of the queried range must never be opened
+	corruptedPartition := constructPartitionPath(dir, testNamespaceID, testBaseTime.Add(-30*24*time.Hour))
+	s.NoError(os.MkdirAll(corruptedPartition, 0766))
+	s.NoError(os.WriteFile(path.Join(corruptedPartition, "corrupted.parquet"), []byte("not parquet"), 0666))
+
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: testBaseTime.Add(-24 * time.Hour),
+		latestCloseTime:   testBaseTime.Add(24 * time.Hour),
+	}, nil)
+	visibilityArchiver.queryParser = mockParser
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.assertExecutionsEqual(s.visibilityRecords[:2], response.Executions)
+
+	// the corrupted file is reported once its partition is in range
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: testBaseTime.Add(-60 * 24 * time.Hour),
+		latestCloseTime:   testBaseTime.Add(24 * time.Hour),
+	}, nil)
+	_, err = visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	var svcErr *serviceerror.Internal
+	s.ErrorAs(err, &svcErr)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_CompactedPartition() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_CompactedPartition")
+	URI, err := archiver.NewURI("blobstore://" + dir)
+	s.NoError(err)
+
+	// records of the same day are written into a single file, as a compaction job would do
+	var rows []*visibilityRow
+	for _, record := range s.visibilityRecords[:2] {
+		row, err := toVisibilityRow(record)
+		s.NoError(err)
+		rows = append(rows, row)
+	}
+	partitionPath := constructPartitionPath(dir, testNamespaceID, testBaseTime)
+	s.NoError(os.MkdirAll(partitionPath, 0766))
+	s.NoError(writeParquetFile(path.Join(partitionPath, "compacted.parquet"), rows, parquet.CompressionCodec_SNAPPY, 0666))
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.assertExecutionsEqual(s.visibilityRecords[:2], executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_Pagination() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_EmptyQuery_Pagination")
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("blobstore://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, record)
+		s.NoError(err)
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "",
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.NotNil(response)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.assertExecutionsEqual(s.visibilityRecords[:4], executions)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	config := &config.BlobstoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+	}
+	a, err := NewVisibilityArchiver(s.logger, s.metricsHandler, config)
+	s.NoError(err)
+	return a.(*visibilityArchiver)
+}
+
+func (s *visibilityArchiverSuite) testQueryURI() archiver.URI {
+	URI, err := archiver.NewURI("blobstore://" + s.testQueryDirectory)
+	s.NoError(err)
+	return URI
+}
+
+// setupVisibilityDirectory archives records of testNamespaceID sorted by close time (desc) over three
+// different close dates, plus a record of another namespace.
+func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
+	s.visibilityRecords = []*archiverspb.VisibilityRecord{
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamppb.New(testBaseTime.Add(-time.Hour)),
+			CloseTime:        timestamppb.New(testBaseTime.Add(time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    101,
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "some random workflow ID",
+			RunId:            "some random run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamppb.New(testBaseTime.Add(-2 * time.Hour)),
+			CloseTime:        timestamppb.New(testBaseTime),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    123,
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "another workflow ID",
+			RunId:            "another run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamppb.New(testBaseTime.Add(-3 * 24 * time.Hour)),
+			CloseTime:        timestamppb.New(testBaseTime.Add(-2 * 24 * time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+			HistoryLength:    456,
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "and another workflow ID",
+			RunId:            "and another run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamppb.New(testBaseTime.Add(-6 * 24 * time.Hour)),
+			CloseTime:        timestamppb.New(testBaseTime.Add(-5 * 24 * time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    456,
+		},
+		{
+			NamespaceId:      "some random namespace ID",
+			Namespace:        "some random namespace name",
+			WorkflowId:       "another workflow ID",
+			RunId:            "another run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamppb.New(testBaseTime.Add(-time.Hour)),
+			CloseTime:        timestamppb.New(testBaseTime.Add(time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+			HistoryLength:    456,
+		},
+	}
+
+	URI := s.testQueryURI()
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords {
+		s.Require().NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+}
+
+func (s *visibilityArchiverSuite) assertExecutionsEqual(
+	expected []*archiverspb.VisibilityRecord,
+	actual []*workflowpb.WorkflowExecutionInfo,
+) {
+	s.Len(actual, len(expected))
+	for i, record := range expected {
+		ei, err := convertToExecutionInfo(record, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		protorequire.ProtoEqual(s.T(), ei, actual[i])
+	}
+}
+
+func toWorkflowExecutionStatusPtr(in enumspb.WorkflowExecutionStatus) *enumspb.WorkflowExecutionStatus {
+	return &in
+}
@@ -4,9 +4,11 @@ package provider
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/blobstore"
 	"go.temporal.io/server/common/archiver/filestore"
 	"go.temporal.io/server/common/archiver/gcloud"
 	"go.temporal.io/server/common/archiver/s3store"
@@ -21,6 +23,9 @@ var (
 	ErrUnknownScheme = errors.New("unknown archiver scheme")
 	// ErrArchiverConfigNotFound is the error for unable to find the config for an archiver given scheme
 	ErrArchiverConfigNotFound = errors.New("unable to find archiver config for the given scheme")
+	// ErrArchiverNotSupported is the error returned by an ArchiverFactory which doesn't support
+	// the requested kind of archiver
+	ErrArchiverNotSupported = errors.New("archiver is not supported for the given scheme")
 )
 
 type (
@@ -31,11 +36,32 @@ type (
 		GetVisibilityArchiver(scheme string) (archiver.VisibilityArchiver, error)
 	}
 
+	// ArchiverFactory creates the archivers of a custom URI scheme. Factories are registered with
+	// temporal.WithArchiverFactory and take precedence over the built-in archivers of the same scheme.
+	// A factory which only supports one kind of archiver returns ErrArchiverNotSupported for the other.
+	ArchiverFactory interface {
+		NewHistoryArchiver(params ArchiverFactoryParams) (archiver.HistoryArchiver, error)
+		NewVisibilityArchiver(params ArchiverFactoryParams) (archiver.VisibilityArchiver, error)
+	}
+
+	// ArchiverFactories maps URI schemes to the factory of their archivers.
+	ArchiverFactories map[string]ArchiverFactory
+
+	// ArchiverFactoryParams contains the dependencies available to an ArchiverFactory.
+	ArchiverFactoryParams struct {
+		// Config is the config block of the scheme under the custom section of the archival provider config.
+		Config           config.CustomArchiverConfig
+		ExecutionManager persistence.ExecutionManager
+		Logger           log.Logger
+		MetricsHandler   metrics.Handler
+	}
+
 	archiverProvider struct {
 		sync.RWMutex
 
 		historyArchiverConfigs    *config.HistoryArchiverProvider
 		visibilityArchiverConfigs *config.VisibilityArchiverProvider
+		archiverFactories         ArchiverFactories
 
 		executionManager persistence.ExecutionManager
 		logger           log.Logger
@@ -51,6 +77,7 @@ type (
 func NewArchiverProvider(
 	historyArchiverConfigs *config.HistoryArchiverProvider,
 	visibilityArchiverConfigs *config.VisibilityArchiverProvider,
+	archiverFactories ArchiverFactories,
 	executionManager persistence.ExecutionManager,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
@@ -58,6 +85,7 @@ func NewArchiverProvider(
 	return &archiverProvider{
 		historyArchiverConfigs:    historyArchiverConfigs,
 		visibilityArchiverConfigs: visibilityArchiverConfigs,
+		archiverFactories:         archiverFactories,
 		executionManager:          executionManager,
 		logger:                    logger,
 		metricsHandler:            metricsHandler,
@@ -74,6 +102,25 @@ func (p *archiverProvider) GetHistoryArchiver(scheme string) (historyArchiver ar
 	}
 	p.RUnlock()
 
+	if factory, ok := p.archiverFactories[scheme]; ok {
+		historyArchiver, err = p.newCustomHistoryArchiver(scheme, factory)
+	} else {
+		historyArchiver, err = p.newHistoryArchiver(scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if existingHistoryArchiver, ok := p.historyArchivers[scheme]; ok {
+		return existingHistoryArchiver, nil
+	}
+	p.historyArchivers[scheme] = historyArchiver
+	return historyArchiver, nil
+}
+
+func (p *archiverProvider) newHistoryArchiver(scheme string) (historyArchiver archiver.HistoryArchiver, err error) {
 	switch scheme {
 	case filestore.URIScheme:
 		if p.historyArchiverConfigs.Filestore == nil {
@@ -93,21 +140,13 @@ func (p *archiverProvider) GetHistoryArchiver(scheme string) (historyArchiver ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(p.executionManager, p.logger, p.metricsHandler, p.historyArchiverConfigs.S3store)
+	case blobstore.URIScheme:
+		// blobstore only archives visibility records, history is archived with filestore instead
+		return nil, ErrArchiverNotSupported
 	default:
 		return nil, ErrUnknownScheme
 	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	p.Lock()
-	defer p.Unlock()
-	if existingHistoryArchiver, ok := p.historyArchivers[scheme]; ok {
-		return existingHistoryArchiver, nil
-	}
-	p.historyArchivers[scheme] = historyArchiver
-	return historyArchiver, nil
+	return historyArchiver, err
 }
 
 func (p *archiverProvider) GetVisibilityArchiver(scheme string) (archiver.VisibilityArchiver, error) {
@@ -120,7 +159,25 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme string) (archiver.Visibi
 
 	var visibilityArchiver archiver.VisibilityArchiver
 	var err error
+	if factory, ok := p.archiverFactories[scheme]; ok {
+		visibilityArchiver, err = p.newCustomVisibilityArchiver(scheme, factory)
+	} else {
+		visibilityArchiver, err = p.newVisibilityArchiver(scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	p.Lock()
+	defer p.Unlock()
+	if existingVisibilityArchiver, ok := p.visibilityArchivers[scheme]; ok {
+		return existingVisibilityArchiver, nil
+	}
+	p.visibilityArchivers[scheme] = visibilityArchiver
+	return visibilityArchiver, nil
+}
+
+func (p *archiverProvider) newVisibilityArchiver(scheme string) (visibilityArchiver archiver.VisibilityArchiver, err error) {
 	switch scheme {
 	case filestore.URIScheme:
 		if p.visibilityArchiverConfigs.Filestore == nil {
@@ -137,20 +194,86 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme string) (archiver.Visibi
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(p.logger, p.metricsHandler, p.visibilityArchiverConfigs.Gstorage)
-
+	case blobstore.URIScheme:
+		if p.visibilityArchiverConfigs.Blobstore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = blobstore.NewVisibilityArchiver(p.logger, p.metricsHandler, p.visibilityArchiverConfigs.Blobstore)
 	default:
 		return nil, ErrUnknownScheme
 	}
-	if err != nil {
-		return nil, err
+	return visibilityArchiver, err
+}
+
+func (p *archiverProvider) newCustomHistoryArchiver(scheme string, factory ArchiverFactory) (archiver.HistoryArchiver, error) {
+	if p.historyArchiverConfigs == nil {
+		return nil, ErrArchiverConfigNotFound
+	}
+	archiverConfig, ok := p.historyArchiverConfigs.Custom[scheme]
+	if !ok {
+		return nil, ErrArchiverConfigNotFound
 	}
+	return factory.NewHistoryArchiver(p.newArchiverFactoryParams(archiverConfig))
+}
 
-	p.Lock()
-	defer p.Unlock()
-	if existingVisibilityArchiver, ok := p.visibilityArchivers[scheme]; ok {
-		return existingVisibilityArchiver, nil
+func (p *archiverProvider) newCustomVisibilityArchiver(scheme string, factory ArchiverFactory) (archiver.VisibilityArchiver, error) {
+	if p.visibilityArchiverConfigs == nil {
+		return nil, ErrArchiverConfigNotFound
 	}
-	p.visibilityArchivers[scheme] = visibilityArchiver
-	return visibilityArchiver, nil
+	archiverConfig, ok := p.visibilityArchiverConfigs.Custom[scheme]
+	if !ok {
+		return nil, ErrArchiverConfigNotFound
+	}
+	return factory.NewVisibilityArchiver(p.newArchiverFactoryParams(archiverConfig))
+}
 
+func (p *archiverProvider) newArchiverFactoryParams(archiverConfig config.CustomArchiverConfig) ArchiverFactoryParams {
+	return ArchiverFactoryParams{
+		Config:           archiverConfig,
+		ExecutionManager: p.executionManager,
+		Logger:           p.logger,
+		MetricsHandler:   p.metricsHandler,
+	}
+}
+
+// ValidateNamespaceDefaultURIs validates the namespace default archival URIs which use the scheme of a custom
+// ArchiverFactory with the archivers created by the factory, so that a misconfigured custom archiver fails
+// at startup rather than when the first workflow is archived.
+func ValidateNamespaceDefaultURIs(
+	archiverProvider ArchiverProvider,
+	archiverFactories ArchiverFactories,
+	namespaceDefaults *config.ArchivalNamespaceDefaults,
+) error {
+	if historyURI := namespaceDefaults.History.URI; historyURI != "" {
+		URI, err := archiver.NewURI(historyURI)
+		if err != nil {
+			return fmt.Errorf("invalid namespace default history archival URI %q: %w", historyURI, err)
+		}
+		if _, ok := archiverFactories[URI.Scheme()]; ok {
+			historyArchiver, err := archiverProvider.GetHistoryArchiver(URI.Scheme())
+			if err != nil {
+				return fmt.Errorf("unable to create history archiver for scheme %q: %w", URI.Scheme(), err)
+			}
+			if err := historyArchiver.ValidateURI(URI); err != nil {
+				return fmt.Errorf("invalid namespace default history archival URI %q: %w", historyURI, err)
+			}
+		}
+	}
+
+	if visibilityURI := namespaceDefaults.Visibility.URI; visibilityURI != "" {
+		URI, err := archiver.NewURI(visibilityURI)
+		if err != nil {
+			return fmt.Errorf("invalid namespace default visibility archival URI %q: %w", visibilityURI, err)
+		}
+		if _, ok := archiverFactories[URI.Scheme()]; ok {
+			visibilityArchiver, err := archiverProvider.GetVisibilityArchiver(URI.Scheme())
+			if err != nil {
+				return fmt.Errorf("unable to create visibility archiver for scheme %q: %w", URI.Scheme(), err)
+			}
+			if err := visibilityArchiver.ValidateURI(URI); err != nil {
+				return fmt.Errorf("invalid namespace default visibility archival URI %q: %w", visibilityURI, err)
+			}
+		}
+	}
+	return nil
 }
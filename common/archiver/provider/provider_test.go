@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/blobstore"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.uber.org/mock/gomock"
+)
+
+const testCustomScheme = "custom"
+
+type (
+	testArchiverConfig struct {
+		Bucket string `yaml:"bucket"`
+	}
+
+	testArchiverFactory struct {
+		visibilityArchiver archiver.VisibilityArchiver
+		configs            []testArchiverConfig
+	}
+)
+
+func (f *testArchiverFactory) NewHistoryArchiver(ArchiverFactoryParams) (archiver.HistoryArchiver, error) {
+	return nil, ErrArchiverNotSupported
+}
+
+func (f *testArchiverFactory) NewVisibilityArchiver(params ArchiverFactoryParams) (archiver.VisibilityArchiver, error) {
+	var cfg testArchiverConfig
+	if err := params.Config.Decode(&cfg); err != nil {
+		return nil, err
+	}
+	f.configs = append(f.configs, cfg)
+	return f.visibilityArchiver, nil
+}
+
+func TestGetVisibilityArchiver_CustomFactory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	factory := &testArchiverFactory{visibilityArchiver: archiver.NewMockVisibilityArchiver(ctrl)}
+	p := NewArchiverProvider(
+		&config.HistoryArchiverProvider{},
+		&config.VisibilityArchiverProvider{
+			Custom: map[string]config.CustomArchiverConfig{
+				testCustomScheme: {"bucket": "archival"},
+			},
+		},
+		ArchiverFactories{testCustomScheme: factory},
+		nil,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+
+	visibilityArchiver, err := p.GetVisibilityArchiver(testCustomScheme)
+	require.NoError(t, err)
+	require.Same(t, factory.visibilityArchiver, visibilityArchiver)
+	// the archiver is cached
+	_, err = p.GetVisibilityArchiver(testCustomScheme)
+	require.NoError(t, err)
+	require.Equal(t, []testArchiverConfig{{Bucket: "archival"}}, factory.configs)
+
+	_, err = p.GetHistoryArchiver(testCustomScheme)
+	require.ErrorIs(t, err, ErrArchiverConfigNotFound)
+
+	_, err = p.GetVisibilityArchiver("unknown")
+	require.ErrorIs(t, err, ErrUnknownScheme)
+}
+
+func TestGetHistoryArchiver_Blobstore(t *testing.T) {
+	p := NewArchiverProvider(
+		&config.HistoryArchiverProvider{},
+		&config.VisibilityArchiverProvider{},
+		nil,
+		nil,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+
+	_, err := p.GetHistoryArchiver(blobstore.URIScheme)
+	require.ErrorIs(t, err, ErrArchiverNotSupported)
+}
+
+func TestValidateNamespaceDefaultURIs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityArchiver := archiver.NewMockVisibilityArchiver(ctrl)
+	factories := ArchiverFactories{testCustomScheme: &testArchiverFactory{visibilityArchiver: visibilityArchiver}}
+	p := NewArchiverProvider(
+		nil,
+		&config.VisibilityArchiverProvider{
+			Custom: map[string]config.CustomArchiverConfig{
+				testCustomScheme: {},
+			},
+		},
+		factories,
+		nil,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+
+	namespaceDefaults := &config.ArchivalNamespaceDefaults{
+		// built-in schemes are not validated at startup
+		History:    config.HistoryArchivalNamespaceDefaults{URI: "file:///tmp/history"},
+		Visibility: config.VisibilityArchivalNamespaceDefaults{URI: "custom://bucket/visibility"},
+	}
+	visibilityArchiver.EXPECT().ValidateURI(gomock.Any()).Return(nil)
+	require.NoError(t, ValidateNamespaceDefaultURIs(p, factories, namespaceDefaults))
+
+	validationErr := errors.New("bucket not found")
+	visibilityArchiver.EXPECT().ValidateURI(gomock.Any()).Return(validationErr)
+	require.ErrorIs(t, ValidateNamespaceDefaultURIs(p, factories, namespaceDefaults), validationErr)
+
+	namespaceDefaults.History.URI = "custom://bucket/history"
+	require.ErrorIs(t, ValidateNamespaceDefaultURIs(p, factories, namespaceDefaults), ErrArchiverConfigNotFound)
+}
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/masker"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	osclient "go.temporal.io/server/common/persistence/visibility/store/opensearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/telemetry"
 	"google.golang.org/grpc/keepalive"
@@ -266,6 +267,22 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// HistoryEventBlobCompression is the codec used to compress history event blobs, per namespace ID
+		HistoryEventBlobCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter `yaml:"-" json:"-"`
+		// Encryption contains the config for encryption at rest of history event and mutable state blobs
+		Encryption *PersistenceEncryption `yaml:"encryption"`
+		// PayloadEncryption is whether blobs are encrypted at rest when Encryption is configured, per namespace ID
+		PayloadEncryption dynamicconfig.BoolPropertyFnWithNamespaceIDFilter `yaml:"-" json:"-"`
+	}
+
+	// PersistenceEncryption is the config for encryption at rest of history event and mutable state blobs.
+	// Blobs are encrypted with the current key of the key ring and record the ID of the key, so keys can be
+	// rotated by adding a new key to the key ring file and making it the current key.
+	PersistenceEncryption struct {
+		// KeyRingFile is the path of the YAML file holding the encryption keys and the ID of the current key
+		KeyRingFile string `yaml:"keyRingFile" validate:"nonzero"`
+		// KeyRingRefreshInterval is how often the key ring file is checked for changes, defaults to 1 minute
+		KeyRingRefreshInterval time.Duration `yaml:"keyRingRefreshInterval"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -280,6 +297,8 @@ type (
 		CustomDataStoreConfig *CustomDatastoreConfig `yaml:"customDatastore"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		Elasticsearch *client.Config `yaml:"elasticsearch"`
+		// OpenSearch contains the config for a OpenSearch datastore
+		OpenSearch *osclient.Config `yaml:"opensearch"`
 	}
 
 	FaultInjection struct {
@@ -476,6 +495,8 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		// Custom contains the config blocks of archivers registered with temporal.WithArchiverFactory, keyed by scheme
+		Custom map[string]CustomArchiverConfig `yaml:"custom"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -493,14 +514,29 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Blobstore *BlobstoreArchiver `yaml:"blobstore"`
+		// Custom contains the config blocks of archivers registered with temporal.WithArchiverFactory, keyed by scheme
+		Custom map[string]CustomArchiverConfig `yaml:"custom"`
 	}
 
+	// CustomArchiverConfig is the config block of a custom archiver. Use Decode to convert it into the
+	// config struct of the archiver.
+	CustomArchiverConfig map[string]any
+
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
 	}
 
+	// BlobstoreArchiver contain the config for blobstore archiver
+	BlobstoreArchiver struct {
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
+		// Compression is the parquet compression codec: snappy (default), gzip, zstd or none
+		Compression string `yaml:"compression"`
+	}
+
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
@@ -668,6 +704,15 @@ func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
 	return false
 }
 
+// Decode converts the config block into out, which is a pointer to a struct with yaml tags.
+func (c CustomArchiverConfig) Decode(out any) error {
+	data, err := yaml.Marshal(map[string]any(c))
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, out)
+}
+
 func (k *KeepAliveServerConfig) GetKeepAliveServerParameters() keepalive.ServerParameters {
 	// the default config is same as grpc default config, same for the below client config and enforcement policy
 	defaultConfig := keepalive.ServerParameters{
@@ -48,6 +48,8 @@ func (c *Persistence) Validate() error {
 	// - visibilityStore (es),            visibilityStore (es) [via elasticsearch.indices config]
 	// - visibilityStore (es),            secondaryVisibilityStore (es)
 	//
+	// OpenSearch data stores are considered the same type as Elasticsearch data stores.
+	//
 	// Invalid dual visibility combinations:
 	// - visibilityStore (advanced sql),  secondaryVisibilityStore (es)
 	// - visibilityStore (es),            secondaryVisibilityStore (advanced sql)
@@ -58,8 +60,10 @@ func (c *Persistence) Validate() error {
 	if c.SecondaryVisibilityStore != "" {
 		isAnyCustom := c.DataStores[c.VisibilityStore].CustomDataStoreConfig != nil ||
 			c.DataStores[c.SecondaryVisibilityStore].CustomDataStoreConfig != nil
-		isPrimaryEs := c.DataStores[c.VisibilityStore].Elasticsearch != nil
-		isSecondaryEs := c.DataStores[c.SecondaryVisibilityStore].Elasticsearch != nil
+		isPrimaryEs := c.DataStores[c.VisibilityStore].Elasticsearch != nil ||
+			c.DataStores[c.VisibilityStore].OpenSearch != nil
+		isSecondaryEs := c.DataStores[c.SecondaryVisibilityStore].Elasticsearch != nil ||
+			c.DataStores[c.SecondaryVisibilityStore].OpenSearch != nil
 		if !isAnyCustom && isPrimaryEs != isSecondaryEs {
 			return fmt.Errorf(
 				"%w: cannot set visibilityStore and secondaryVisibilityStore with different datastore types",
@@ -136,6 +140,8 @@ func (ds *DataStore) GetIndexName() string {
 		return ds.Cassandra.Keyspace
 	case ds.Elasticsearch != nil:
 		return ds.Elasticsearch.GetVisibilityIndex()
+	case ds.OpenSearch != nil:
+		return ds.OpenSearch.GetVisibilityIndex()
 	default:
 		return ""
 	}
@@ -156,10 +162,13 @@ func (ds *DataStore) Validate() error {
 	if ds.Elasticsearch != nil {
 		storeConfigCount++
 	}
+	if ds.OpenSearch != nil {
+		storeConfigCount++
+	}
 	if storeConfigCount != 1 {
 		return errors.New(
 			"must provide config for one and only one datastore: " +
-				"elasticsearch, cassandra, sql or custom store",
+				"elasticsearch, opensearch, cassandra, sql or custom store",
 		)
 	}
 
@@ -176,6 +185,11 @@ func (ds *DataStore) Validate() error {
 			return err
 		}
 	}
+	if ds.OpenSearch != nil {
+		if err := ds.OpenSearch.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
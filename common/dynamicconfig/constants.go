@@ -83,7 +83,7 @@ var (
 	VisibilityDisableOrderByClause = NewNamespaceBoolSetting(
 		"system.visibilityDisableOrderByClause",
 		true,
-		`VisibilityDisableOrderByClause is the config to disable ORDERY BY clause for Elasticsearch`,
+		`VisibilityDisableOrderByClause is the config to disable ORDERY BY clause for Elasticsearch and SQL visibility`,
 	)
 	VisibilityEnableManualPagination = NewNamespaceBoolSetting(
 		"system.visibilityEnableManualPagination",
@@ -138,6 +138,20 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	HistoryEventBlobCompression = NewNamespaceIDStringSetting(
+		"system.historyEventBlobCompression",
+		"none",
+		`HistoryEventBlobCompression is the codec used to compress history event blobs written for a namespace.
+Valid values are "none", "zstd" and "snappy". Changing it only affects newly written events: blobs written with
+any codec remain readable regardless of the current value.`,
+	)
+	PersistencePayloadEncryption = NewNamespaceIDBoolSetting(
+		"system.persistencePayloadEncryption",
+		true,
+		`PersistencePayloadEncryption is whether history event and mutable state blobs written for a namespace are
+encrypted at rest. It only takes effect when persistence.encryption is configured in static config. Encrypted blobs
+are decrypted on read regardless of the current value, as long as their keys are still in the key ring.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -198,6 +212,15 @@ response to a StartWorkflowExecution request and skipping the trip through match
 		2*time.Second,
 		`NamespaceCacheRefreshInterval is the key for namespace cache refresh interval dynamic config`,
 	)
+	PersistenceFaultInjection = NewGlobalTypedSetting(
+		"system.persistenceFaultInjection",
+		[]PersistenceFaultInjectionRule(nil),
+		`PersistenceFaultInjection is a list of rules injecting errors and latency into persistence store calls,
+for chaos experiments. Rules are evaluated in order and the first one matching a call is applied. Changes take
+effect without a restart. This is applied on top of the faultInjection config of the default data store.
+Fields: Store, Methods, Namespaces, ShardIDs, WorkflowIDs, Errors, Latency, LatencyRate, Seed.
+See PersistenceFaultInjectionRule comments for more details.`,
+	)
 	PersistenceHealthSignalMetricsEnabled = NewGlobalBoolSetting(
 		"system.persistenceHealthSignalMetricsEnabled",
 		true,
@@ -433,6 +456,19 @@ to exceed this number will fail with a FailedPrecondition error.`,
 		`MatchingDeletedRuleRetentionTime is the length of time that deleted Version Assignment Rules and
 Deleted Redirect Rules will be kept in the DB (with DeleteTimestamp). After this time, the tombstones are deleted at the next time update of versioning data for the task queue.`,
 	)
+	MatchingTaskQueuePurgeFilterRetention = NewNamespaceDurationSetting(
+		"matching.taskQueuePurgeFilterRetention",
+		7*24*time.Hour,
+		`MatchingTaskQueuePurgeFilterRetention is the length of time that the filters of the purges of a task queue are
+kept in its user data. After this time, the filters are deleted at the next purge of the task queue. The task queue
+scavenger, which runs every 12 hours, deletes the purged tasks from persistence while the filters are kept, so this
+should be long enough for it to complete a few runs.`,
+	)
+	MatchingTaskQueuePurgeFilterLimit = NewNamespaceIntSetting(
+		"matching.taskQueuePurgeFilterLimit",
+		10,
+		`MatchingTaskQueuePurgeFilterLimit is the maximum number of purge filters kept for a task queue type`,
+	)
 	PollerHistoryTTL = NewNamespaceDurationSetting(
 		"matching.PollerHistoryTTL",
 		5*time.Minute,
@@ -1129,6 +1165,57 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		defaultNumTaskQueuePartitions,
 		`MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue`,
 	)
+	MatchingEnablePartitionAutoScaling = NewTaskQueueBoolSetting(
+		"matching.enablePartitionAutoScaling",
+		false,
+		`MatchingEnablePartitionAutoScaling enables automatic scaling of the partition counts of a task queue based on
+its add and dispatch rates and backlog. When enabled, the counts chosen by matching take precedence over
+matching.numTaskqueueReadPartitions and matching.numTaskqueueWritePartitions, which only provide the initial counts.
+Matching clients in the frontend and history services read it too to pick partitions, so it must be set for them alike.`,
+	)
+	MatchingPartitionAutoScalingMinPartitions = NewNamespaceIntSetting(
+		"matching.partitionAutoScalingMinPartitions",
+		1,
+		`MatchingPartitionAutoScalingMinPartitions is the minimum number of partitions partition auto scaling
+scales a task queue down to`,
+	)
+	MatchingPartitionAutoScalingMaxPartitions = NewNamespaceIntSetting(
+		"matching.partitionAutoScalingMaxPartitions",
+		16,
+		`MatchingPartitionAutoScalingMaxPartitions is the maximum number of partitions partition auto scaling
+scales a task queue up to`,
+	)
+	MatchingPartitionAutoScalingTargetRate = NewTaskQueueFloatSetting(
+		"matching.partitionAutoScalingTargetRate",
+		200,
+		`MatchingPartitionAutoScalingTargetRate is the number of tasks per second added to or dispatched from a
+single partition that partition auto scaling aims for`,
+	)
+	MatchingPartitionAutoScalingBacklogThreshold = NewTaskQueueIntSetting(
+		"matching.partitionAutoScalingBacklogThreshold",
+		1000,
+		`MatchingPartitionAutoScalingBacklogThreshold is the average backlog per write partition above which
+partition auto scaling adds a partition, as long as the backlog is being dispatched`,
+	)
+	MatchingPartitionAutoScalingInterval = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingInterval",
+		time.Minute,
+		`MatchingPartitionAutoScalingInterval is the interval at which partition auto scaling evaluates the load
+of a task queue`,
+	)
+	MatchingPartitionAutoScalingCooldown = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingCooldown",
+		5*time.Minute,
+		`MatchingPartitionAutoScalingCooldown is the minimum time between two changes of the write partition count
+of a task queue`,
+	)
+	MatchingPartitionCountsCacheTTL = NewGlobalDurationSetting(
+		"matching.partitionCountsCacheTTL",
+		time.Minute,
+		`MatchingPartitionCountsCacheTTL is how long matching clients keep using the partition counts chosen by
+partition auto scaling that they last learned from matching. Read partitions are removed no sooner than this long
+after the write partitions, so that tasks written with outdated counts are still polled.`,
+	)
 	MetricsBreakdownByTaskQueue = NewTaskQueueBoolSetting(
 		"metrics.breakdownByTaskQueue",
 		true,
@@ -1291,6 +1378,32 @@ second per poller by one physical queue manager`,
 		5,
 		`Number of simple priority levels (requires new matcher)`,
 	)
+	MatchingFairnessBuckets = NewTaskQueueIntSetting(
+		"matching.fairnessBuckets",
+		8,
+		`Number of buckets the backlog of each priority level is split into by the hash of the fairness key of the
+tasks, so that the backlog of a key doesn't delay reading the backlog of keys in other buckets. Tasks without
+fairness key are always in the first bucket (requires new matcher)`,
+	)
+	MatchingFairnessKeyDispatchRateLimits = NewTaskQueueTypedSetting(
+		"matching.fairnessKeyDispatchRateLimits",
+		map[string]float64(nil),
+		`Max tasks dispatched per second for each fairness key, across all partitions of the task queue. Tasks over
+the limit wait in the backlog until they can be dispatched (requires new matcher)`,
+	)
+	MatchingFairnessKeyDefaultDispatchRateLimit = NewTaskQueueFloatSetting(
+		"matching.fairnessKeyDefaultDispatchRateLimit",
+		0,
+		`Max tasks dispatched per second for the fairness keys that are not in matching.fairnessKeyDispatchRateLimits,
+across all partitions of the task queue. 0 means unlimited (requires new matcher)`,
+	)
+	MatchingTypeNameDispatchRateLimits = NewTaskQueueTypedSetting(
+		"matching.typeNameDispatchRateLimits",
+		map[string]float64(nil),
+		`Max tasks dispatched per second for each workflow type of a workflow task queue, or each activity type of an
+activity task queue, across all partitions of the task queue. Tasks over the limit wait in the backlog until they
+can be dispatched (requires new matcher)`,
+	)
 	MatchingBacklogTaskForwardTimeout = NewTaskQueueDurationSetting(
 		"matching.backlogTaskForwardTimeout",
 		60*time.Second,
@@ -1949,6 +2062,12 @@ attributes.`,
 		2,
 		`VisibilityQueueMaxReaderCount is the max number of readers in one multi-cursor visibility queue`,
 	)
+	VisibilityChangeStreamBufferSize = NewGlobalIntSetting(
+		"history.visibilityChangeStreamBufferSize",
+		0,
+		`VisibilityChangeStreamBufferSize is the max number of visibility changes kept in memory by each shard for the
+StreamVisibilityChanges API. Streams which fall further behind must be restarted without a cursor. 0 disables the API.`,
+	)
 
 	DisableFetchRelocatableAttributesFromVisibility = NewNamespaceBoolSetting(
 		"history.disableFetchRelocatableAttributesFromVisibility",
@@ -2110,6 +2229,19 @@ When the this config is zero or lower we will only update shard info at most onc
 		enumspb.ENCODING_TYPE_PROTO3.String(),
 		`DefaultEventEncoding is the encoding type for history events`,
 	)
+	FairnessKeySearchAttribute = NewNamespaceStringSetting(
+		"history.fairnessKeySearchAttribute",
+		"",
+		`FairnessKeySearchAttribute is the name of a Keyword search attribute of the namespace whose value is used as the
+fairness key of the workflow and activity tasks of a workflow, e.g. a tenant ID. Matching dispatches the tasks of
+different fairness keys sharing a task queue in proportion to their weights (requires new matcher).`,
+	)
+	FairnessWeightSearchAttribute = NewNamespaceStringSetting(
+		"history.fairnessWeightSearchAttribute",
+		"",
+		`FairnessWeightSearchAttribute is the name of an Int or Double search attribute of the namespace whose value is
+used as the fairness weight of the workflow and activity tasks of a workflow. Tasks without weight have weight 1.`,
+	)
 	DefaultActivityRetryPolicy = NewNamespaceTypedSetting(
 		"history.defaultActivityRetryPolicy",
 		retrypolicy.DefaultDefaultRetrySettings,
@@ -2598,6 +2730,39 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		`HistoryScannerVerifyRetention indicates the history scanner verify data retention.
 If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention.`,
 	)
+	HistoryScannerDeleteOrphanBranches = NewGlobalBoolSetting(
+		"worker.historyScannerDeleteOrphanBranches",
+		false,
+		`HistoryScannerDeleteOrphanBranches indicates if the history scanner deletes the orphan history branches it finds.
+A branch is orphan when the mutable state of its execution exists but none of its version histories references the
+branch, e.g. a branch forked by a reset or conflict resolution which was never used. Orphan branches are reported
+either way.`,
+	)
+	ArchivalScannerEnabled = NewGlobalBoolSetting(
+		"worker.archivalScannerEnabled",
+		false,
+		`ArchivalScannerEnabled indicates if archival scanner should be started as part of worker.Scanner.
+The archival scanner reads back archived executions and verifies that their archived histories are complete.`,
+	)
+	ArchivalScannerVerifyCount = NewNamespaceIntSetting(
+		"worker.archivalScannerVerifyCount",
+		100,
+		`ArchivalScannerVerifyCount is the number of archived executions verified per namespace in each archival scanner run.
+The executions are the next page of results of ArchivalScannerQuery: each run continues from the page the previous run
+stopped at, and starts over from the first page once all the results were verified.`,
+	)
+	ArchivalScannerQuery = NewNamespaceStringSetting(
+		"worker.archivalScannerQuery",
+		"",
+		`ArchivalScannerQuery is the archived visibility query used by the archival scanner to select the archived
+executions of a namespace to verify. The query syntax depends on the visibility archiver of the namespace. The
+default empty query is supported by the filestore and blobstore archivers, other archivers require a query to be set.`,
+	)
+	ArchivalScannerRPS = NewGlobalIntSetting(
+		"worker.archivalScannerRPS",
+		10,
+		`ArchivalScannerRPS is the maximum rate of archive reads from the archival scanner`,
+	)
 	EnableBatcherNamespace = NewNamespaceBoolSetting(
 		"worker.enableNamespaceBatcher",
 		true,
@@ -2714,6 +2879,12 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		`Maximum number of workflow rules in a given namespace`,
 	)
 
+	MaxSavedVisibilityQueriesPerNamespace = NewNamespaceIntSetting(
+		"frontend.maxSavedVisibilityQueriesPerNamespace",
+		100,
+		`Maximum number of saved visibility queries in a given namespace`,
+	)
+
 	SlowRequestLoggingThreshold = NewGlobalDurationSetting(
 		"rpc.slowRequestLoggingThreshold",
 		5*time.Second,
@@ -90,6 +90,31 @@ var DefaultDynamicRateLimitingParams = DynamicRateLimitingParams{
 	RateMultiMax:         1.0,
 }
 
+// PersistenceFaultInjectionRule describes faults injected into calls to persistence stores. Empty scopes match
+// every call, non-empty scopes are ANDed together.
+type PersistenceFaultInjectionRule struct {
+	// Store is the name of the targeted data store, e.g. "ExecutionStore". Empty matches all stores.
+	Store string
+	// Methods are the names of the targeted store methods, e.g. "UpdateWorkflowExecution".
+	Methods []string
+	// Namespaces are the names or IDs of the targeted namespaces. Names are matched against the caller
+	// of the persistence call, IDs against the NamespaceID of the request.
+	Namespaces []string
+	// ShardIDs are the targeted history shards.
+	ShardIDs []int32
+	// WorkflowIDs are the targeted workflows.
+	WorkflowIDs []string
+	// Errors maps the error to inject, e.g. "Timeout" or "ResourceExhausted", to how often it is
+	// injected, between 0 and 1. Rates of all errors should add up to at most 1.
+	Errors map[string]float64
+	// Latency is added to matching calls before they are executed (or fail with an injected error).
+	Latency time.Duration
+	// LatencyRate is how often Latency is added, between 0 and 1. Latency is always added if 0.
+	LatencyRate float64
+	// Seed is the random seed used to sample faults, for reproducible experiments. Random if 0.
+	Seed int64
+}
+
 type CircuitBreakerSettings struct {
 	// MaxRequests: Maximum number of requests allowed to pass through when
 	// it is in half-open state (default 1).
@@ -15,6 +15,7 @@ const (
 	QueueTypeTagName            = "queue_type"
 	visibilityPluginNameTagName = "visibility_plugin_name"
 	visibilityIndexNameTagName  = "visibility_index_name"
+	visibilityMismatchTagName   = "visibility_mismatch_type"
 	ErrorTypeTagName            = "error_type"
 	httpStatusTagName           = "http_status"
 	nexusMethodTagName          = "method"
@@ -27,6 +28,7 @@ const (
 	resourceExhaustedScopeTag   = "resource_exhausted_scope"
 	PartitionTagName            = "partition"
 	PriorityTagName             = "priority"
+	compressionCodecTagName     = "compression_codec"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -50,12 +52,16 @@ const (
 const (
 	// AdminClientStreamWorkflowReplicationMessagesScope tracks RPC calls to admin service
 	AdminClientStreamWorkflowReplicationMessagesScope = "AdminClientStreamWorkflowReplicationMessages"
+	// AdminClientStreamVisibilityChangesScope tracks RPC calls to admin service
+	AdminClientStreamVisibilityChangesScope = "AdminClientStreamVisibilityChanges"
 )
 
 // History Client Operations
 const (
 	// HistoryClientStreamWorkflowReplicationMessagesScope tracks RPC calls to history service
 	HistoryClientStreamWorkflowReplicationMessagesScope = "HistoryClientStreamWorkflowReplicationMessages"
+	// HistoryClientStreamVisibilityChangesScope tracks RPC calls to history service
+	HistoryClientStreamVisibilityChangesScope = "HistoryClientStreamVisibilityChanges"
 )
 
 // Matching Client Operations
@@ -217,6 +223,8 @@ const (
 	PersistenceCompleteTaskScope = "CompleteTask"
 	// PersistenceCompleteTasksLessThanScope is the metric scope for persistence.TaskManager.PersistenceCompleteTasksLessThan API
 	PersistenceCompleteTasksLessThanScope = "CompleteTasksLessThan"
+	// PersistenceCompleteTasksInRangeScope tracks CompleteTasksInRange calls made by service to persistence layer
+	PersistenceCompleteTasksInRangeScope = "CompleteTasksInRange"
 	// PersistenceCreateTaskQueueScope tracks PersistenceCreateTaskQueueScope calls made by service to persistence layer
 	PersistenceCreateTaskQueueScope = "CreateTaskQueue"
 	// PersistenceUpdateTaskQueueScope tracks PersistenceUpdateTaskQueueScope calls made by service to persistence layer
@@ -278,6 +286,10 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to visibility persistence layer
+	VisibilityPersistenceAggregateWorkflowExecutionsScope = "AggregateWorkflowExecutions"
+	// VisibilityPersistenceExplainQueryScope tracks ExplainQuery calls made by service to visibility persistence layer
+	VisibilityPersistenceExplainQueryScope = "ExplainQuery"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 )
@@ -469,6 +481,8 @@ const (
 	VisibilityArchiverScope = "VisibilityArchiver"
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope = "HistoryScavenger"
+	// ArchivalVerifierScope is scope used by all metrics emitted by worker.archival.Verifier module
+	ArchivalVerifierScope = "ArchivalVerifier"
 	// ArchiverDeleteHistoryActivityScope is scope used by all metrics emitted by archiver.DeleteHistoryActivity
 	ArchiverDeleteHistoryActivityScope = "ArchiverDeleteHistoryActivity"
 	// ArchiverUploadHistoryActivityScope is scope used by all metrics emitted by archiver.UploadHistoryActivity
@@ -491,6 +505,8 @@ const (
 	ElasticsearchVisibility = "ElasticsearchVisibility"
 	// MigrationWorkflowScope is scope used by metrics emitted by migration related workflows
 	MigrationWorkflowScope = "MigrationWorkflow"
+	// VisibilityReindexWorkflowScope is scope used by all metrics emitted by worker.VisibilityReindexWorkflow module
+	VisibilityReindexWorkflowScope = "VisibilityReindexWorkflow"
 	// ReplicatorScope is the scope used by all metric emitted by replicator
 	ReplicatorScope = "Replicator"
 	// NamespaceReplicationTaskScope is the scope used by namespace task replication processing
@@ -1007,6 +1023,7 @@ var (
 	SyncThrottlePerTaskQueueCounter                   = NewCounterDef("sync_throttle_count")
 	BufferThrottlePerTaskQueueCounter                 = NewCounterDef("buffer_throttle_count")
 	ExpiredTasksPerTaskQueueCounter                   = NewCounterDef("tasks_expired")
+	PurgedTasksPerTaskQueueCounter                    = NewCounterDef("tasks_purged")
 	ForwardedPerTaskQueueCounter                      = NewCounterDef("forwarded_per_tl")
 	ForwardTaskErrorsPerTaskQueue                     = NewCounterDef("forward_task_errors")
 	LocalToLocalMatchPerTaskQueueCounter              = NewCounterDef("local_to_local_matches")
@@ -1070,11 +1087,20 @@ var (
 	HistoryScavengerSuccessCount                    = NewCounterDef("scavenger_success")
 	HistoryScavengerErrorCount                      = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                       = NewCounterDef("scavenger_skips")
+	HistoryScavengerOrphanBranchCount               = NewCounterDef("scavenger_orphan_branches")
+	HistoryScavengerOrphanBranchSize                = NewBytesHistogramDef("scavenger_orphan_branch_size")
+	HistoryScavengerOrphanBranchDeleteCount         = NewCounterDef("scavenger_orphan_branch_deletes")
 	ExecutionsOutstandingCount                      = NewGaugeDef("executions_outstanding")
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
+	VisibilityReindexExecutionsCount                = NewCounterDef("visibility_reindex_executions")
+	VisibilityReindexSkipsCount                     = NewCounterDef("visibility_reindex_skips")
+	ArchivalVerifierVerifiedCount                   = NewCounterDef("archival_verifier_verified")
+	ArchivalVerifierMissingCount                    = NewCounterDef("archival_verifier_missing")
+	ArchivalVerifierCorruptedCount                  = NewCounterDef("archival_verifier_corrupted")
+	ArchivalVerifierErrorCount                      = NewCounterDef("archival_verifier_errors")
 
 	// Delete Namespace metrics.
 	ReclaimResourcesNamespaceDeleteSuccessCount = NewCounterDef(
@@ -1219,6 +1245,22 @@ var (
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
+	HistoryEventBlobUncompressedBytes      = NewCounterDef(
+		"history_event_blob_uncompressed_bytes",
+		WithDescription("Size of history event blobs before compression, keyed by `compression_codec`. Divide history_event_blob_compressed_bytes by this to get the compression ratio."),
+	)
+	HistoryEventBlobCompressedBytes = NewCounterDef(
+		"history_event_blob_compressed_bytes",
+		WithDescription("Size of history event blobs as written to persistence after compression, keyed by `compression_codec`."),
+	)
+	VisibilityConsistencyCheckedCount = NewCounterDef(
+		"visibility_consistency_checked",
+		WithDescription("Visibility records of the primary store compared with the secondary store, keyed by `namespace`"),
+	)
+	VisibilityConsistencyMismatchCount = NewCounterDef(
+		"visibility_consistency_mismatches",
+		WithDescription("Visibility records differing between the primary and secondary stores, keyed by `namespace` and `visibility_mismatch_type`"),
+	)
 
 	// Common service base metrics
 	RestartCount           = NewCounterDef("restarts")
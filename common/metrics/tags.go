@@ -287,6 +287,13 @@ func VisibilityIndexNameTag(value string) Tag {
 	return &tagImpl{key: visibilityIndexNameTagName, value: value}
 }
 
+func VisibilityMismatchTypeTag(value string) Tag {
+	if value == "" {
+		value = unknownValue
+	}
+	return &tagImpl{key: visibilityMismatchTagName, value: value}
+}
+
 // VersionedTag represents whether a loaded task queue manager represents a specific version set or build ID or not.
 func VersionedTag(versioned string) Tag {
 	return &tagImpl{key: versionedTagName, value: versioned}
@@ -383,3 +390,8 @@ func DestinationTag(value string) Tag {
 		value: value,
 	}
 }
+
+// CompressionCodecTag is a tag for metrics emitted when compressing persisted blobs.
+func CompressionCodecTag(codec string) Tag {
+	return &tagImpl{key: compressionCodecTagName, value: codec}
+}
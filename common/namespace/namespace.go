@@ -292,6 +292,18 @@ func (ns *Namespace) GetWorkflowRule(ruleID string) (*rulespb.WorkflowRule, bool
 	return result, ok
 }
 
+// GetSavedVisibilityQuery returns the saved visibility query of the namespace with the given name.
+func (ns *Namespace) GetSavedVisibilityQuery(name string) (*persistencespb.SavedVisibilityQuery, bool) {
+	result, ok := ns.config.GetSavedVisibilityQueries()[name]
+	return result, ok
+}
+
+// CustomSearchAttributeFieldMigrations returns the migrations of the custom search attribute fields of the
+// namespace, by source field name.
+func (ns *Namespace) CustomSearchAttributeFieldMigrations() map[string]*persistencespb.CustomSearchAttributeFieldMigration {
+	return ns.config.GetCustomSearchAttributeFieldMigrations()
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
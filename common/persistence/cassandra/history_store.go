@@ -7,6 +7,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 )
 
@@ -71,7 +72,7 @@ func (h *HistoryStore) AppendHistoryNodes(
 			node.PrevTransactionID,
 			node.TransactionID,
 			node.Events.Data,
-			node.Events.EncodingType.String(),
+			serialization.EncodingTypeToString(node.Events.EncodingType),
 		).WithContext(ctx)
 		if err := query.Exec(); err != nil {
 			return convertTimeoutError(gocql.ConvertError("AppendHistoryNodes", err))
@@ -94,7 +95,7 @@ func (h *HistoryStore) AppendHistoryNodes(
 		node.PrevTransactionID,
 		node.TransactionID,
 		node.Events.Data,
-		node.Events.EncodingType.String(),
+		serialization.EncodingTypeToString(node.Events.EncodingType),
 	)
 	if err := h.Session.ExecuteBatch(batch); err != nil {
 		return convertTimeoutError(gocql.ConvertError("AppendHistoryNodes", err))
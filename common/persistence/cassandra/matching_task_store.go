@@ -41,6 +41,14 @@ const (
 		`AND type = ? ` +
 		`AND task_id < ? `
 
+	templateCompleteTasksInRangeQuery = `DELETE FROM tasks ` +
+		`WHERE namespace_id = ? ` +
+		`AND task_queue_name = ? ` +
+		`AND task_queue_type = ? ` +
+		`AND type = ? ` +
+		`AND task_id >= ? ` +
+		`AND task_id < ? `
+
 	templateGetTaskQueueQuery = `SELECT ` +
 		`range_id, ` +
 		`task_queue, ` +
@@ -488,6 +496,27 @@ func (d *MatchingTaskStore) CompleteTasksLessThan(
 	return p.UnknownNumRowsAffected, nil
 }
 
+// CompleteTasksInRange deletes all tasks with ids in the given range.
+func (d *MatchingTaskStore) CompleteTasksInRange(
+	ctx context.Context,
+	request *p.CompleteTasksInRangeRequest,
+) (int, error) {
+	query := d.Session.Query(
+		templateCompleteTasksInRangeQuery,
+		request.NamespaceID,
+		request.TaskQueueName,
+		request.TaskType,
+		rowTypeTaskInSubqueue(request.Subqueue),
+		request.InclusiveMinTaskID,
+		request.ExclusiveMaxTaskID,
+	).WithContext(ctx)
+	err := query.Exec()
+	if err != nil {
+		return 0, gocql.ConvertError("CompleteTasksInRange", err)
+	}
+	return p.UnknownNumRowsAffected, nil
+}
+
 func (d *MatchingTaskStore) GetTaskQueueUserData(
 	ctx context.Context,
 	request *p.GetTaskQueueUserDataRequest,
@@ -7,6 +7,7 @@ import (
 	"go.temporal.io/server/common/convert"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -388,7 +389,7 @@ func createExecution(
 		snapshot.RunID,
 		rowTypeExecution,
 		snapshot.ExecutionInfoBlob.Data,
-		snapshot.ExecutionInfoBlob.EncodingType.String(),
+		serialization.EncodingTypeToString(snapshot.ExecutionInfoBlob.EncodingType),
 		snapshot.ExecutionStateBlob.Data,
 		snapshot.ExecutionStateBlob.EncodingType.String(),
 		snapshot.NextEventID,
@@ -427,7 +428,7 @@ func updateExecution(
 	if dbRecordVersion == 0 {
 		batch.Query(templateUpdateWorkflowExecutionQueryDeprecated,
 			executionInfoBlob.Data,
-			executionInfoBlob.EncodingType.String(),
+			serialization.EncodingTypeToString(executionInfoBlob.EncodingType),
 			executionStateBlob.Data,
 			executionStateBlob.EncodingType.String(),
 			nextEventID,
@@ -446,7 +447,7 @@ func updateExecution(
 	} else {
 		batch.Query(templateUpdateWorkflowExecutionQuery,
 			executionInfoBlob.Data,
-			executionInfoBlob.EncodingType.String(),
+			serialization.EncodingTypeToString(executionInfoBlob.EncodingType),
 			executionStateBlob.Data,
 			executionStateBlob.EncodingType.String(),
 			nextEventID,
@@ -621,7 +622,7 @@ func updateActivityInfos(
 		batch.Query(templateUpdateActivityInfoQuery,
 			scheduledEventID,
 			blob.Data,
-			blob.EncodingType.String(),
+			serialization.EncodingTypeToString(blob.EncodingType),
 			shardID,
 			rowTypeExecution,
 			namespaceID,
@@ -678,7 +679,7 @@ func resetActivityInfos(
 
 	batch.Query(templateResetActivityInfoQuery,
 		infoMap,
-		encoding.String(),
+		serialization.EncodingTypeToString(encoding),
 		shardID,
 		rowTypeExecution,
 		namespaceID,
@@ -703,7 +704,7 @@ func updateTimerInfos(
 		batch.Query(templateUpdateTimerInfoQuery,
 			timerID,
 			blob.Data,
-			blob.EncodingType.String(),
+			serialization.EncodingTypeToString(blob.EncodingType),
 			shardID,
 			rowTypeExecution,
 			namespaceID,
@@ -743,7 +744,7 @@ func resetTimerInfos(
 
 	batch.Query(templateResetTimerInfoQuery,
 		timerMap,
-		timerMapEncoding.String(),
+		serialization.EncodingTypeToString(timerMapEncoding),
 		shardID,
 		rowTypeExecution,
 		namespaceID,
@@ -769,7 +770,7 @@ func updateChildExecutionInfos(
 		batch.Query(templateUpdateChildExecutionInfoQuery,
 			initiatedId,
 			blob.Data,
-			blob.EncodingType.String(),
+			serialization.EncodingTypeToString(blob.EncodingType),
 			shardID,
 			rowTypeExecution,
 			namespaceID,
@@ -808,7 +809,7 @@ func resetChildExecutionInfos(
 
 	batch.Query(templateResetChildExecutionInfoQuery,
 		infoMap,
-		encoding.String(),
+		serialization.EncodingTypeToString(encoding),
 		shardID,
 		rowTypeExecution,
 		namespaceID,
@@ -834,7 +835,7 @@ func updateRequestCancelInfos(
 		batch.Query(templateUpdateRequestCancelInfoQuery,
 			initiatedId,
 			blob.Data,
-			blob.EncodingType.String(),
+			serialization.EncodingTypeToString(blob.EncodingType),
 			shardID,
 			rowTypeExecution,
 			namespaceID,
@@ -873,7 +874,7 @@ func resetRequestCancelInfos(
 
 	batch.Query(templateResetRequestCancelInfoQuery,
 		rciMap,
-		rciMapEncoding.String(),
+		serialization.EncodingTypeToString(rciMapEncoding),
 		shardID,
 		rowTypeExecution,
 		namespaceID,
@@ -899,7 +900,7 @@ func updateSignalInfos(
 		batch.Query(templateUpdateSignalInfoQuery,
 			initiatedId,
 			blob.Data,
-			blob.EncodingType.String(),
+			serialization.EncodingTypeToString(blob.EncodingType),
 			shardID,
 			rowTypeExecution,
 			namespaceID,
@@ -938,7 +939,7 @@ func resetSignalInfos(
 
 	batch.Query(templateResetSignalInfoQuery,
 		sMap,
-		sMapEncoding.String(),
+		serialization.EncodingTypeToString(sMapEncoding),
 		shardID,
 		rowTypeExecution,
 		namespaceID,
@@ -967,7 +968,7 @@ func resetChasmNodes(
 
 	batch.Query(templateResetChasmNodeQuery,
 		blobMap,
-		encoding.String(),
+		serialization.EncodingTypeToString(encoding),
 		shardID,
 		rowTypeExecution,
 		namespaceID,
@@ -1004,7 +1005,7 @@ func updateChasmNodes(
 		batch.Query(templateUpdateChasmNodeQuery,
 			upsertPath,
 			node.CassandraBlob.Data,
-			node.CassandraBlob.EncodingType.String(),
+			serialization.EncodingTypeToString(node.CassandraBlob.EncodingType),
 			shardID,
 			rowTypeExecution,
 			namespaceID,
@@ -1093,7 +1094,7 @@ func updateBufferedEvents(
 			rowTypeExecutionTaskID)
 	} else if newBufferedEvents != nil {
 		values := make(map[string]interface{})
-		values["encoding_type"] = newBufferedEvents.EncodingType.String()
+		values["encoding_type"] = serialization.EncodingTypeToString(newBufferedEvents.EncodingType)
 		values["version"] = int64(0)
 		values["data"] = newBufferedEvents.Data
 		newEventValues := []map[string]interface{}{values}
@@ -1131,7 +1132,7 @@ func createHistoryEventBatchBlob(
 		switch k {
 		case "encoding_type":
 			encodingStr := v.(string)
-			if encoding, err := enumspb.EncodingTypeFromString(encodingStr); err == nil {
+			if encoding, err := serialization.EncodingTypeFromString(encodingStr); err == nil {
 				eventBatch.EncodingType = enumspb.EncodingType(encoding)
 			}
 		case "data":
@@ -175,7 +175,29 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		return nil, err
 	}
 
-	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	var keyProvider serialization.KeyProvider
+	if f.config.Encryption != nil {
+		keyProvider, err = serialization.NewFileKeyRing(
+			f.config.Encryption.KeyRingFile,
+			f.config.Encryption.KeyRingRefreshInterval,
+			f.logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := persistence.NewExecutionManager(
+		store,
+		f.serializer,
+		f.eventBlobCache,
+		f.logger,
+		f.metricsHandler,
+		f.config.TransactionSizeLimit,
+		f.config.HistoryEventBlobCompression,
+		keyProvider,
+		f.config.PayloadEncryption,
+	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
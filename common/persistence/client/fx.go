@@ -159,6 +159,7 @@ func DataStoreFactoryProvider(
 	r resolver.ServiceResolver,
 	cfg *config.Persistence,
 	abstractDataStoreFactory AbstractDataStoreFactory,
+	dynamicCollection *dynamicconfig.Collection,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	tracerProvider trace.TracerProvider,
@@ -177,8 +178,10 @@ func DataStoreFactoryProvider(
 		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
 	}
 
-	if defaultStoreCfg.FaultInjection != nil {
-		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory)
+	// Stores are wrapped even without fault injection rules, so that the PersistenceFaultInjection rules can be
+	// added without a restart. Calls only load the cached rules until then.
+	if defaultStoreCfg.FaultInjection != nil || dynamicCollection != nil {
+		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dynamicCollection, dataStoreFactory)
 	}
 
 	tracer := tracerProvider.Tracer(otel.ComponentPersistence)
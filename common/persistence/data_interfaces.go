@@ -630,6 +630,16 @@ type (
 		Limit              int // Limit on the max number of tasks that can be completed. Required param
 	}
 
+	// CompleteTasksInRangeRequest contains the request params needed to invoke CompleteTasksInRange API
+	CompleteTasksInRangeRequest struct {
+		NamespaceID        string
+		TaskQueueName      string
+		TaskType           enumspb.TaskQueueType
+		InclusiveMinTaskID int64
+		ExclusiveMaxTaskID int64
+		Subqueue           int
+	}
+
 	// CreateNamespaceRequest is used to create the namespace
 	CreateNamespaceRequest struct {
 		Namespace         *persistencespb.NamespaceDetail
@@ -744,6 +754,8 @@ type (
 	AppendHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace the branch belongs to, used to resolve namespace level persistence options
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -768,6 +780,8 @@ type (
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace the branch belongs to, used to resolve namespace level persistence options
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -1155,6 +1169,11 @@ type (
 		//  - UnknownNumRowsAffected (this means all rows below value are deleted)
 		//  - number of rows deleted, which may be equal to limit
 		CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error)
+		// CompleteTasksInRange completes the tasks with ids in [InclusiveMinTaskID, ExclusiveMaxTaskID).
+		// Unlike CompleteTasksLessThan, the tasks before the range are kept, so that tasks can be deleted
+		// from the middle of a backlog. On success, this method returns either UnknownNumRowsAffected or
+		// the number of rows deleted.
+		CompleteTasksInRange(ctx context.Context, request *CompleteTasksInRangeRequest) (int, error)
 
 		// GetTaskQueueUserData gets versioned user data.
 		// This data would only exist if a user uses APIs that generate it, such as the worker versioning related APIs.
@@ -657,6 +657,21 @@ func (mr *MockTaskManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTaskManager)(nil).Close))
 }
 
+// CompleteTasksInRange mocks base method.
+func (m *MockTaskManager) CompleteTasksInRange(ctx context.Context, request *CompleteTasksInRangeRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTasksInRange", ctx, request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTasksInRange indicates an expected call of CompleteTasksInRange.
+func (mr *MockTaskManagerMockRecorder) CompleteTasksInRange(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTasksInRange", reflect.TypeOf((*MockTaskManager)(nil).CompleteTasksInRange), ctx, request)
+}
+
 // CompleteTasksLessThan mocks base method.
 func (m *MockTaskManager) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
 	m.ctrl.T.Helper()
@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -29,8 +30,12 @@ type (
 		eventBlobCache        XDCCache
 		persistence           ExecutionStore
 		logger                log.Logger
+		metricsHandler        metrics.Handler
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		historyCompression    dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		keyProvider           serialization.KeyProvider
+		payloadEncryption     dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
 	}
 )
 
@@ -42,15 +47,32 @@ func NewExecutionManager(
 	serializer serialization.Serializer,
 	eventBlobCache XDCCache,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	historyCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter,
+	keyProvider serialization.KeyProvider,
+	payloadEncryption dynamicconfig.BoolPropertyFnWithNamespaceIDFilter,
 ) ExecutionManager {
+	if metricsHandler == nil {
+		metricsHandler = metrics.NoopMetricsHandler
+	}
+	if historyCompression == nil {
+		historyCompression = dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(serialization.HistoryCompressionNone)
+	}
+	if payloadEncryption == nil {
+		payloadEncryption = dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(true)
+	}
 	return &executionManagerImpl{
 		serializer:            serializer,
 		eventBlobCache:        eventBlobCache,
 		persistence:           persistence,
 		logger:                logger,
+		metricsHandler:        metricsHandler,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		historyCompression:    historyCompression,
+		keyProvider:           keyProvider,
+		payloadEncryption:     payloadEncryption,
 	}
 }
 
@@ -464,6 +486,14 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+		// compress only after the uncompressed blob is cached and accounted for,
+		// history size limits and replication are based on the uncompressed events
+		if err := m.compressHistoryNode(workflowEvents.NamespaceID, &newEvents.Node); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := m.encryptHistoryNode(workflowEvents.NamespaceID, &newEvents.Node); err != nil {
+			return nil, nil, nil, err
+		}
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
@@ -491,6 +521,10 @@ func (m *executionManagerImpl) DeserializeBufferedEvents( // unexport
 			continue
 		}
 
+		b, err := m.decryptBlob(b)
+		if err != nil {
+			return nil, err
+		}
 		history, err := m.serializer.DeserializeEvents(b)
 		if err != nil {
 			return nil, err
@@ -510,6 +544,7 @@ func (m *executionManagerImpl) serializeWorkflowEvents(
 
 	request := &AppendHistoryNodesRequest{
 		ShardID:           shardID,
+		NamespaceID:       workflowEvents.NamespaceID,
 		BranchToken:       workflowEvents.BranchToken,
 		Events:            workflowEvents.Events,
 		PrevTransactionID: workflowEvents.PrevTxnID,
@@ -642,6 +677,9 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	if err != nil {
 		return nil, err
 	}
+	if err := m.encryptWorkflowMutation(result); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -739,6 +777,9 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	if err != nil {
 		return nil, err
 	}
+	if err := m.encryptWorkflowSnapshot(result); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -1007,6 +1048,10 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		BufferedEvents:      make([]*historypb.HistoryEvent, len(internState.BufferedEvents)),
 	}
 	for key, blob := range internState.ActivityInfos {
+		blob, err := m.decryptBlob(blob)
+		if err != nil {
+			return nil, err
+		}
 		info, err := m.serializer.ActivityInfoFromBlob(blob)
 		if err != nil {
 			return nil, err
@@ -1014,6 +1059,10 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		state.ActivityInfos[key] = info
 	}
 	for key, blob := range internState.TimerInfos {
+		blob, err := m.decryptBlob(blob)
+		if err != nil {
+			return nil, err
+		}
 		info, err := m.serializer.TimerInfoFromBlob(blob)
 		if err != nil {
 			return nil, err
@@ -1021,6 +1070,10 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		state.TimerInfos[key] = info
 	}
 	for key, blob := range internState.ChildExecutionInfos {
+		blob, err := m.decryptBlob(blob)
+		if err != nil {
+			return nil, err
+		}
 		info, err := m.serializer.ChildExecutionInfoFromBlob(blob)
 		if err != nil {
 			return nil, err
@@ -1028,6 +1081,10 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		state.ChildExecutionInfos[key] = info
 	}
 	for key, blob := range internState.RequestCancelInfos {
+		blob, err := m.decryptBlob(blob)
+		if err != nil {
+			return nil, err
+		}
 		info, err := m.serializer.RequestCancelInfoFromBlob(blob)
 		if err != nil {
 			return nil, err
@@ -1035,6 +1092,10 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		state.RequestCancelInfos[key] = info
 	}
 	for key, blob := range internState.SignalInfos {
+		blob, err := m.decryptBlob(blob)
+		if err != nil {
+			return nil, err
+		}
 		info, err := m.serializer.SignalInfoFromBlob(blob)
 		if err != nil {
 			return nil, err
@@ -1046,9 +1107,15 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		var err error
 
 		if internal.CassandraBlob != nil {
-			node, err = m.serializer.ChasmNodeFromBlob(internal.CassandraBlob)
+			var blob *commonpb.DataBlob
+			if blob, err = m.decryptBlob(internal.CassandraBlob); err == nil {
+				node, err = m.serializer.ChasmNodeFromBlob(blob)
+			}
 		} else {
-			node, err = m.serializer.ChasmNodeFromBlobs(internal.Metadata, internal.Data)
+			var data *commonpb.DataBlob
+			if data, err = m.decryptBlob(internal.Data); err == nil {
+				node, err = m.serializer.ChasmNodeFromBlobs(internal.Metadata, data)
+			}
 		}
 		if err != nil {
 			return nil, err
@@ -1056,8 +1123,11 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 
 		state.ChasmNodes[key] = node
 	}
-	var err error
-	state.ExecutionInfo, err = m.serializer.WorkflowExecutionInfoFromBlob(internState.ExecutionInfo)
+	executionInfoBlob, err := m.decryptBlob(internState.ExecutionInfo)
+	if err != nil {
+		return nil, err
+	}
+	state.ExecutionInfo, err = m.serializer.WorkflowExecutionInfoFromBlob(executionInfoBlob)
 	if err != nil {
 		return nil, err
 	}
@@ -2,13 +2,15 @@ package faultinjection
 
 import (
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/persistence"
 )
 
 type (
 	FaultInjectionDataStoreFactory struct {
-		baseFactory persistence.DataStoreFactory
-		fiConfig    *config.FaultInjection
+		baseFactory  persistence.DataStoreFactory
+		fiConfig     *config.FaultInjection
+		dynamicRules *dynamicconfig.GlobalCachedTypedValue[[]*dynamicFaultRule]
 
 		taskStore          persistence.TaskStore
 		shardStore         persistence.ShardStore
@@ -21,31 +23,61 @@ type (
 	}
 )
 
+// NewFaultInjectionDatastoreFactory returns a data store factory which injects faults into the stores of the base
+// factory, as configured by the static fault injection config and the PersistenceFaultInjection dynamic config. Either
+// fiConfig or dc may be nil.
 func NewFaultInjectionDatastoreFactory(
 	fiConfig *config.FaultInjection,
+	dc *dynamicconfig.Collection,
 	baseFactory persistence.DataStoreFactory,
 ) *FaultInjectionDataStoreFactory {
-	return &FaultInjectionDataStoreFactory{
+	factory := &FaultInjectionDataStoreFactory{
 		baseFactory: baseFactory,
 		fiConfig:    fiConfig,
 	}
+	if dc != nil {
+		factory.dynamicRules = newDynamicFaultRules(dc)
+	}
+	return factory
 }
 
 func (d *FaultInjectionDataStoreFactory) Close() {
+	if d.dynamicRules != nil {
+		_ = d.dynamicRules.Close()
+	}
 	d.baseFactory.Close()
 }
 
+// faultGenerator returns the fault generator for the store, or nil if no faults can be injected into it. Static
+// faults take precedence over dynamic ones.
+func (d *FaultInjectionDataStoreFactory) faultGenerator(storeName config.DataStoreName) faultGenerator {
+	var generators faultGenerators
+	if d.fiConfig != nil {
+		if storeConfig, ok := d.fiConfig.Targets.DataStores[storeName]; ok && len(storeConfig.Methods) > 0 {
+			generators = append(generators, newStoreFaultGenerator(&storeConfig))
+		}
+	}
+	if d.dynamicRules != nil {
+		generators = append(generators, newDynamicFaultGenerator(storeName, d.dynamicRules))
+	}
+	switch len(generators) {
+	case 0:
+		return nil
+	case 1:
+		return generators[0]
+	default:
+		return generators
+	}
+}
+
 func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
 	if d.taskStore == nil {
 		baseStore, err := d.baseFactory.NewTaskStore()
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.TaskStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.taskStore = newFaultInjectionTaskStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.TaskStoreName); generator != nil {
+			d.taskStore = newFaultInjectionTaskStore(baseStore, generator)
 		} else {
 			d.taskStore = baseStore
 		}
@@ -59,11 +91,8 @@ func (d *FaultInjectionDataStoreFactory) NewShardStore() (persistence.ShardStore
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ShardStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.shardStore = newFaultInjectionShardStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.ShardStoreName); generator != nil {
+			d.shardStore = newFaultInjectionShardStore(baseStore, generator)
 		} else {
 			d.shardStore = baseStore
 		}
@@ -76,11 +105,8 @@ func (d *FaultInjectionDataStoreFactory) NewMetadataStore() (persistence.Metadat
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.MetadataStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.metadataStore = newFaultInjectionMetadataStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.MetadataStoreName); generator != nil {
+			d.metadataStore = newFaultInjectionMetadataStore(baseStore, generator)
 		} else {
 			d.metadataStore = baseStore
 		}
@@ -94,11 +120,8 @@ func (d *FaultInjectionDataStoreFactory) NewExecutionStore() (persistence.Execut
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ExecutionStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.executionStore = newFaultInjectionExecutionStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.ExecutionStoreName); generator != nil {
+			d.executionStore = newFaultInjectionExecutionStore(baseStore, generator)
 		} else {
 			d.executionStore = baseStore
 		}
@@ -112,11 +135,8 @@ func (d *FaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueTyp
 		if err != nil {
 			return baseQueue, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.QueueName]; ok && len(storeConfig.Methods) > 0 {
-			d.queue = newFaultInjectionQueue(
-				baseQueue,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.QueueName); generator != nil {
+			d.queue = newFaultInjectionQueue(baseQueue, generator)
 		} else {
 			d.queue = baseQueue
 		}
@@ -130,11 +150,8 @@ func (d *FaultInjectionDataStoreFactory) NewQueueV2() (persistence.QueueV2, erro
 		if err != nil {
 			return baseQueue, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.QueueV2Name]; ok && len(storeConfig.Methods) > 0 {
-			d.queueV2 = newFaultInjectionQueueV2(
-				baseQueue,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.QueueV2Name); generator != nil {
+			d.queueV2 = newFaultInjectionQueueV2(baseQueue, generator)
 		} else {
 			d.queueV2 = baseQueue
 		}
@@ -148,11 +165,8 @@ func (d *FaultInjectionDataStoreFactory) NewClusterMetadataStore() (persistence.
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ClusterMDStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.clusterMDStore = newFaultInjectionClusterMetadataStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.ClusterMDStoreName); generator != nil {
+			d.clusterMDStore = newFaultInjectionClusterMetadataStore(baseStore, generator)
 		} else {
 			d.clusterMDStore = baseStore
 		}
@@ -166,11 +180,8 @@ func (d *FaultInjectionDataStoreFactory) NewNexusEndpointStore() (persistence.Ne
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.NexusEndpointStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.nexusEndpointStore = newFaultInjectionNexusEndpointStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
+		if generator := d.faultGenerator(config.NexusEndpointStoreName); generator != nil {
+			d.nexusEndpointStore = newFaultInjectionNexusEndpointStore(baseStore, generator)
 		} else {
 			d.nexusEndpointStore = baseStore
 		}
@@ -0,0 +1,316 @@
+package faultinjection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+)
+
+type (
+	// dynamicFaultGenerator is an implementation of faultGenerator that injects faults into the calls of a data store
+	// using the rules of the PersistenceFaultInjection dynamic config.
+	dynamicFaultGenerator struct {
+		storeName config.DataStoreName
+		rules     *dynamicconfig.GlobalCachedTypedValue[[]*dynamicFaultRule]
+	}
+
+	// dynamicFaultRule is a compiled dynamicconfig.PersistenceFaultInjectionRule.
+	dynamicFaultRule struct {
+		store       config.DataStoreName
+		methods     map[string]struct{}
+		namespaces  map[string]struct{}
+		shardIDs    map[int32]struct{}
+		workflowIDs map[string]struct{}
+
+		errors  *methodFaultGenerator
+		latency *methodFaultGenerator
+	}
+
+	// faultGenerators returns the fault of the first generator which generates one.
+	faultGenerators []faultGenerator
+
+	// callTargets are the namespace, shard and workflow a persistence call is made for, as far as they are known
+	// from the request.
+	callTargets struct {
+		namespaceID string
+		workflowID  string
+		shardID     int32
+		hasShardID  bool
+	}
+
+	// targetFields are the indexes of the fields of a request type the call targets are extracted from.
+	targetFields struct {
+		namespaceID []int
+		workflowID  []int
+		shardID     []int
+	}
+)
+
+var (
+	dataStoreNames = map[config.DataStoreName]struct{}{
+		config.ShardStoreName:         {},
+		config.TaskStoreName:          {},
+		config.MetadataStoreName:      {},
+		config.ExecutionStoreName:     {},
+		config.QueueName:              {},
+		config.QueueV2Name:            {},
+		config.ClusterMDStoreName:     {},
+		config.NexusEndpointStoreName: {},
+	}
+
+	// targetFieldsCache caches the indexes of the target fields by request type, see targetFieldsOf.
+	targetFieldsCache sync.Map // reflect.Type -> *targetFields
+)
+
+func newDynamicFaultRules(dc *dynamicconfig.Collection) *dynamicconfig.GlobalCachedTypedValue[[]*dynamicFaultRule] {
+	return dynamicconfig.NewGlobalCachedTypedValue(dc, dynamicconfig.PersistenceFaultInjection, compileDynamicFaultRules)
+}
+
+func newDynamicFaultGenerator(
+	storeName config.DataStoreName,
+	rules *dynamicconfig.GlobalCachedTypedValue[[]*dynamicFaultRule],
+) *dynamicFaultGenerator {
+	return &dynamicFaultGenerator{
+		storeName: storeName,
+		rules:     rules,
+	}
+}
+
+// compileDynamicFaultRules validates the rules and compiles them. Invalid rules are rejected as a whole, in which
+// case the previous rules stay in effect.
+func compileDynamicFaultRules(rules []dynamicconfig.PersistenceFaultInjectionRule) ([]*dynamicFaultRule, error) {
+	compiled := make([]*dynamicFaultRule, 0, len(rules))
+	for i, rule := range rules {
+		compiledRule, err := compileDynamicFaultRule(rule, fmt.Sprintf("dynamic rule %d", i))
+		if err != nil {
+			return nil, fmt.Errorf("invalid persistence fault injection rule %d: %w", i, err)
+		}
+		compiled = append(compiled, compiledRule)
+	}
+	return compiled, nil
+}
+
+func compileDynamicFaultRule(rule dynamicconfig.PersistenceFaultInjectionRule, ruleName string) (*dynamicFaultRule, error) {
+	store := config.DataStoreName(rule.Store)
+	if _, ok := dataStoreNames[store]; !ok && store != "" {
+		return nil, fmt.Errorf("unknown data store %q", rule.Store)
+	}
+	if rule.Latency < 0 {
+		return nil, errors.New("latency must not be negative")
+	}
+	if rule.LatencyRate < 0 || rule.LatencyRate > 1 {
+		return nil, fmt.Errorf("latency rate must be between 0 and 1, got %v", rule.LatencyRate)
+	}
+
+	var faults []fault
+	totalRate := 0.0
+	for errName, errRate := range rule.Errors {
+		if errRate < 0 || errRate > 1 {
+			return nil, fmt.Errorf("rate of error %q must be between 0 and 1, got %v", errName, errRate)
+		}
+		f, err := newFaultFromName(errName, errRate, ruleName)
+		if err != nil {
+			return nil, err
+		}
+		faults = append(faults, f)
+		totalRate += errRate
+	}
+	if totalRate > 1 {
+		return nil, fmt.Errorf("rates of all errors must add up to at most 1, got %v", totalRate)
+	}
+
+	compiled := &dynamicFaultRule{
+		store:       store,
+		methods:     toSet(rule.Methods),
+		namespaces:  toSet(rule.Namespaces),
+		shardIDs:    toSet(rule.ShardIDs),
+		workflowIDs: toSet(rule.WorkflowIDs),
+		errors:      newMethodFaultGenerator(faults, rule.Seed),
+	}
+	if rule.Latency > 0 {
+		latencyRate := rule.LatencyRate
+		if latencyRate == 0 {
+			latencyRate = 1
+		}
+		latencySeed := rule.Seed
+		if latencySeed != 0 {
+			// errors and latency are sampled independently
+			latencySeed++
+		}
+		compiled.latency = newMethodFaultGenerator([]fault{{latency: rule.Latency, rate: latencyRate}}, latencySeed)
+	}
+	return compiled, nil
+}
+
+// generate returns a fault sampled from the first rule matching the call, if any.
+func (d *dynamicFaultGenerator) generate(ctx context.Context, methodName string, request any) *fault {
+	rules := d.rules.Get()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var targets *callTargets
+	for _, rule := range rules {
+		if rule.store != "" && rule.store != d.storeName {
+			continue
+		}
+		if !matches(rule.methods, methodName) {
+			continue
+		}
+		if rule.hasTargetScopes() && targets == nil {
+			targets = targetsOf(request)
+		}
+		if !rule.matchesTargets(ctx, targets) {
+			continue
+		}
+		return rule.sample(ctx, methodName, request)
+	}
+	return nil
+}
+
+func (r *dynamicFaultRule) hasTargetScopes() bool {
+	return len(r.namespaces) > 0 || len(r.shardIDs) > 0 || len(r.workflowIDs) > 0
+}
+
+func (r *dynamicFaultRule) matchesTargets(ctx context.Context, targets *callTargets) bool {
+	if len(r.namespaces) > 0 {
+		_, nameMatches := r.namespaces[headers.GetCallerInfo(ctx).CallerName]
+		_, idMatches := r.namespaces[targets.namespaceID]
+		if !(nameMatches || idMatches && targets.namespaceID != "") {
+			return false
+		}
+	}
+	if len(r.shardIDs) > 0 && !(targets.hasShardID && matches(r.shardIDs, targets.shardID)) {
+		return false
+	}
+	if len(r.workflowIDs) > 0 && !(targets.workflowID != "" && matches(r.workflowIDs, targets.workflowID)) {
+		return false
+	}
+	return true
+}
+
+func (r *dynamicFaultRule) sample(ctx context.Context, methodName string, request any) *fault {
+	errFault := r.errors.generate(ctx, methodName, request)
+	if r.latency == nil {
+		return errFault
+	}
+	latencyFault := r.latency.generate(ctx, methodName, request)
+	if latencyFault == nil {
+		return errFault
+	}
+	if errFault == nil {
+		return latencyFault
+	}
+	f := *errFault
+	f.latency = latencyFault.latency
+	return &f
+}
+
+func (g faultGenerators) generate(ctx context.Context, methodName string, request any) *fault {
+	for _, generator := range g {
+		if f := generator.generate(ctx, methodName, request); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// targetsOf extracts the targets of a call from the NamespaceID, WorkflowID and ShardID fields of the request, or of
+// the structs it embeds or references directly (e.g. the UpdateWorkflowMutation of an update request).
+func targetsOf(request any) *callTargets {
+	targets := &callTargets{}
+	v := reflect.ValueOf(request)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return targets
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return targets
+	}
+
+	fields := targetFieldsOf(v.Type())
+	if value, ok := fieldByIndex(v, fields.namespaceID); ok {
+		targets.namespaceID = value.String()
+	}
+	if value, ok := fieldByIndex(v, fields.workflowID); ok {
+		targets.workflowID = value.String()
+	}
+	if value, ok := fieldByIndex(v, fields.shardID); ok {
+		targets.shardID = int32(value.Int())
+		targets.hasShardID = true
+	}
+	return targets
+}
+
+func targetFieldsOf(t reflect.Type) *targetFields {
+	if fields, ok := targetFieldsCache.Load(t); ok {
+		return fields.(*targetFields)
+	}
+	fields := &targetFields{
+		namespaceID: findField(t, "NamespaceID", reflect.String),
+		workflowID:  findField(t, "WorkflowID", reflect.String),
+		shardID:     findField(t, "ShardID", reflect.Int32),
+	}
+	targetFieldsCache.Store(t, fields)
+	return fields
+}
+
+// findField returns the index of the named field of the struct, or of a field of a struct it has a field of, or nil.
+func findField(t reflect.Type, name string, kind reflect.Kind) []int {
+	if field, ok := t.FieldByName(name); ok && field.Type.Kind() == kind {
+		return field.Index
+	}
+	for i := range t.NumField() {
+		fieldType := t.Field(i).Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct || !t.Field(i).IsExported() {
+			continue
+		}
+		if field, ok := fieldType.FieldByName(name); ok && field.Type.Kind() == kind {
+			return append([]int{i}, field.Index...)
+		}
+	}
+	return nil
+}
+
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	if index == nil {
+		return reflect.Value{}, false
+	}
+	field, err := v.FieldByIndexErr(index)
+	if err != nil {
+		// nil pointer on the way to the field
+		return reflect.Value{}, false
+	}
+	return field, true
+}
+
+func toSet[T comparable](values []T) map[T]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[T]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+// matches returns true if the set is empty or contains the value.
+func matches[T comparable](set map[T]struct{}, value T) bool {
+	if len(set) == 0 {
+		return true
+	}
+	_, ok := set[value]
+	return ok
+}
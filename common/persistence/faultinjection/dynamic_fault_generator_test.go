@@ -0,0 +1,332 @@
+package faultinjection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDynamicFaultGenerator_Scopes(t *testing.T) {
+	t.Parallel()
+
+	updateRequest := func(shardID int32, namespaceID string, workflowID string) *persistence.InternalUpdateWorkflowExecutionRequest {
+		return &persistence.InternalUpdateWorkflowExecutionRequest{
+			ShardID: shardID,
+			UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+				NamespaceID: namespaceID,
+				WorkflowID:  workflowID,
+			},
+		}
+	}
+	callerCtx := func(namespaceName string) context.Context {
+		return headers.SetCallerName(context.Background(), namespaceName)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		rule        dynamicconfig.PersistenceFaultInjectionRule
+		storeName   config.DataStoreName
+		methodName  string
+		ctx         context.Context
+		request     any
+		expectFault bool
+	}{
+		{
+			name:        "no scopes",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{},
+			storeName:   config.TaskStoreName,
+			methodName:  "CreateTasks",
+			ctx:         context.Background(),
+			request:     &persistence.InternalCreateTasksRequest{},
+			expectFault: true,
+		},
+		{
+			name:        "store",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{Store: "ExecutionStore"},
+			storeName:   config.TaskStoreName,
+			methodName:  "CreateTasks",
+			ctx:         context.Background(),
+			request:     &persistence.InternalCreateTasksRequest{},
+			expectFault: false,
+		},
+		{
+			name:        "method",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{Methods: []string{"UpdateWorkflowExecution"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         context.Background(),
+			request:     updateRequest(1, "ns-id", "wf-id"),
+			expectFault: true,
+		},
+		{
+			name:        "other method",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{Methods: []string{"UpdateWorkflowExecution"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "GetWorkflowExecution",
+			ctx:         context.Background(),
+			request:     &persistence.GetWorkflowExecutionRequest{},
+			expectFault: false,
+		},
+		{
+			name:        "namespace name",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{Namespaces: []string{"ns"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         callerCtx("ns"),
+			request:     updateRequest(1, "ns-id", "wf-id"),
+			expectFault: true,
+		},
+		{
+			name:        "namespace ID",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{Namespaces: []string{"ns-id"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         callerCtx("other-ns"),
+			request:     updateRequest(1, "ns-id", "wf-id"),
+			expectFault: true,
+		},
+		{
+			name:        "other namespace",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{Namespaces: []string{"ns", "ns-id"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         callerCtx("other-ns"),
+			request:     updateRequest(1, "other-ns-id", "wf-id"),
+			expectFault: false,
+		},
+		{
+			name:        "shard",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{ShardIDs: []int32{1, 2}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         context.Background(),
+			request:     updateRequest(2, "ns-id", "wf-id"),
+			expectFault: true,
+		},
+		{
+			name:        "other shard",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{ShardIDs: []int32{1, 2}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         context.Background(),
+			request:     updateRequest(3, "ns-id", "wf-id"),
+			expectFault: false,
+		},
+		{
+			name:        "request without shard",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{ShardIDs: []int32{1}},
+			storeName:   config.TaskStoreName,
+			methodName:  "CreateTasks",
+			ctx:         context.Background(),
+			request:     &persistence.InternalCreateTasksRequest{},
+			expectFault: false,
+		},
+		{
+			name: "workflow",
+			rule: dynamicconfig.PersistenceFaultInjectionRule{
+				ShardIDs:    []int32{1},
+				WorkflowIDs: []string{"wf-id"},
+			},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         context.Background(),
+			request:     updateRequest(1, "ns-id", "wf-id"),
+			expectFault: true,
+		},
+		{
+			name:        "other workflow",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{WorkflowIDs: []string{"wf-id"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         context.Background(),
+			request:     updateRequest(1, "ns-id", "other-wf-id"),
+			expectFault: false,
+		},
+		{
+			name:        "nil request",
+			rule:        dynamicconfig.PersistenceFaultInjectionRule{WorkflowIDs: []string{"wf-id"}},
+			storeName:   config.ExecutionStoreName,
+			methodName:  "UpdateWorkflowExecution",
+			ctx:         context.Background(),
+			request:     (*persistence.InternalUpdateWorkflowExecutionRequest)(nil),
+			expectFault: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.rule.Errors = map[string]float64{"Unavailable": 1}
+			generator := newTestDynamicFaultGenerator(t, tc.storeName, tc.rule)
+			f := generator.generate(tc.ctx, tc.methodName, tc.request)
+			if tc.expectFault {
+				require.NotNil(t, f)
+				assert.Error(t, f.err)
+			} else {
+				assert.Nil(t, f)
+			}
+		})
+	}
+}
+
+func TestDynamicFaultGenerator_FirstMatchingRule(t *testing.T) {
+	t.Parallel()
+
+	generator := newTestDynamicFaultGenerator(t, config.ExecutionStoreName,
+		dynamicconfig.PersistenceFaultInjectionRule{
+			Methods: []string{"GetWorkflowExecution"},
+			Errors:  map[string]float64{"Unavailable": 1},
+		},
+		dynamicconfig.PersistenceFaultInjectionRule{
+			Errors: map[string]float64{"Timeout": 1},
+		},
+	)
+
+	f := generator.generate(context.Background(), "GetWorkflowExecution", nil)
+	require.NotNil(t, f)
+	assert.Contains(t, f.err.Error(), "serviceerror.Unavailable")
+
+	f = generator.generate(context.Background(), "UpdateWorkflowExecution", nil)
+	require.NotNil(t, f)
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, f.err, &timeoutErr)
+}
+
+func TestDynamicFaultGenerator_Latency(t *testing.T) {
+	t.Parallel()
+
+	generator := newTestDynamicFaultGenerator(t, config.ExecutionStoreName,
+		dynamicconfig.PersistenceFaultInjectionRule{
+			Latency: 10 * time.Millisecond,
+		},
+	)
+	f := generator.generate(context.Background(), "GetWorkflowExecution", nil)
+	require.NotNil(t, f)
+	assert.NoError(t, f.err)
+	assert.Equal(t, 10*time.Millisecond, f.latency)
+
+	executed := false
+	start := time.Now()
+	err := f.inject(context.Background(), func() error {
+		executed = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, executed)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+
+	// the caller's deadline is reached while waiting
+	f = &fault{latency: time.Minute}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = f.inject(ctx, func() error {
+		t.Fatal("operation must not be executed")
+		return nil
+	})
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+
+	// latency is added to injected errors
+	generator = newTestDynamicFaultGenerator(t, config.ExecutionStoreName,
+		dynamicconfig.PersistenceFaultInjectionRule{
+			Errors:      map[string]float64{"Timeout": 1},
+			Latency:     time.Millisecond,
+			LatencyRate: 1,
+		},
+	)
+	f = generator.generate(context.Background(), "GetWorkflowExecution", nil)
+	require.NotNil(t, f)
+	assert.ErrorAs(t, f.err, &timeoutErr)
+	assert.Equal(t, time.Millisecond, f.latency)
+}
+
+func TestDynamicFaultGenerator_InvalidRules(t *testing.T) {
+	t.Parallel()
+
+	for _, rule := range []dynamicconfig.PersistenceFaultInjectionRule{
+		{Store: "UnknownStore"},
+		{Errors: map[string]float64{"UnknownError": 0.1}},
+		{Errors: map[string]float64{"Timeout": 1.5}},
+		{Errors: map[string]float64{"Timeout": 0.6, "Unavailable": 0.6}},
+		{Latency: -time.Second},
+		{Latency: time.Second, LatencyRate: 2},
+	} {
+		_, err := compileDynamicFaultRules([]dynamicconfig.PersistenceFaultInjectionRule{{}, rule})
+		assert.Error(t, err, "rule %+v", rule)
+	}
+
+	// invalid rules are ignored
+	generator := newTestDynamicFaultGenerator(t, config.ExecutionStoreName,
+		dynamicconfig.PersistenceFaultInjectionRule{Errors: map[string]float64{"UnknownError": 1}},
+	)
+	assert.Nil(t, generator.generate(context.Background(), "GetWorkflowExecution", nil))
+}
+
+func TestFaultInjection_DynamicConfig(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	baseFactory := mock.NewMockDataStoreFactory(ctrl)
+	baseStore := mock.NewMockExecutionStore(ctrl)
+	baseFactory.EXPECT().NewExecutionStore().Return(baseStore, nil)
+	baseFactory.EXPECT().Close()
+
+	dc := dynamicconfig.NewMemoryClient()
+	factory := NewFaultInjectionDatastoreFactory(nil, newTestCollection(t, dc), baseFactory)
+	defer factory.Close()
+	store, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	request := &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 1}
+	baseStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(nil)
+	require.NoError(t, store.UpdateWorkflowExecution(context.Background(), request))
+
+	// rules are applied without recreating the store
+	removeOverride := dc.OverrideSetting(dynamicconfig.PersistenceFaultInjection, []dynamicconfig.PersistenceFaultInjectionRule{{
+		ShardIDs: []int32{1},
+		Errors:   map[string]float64{"ResourceExhausted": 1},
+	}})
+	require.Eventually(t, func() bool { return len(factory.dynamicRules.Get()) == 1 }, time.Second, time.Millisecond)
+	err = store.UpdateWorkflowExecution(context.Background(), request)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "fault injection error")
+
+	// other shards are not affected
+	otherRequest := &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 2}
+	baseStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), otherRequest).Return(nil)
+	require.NoError(t, store.UpdateWorkflowExecution(context.Background(), otherRequest))
+
+	removeOverride()
+	require.Eventually(t, func() bool { return len(factory.dynamicRules.Get()) == 0 }, time.Second, time.Millisecond)
+	baseStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(errors.New("base error"))
+	require.EqualError(t, store.UpdateWorkflowExecution(context.Background(), request), "base error")
+}
+
+func newTestDynamicFaultGenerator(
+	t *testing.T,
+	storeName config.DataStoreName,
+	rules ...dynamicconfig.PersistenceFaultInjectionRule,
+) *dynamicFaultGenerator {
+	dc := dynamicconfig.NewMemoryClient()
+	dc.OverrideSetting(dynamicconfig.PersistenceFaultInjection, rules)
+	cachedRules := newDynamicFaultRules(newTestCollection(t, dc))
+	t.Cleanup(func() { _ = cachedRules.Close() })
+	return newDynamicFaultGenerator(storeName, cachedRules)
+}
+
+func newTestCollection(t *testing.T, client dynamicconfig.Client) *dynamicconfig.Collection {
+	dc := dynamicconfig.NewCollection(client, log.NewTestLogger())
+	dc.Start()
+	t.Cleanup(dc.Stop)
+	return dc
+}
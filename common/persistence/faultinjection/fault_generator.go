@@ -1,7 +1,12 @@
 package faultinjection
 
+import (
+	"context"
+)
+
 type (
 	faultGenerator interface {
-		generate(methodName string) *fault
+		// generate returns the fault to inject into a call of the method with the given request, or nil.
+		generate(ctx context.Context, methodName string, request any) *fault
 	}
 )
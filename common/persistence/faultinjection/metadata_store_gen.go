@@ -33,7 +33,7 @@ func newFaultInjectionMetadataStore(
 
 // CreateNamespace wraps MetadataStore.CreateNamespace.
 func (d faultInjectionMetadataStore) CreateNamespace(ctx context.Context, request *_sourcePersistence.InternalCreateNamespaceRequest) (cp1 *_sourcePersistence.CreateNamespaceResponse, err error) {
-	err = d.generator.generate("CreateNamespace").inject(func() error {
+	err = d.generator.generate(ctx, "CreateNamespace", request).inject(ctx, func() error {
 		cp1, err = d.MetadataStore.CreateNamespace(ctx, request)
 		return err
 	})
@@ -42,7 +42,7 @@ func (d faultInjectionMetadataStore) CreateNamespace(ctx context.Context, reques
 
 // DeleteNamespace wraps MetadataStore.DeleteNamespace.
 func (d faultInjectionMetadataStore) DeleteNamespace(ctx context.Context, request *_sourcePersistence.DeleteNamespaceRequest) (err error) {
-	err = d.generator.generate("DeleteNamespace").inject(func() error {
+	err = d.generator.generate(ctx, "DeleteNamespace", request).inject(ctx, func() error {
 		err = d.MetadataStore.DeleteNamespace(ctx, request)
 		return err
 	})
@@ -51,7 +51,7 @@ func (d faultInjectionMetadataStore) DeleteNamespace(ctx context.Context, reques
 
 // DeleteNamespaceByName wraps MetadataStore.DeleteNamespaceByName.
 func (d faultInjectionMetadataStore) DeleteNamespaceByName(ctx context.Context, request *_sourcePersistence.DeleteNamespaceByNameRequest) (err error) {
-	err = d.generator.generate("DeleteNamespaceByName").inject(func() error {
+	err = d.generator.generate(ctx, "DeleteNamespaceByName", request).inject(ctx, func() error {
 		err = d.MetadataStore.DeleteNamespaceByName(ctx, request)
 		return err
 	})
@@ -60,7 +60,7 @@ func (d faultInjectionMetadataStore) DeleteNamespaceByName(ctx context.Context,
 
 // GetNamespace wraps MetadataStore.GetNamespace.
 func (d faultInjectionMetadataStore) GetNamespace(ctx context.Context, request *_sourcePersistence.GetNamespaceRequest) (ip1 *_sourcePersistence.InternalGetNamespaceResponse, err error) {
-	err = d.generator.generate("GetNamespace").inject(func() error {
+	err = d.generator.generate(ctx, "GetNamespace", request).inject(ctx, func() error {
 		ip1, err = d.MetadataStore.GetNamespace(ctx, request)
 		return err
 	})
@@ -69,7 +69,7 @@ func (d faultInjectionMetadataStore) GetNamespace(ctx context.Context, request *
 
 // ListNamespaces wraps MetadataStore.ListNamespaces.
 func (d faultInjectionMetadataStore) ListNamespaces(ctx context.Context, request *_sourcePersistence.InternalListNamespacesRequest) (ip1 *_sourcePersistence.InternalListNamespacesResponse, err error) {
-	err = d.generator.generate("ListNamespaces").inject(func() error {
+	err = d.generator.generate(ctx, "ListNamespaces", request).inject(ctx, func() error {
 		ip1, err = d.MetadataStore.ListNamespaces(ctx, request)
 		return err
 	})
@@ -78,7 +78,7 @@ func (d faultInjectionMetadataStore) ListNamespaces(ctx context.Context, request
 
 // RenameNamespace wraps MetadataStore.RenameNamespace.
 func (d faultInjectionMetadataStore) RenameNamespace(ctx context.Context, request *_sourcePersistence.InternalRenameNamespaceRequest) (err error) {
-	err = d.generator.generate("RenameNamespace").inject(func() error {
+	err = d.generator.generate(ctx, "RenameNamespace", request).inject(ctx, func() error {
 		err = d.MetadataStore.RenameNamespace(ctx, request)
 		return err
 	})
@@ -87,7 +87,7 @@ func (d faultInjectionMetadataStore) RenameNamespace(ctx context.Context, reques
 
 // UpdateNamespace wraps MetadataStore.UpdateNamespace.
 func (d faultInjectionMetadataStore) UpdateNamespace(ctx context.Context, request *_sourcePersistence.InternalUpdateNamespaceRequest) (err error) {
-	err = d.generator.generate("UpdateNamespace").inject(func() error {
+	err = d.generator.generate(ctx, "UpdateNamespace", request).inject(ctx, func() error {
 		err = d.MetadataStore.UpdateNamespace(ctx, request)
 		return err
 	})
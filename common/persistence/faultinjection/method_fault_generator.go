@@ -1,6 +1,7 @@
 package faultinjection
 
 import (
+	"context"
 	"math/rand"
 	"sync"
 	"time"
@@ -43,7 +44,7 @@ func newMethodFaultGenerator(faults []fault, seed int64) *methodFaultGenerator {
 	}
 }
 
-func (p *methodFaultGenerator) generate(_ string) *fault {
+func (p *methodFaultGenerator) generate(_ context.Context, _ string, _ any) *fault {
 	if p.rate <= 0 {
 		return nil
 	}
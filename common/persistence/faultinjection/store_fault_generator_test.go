@@ -25,7 +25,7 @@ func TestFaultInjection_DataStoreFactory_CreateErr(t *testing.T) {
 	errCreate := errors.New("error creating QueueV2")
 	dataStoreFactory.EXPECT().NewQueueV2().Return(nil, errCreate)
 
-	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, dataStoreFactory)
+	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, nil, dataStoreFactory)
 
 	_, err := factory.NewQueueV2()
 	assert.ErrorIs(t, err, errCreate)
@@ -91,7 +91,7 @@ func TestFaultInjection_Inject(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			baseFactory := mock.NewMockDataStoreFactory(ctrl)
-			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory)
 			baseQueue := mock.NewMockQueueV2(ctrl)
 			baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
@@ -144,7 +144,7 @@ func TestFaultInjection_StoreNotConfigured(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	baseFactory := mock.NewMockDataStoreFactory(ctrl)
-	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory)
 	baseQueue := mock.NewMockQueueV2(ctrl)
 	baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
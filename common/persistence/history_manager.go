@@ -12,6 +12,9 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -485,10 +488,17 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
+	size := len(req.Node.Events.Data)
+	if err := m.compressHistoryNode(request.NamespaceID, &req.Node); err != nil {
+		return nil, err
+	}
+	if err := m.encryptHistoryNode(request.NamespaceID, &req.Node); err != nil {
+		return nil, err
+	}
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -503,6 +513,12 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 		return nil, err
 	}
 
+	if err := m.compressHistoryNode(request.NamespaceID, &req.Node); err != nil {
+		return nil, err
+	}
+	if err := m.encryptHistoryNode(request.NamespaceID, &req.Node); err != nil {
+		return nil, err
+	}
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 	return &AppendHistoryNodesResponse{
 		Size: len(request.History.Data),
@@ -791,11 +807,19 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
 			if node.Events == nil {
 				return nil, nil, nil, nil, 0, serviceerror.NewDataLoss("no events in history node")
 			}
-			dataSize += len(node.Events.Data)
+			dataBlob, err := m.decryptBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			dataBlob, err = serialization.DecompressBlob(dataBlob)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = dataBlob
+			dataSize += len(dataBlob.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 			nodeIDs = append(nodeIDs, node.NodeID)
 		}
@@ -883,8 +907,16 @@ func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			dataBlob, err := m.decryptBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			dataBlob, err = serialization.DecompressBlob(dataBlob)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = dataBlob
+			dataSize += len(dataBlob.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 		}
 		lastNode := nodes[len(nodes)-1]
@@ -1134,3 +1166,34 @@ func (m *executionManagerImpl) serializeToken(
 
 	return m.pagingTokenSerializer.Serialize(pagingToken)
 }
+
+// compressHistoryNode replaces the events blob of the node with a compressed one if compression is enabled for the
+// namespace. Compressed blobs are recorded with a server-only encoding type and are transparently decompressed when
+// the branch is read back, so blobs written before and after enabling compression can be mixed within a branch.
+// The namespace ID is required when compression is enabled, otherwise the namespace level config can't be applied.
+func (m *executionManagerImpl) compressHistoryNode(
+	namespaceID string,
+	node *InternalHistoryNode,
+) error {
+	if node.Events == nil {
+		return nil
+	}
+	codec := m.historyCompression(namespace.ID(namespaceID))
+	if codec == "" || codec == serialization.HistoryCompressionNone {
+		return nil
+	}
+	if namespaceID == "" {
+		return serviceerror.NewInternal("history node append is missing namespace ID while history compression is enabled")
+	}
+	if err := serialization.ValidateHistoryCompression(codec); err != nil {
+		m.logger.Warn("Ignoring invalid history event blob compression config", tag.WorkflowNamespaceID(namespaceID), tag.Error(err))
+		return nil
+	}
+
+	compressed := serialization.CompressBlob(node.Events, codec)
+	codecTag := metrics.CompressionCodecTag(codec)
+	metrics.HistoryEventBlobUncompressedBytes.With(m.metricsHandler).Record(int64(len(node.Events.Data)), codecTag)
+	metrics.HistoryEventBlobCompressedBytes.With(m.metricsHandler).Record(int64(len(compressed.Data)), codecTag)
+	node.Events = compressed
+	return nil
+}
@@ -317,6 +317,21 @@ func (mr *MockTaskStoreMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTaskStore)(nil).Close))
 }
 
+// CompleteTasksInRange mocks base method.
+func (m *MockTaskStore) CompleteTasksInRange(ctx context.Context, request *persistence.CompleteTasksInRangeRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTasksInRange", ctx, request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTasksInRange indicates an expected call of CompleteTasksInRange.
+func (mr *MockTaskStoreMockRecorder) CompleteTasksInRange(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTasksInRange", reflect.TypeOf((*MockTaskStore)(nil).CompleteTasksInRange), ctx, request)
+}
+
 // CompleteTasksLessThan mocks base method.
 func (m *MockTaskStore) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
 	m.ctrl.T.Helper()
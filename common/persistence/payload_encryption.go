@@ -0,0 +1,131 @@
+package persistence
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// blobEncryptor encrypts a blob before it's written to the store.
+type blobEncryptor func(*commonpb.DataBlob) (*commonpb.DataBlob, error)
+
+func noopBlobEncryptor(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return blob, nil
+}
+
+// blobEncryptor returns the encryptor for blobs written for the namespace. Blobs are encrypted only if a key provider
+// is configured and encryption at rest is enabled for the namespace; they are always decrypted on read, see decryptBlob.
+func (m *executionManagerImpl) blobEncryptor(namespaceID string) blobEncryptor {
+	if m.keyProvider == nil || namespaceID == "" || !m.payloadEncryption(namespace.ID(namespaceID)) {
+		return noopBlobEncryptor
+	}
+	return func(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+		return serialization.EncryptBlob(blob, m.keyProvider)
+	}
+}
+
+func (m *executionManagerImpl) decryptBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return serialization.DecryptBlob(blob, m.keyProvider)
+}
+
+// encryptHistoryNode encrypts the events blob of the node, after it has been compressed.
+func (m *executionManagerImpl) encryptHistoryNode(
+	namespaceID string,
+	node *InternalHistoryNode,
+) error {
+	events, err := m.blobEncryptor(namespaceID)(node.Events)
+	if err != nil {
+		return err
+	}
+	node.Events = events
+	return nil
+}
+
+// encryptWorkflowMutation encrypts the blobs of the mutation which may carry user payloads. Execution state and
+// checksum blobs are left in plaintext since they don't carry any and stores read the execution state directly.
+func (m *executionManagerImpl) encryptWorkflowMutation(mutation *InternalWorkflowMutation) error {
+	encrypt := m.blobEncryptor(mutation.NamespaceID)
+	var err error
+	if mutation.ExecutionInfoBlob, err = encrypt(mutation.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(mutation.UpsertActivityInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(mutation.UpsertTimerInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(mutation.UpsertChildExecutionInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(mutation.UpsertRequestCancelInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(mutation.UpsertSignalInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptChasmNodeMap(mutation.UpsertChasmNodes, encrypt); err != nil {
+		return err
+	}
+	mutation.NewBufferedEvents, err = encrypt(mutation.NewBufferedEvents)
+	return err
+}
+
+// encryptWorkflowSnapshot is the same as encryptWorkflowMutation for snapshots.
+func (m *executionManagerImpl) encryptWorkflowSnapshot(snapshot *InternalWorkflowSnapshot) error {
+	encrypt := m.blobEncryptor(snapshot.NamespaceID)
+	var err error
+	if snapshot.ExecutionInfoBlob, err = encrypt(snapshot.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(snapshot.ActivityInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(snapshot.TimerInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(snapshot.ChildExecutionInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(snapshot.RequestCancelInfos, encrypt); err != nil {
+		return err
+	}
+	if err := encryptBlobMap(snapshot.SignalInfos, encrypt); err != nil {
+		return err
+	}
+	return encryptChasmNodeMap(snapshot.ChasmNodes, encrypt)
+}
+
+func encryptBlobMap[K comparable](
+	blobs map[K]*commonpb.DataBlob,
+	encrypt blobEncryptor,
+) error {
+	for key, blob := range blobs {
+		encrypted, err := encrypt(blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = encrypted
+	}
+	return nil
+}
+
+// encryptChasmNodeMap encrypts the node data, node metadata is left in plaintext.
+func encryptChasmNodeMap(
+	nodes map[string]InternalChasmNode,
+	encrypt blobEncryptor,
+) error {
+	for path, node := range nodes {
+		var err error
+		if node.CassandraBlob != nil {
+			node.CassandraBlob, err = encrypt(node.CassandraBlob)
+		} else {
+			node.Data, err = encrypt(node.Data)
+		}
+		if err != nil {
+			return err
+		}
+		nodes[path] = node
+	}
+	return nil
+}
@@ -195,6 +195,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		resolver.NewNoopResolver(),
 		&cfg,
 		s.AbstractDataStoreFactory,
+		nil,
 		s.Logger,
 		metrics.NoopMetricsHandler,
 		s.TracerProvider,
@@ -547,6 +547,19 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
+func (p *taskPersistenceClient) CompleteTasksInRange(
+	ctx context.Context,
+	request *CompleteTasksInRangeRequest,
+) (_ int, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceCompleteTasksInRangeScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.CompleteTasksInRange(ctx, request)
+}
+
 func (p *taskPersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
@@ -542,6 +542,16 @@ func (p *taskRateLimitedPersistenceClient) CompleteTasksLessThan(
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
+func (p *taskRateLimitedPersistenceClient) CompleteTasksInRange(
+	ctx context.Context,
+	request *CompleteTasksInRangeRequest,
+) (int, error) {
+	if err := allow(ctx, "CompleteTasksInRange", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+		return 0, err
+	}
+	return p.persistence.CompleteTasksInRange(ctx, request)
+}
+
 func (p *taskRateLimitedPersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
@@ -650,6 +650,21 @@ func (p *taskRetryablePersistenceClient) CompleteTasksLessThan(
 	return response, err
 }
 
+func (p *taskRetryablePersistenceClient) CompleteTasksInRange(
+	ctx context.Context,
+	request *CompleteTasksInRangeRequest,
+) (int, error) {
+	var response int
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.CompleteTasksInRange(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 func (p *taskRetryablePersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
@@ -0,0 +1,177 @@
+package serialization
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// EncodingTypeEncrypted is the server-only encoding type of blobs encrypted at rest by EncryptBlob. Like the compressed
+// encoding types, it is never sent over the wire: the persistence layer decrypts blobs before handing them to callers.
+const EncodingTypeEncrypted enumspb.EncodingType = 1003
+
+const encodingTypeEncryptedStr = "Encrypted"
+
+// Encrypted blobs are stored as an envelope:
+//
+//	magic (1 byte) | version (1 byte) | key ID length (1 byte) | key ID | inner encoding type (uvarint) | nonce | ciphertext
+//
+// Everything before the nonce is authenticated as additional data. The key ID makes blobs readable after the current
+// key is rotated, the inner encoding type restores the original encoding (which may be a compressed one) on decryption.
+// The magic byte has wire type 7, which can't start a valid proto3 message, so envelopes can't be confused with
+// plaintext proto3 blobs.
+const (
+	encryptionEnvelopeMagic   byte = 0xef
+	encryptionEnvelopeVersion byte = 1
+
+	maxEncryptionKeyIDLength = 255
+)
+
+var (
+	// ErrEncryptionKeyNotFound is returned by a KeyProvider when the requested key doesn't exist.
+	ErrEncryptionKeyNotFound = errors.New("encryption key not found")
+
+	errNoKeyProvider              = errors.New("blob is encrypted but no encryption key provider is configured")
+	errMalformedEncryptedBlob     = errors.New("malformed encrypted blob")
+	errUnsupportedEnvelopeVersion = errors.New("unsupported encrypted blob version")
+)
+
+type (
+	// KeyProvider provides the keys used to encrypt persisted blobs at rest.
+	KeyProvider interface {
+		// CurrentKey returns the key new blobs are encrypted with.
+		CurrentKey() (*EncryptionKey, error)
+		// GetKey returns the key with the given ID. Encrypted blobs record the ID of the key they were encrypted with,
+		// so a key must stay available for as long as blobs encrypted with it are retained.
+		GetKey(id string) (*EncryptionKey, error)
+	}
+
+	// EncryptionKey is an AES-GCM key identified by an ID which is persisted along with the blobs encrypted with it.
+	EncryptionKey struct {
+		id   string
+		aead cipher.AEAD
+	}
+)
+
+// NewEncryptionKey returns an AES-GCM key. The key material must be 16, 24 or 32 bytes long to select AES-128,
+// AES-192 or AES-256.
+func NewEncryptionKey(id string, material []byte) (*EncryptionKey, error) {
+	if id == "" || len(id) > maxEncryptionKeyIDLength {
+		return nil, fmt.Errorf("encryption key ID must be between 1 and %d bytes long, got %q", maxEncryptionKeyIDLength, id)
+	}
+	block, err := aes.NewCipher(material)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key %q: %w", id, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key %q: %w", id, err)
+	}
+	return &EncryptionKey{id: id, aead: aead}, nil
+}
+
+// ID returns the ID of the key.
+func (k *EncryptionKey) ID() string {
+	return k.id
+}
+
+// IsEncryptedBlob returns true if the blob was encrypted by EncryptBlob.
+func IsEncryptedBlob(blob *commonpb.DataBlob) bool {
+	if blob == nil || len(blob.Data) == 0 || blob.Data[0] != encryptionEnvelopeMagic {
+		return false
+	}
+	// Cassandra stores a single encoding type for all blobs of a mutable state map, so an encrypted blob may be
+	// labeled as proto3 if it shares its map with blobs written while encryption was disabled.
+	return blob.EncodingType == EncodingTypeEncrypted || blob.EncodingType == enumspb.ENCODING_TYPE_PROTO3
+}
+
+// EncryptBlob encrypts the blob with the current key of the key provider. Blobs which are empty or already encrypted
+// are returned unchanged. The input blob is never modified.
+func EncryptBlob(blob *commonpb.DataBlob, keyProvider KeyProvider) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || IsEncryptedBlob(blob) {
+		return blob, nil
+	}
+	key, err := keyProvider.CurrentKey()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get current key to encrypt blob: %w", err)
+	}
+
+	header := make([]byte, 0, 3+len(key.id)+binary.MaxVarintLen32)
+	header = append(header, encryptionEnvelopeMagic, encryptionEnvelopeVersion, byte(len(key.id)))
+	header = append(header, key.id...)
+	header = binary.AppendUvarint(header, uint64(blob.EncodingType))
+
+	nonceSize := key.aead.NonceSize()
+	data := make([]byte, len(header)+nonceSize, len(header)+nonceSize+len(blob.Data)+key.aead.Overhead())
+	copy(data, header)
+	nonce := data[len(header):]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, NewSerializationError(EncodingTypeEncrypted, err)
+	}
+	data = key.aead.Seal(data, nonce, blob.Data, header)
+
+	return &commonpb.DataBlob{
+		EncodingType: EncodingTypeEncrypted,
+		Data:         data,
+	}, nil
+}
+
+// DecryptBlob returns the original blob for a blob encrypted by EncryptBlob, looking up the key it was encrypted with
+// by ID. Blobs which aren't encrypted are returned unchanged. The input blob is never modified.
+func DecryptBlob(blob *commonpb.DataBlob, keyProvider KeyProvider) (*commonpb.DataBlob, error) {
+	if !IsEncryptedBlob(blob) {
+		if blob != nil && blob.EncodingType == EncodingTypeEncrypted {
+			// plaintext blob sharing a Cassandra map encoding with encrypted blobs, see IsEncryptedBlob
+			return &commonpb.DataBlob{
+				EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+				Data:         blob.Data,
+			}, nil
+		}
+		return blob, nil
+	}
+	if keyProvider == nil {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, errNoKeyProvider)
+	}
+
+	data := blob.Data
+	if len(data) < 3 {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, errMalformedEncryptedBlob)
+	}
+	if data[1] != encryptionEnvelopeVersion {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, fmt.Errorf("%w: %d", errUnsupportedEnvelopeVersion, data[1]))
+	}
+	keyIDEnd := 3 + int(data[2])
+	if len(data) < keyIDEnd {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, errMalformedEncryptedBlob)
+	}
+	keyID := string(data[3:keyIDEnd])
+	innerEncodingType, n := binary.Uvarint(data[keyIDEnd:])
+	if n <= 0 {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, errMalformedEncryptedBlob)
+	}
+	headerEnd := keyIDEnd + n
+
+	key, err := keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get key %q to decrypt blob: %w", keyID, err)
+	}
+	nonceEnd := headerEnd + key.aead.NonceSize()
+	if len(data) < nonceEnd {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, errMalformedEncryptedBlob)
+	}
+	plaintext, err := key.aead.Open(nil, data[headerEnd:nonceEnd], data[nonceEnd:], data[:headerEnd])
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeEncrypted, fmt.Errorf("unable to decrypt blob with key %q: %w", keyID, err))
+	}
+
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.EncodingType(innerEncodingType),
+		Data:         plaintext,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestEncryptBlob_RoundTrip(t *testing.T) {
+	keyRing := newTestKeyRing(t, "key-1", "key-1")
+	blob := testEventsBlob(t)
+
+	encrypted, err := EncryptBlob(blob, keyRing)
+	require.NoError(t, err)
+	require.Equal(t, EncodingTypeEncrypted, encrypted.EncodingType)
+	require.True(t, IsEncryptedBlob(encrypted))
+	require.False(t, bytes.Contains(encrypted.Data, blob.Data))
+
+	// the encoding type survives a round trip through its persisted string form
+	parsed, err := EncodingTypeFromString(EncodingTypeToString(encrypted.EncodingType))
+	require.NoError(t, err)
+	require.Equal(t, EncodingTypeEncrypted, parsed)
+
+	// already encrypted blobs are not encrypted twice
+	reencrypted, err := EncryptBlob(encrypted, keyRing)
+	require.NoError(t, err)
+	require.Same(t, encrypted, reencrypted)
+
+	decrypted, err := DecryptBlob(encrypted, keyRing)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, blob, decrypted)
+
+	// compressed blobs keep their encoding type
+	compressed := CompressBlob(blob, HistoryCompressionZstd)
+	encrypted, err = EncryptBlob(compressed, keyRing)
+	require.NoError(t, err)
+	decrypted, err = DecryptBlob(encrypted, keyRing)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, compressed, decrypted)
+}
+
+func TestDecryptBlob_NotEncrypted(t *testing.T) {
+	keyRing := newTestKeyRing(t, "key-1", "key-1")
+	blob := testEventsBlob(t)
+
+	decrypted, err := DecryptBlob(blob, keyRing)
+	require.NoError(t, err)
+	require.Same(t, blob, decrypted)
+
+	decrypted, err = DecryptBlob(blob, nil)
+	require.NoError(t, err)
+	require.Same(t, blob, decrypted)
+
+	// plaintext blob labeled as encrypted, e.g. sharing a Cassandra map encoding with encrypted blobs
+	decrypted, err = DecryptBlob(&commonpb.DataBlob{EncodingType: EncodingTypeEncrypted, Data: blob.Data}, keyRing)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, blob, decrypted)
+
+	decrypted, err = DecryptBlob(nil, keyRing)
+	require.NoError(t, err)
+	require.Nil(t, decrypted)
+}
+
+func TestDecryptBlob_Errors(t *testing.T) {
+	keyRing := newTestKeyRing(t, "key-1", "key-1")
+	encrypted, err := EncryptBlob(testEventsBlob(t), keyRing)
+	require.NoError(t, err)
+
+	_, err = DecryptBlob(encrypted, nil)
+	require.ErrorIs(t, err, errNoKeyProvider)
+
+	tampered := &commonpb.DataBlob{EncodingType: encrypted.EncodingType, Data: bytes.Clone(encrypted.Data)}
+	tampered.Data[len(tampered.Data)-1] ^= 0xff
+	_, err = DecryptBlob(tampered, keyRing)
+	var deserializationErr *DeserializationError
+	require.ErrorAs(t, err, &deserializationErr)
+
+	truncated := &commonpb.DataBlob{EncodingType: encrypted.EncodingType, Data: encrypted.Data[:10]}
+	_, err = DecryptBlob(truncated, keyRing)
+	require.ErrorIs(t, err, errMalformedEncryptedBlob)
+
+	otherKeyRing := newTestKeyRing(t, "key-2", "key-2")
+	_, err = DecryptBlob(encrypted, otherKeyRing)
+	require.ErrorIs(t, err, ErrEncryptionKeyNotFound)
+	require.ErrorContains(t, err, "key-1")
+}
+
+func TestFileKeyRing_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	keys := map[string][]byte{
+		"key-1": bytes.Repeat([]byte{1}, 32),
+		"key-2": bytes.Repeat([]byte{2}, 32),
+	}
+	writeKeyRingFile(t, path, "key-1", keys, "key-1")
+	keyRing, err := NewFileKeyRing(path, time.Hour, log.NewTestLogger())
+	require.NoError(t, err)
+	blob := testEventsBlob(t)
+
+	encryptedWithKey1, err := EncryptBlob(blob, keyRing)
+	require.NoError(t, err)
+
+	// rotate the key on another host: the blob references a key unknown to this key ring yet, which is loaded on demand
+	writeKeyRingFile(t, path, "key-2", keys, "key-1", "key-2")
+	otherKeyRing, err := NewFileKeyRing(path, time.Hour, log.NewTestLogger())
+	require.NoError(t, err)
+	encryptedWithKey2, err := EncryptBlob(blob, otherKeyRing)
+	require.NoError(t, err)
+
+	for _, encrypted := range []*commonpb.DataBlob{encryptedWithKey1, encryptedWithKey2} {
+		decrypted, err := DecryptBlob(encrypted, keyRing)
+		require.NoError(t, err)
+		protorequire.ProtoEqual(t, blob, decrypted)
+	}
+	currentKey, err := keyRing.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "key-2", currentKey.ID())
+
+	// invalid key rings are rejected, the previously loaded keys are kept
+	require.NoError(t, os.WriteFile(path, []byte("currentKey: key-3\n"), 0o600))
+	require.Error(t, keyRing.Reload())
+	currentKey, err = keyRing.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, "key-2", currentKey.ID())
+}
+
+func TestNewFileKeyRing_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+
+	_, err := NewFileKeyRing(path, 0, log.NewTestLogger())
+	require.Error(t, err)
+
+	writeKeyRingFile(t, path, "key-1", map[string][]byte{"key-1": []byte("too short")}, "key-1")
+	_, err = NewFileKeyRing(path, 0, log.NewTestLogger())
+	require.Error(t, err)
+
+	writeKeyRingFile(t, path, "key-2", map[string][]byte{"key-1": bytes.Repeat([]byte{1}, 32)}, "key-1")
+	_, err = NewFileKeyRing(path, 0, log.NewTestLogger())
+	require.Error(t, err)
+}
+
+func newTestKeyRing(t *testing.T, currentKey string, keyIDs ...string) *FileKeyRing {
+	keys := make(map[string][]byte, len(keyIDs))
+	for i, id := range keyIDs {
+		keys[id] = bytes.Repeat([]byte{byte(i + 1)}, 32)
+	}
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeKeyRingFile(t, path, currentKey, keys, keyIDs...)
+	keyRing, err := NewFileKeyRing(path, time.Hour, log.NewTestLogger())
+	require.NoError(t, err)
+	return keyRing
+}
+
+func writeKeyRingFile(t *testing.T, path string, currentKey string, keys map[string][]byte, keyIDs ...string) {
+	content := fmt.Sprintf("currentKey: %s\nkeys:\n", currentKey)
+	for _, id := range keyIDs {
+		content += fmt.Sprintf("  %s: %s\n", id, base64.StdEncoding.EncodeToString(keys[id]))
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func testEventsBlob(t *testing.T) *commonpb.DataBlob {
+	events := make([]*historypb.HistoryEvent, 0, 5)
+	for i := int64(1); i <= 5; i++ {
+		events = append(events, &historypb.HistoryEvent{
+			EventId:   i,
+			Version:   1234,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		})
+	}
+	blob, err := NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	return blob
+}
@@ -0,0 +1,182 @@
+package serialization
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultKeyRingRefreshInterval is how often a FileKeyRing checks its file for changes unless configured otherwise.
+const DefaultKeyRingRefreshInterval = time.Minute
+
+type (
+	// FileKeyRing is a KeyProvider backed by a local YAML file of base64 encoded AES keys:
+	//
+	//	currentKey: key-2
+	//	keys:
+	//	  key-1: <base64 encoded 32 byte key>
+	//	  key-2: <base64 encoded 32 byte key>
+	//
+	// Keys are rotated by adding a new key to the file and pointing currentKey at it. The file is reloaded when it
+	// changes, checked at most once per refresh interval, or when a blob references a key which isn't known yet (e.g.
+	// it was written by a host which already picked up the rotation). Previous keys must be kept in the file for as
+	// long as blobs encrypted with them exist.
+	FileKeyRing struct {
+		path            string
+		refreshInterval time.Duration
+		logger          log.Logger
+
+		mu          sync.RWMutex
+		currentKey  *EncryptionKey
+		keys        map[string]*EncryptionKey
+		fileModTime time.Time
+		fileSize    int64
+		lastCheck   time.Time
+	}
+
+	keyRingFile struct {
+		CurrentKey string            `yaml:"currentKey"`
+		Keys       map[string]string `yaml:"keys"`
+	}
+)
+
+var _ KeyProvider = (*FileKeyRing)(nil)
+
+// NewFileKeyRing loads the key ring from the given file. It fails if the file can't be read or is invalid.
+func NewFileKeyRing(
+	path string,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) (*FileKeyRing, error) {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultKeyRingRefreshInterval
+	}
+	r := &FileKeyRing{
+		path:            path,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// CurrentKey implements KeyProvider.
+func (r *FileKeyRing) CurrentKey() (*EncryptionKey, error) {
+	r.maybeReload(false)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.currentKey, nil
+}
+
+// GetKey implements KeyProvider.
+func (r *FileKeyRing) GetKey(id string) (*EncryptionKey, error) {
+	if key, ok := r.getKey(id); ok {
+		return key, nil
+	}
+	r.maybeReload(true)
+	if key, ok := r.getKey(id); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrEncryptionKeyNotFound, id)
+}
+
+func (r *FileKeyRing) getKey(id string) (*EncryptionKey, bool) {
+	r.maybeReload(false)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key, ok := r.keys[id]
+	return key, ok
+}
+
+// Reload reads the key ring file unconditionally. The previously loaded keys are kept if the file is invalid.
+func (r *FileKeyRing) Reload() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return fmt.Errorf("unable to read encryption key ring: %w", err)
+	}
+	currentKey, keys, err := loadKeyRingFile(r.path)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.currentKey = currentKey
+	r.keys = keys
+	r.fileModTime = info.ModTime()
+	r.fileSize = info.Size()
+	r.lastCheck = time.Now()
+	return nil
+}
+
+func (r *FileKeyRing) maybeReload(force bool) {
+	r.mu.RLock()
+	checkDue := force || time.Since(r.lastCheck) >= r.refreshInterval
+	r.mu.RUnlock()
+	if !checkDue {
+		return
+	}
+
+	info, err := os.Stat(r.path)
+	r.mu.Lock()
+	r.lastCheck = time.Now()
+	changed := err == nil && (!info.ModTime().Equal(r.fileModTime) || info.Size() != r.fileSize)
+	r.mu.Unlock()
+	if err != nil {
+		r.logger.Warn("Unable to check encryption key ring for changes", tag.Error(err))
+		return
+	}
+	if !changed {
+		return
+	}
+	if err := r.Reload(); err != nil {
+		r.logger.Error("Unable to reload encryption key ring, keeping previously loaded keys", tag.Error(err))
+		return
+	}
+	r.logger.Info("Reloaded encryption key ring", tag.NewStringTag("current-key-id", r.currentKeyID()))
+}
+
+func (r *FileKeyRing) currentKeyID() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.currentKey.ID()
+}
+
+func loadKeyRingFile(path string) (*EncryptionKey, map[string]*EncryptionKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read encryption key ring: %w", err)
+	}
+	var file keyRingFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse encryption key ring %v: %w", path, err)
+	}
+
+	keys := make(map[string]*EncryptionKey, len(file.Keys))
+	for id, encoded := range file.Keys {
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode encryption key %q: %w", id, err)
+		}
+		key, err := NewEncryptionKey(id, material)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[id] = key
+	}
+	currentKey, ok := keys[file.CurrentKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("current encryption key %q is not in the key ring %v", file.CurrentKey, path)
+	}
+	return currentKey, keys, nil
+}
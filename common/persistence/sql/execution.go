@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -720,11 +721,77 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 	)
 }
 
+// listConcreteExecutionsPageToken represents the primary key of the last row in the executions table that we returned.
+type listConcreteExecutionsPageToken struct {
+	NamespaceID primitives.UUID
+	WorkflowID  string
+	RunID       primitives.UUID
+}
+
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	if request.PageSize <= 0 {
+		return nil, serviceerror.NewInvalidArgumentf("PageSize must be greater than 0, but was %d", request.PageSize)
+	}
+
+	// the first page starts after the zero keys, which sort before any execution. Empty UUIDs can't be used: they
+	// are bound as NULL by the MySQL and PostgreSQL drivers, and no row compares greater than NULL.
+	page := sqlplugin.ExecutionsPage{
+		ShardID:     request.ShardID,
+		NamespaceID: make(primitives.UUID, 16),
+		RunID:       make(primitives.UUID, 16),
+		Limit:       request.PageSize,
+	}
+	if len(request.PageToken) != 0 {
+		var token listConcreteExecutionsPageToken
+		if err := json.Unmarshal(request.PageToken, &token); err != nil {
+			return nil, serviceerror.NewInvalidArgumentf("ListConcreteExecutions: invalid page token. Error: %v", err)
+		}
+		page.NamespaceID = token.NamespaceID
+		page.WorkflowID = token.WorkflowID
+		page.RunID = token.RunID
+	}
+
+	rows, err := m.Db.PaginateFromExecutions(ctx, page)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef("ListConcreteExecutions: failed. Error: %v", err)
+	}
+	response := &p.InternalListConcreteExecutionsResponse{
+		States: make([]*p.InternalWorkflowMutableState, 0, len(rows)),
+	}
+	for _, row := range rows {
+		execution, err := m.GetWorkflowExecution(ctx, &p.GetWorkflowExecutionRequest{
+			ShardID:     request.ShardID,
+			NamespaceID: row.NamespaceID.String(),
+			WorkflowID:  row.WorkflowID,
+			RunID:       row.RunID.String(),
+		})
+		switch err.(type) {
+		case nil:
+			response.States = append(response.States, execution.State)
+		case *serviceerror.NotFound:
+			// deleted since the page was read
+		default:
+			return nil, err
+		}
+	}
+	if len(rows) < request.PageSize {
+		// no next page token because there are no more results
+		return response, nil
+	}
+
+	lastRow := rows[len(rows)-1]
+	response.NextPageToken, err = json.Marshal(listConcreteExecutionsPageToken{
+		NamespaceID: lastRow.NamespaceID,
+		WorkflowID:  lastRow.WorkflowID,
+		RunID:       lastRow.RunID,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternalf("ListConcreteExecutions: failed to serialize page token. Error: %v", err)
+	}
+	return response, nil
 }
 
 func getStartTimeFromState(state *persistencespb.WorkflowExecutionState) *time.Time {
@@ -8,6 +8,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/primitives"
 	expmaps "golang.org/x/exp/maps"
@@ -34,7 +35,7 @@ func updateActivityInfos(
 				RunID:        runID,
 				ScheduleID:   scheduledEventId,
 				Data:         blob.Data,
-				DataEncoding: blob.EncodingType.String(),
+				DataEncoding: serialization.EncodingTypeToString(blob.EncodingType),
 			})
 		}
 
@@ -125,7 +126,7 @@ func updateTimerInfos(
 				RunID:        runID,
 				TimerID:      timerID,
 				Data:         blob.Data,
-				DataEncoding: blob.EncodingType.String(),
+				DataEncoding: serialization.EncodingTypeToString(blob.EncodingType),
 			})
 		}
 		if _, err := tx.ReplaceIntoTimerInfoMaps(ctx, rows); err != nil {
@@ -214,7 +215,7 @@ func updateChildExecutionInfos(
 				RunID:        runID,
 				InitiatedID:  initiatedID,
 				Data:         blob.Data,
-				DataEncoding: blob.EncodingType.String(),
+				DataEncoding: serialization.EncodingTypeToString(blob.EncodingType),
 			})
 		}
 		if _, err := tx.ReplaceIntoChildExecutionInfoMaps(ctx, rows); err != nil {
@@ -304,7 +305,7 @@ func updateRequestCancelInfos(
 				RunID:        runID,
 				InitiatedID:  initiatedID,
 				Data:         blob.Data,
-				DataEncoding: blob.EncodingType.String(),
+				DataEncoding: serialization.EncodingTypeToString(blob.EncodingType),
 			})
 		}
 
@@ -395,7 +396,7 @@ func updateSignalInfos(
 				RunID:        runID,
 				InitiatedID:  initiatedId,
 				Data:         blob.Data,
-				DataEncoding: blob.EncodingType.String(),
+				DataEncoding: serialization.EncodingTypeToString(blob.EncodingType),
 			})
 		}
 
@@ -487,7 +488,7 @@ func updateChasmNodes(
 				Metadata:         node.Metadata.Data,
 				MetadataEncoding: node.Metadata.EncodingType.String(),
 				Data:             node.Data.Data,
-				DataEncoding:     node.Data.EncodingType.String(),
+				DataEncoding:     serialization.EncodingTypeToString(node.Data.EncodingType),
 			})
 		}
 		if _, err := tx.ReplaceIntoChasmNodeMaps(ctx, rows); err != nil {
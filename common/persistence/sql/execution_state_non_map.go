@@ -8,6 +8,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/convert"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/primitives"
 )
@@ -117,7 +118,7 @@ func updateBufferedEvents(
 		WorkflowID:   workflowID,
 		RunID:        runID,
 		Data:         batch.Data,
-		DataEncoding: batch.EncodingType.String(),
+		DataEncoding: serialization.EncodingTypeToString(batch.EncodingType),
 	}
 
 	if _, err := tx.InsertIntoBufferedEvents(ctx, []sqlplugin.BufferedEventsRow{row}); err != nil {
@@ -1092,7 +1092,7 @@ func buildExecutionRow(
 		NextEventID:      nextEventID,
 		LastWriteVersion: lastWriteVersion,
 		Data:             executionInfo.Data,
-		DataEncoding:     executionInfo.EncodingType.String(),
+		DataEncoding:     serialization.EncodingTypeToString(executionInfo.EncodingType),
 		State:            stateBlob.Data,
 		StateEncoding:    stateBlob.EncodingType.String(),
 		DBRecordVersion:  dbRecordVersion,
@@ -10,6 +10,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/primitives"
 )
@@ -44,7 +45,7 @@ func (m *sqlExecutionStore) AppendHistoryNodes(
 		PrevTxnID:    node.PrevTransactionID,
 		TxnID:        node.TransactionID,
 		Data:         node.Events.Data,
-		DataEncoding: node.Events.EncodingType.String(),
+		DataEncoding: serialization.EncodingTypeToString(node.Events.EncodingType),
 		ShardID:      request.ShardID,
 	}
 
@@ -35,6 +35,15 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsPage is a page of executions of a shard to query, starting after the given execution
+	ExecutionsPage struct {
+		ShardID     int32
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RunID       primitives.UUID
+		Limit       int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -70,6 +79,9 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter ExecutionsFilter) (*ExecutionsRow, error)
+		// PaginateFromExecutions returns up to page.Limit rows of the shard from executions table, sorted by their
+		// primary key and starting after the given execution
+		PaginateFromExecutions(ctx context.Context, page ExecutionsPage) ([]ExecutionsRow, error)
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
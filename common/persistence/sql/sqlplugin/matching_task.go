@@ -37,5 +37,10 @@ type (
 		//    - {namespaceID, taskqueueName, taskType, exclusiveMaxTaskID, limit }
 		//    - this will delete upto limit number of tasks less than the given max task id
 		DeleteFromTasks(ctx context.Context, filter TasksFilter) (sql.Result, error)
+		// RangeDeleteFromTasks deletes multiple rows from tasks table
+		// Required filter params:
+		//    - {namespaceID, taskqueueName, taskType, inclusiveMinTaskID, exclusiveMaxTaskID}
+		//    - this will delete all tasks with ids in the given range
+		RangeDeleteFromTasks(ctx context.Context, filter TasksFilter) (sql.Result, error)
 	}
 )
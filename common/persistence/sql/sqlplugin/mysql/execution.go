@@ -20,6 +20,11 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	paginateExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND ((namespace_id = ? AND ((workflow_id = ? AND run_id > ?) OR workflow_id > ?)) OR namespace_id > ?)
+ ORDER BY namespace_id, workflow_id, run_id
+ LIMIT ?`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -206,6 +211,27 @@ func (mdb *db) SelectFromExecutions(
 	return &row, err
 }
 
+// PaginateFromExecutions reads up to page.Limit rows of the shard from executions table sorted by their primary key,
+// starting after the given execution
+func (mdb *db) PaginateFromExecutions(
+	ctx context.Context,
+	page sqlplugin.ExecutionsPage,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := mdb.SelectContext(ctx,
+		&rows,
+		paginateExecutionsQuery,
+		page.ShardID,
+		page.NamespaceID,
+		page.WorkflowID,
+		page.RunID,
+		page.WorkflowID,
+		page.NamespaceID,
+		page.Limit,
+	)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *db) DeleteFromExecutions(
 	ctx context.Context,
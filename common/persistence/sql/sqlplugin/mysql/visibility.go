@@ -266,6 +266,44 @@ func (mdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (mdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (_ []sqlplugin.VisibilityAggregateRow, retError error) {
+	defer func() {
+		retError = mdb.handle.ConvertError(retError)
+	}()
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregateRows(rows, filter.GroupBy)
+}
+
+func (mdb *db) ExplainFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (_ []string, retError error) {
+	defer func() {
+		retError = mdb.handle.ConvertError(retError)
+	}()
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, "EXPLAIN "+filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseExplainRows(rows)
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
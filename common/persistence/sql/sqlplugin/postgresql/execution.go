@@ -20,6 +20,11 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	paginateExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (namespace_id, workflow_id, run_id) > ($2, $3, $4)
+ ORDER BY namespace_id, workflow_id, run_id
+ LIMIT $5`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -206,6 +211,25 @@ func (pdb *db) SelectFromExecutions(
 	return &row, nil
 }
 
+// PaginateFromExecutions reads up to page.Limit rows of the shard from executions table sorted by their primary key,
+// starting after the given execution
+func (pdb *db) PaginateFromExecutions(
+	ctx context.Context,
+	page sqlplugin.ExecutionsPage,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := pdb.SelectContext(ctx,
+		&rows,
+		paginateExecutionsQuery,
+		page.ShardID,
+		page.NamespaceID,
+		page.WorkflowID,
+		page.RunID,
+		page.Limit,
+	)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -62,6 +62,9 @@ task_queue_id = :task_queue_id
 		 tasks WHERE range_hash = $1 AND task_queue_id = $2 AND task_id < $3 ` +
 		`ORDER BY task_queue_id,task_id LIMIT $4 )`
 
+	rangeDeleteTaskInRangeQry = `DELETE FROM tasks ` +
+		`WHERE range_hash = $1 AND task_queue_id = $2 AND task_id >= $3 AND task_id < $4`
+
 	getTaskQueueUserDataQry = `SELECT data, data_encoding, version FROM task_queue_user_data ` +
 		`WHERE namespace_id = $1 AND task_queue_name = $2`
 
@@ -147,6 +150,26 @@ func (pdb *db) DeleteFromTasks(
 	)
 }
 
+// RangeDeleteFromTasks deletes the rows of tasks table with ids in a range
+func (pdb *db) RangeDeleteFromTasks(
+	ctx context.Context,
+	filter sqlplugin.TasksFilter,
+) (sql.Result, error) {
+	if filter.InclusiveMinTaskID == nil {
+		return nil, serviceerror.NewInternal("missing InclusiveMinTaskID parameter")
+	}
+	if filter.ExclusiveMaxTaskID == nil {
+		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskID parameter")
+	}
+	return pdb.ExecContext(ctx,
+		rangeDeleteTaskInRangeQry,
+		filter.RangeHash,
+		filter.TaskQueueID,
+		*filter.InclusiveMinTaskID,
+		*filter.ExclusiveMaxTaskID,
+	)
+}
+
 // InsertIntoTaskQueues inserts one or more rows into task_queues table
 func (pdb *db) InsertIntoTaskQueues(
 	ctx context.Context,
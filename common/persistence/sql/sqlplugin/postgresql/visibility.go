@@ -157,6 +157,32 @@ func (pdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (pdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityAggregateRow, error) {
+	filter.Query = pdb.Rebind(filter.Query)
+	rows, err := pdb.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregateRows(rows, filter.GroupBy)
+}
+
+func (pdb *db) ExplainFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]string, error) {
+	filter.Query = pdb.Rebind("EXPLAIN " + filter.Query)
+	rows, err := pdb.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseExplainRows(rows)
+}
+
 func (pdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"modernc.org/sqlite"
+)
+
+// snapshotTimeFormat is the time format of the names of snapshots written to a directory.
+const snapshotTimeFormat = "20060102T150405Z"
+
+var errInMemoryDatabase = errors.New("snapshot and restore are only supported for file-backed databases")
+
+// backupConn is implemented by the connections of the SQLite driver and exposes its online backup API.
+type backupConn interface {
+	NewBackup(dstURI string) (*sqlite.Backup, error)
+	NewRestore(srcURI string) (*sqlite.Backup, error)
+}
+
+// Snapshot writes a consistent point-in-time copy of the file-backed database configured by cfg to path, using the
+// online backup API of SQLite. The database may be in use by a running server while the snapshot is taken, which
+// doesn't block writes if the database is in WAL mode (see the "durable" connect attribute). If path is an existing
+// directory, the snapshot is written to a file in it named after the database and the current time. The snapshot is
+// written to a temporary file first and checked for integrity, so path never holds a partial snapshot. Snapshot returns
+// the path of the written snapshot.
+func Snapshot(ctx context.Context, cfg *config.SQL, path string) (string, error) {
+	if cfg.ConnectAttributes["mode"] == "memory" {
+		return "", errInMemoryDatabase
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		base := filepath.Base(cfg.DatabaseName)
+		name := fmt.Sprintf("%s-%s.db", strings.TrimSuffix(base, filepath.Ext(base)), time.Now().UTC().Format(snapshotTimeFormat))
+		path = filepath.Join(path, name)
+	}
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("snapshot %v already exists", path)
+	}
+	if _, err := os.Stat(cfg.DatabaseName); err != nil {
+		return "", fmt.Errorf("unable to read database: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	// leftovers of a previous failed attempt
+	if err := removeDatabaseFiles(tmpPath); err != nil {
+		return "", err
+	}
+	err := withBackupConn(ctx, cfg, func(conn backupConn) error {
+		backup, err := conn.NewBackup(tmpPath)
+		if err != nil {
+			return err
+		}
+		return runBackup(backup)
+	})
+	if err == nil {
+		err = checkIntegrity(ctx, tmpPath)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = removeDatabaseFiles(tmpPath)
+		return "", fmt.Errorf("unable to snapshot database %v: %w", cfg.DatabaseName, err)
+	}
+	return path, nil
+}
+
+// Restore replaces the content of the file-backed database configured by cfg with the snapshot at path, which is
+// checked for integrity first. The database is created if it doesn't exist. Servers using the database should be
+// stopped while it is restored.
+func Restore(ctx context.Context, cfg *config.SQL, path string) error {
+	if cfg.ConnectAttributes["mode"] == "memory" {
+		return errInMemoryDatabase
+	}
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("unable to read snapshot: %w", err)
+	}
+	if err := checkIntegrity(ctx, path); err != nil {
+		return fmt.Errorf("snapshot %v is corrupted: %w", path, err)
+	}
+
+	err := withBackupConn(ctx, cfg, func(conn backupConn) error {
+		restore, err := conn.NewRestore(path)
+		if err != nil {
+			return err
+		}
+		return runBackup(restore)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore database %v: %w", cfg.DatabaseName, err)
+	}
+	return nil
+}
+
+// withBackupConn calls fn with a dedicated connection to the database, which is not shared with the connection pool
+// of the plugin.
+func withBackupConn(ctx context.Context, cfg *config.SQL, fn func(conn backupConn) error) error {
+	dsn, err := buildDSN(cfg)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open(goSqlDriverName, dsn)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return conn.Raw(func(driverConn any) error {
+		bc, ok := driverConn.(backupConn)
+		if !ok {
+			return fmt.Errorf("SQLite driver connection %T doesn't support backups", driverConn)
+		}
+		return fn(bc)
+	})
+}
+
+// runBackup copies all pages in a single step. This holds a read transaction on the source database for the duration
+// of the copy, which gives a consistent snapshot: with smaller steps, the backup would restart whenever the source is
+// written to by another connection, and might never finish on a busy server.
+func runBackup(backup *sqlite.Backup) error {
+	_, err := backup.Step(-1)
+	if finishErr := backup.Finish(); err == nil {
+		err = finishErr
+	}
+	return err
+}
+
+func checkIntegrity(ctx context.Context, path string) error {
+	db, err := sql.Open(goSqlDriverName, "file:"+path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA quick_check").Scan(&result); err != nil {
+		return err
+	}
+	if result != "ok" {
+		return fmt.Errorf("integrity check failed: %s", result)
+	}
+	return nil
+}
+
+// removeDatabaseFiles removes the database file at path along with its journal and WAL files, if any.
+func removeDatabaseFiles(path string) error {
+	for _, file := range []string{path, path + "-journal", path + "-wal", path + "-shm"} {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package sqlite
 
 import (
 	"fmt"
+	"maps"
 	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -21,12 +23,16 @@ import (
 const (
 	// PluginName is the name of the plugin
 	PluginName = "sqlite"
+
+	// durableAttr is the connect attribute enabling the durable file-backed mode, see durableModePragmas.
+	durableAttr = "durable"
 )
 
 // List of non-pragma parameters
 // Taken from https://www.sqlite.org/uri.html
 var queryParameters = map[string]struct{}{
 	"cache":     {},
+	"durable":   {},
 	"immutable": {},
 	"mode":      {},
 	"modeof":    {},
@@ -36,6 +42,15 @@ var queryParameters = map[string]struct{}{
 	"vfs":       {},
 }
 
+// Pragmas set by default in durable mode: the write-ahead log lets snapshots be taken while the server is writing,
+// syncing on every commit makes committed transactions survive power loss, and the busy timeout lets the server wait
+// for the locks briefly held by the snapshot and restore commands. Any of them can be overridden by a connect attribute.
+var durableModePragmas = map[string]string{
+	"journal_mode": "wal",
+	"synchronous":  "full",
+	"busy_timeout": "10000",
+}
+
 type plugin struct {
 	connPool *connPool
 }
@@ -145,13 +160,26 @@ func buildDSN(cfg *config.SQL) (string, error) {
 func buildDSNAttr(cfg *config.SQL) (url.Values, error) {
 	parameters := url.Values{}
 
+	attributes := cfg.ConnectAttributes
+	if isDurable(cfg) {
+		if cfg.ConnectAttributes["mode"] == "memory" {
+			return nil, fmt.Errorf("connect attribute %q can't be used with in-memory databases", durableAttr)
+		}
+		attributes = maps.Clone(cfg.ConnectAttributes)
+		for key, value := range durableModePragmas {
+			if _, ok := attributes[key]; !ok {
+				attributes[key] = value
+			}
+		}
+	}
+
 	// sort ConnectAttributes to get a deterministic order
-	keys := expmaps.Keys(cfg.ConnectAttributes)
+	keys := expmaps.Keys(attributes)
 	sort.Strings(keys)
 
 	for _, k := range keys {
 		key := strings.TrimSpace(k)
-		value := strings.TrimSpace(cfg.ConnectAttributes[k])
+		value := strings.TrimSpace(attributes[k])
 		if parameters.Get(key) != "" {
 			return nil, fmt.Errorf("duplicate connection attr: %v:%v, %v:%v",
 				key,
@@ -172,3 +200,9 @@ func buildDSNAttr(cfg *config.SQL) (url.Values, error) {
 	parameters.Add("_time_format", "sqlite")
 	return parameters, nil
 }
+
+// isDurable returns true if the database is file-backed with durable mode enabled by the "durable" connect attribute.
+func isDurable(cfg *config.SQL) bool {
+	durable, _ := strconv.ParseBool(cfg.ConnectAttributes[durableAttr])
+	return durable
+}
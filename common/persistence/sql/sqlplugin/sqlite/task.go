@@ -65,6 +65,9 @@ task_queue_id = :task_queue_id
 		 tasks WHERE range_hash = ? AND task_queue_id = ? AND task_id < ? ` +
 		`ORDER BY task_queue_id,task_id LIMIT ? ) `
 
+	rangeDeleteTaskInRangeQry = `DELETE FROM tasks ` +
+		`WHERE range_hash = ? AND task_queue_id = ? AND task_id >= ? AND task_id < ?`
+
 	getTaskQueueUserDataQry = `SELECT data, data_encoding, version FROM task_queue_user_data ` +
 		`WHERE namespace_id = ? AND task_queue_name = ?`
 
@@ -153,6 +156,26 @@ func (mdb *db) DeleteFromTasks(
 	)
 }
 
+// RangeDeleteFromTasks deletes the rows of tasks table with ids in a range
+func (mdb *db) RangeDeleteFromTasks(
+	ctx context.Context,
+	filter sqlplugin.TasksFilter,
+) (sql.Result, error) {
+	if filter.InclusiveMinTaskID == nil {
+		return nil, serviceerror.NewInternal("missing InclusiveMinTaskID parameter")
+	}
+	if filter.ExclusiveMaxTaskID == nil {
+		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskID parameter")
+	}
+	return mdb.conn.ExecContext(ctx,
+		rangeDeleteTaskInRangeQry,
+		filter.RangeHash,
+		filter.TaskQueueID,
+		*filter.InclusiveMinTaskID,
+		*filter.ExclusiveMaxTaskID,
+	)
+}
+
 // InsertIntoTaskQueues inserts one or more rows into task_queues table
 func (mdb *db) InsertIntoTaskQueues(
 	ctx context.Context,
@@ -265,6 +265,18 @@ func (s *visibilitySuite) TestReplaceSelect_Exists() {
 	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
 }
 
+func (s *visibilitySuite) TestExplain() {
+	namespaceID := primitives.NewUUID()
+
+	plan, err := s.store.ExplainFromVisibility(newVisibilityContext(), sqlplugin.VisibilitySelectFilter{
+		Query:     "SELECT run_id FROM executions_visibility WHERE namespace_id = ? AND workflow_id = ?",
+		QueryArgs: []any{namespaceID.String(), testVisibilityWorkflowID},
+	})
+	s.NoError(err)
+	// Header with the column names and at least one row.
+	s.GreaterOrEqual(len(plan), 2)
+}
+
 func (s *visibilitySuite) TestDeleteGet() {
 	namespaceID := primitives.NewUUID()
 	runID := primitives.NewUUID()
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -88,6 +89,14 @@ type (
 		Count       int64
 	}
 
+	// VisibilityAggregateRow is a row returned by an aggregation query: the values of the
+	// group by columns, the count of the group and the values of the aggregates.
+	VisibilityAggregateRow struct {
+		GroupValues []any
+		Count       int64
+		Values      []any
+	}
+
 	Visibility interface {
 		// InsertIntoVisibility inserts a row into visibility table. If a row already exist,
 		// no changes will be made by this API
@@ -107,6 +116,10 @@ type (
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
 		CountGroupByFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityCountRow, error)
+		AggregateFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityAggregateRow, error)
+		// ExplainFromVisibility returns the plan the database chooses for the select query of the filter,
+		// one line per row of the EXPLAIN output.
+		ExplainFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]string, error)
 	}
 )
 
@@ -169,6 +182,94 @@ func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountR
 	return res, nil
 }
 
+// ParseAggregateRows parses the rows of an aggregation query. The columns are the group by fields,
+// the count and then the aggregates, which are returned as scanned by the driver except for
+// []byte values which are converted to string.
+func ParseAggregateRows(rows *sql.Rows, groupBy []string) ([]VisibilityAggregateRow, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	rowValues := make([]any, len(columns))
+	for i := range rowValues {
+		rowValues[i] = new(any)
+	}
+
+	var res []VisibilityAggregateRow
+	for rows.Next() {
+		err := rows.Scan(rowValues...)
+		if err != nil {
+			return nil, err
+		}
+		groupValues := make([]any, len(groupBy))
+		for i := range groupBy {
+			groupValues[i], err = parseCountGroupByGroupValue(groupBy[i], *(rowValues[i].(*any)))
+			if err != nil {
+				return nil, err
+			}
+		}
+		count, err := parseAggregateCount(*(rowValues[len(groupBy)].(*any)))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]any, len(columns)-len(groupBy)-1)
+		for i := range values {
+			values[i] = *(rowValues[len(groupBy)+1+i].(*any))
+			if bs, ok := values[i].([]byte); ok {
+				values[i] = string(bs)
+			}
+		}
+		res = append(res, VisibilityAggregateRow{
+			GroupValues: groupValues,
+			Count:       count,
+			Values:      values,
+		})
+	}
+	return res, rows.Err()
+}
+
+// ParseExplainRows formats the rows of an EXPLAIN statement, which columns differ between databases,
+// as a header line with the column names followed by one line per row with tab separated values.
+func ParseExplainRows(rows *sql.Rows) ([]string, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	rowValues := make([]any, len(columns))
+	for i := range rowValues {
+		rowValues[i] = new(sql.NullString)
+	}
+
+	res := []string{strings.Join(columns, "\t")}
+	for rows.Next() {
+		if err := rows.Scan(rowValues...); err != nil {
+			return nil, err
+		}
+		values := make([]string, len(columns))
+		for i := range values {
+			if value := rowValues[i].(*sql.NullString); value.Valid {
+				values[i] = value.String
+			}
+		}
+		res = append(res, strings.Join(values, "\t"))
+	}
+	return res, rows.Err()
+}
+
+func parseAggregateCount(value any) (int64, error) {
+	switch typedValue := value.(type) {
+	case int64:
+		return typedValue, nil
+	case []byte:
+		return strconv.ParseInt(string(typedValue), 10, 64)
+	default:
+		// This should never happen.
+		return 0, serviceerror.NewInternal(
+			fmt.Sprintf("Unable to parse count value from DB (got: %v of type: %T, expected type: integer)", value, value),
+		)
+	}
+}
+
 func parseCountGroupByGroupValue(fieldName string, value any) (any, error) {
 	switch fieldName {
 	case searchattribute.ExecutionStatus:
@@ -191,6 +292,9 @@ func parseCountGroupByGroupValue(fieldName string, value any) (any, error) {
 			)
 		}
 	default:
+		if bs, ok := value.([]byte); ok {
+			return string(bs), nil
+		}
 		return value, nil
 	}
 }
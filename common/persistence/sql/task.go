@@ -447,6 +447,31 @@ func (m *sqlTaskManager) CompleteTasksLessThan(
 	return int(nRows), nil
 }
 
+func (m *sqlTaskManager) CompleteTasksInRange(
+	ctx context.Context,
+	request *persistence.CompleteTasksInRangeRequest,
+) (int, error) {
+	nidBytes, err := primitives.ParseUUID(request.NamespaceID)
+	if err != nil {
+		return 0, serviceerror.NewUnavailable(err.Error())
+	}
+	tqId, tqHash := m.taskQueueIdAndHash(nidBytes, request.TaskQueueName, request.TaskType, request.Subqueue)
+	result, err := m.Db.RangeDeleteFromTasks(ctx, sqlplugin.TasksFilter{
+		RangeHash:          tqHash,
+		TaskQueueID:        tqId,
+		InclusiveMinTaskID: &request.InclusiveMinTaskID,
+		ExclusiveMaxTaskID: &request.ExclusiveMaxTaskID,
+	})
+	if err != nil {
+		return 0, serviceerror.NewUnavailable(err.Error())
+	}
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, serviceerror.NewUnavailablef("rowsAffected returned error: %v", err)
+	}
+	return int(nRows), nil
+}
+
 func (m *sqlTaskManager) GetTaskQueueUserData(ctx context.Context, request *persistence.GetTaskQueueUserDataRequest) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
 	namespaceID, err := primitives.ParseUUID(request.NamespaceID)
 	if err != nil {
@@ -225,6 +225,13 @@ func (m *taskManagerImpl) CompleteTasksLessThan(
 	return m.taskStore.CompleteTasksLessThan(ctx, request)
 }
 
+func (m *taskManagerImpl) CompleteTasksInRange(
+	ctx context.Context,
+	request *CompleteTasksInRangeRequest,
+) (int, error) {
+	return m.taskStore.CompleteTasksInRange(ctx, request)
+}
+
 // GetTaskQueueUserData implements TaskManager
 func (m *taskManagerImpl) GetTaskQueueUserData(ctx context.Context, request *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error) {
 	response, err := m.taskStore.GetTaskQueueUserData(ctx, request)
@@ -40,6 +40,48 @@ func newTelemetryTaskStore(
 	}
 }
 
+// CompleteTasksInRange wraps TaskStore.CompleteTasksInRange.
+func (d telemetryTaskStore) CompleteTasksInRange(ctx context.Context, request *_sourcePersistence.CompleteTasksInRangeRequest) (i1 int, err error) {
+	ctx, span := d.tracer.Start(
+		ctx,
+		"persistence.TaskStore/CompleteTasksInRange",
+		trace.WithAttributes(
+			attribute.Key("persistence.store").String("TaskStore"),
+			attribute.Key("persistence.method").String("CompleteTasksInRange"),
+		))
+	defer span.End()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		span.SetAttributes(attribute.String("deadline", deadline.Format(time.RFC3339Nano)))
+		span.SetAttributes(attribute.String("timeout", time.Until(deadline).String()))
+	}
+
+	i1, err = d.TaskStore.CompleteTasksInRange(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	if d.debugMode {
+
+		requestPayload, err := json.MarshalIndent(request, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize *_sourcePersistence.CompleteTasksInRangeRequest for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.request.payload").String(string(requestPayload)))
+		}
+
+		responsePayload, err := json.MarshalIndent(i1, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize int for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.response.payload").String(string(responsePayload)))
+		}
+
+	}
+
+	return
+}
+
 // CompleteTasksLessThan wraps TaskStore.CompleteTasksLessThan.
 func (d telemetryTaskStore) CompleteTasksLessThan(ctx context.Context, request *_sourcePersistence.CompleteTasksLessThanRequest) (i1 int, err error) {
 	ctx, span := d.tracer.Start(
@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -71,7 +72,12 @@ func NewExecutionMutableStateSuite(
 			serializer,
 			nil,
 			logger,
+			metrics.NoopMetricsHandler,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(serialization.HistoryCompressionNone),
+			// all mutable state round trips go through encryption at rest
+			newTestKeyProvider(t),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(true),
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
@@ -2027,6 +2033,42 @@ func (s *ExecutionMutableStateSuite) TestDelete_NotExists() {
 	s.AssertMissingFromDB(s.NamespaceID, s.WorkflowID, s.RunID)
 }
 
+func (s *ExecutionMutableStateSuite) TestListConcreteExecutions() {
+	expectedRunIDs := make(map[string]struct{})
+	for range 3 {
+		s.WorkflowID = uuid.New().String()
+		s.RunID = uuid.New().String()
+		s.CreateWorkflow(
+			rand.Int63(),
+			enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+			enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			rand.Int63(),
+		)
+		expectedRunIDs[s.RunID] = struct{}{}
+	}
+
+	runIDs := make(map[string]struct{})
+	var pageToken []byte
+	for {
+		resp, err := s.ExecutionManager.ListConcreteExecutions(s.Ctx, &p.ListConcreteExecutionsRequest{
+			ShardID:   s.ShardID,
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		s.LessOrEqual(len(resp.States), 2)
+		for _, state := range resp.States {
+			s.Equal(s.NamespaceID, state.ExecutionInfo.NamespaceId)
+			runIDs[state.ExecutionState.RunId] = struct{}{}
+		}
+		if len(resp.PageToken) == 0 {
+			break
+		}
+		pageToken = resp.PageToken
+	}
+	s.Equal(expectedRunIDs, runIDs)
+}
+
 func (s *ExecutionMutableStateSuite) CreateWorkflow(
 	lastWriteVersion int64,
 	state enumsspb.WorkflowExecutionState,
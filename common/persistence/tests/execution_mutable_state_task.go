@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -81,7 +82,11 @@ func NewExecutionMutableStateTaskSuite(
 			serializer,
 			nil,
 			logger,
+			metrics.NoopMetricsHandler,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(serialization.HistoryCompressionNone),
+			nil,
+			nil,
 		),
 		Logger: logger,
 	}
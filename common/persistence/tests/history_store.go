@@ -16,6 +16,8 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/testing/protorequire"
@@ -27,6 +29,12 @@ import (
 //  * GetHistoryTreeContainingBranch
 //  * GetAllHistoryTreeBranches
 
+const (
+	zstdCompressedNamespaceID   = "8b4c6a4e-3b1c-4a56-9d5e-0f3c1d2b7a11"
+	snappyCompressedNamespaceID = "2f1e9d7c-6b5a-4c3d-8e2f-1a0b9c8d7e62"
+	encryptedNamespaceID        = "5d3a7b1e-9c2f-4e8d-a6b0-3f1c7e9d2a54"
+)
+
 type (
 	HistoryEventsPacket struct {
 		nodeID            int64
@@ -65,7 +73,24 @@ func NewHistoryEventsSuite(
 			eventSerializer,
 			nil,
 			logger,
+			metrics.NoopMetricsHandler,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			func(namespaceID namespace.ID) string {
+				switch namespaceID.String() {
+				case zstdCompressedNamespaceID:
+					return serialization.HistoryCompressionZstd
+				case snappyCompressedNamespaceID:
+					return serialization.HistoryCompressionSnappy
+				case encryptedNamespaceID:
+					return serialization.HistoryCompressionZstd
+				default:
+					return serialization.HistoryCompressionNone
+				}
+			},
+			newTestKeyProvider(t),
+			func(namespaceID namespace.ID) bool {
+				return namespaceID.String() == encryptedNamespaceID
+			},
 		),
 		serializer: eventSerializer,
 		logger:     logger,
@@ -119,6 +144,164 @@ func (s *HistoryEventsSuite) TestAppendSelect_First() {
 	protorequire.ProtoSliceEqual(s.T(), eventsPacket.events, s.listAllHistoryEvents(s.ShardID, branchToken))
 }
 
+func (s *HistoryEventsSuite) TestAppendSelect_Compressed() {
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		uuid.New(),
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+	)
+	s.NoError(err)
+	var events []*historypb.HistoryEvent
+
+	// uncompressed, zstd and snappy batches can be mixed within a branch
+	eventsPacket0 := s.newHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63(),
+		0,
+	)
+	s.appendHistoryEvents(s.ShardID, branchToken, eventsPacket0)
+	events = append(events, eventsPacket0.events...)
+
+	eventsPacket1 := s.newHistoryEvents(
+		[]int64{4, 5, 6, 7, 8, 9},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	_, err = s.store.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       zstdCompressedNamespaceID,
+		BranchToken:       branchToken,
+		Events:            eventsPacket1.events,
+		TransactionID:     eventsPacket1.transactionID,
+		PrevTransactionID: eventsPacket1.prevTransactionID,
+	})
+	s.NoError(err)
+	events = append(events, eventsPacket1.events...)
+
+	eventsPacket2 := s.newHistoryEvents(
+		[]int64{10, 11, 12, 13},
+		eventsPacket1.transactionID+1,
+		eventsPacket1.transactionID,
+	)
+	blob, err := s.serializer.SerializeEvents(eventsPacket2.events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	_, err = s.store.AppendRawHistoryNodes(s.Ctx, &p.AppendRawHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       snappyCompressedNamespaceID,
+		BranchToken:       branchToken,
+		NodeID:            eventsPacket2.nodeID,
+		TransactionID:     eventsPacket2.transactionID,
+		PrevTransactionID: eventsPacket2.prevTransactionID,
+		History:           blob,
+	})
+	s.NoError(err)
+	events = append(events, eventsPacket2.events...)
+
+	protorequire.ProtoSliceEqual(s.T(), events, s.listAllHistoryEvents(s.ShardID, branchToken))
+
+	// raw history is never handed out compressed
+	resp, err := s.store.ReadRawHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+		ShardID:     s.ShardID,
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.LastEventID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Len(resp.HistoryEventBlobs, 3)
+	for _, blob := range resp.HistoryEventBlobs {
+		s.Equal(enumspb.ENCODING_TYPE_PROTO3, blob.EncodingType)
+	}
+	protorequire.ProtoEqual(s.T(), blob, resp.HistoryEventBlobs[2])
+}
+
+func (s *HistoryEventsSuite) TestAppendSelect_Encrypted() {
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		uuid.New(),
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+	)
+	s.NoError(err)
+	var events []*historypb.HistoryEvent
+
+	// plaintext and encrypted batches can be mixed within a branch
+	eventsPacket0 := s.newHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63(),
+		0,
+	)
+	s.appendHistoryEvents(s.ShardID, branchToken, eventsPacket0)
+	events = append(events, eventsPacket0.events...)
+
+	eventsPacket1 := s.newHistoryEvents(
+		[]int64{4, 5, 6, 7, 8, 9},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	_, err = s.store.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       encryptedNamespaceID,
+		BranchToken:       branchToken,
+		Events:            eventsPacket1.events,
+		TransactionID:     eventsPacket1.transactionID,
+		PrevTransactionID: eventsPacket1.prevTransactionID,
+	})
+	s.NoError(err)
+	events = append(events, eventsPacket1.events...)
+
+	eventsPacket2 := s.newHistoryEvents(
+		[]int64{10, 11, 12, 13},
+		eventsPacket1.transactionID+1,
+		eventsPacket1.transactionID,
+	)
+	blob, err := s.serializer.SerializeEvents(eventsPacket2.events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	_, err = s.store.AppendRawHistoryNodes(s.Ctx, &p.AppendRawHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       encryptedNamespaceID,
+		BranchToken:       branchToken,
+		NodeID:            eventsPacket2.nodeID,
+		TransactionID:     eventsPacket2.transactionID,
+		PrevTransactionID: eventsPacket2.prevTransactionID,
+		History:           blob,
+	})
+	s.NoError(err)
+	events = append(events, eventsPacket2.events...)
+
+	protorequire.ProtoSliceEqual(s.T(), events, s.listAllHistoryEvents(s.ShardID, branchToken))
+
+	// raw history is never handed out encrypted
+	resp, err := s.store.ReadRawHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+		ShardID:     s.ShardID,
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.LastEventID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Len(resp.HistoryEventBlobs, 3)
+	for _, blob := range resp.HistoryEventBlobs {
+		s.Equal(enumspb.ENCODING_TYPE_PROTO3, blob.EncodingType)
+	}
+	protorequire.ProtoEqual(s.T(), blob, resp.HistoryEventBlobs[2])
+}
+
 func (s *HistoryEventsSuite) TestAppendSelect_NonShadowing() {
 	treeID := uuid.New()
 	branchID := uuid.New()
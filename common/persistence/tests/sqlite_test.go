@@ -9,6 +9,7 @@ import (
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -18,7 +19,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	sqltests "go.temporal.io/server/common/persistence/sql/sqlplugin/tests"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/temporal/environment"
@@ -1200,3 +1201,62 @@ func TestSQLiteTransactionContextCancellation(t *testing.T) {
 	assert.NotContains(t, err.Error(), "no such table")
 	assert.ErrorAs(t, err, &gosql.ErrNoRows)
 }
+
+func TestSQLiteFileSnapshotRestore(t *testing.T) {
+	dir := t.TempDir()
+	cfg := NewSQLiteFileConfig()
+	cfg.DatabaseName = path.Join(dir, "temporal.db")
+	cfg.ConnectAttributes["durable"] = "true"
+	cfg.ConnectAttributes["setup"] = "true"
+	db, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewTestLogger(), metrics.NoopMetricsHandler)
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	insertTaskQueue := func(name string) {
+		_, err := db.InsertIntoTaskQueues(context.Background(), &sqlplugin.TaskQueuesRow{
+			TaskQueueID: []byte(name),
+			Data:        []byte("test-data"),
+		})
+		require.NoError(t, err)
+	}
+	countTaskQueues := func(dbPath string) int {
+		conn, err := gosql.Open("sqlite", "file:"+dbPath)
+		require.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+		var count int
+		require.NoError(t, conn.QueryRow("SELECT COUNT(*) FROM task_queues").Scan(&count))
+		return count
+	}
+
+	conn, err := gosql.Open("sqlite", "file:"+cfg.DatabaseName)
+	require.NoError(t, err)
+	var journalMode string
+	require.NoError(t, conn.QueryRow("PRAGMA journal_mode").Scan(&journalMode))
+	require.NoError(t, conn.Close())
+	require.Equal(t, "wal", journalMode, "durable mode should enable the write-ahead log")
+
+	insertTaskQueue("before-snapshot")
+	snapshotPath, err := sqlite.Snapshot(context.Background(), cfg, dir)
+	require.NoError(t, err)
+	require.Equal(t, dir, path.Dir(snapshotPath))
+	insertTaskQueue("after-snapshot")
+	require.Equal(t, 2, countTaskQueues(cfg.DatabaseName))
+	require.Equal(t, 1, countTaskQueues(snapshotPath))
+
+	_, err = sqlite.Snapshot(context.Background(), cfg, snapshotPath)
+	require.ErrorContains(t, err, "already exists")
+
+	restoreCfg := NewSQLiteFileConfig()
+	restoreCfg.DatabaseName = path.Join(dir, "restored.db")
+	require.NoError(t, sqlite.Restore(context.Background(), restoreCfg, snapshotPath))
+	require.Equal(t, 1, countTaskQueues(restoreCfg.DatabaseName))
+
+	require.NoError(t, os.WriteFile(path.Join(dir, "corrupted.db"), []byte("not a database"), 0o600))
+	require.Error(t, sqlite.Restore(context.Background(), restoreCfg, path.Join(dir, "corrupted.db")))
+	require.Equal(t, 1, countTaskQueues(restoreCfg.DatabaseName))
+
+	memoryCfg := NewSQLiteMemoryConfig()
+	memoryCfg.ConnectAttributes["durable"] = "true"
+	_, err = sql.NewSQLDB(sqlplugin.DbKindMain, memoryCfg, resolver.NewNoopResolver(), log.NewTestLogger(), metrics.NoopMetricsHandler)
+	require.Error(t, err)
+}
@@ -233,6 +233,50 @@ func (s *TaskQueueTaskSuite) TestCreateDelete_Multiple() {
 	s.Nil(resp.NextPageToken)
 }
 
+func (s *TaskQueueTaskSuite) TestCreateDelete_Range() {
+	numTasks := int64(32)
+	minTaskID := rand.Int63()
+	maxTaskID := minTaskID + numTasks
+
+	rangeID := rand.Int63()
+	taskQueue := s.createTaskQueue(rangeID)
+
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for taskID := minTaskID; taskID < maxTaskID; taskID++ {
+		tasks = append(tasks, s.randomTask(taskID))
+	}
+	_, err := s.taskManager.CreateTasks(s.ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{
+			RangeID: rangeID,
+			Data:    taskQueue,
+		},
+		Tasks: tasks,
+	})
+	s.NoError(err)
+
+	// delete the tasks in the middle, the tasks before and after the range are kept
+	_, err = s.taskManager.CompleteTasksInRange(s.ctx, &p.CompleteTasksInRangeRequest{
+		NamespaceID:        s.namespaceID,
+		TaskQueueName:      s.taskQueueName,
+		TaskType:           s.taskQueueType,
+		InclusiveMinTaskID: minTaskID + 8,
+		ExclusiveMaxTaskID: minTaskID + 24,
+	})
+	s.NoError(err)
+
+	resp, err := s.taskManager.GetTasks(s.ctx, &p.GetTasksRequest{
+		NamespaceID:        s.namespaceID,
+		TaskQueue:          s.taskQueueName,
+		TaskType:           s.taskQueueType,
+		InclusiveMinTaskID: minTaskID,
+		ExclusiveMaxTaskID: maxTaskID,
+		PageSize:           100,
+		NextPageToken:      nil,
+	})
+	s.NoError(err)
+	protorequire.ProtoSliceEqual(s.T(), append(tasks[:8:8], tasks[24:]...), resp.Tasks)
+}
+
 func (s *TaskQueueTaskSuite) createTaskQueue(
 	rangeID int64,
 ) *persistencespb.TaskQueueInfo {
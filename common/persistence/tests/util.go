@@ -1,12 +1,14 @@
 package tests
 
 import (
+	"bytes"
 	"math/rand"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -364,3 +366,25 @@ func RandomTime() *timestamppb.Timestamp {
 func RandomDuration() *durationpb.Duration {
 	return durationpb.New(time.Duration(rand.Int63()))
 }
+
+type testKeyProvider struct {
+	key *serialization.EncryptionKey
+}
+
+// newTestKeyProvider returns a key provider with a single AES-256 key.
+func newTestKeyProvider(t *testing.T) serialization.KeyProvider {
+	key, err := serialization.NewEncryptionKey("test-key", bytes.Repeat([]byte{0x5a}, 32))
+	require.NoError(t, err)
+	return &testKeyProvider{key: key}
+}
+
+func (p *testKeyProvider) CurrentKey() (*serialization.EncryptionKey, error) {
+	return p.key, nil
+}
+
+func (p *testKeyProvider) GetKey(id string) (*serialization.EncryptionKey, error) {
+	if id != p.key.ID() {
+		return nil, serialization.ErrEncryptionKeyNotFound
+	}
+	return p.key, nil
+}
@@ -0,0 +1,226 @@
+package visibility
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	consistencyFieldExecutionStatus = "ExecutionStatus"
+	consistencyFieldCloseTime       = "CloseTime"
+
+	// Visibility stores don't persist timestamps with the same precision.
+	consistencyTimePrecision = time.Millisecond
+)
+
+type (
+	// ConsistencyChecker compares the visibility records of the primary and secondary visibility stores,
+	// typically while migrating from one to the other with VisibilityManagerDual.
+	ConsistencyChecker struct {
+		primary        manager.VisibilityManager
+		secondary      manager.VisibilityManager
+		metricsHandler metrics.Handler
+	}
+
+	CheckConsistencyRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name
+		Query       string
+		// SampleRate is the fraction of the records of the primary store which are checked. Records missing
+		// in the primary store are only looked for by full scans, i.e. with a sample rate of 1.
+		SampleRate    float64
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	CheckConsistencyResponse struct {
+		CheckedCount  int64
+		Mismatches    []*adminservice.CheckVisibilityConsistencyResponse_Mismatch
+		NextPageToken []byte
+	}
+
+	consistencyCheckPageToken struct {
+		// ScanSecondary is set once all the records of the primary store have been checked.
+		ScanSecondary bool
+		StoreToken    []byte
+	}
+)
+
+func NewConsistencyChecker(
+	primary manager.VisibilityManager,
+	secondary manager.VisibilityManager,
+	metricsHandler metrics.Handler,
+) *ConsistencyChecker {
+	return &ConsistencyChecker{
+		primary:        primary,
+		secondary:      secondary,
+		metricsHandler: metricsHandler,
+	}
+}
+
+// Check compares a page of records. Records of the primary store are looked up in the secondary store
+// first, then records of the secondary store are looked up in the primary store, unless sampling.
+func (c *ConsistencyChecker) Check(
+	ctx context.Context,
+	request *CheckConsistencyRequest,
+) (*CheckConsistencyResponse, error) {
+	var token consistencyCheckPageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, serviceerror.NewInvalidArgumentf("invalid next page token: %v", err)
+		}
+	}
+	fullScan := request.SampleRate <= 0 || request.SampleRate >= 1
+
+	listStore, lookupStore := c.primary, c.secondary
+	if token.ScanSecondary {
+		listStore, lookupStore = c.secondary, c.primary
+	}
+	listResp, err := listStore.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   request.NamespaceID,
+		Namespace:     request.Namespace,
+		PageSize:      request.PageSize,
+		NextPageToken: token.StoreToken,
+		Query:         request.Query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nsHandler := c.metricsHandler.WithTags(metrics.NamespaceTag(request.Namespace.String()))
+	resp := &CheckConsistencyResponse{}
+	for _, record := range listResp.Executions {
+		if !token.ScanSecondary && !fullScan && rand.Float64() >= request.SampleRate {
+			continue
+		}
+
+		lookupResp, err := lookupStore.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+			NamespaceID: request.NamespaceID,
+			Namespace:   request.Namespace,
+			WorkflowID:  record.GetExecution().GetWorkflowId(),
+			RunID:       record.GetExecution().GetRunId(),
+		})
+		var notFound *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return nil, err
+		}
+
+		mismatch := &adminservice.CheckVisibilityConsistencyResponse_Mismatch{
+			Execution: record.GetExecution(),
+		}
+		switch {
+		case token.ScanSecondary:
+			// Records present in both stores were already compared while scanning the primary store.
+			if notFound == nil {
+				continue
+			}
+			mismatch.Type = enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY
+		case notFound != nil:
+			mismatch.Type = enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY
+		default:
+			mismatch.Fields = diffVisibilityRecords(record, lookupResp.Execution)
+			if len(mismatch.Fields) == 0 {
+				mismatch = nil
+				break
+			}
+			mismatch.Type = enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER
+		}
+		if !token.ScanSecondary {
+			resp.CheckedCount++
+		}
+		if mismatch != nil {
+			resp.Mismatches = append(resp.Mismatches, mismatch)
+			metrics.VisibilityConsistencyMismatchCount.With(nsHandler).Record(
+				1, metrics.VisibilityMismatchTypeTag(mismatch.Type.String()))
+		}
+	}
+	metrics.VisibilityConsistencyCheckedCount.With(nsHandler).Record(resp.CheckedCount)
+
+	nextToken := consistencyCheckPageToken{ScanSecondary: token.ScanSecondary, StoreToken: listResp.NextPageToken}
+	if len(listResp.NextPageToken) == 0 {
+		if token.ScanSecondary || !fullScan {
+			return resp, nil
+		}
+		nextToken = consistencyCheckPageToken{ScanSecondary: true}
+	}
+	resp.NextPageToken, err = json.Marshal(nextToken)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// diffVisibilityRecords returns the names of the fields which differ between the records.
+func diffVisibilityRecords(primary, secondary *workflowpb.WorkflowExecutionInfo) []string {
+	var fields []string
+	if primary.GetStatus() != secondary.GetStatus() {
+		fields = append(fields, consistencyFieldExecutionStatus)
+	}
+	if !primary.GetCloseTime().AsTime().Truncate(consistencyTimePrecision).Equal(
+		secondary.GetCloseTime().AsTime().Truncate(consistencyTimePrecision)) {
+		fields = append(fields, consistencyFieldCloseTime)
+	}
+
+	primarySA := primary.GetSearchAttributes().GetIndexedFields()
+	secondarySA := secondary.GetSearchAttributes().GetIndexedFields()
+	var saFields []string
+	for name, value := range primarySA {
+		if !searchAttributeValuesEqual(value, secondarySA[name]) {
+			saFields = append(saFields, name)
+		}
+	}
+	for name, value := range secondarySA {
+		if _, ok := primarySA[name]; !ok && !searchAttributeValuesEqual(nil, value) {
+			saFields = append(saFields, name)
+		}
+	}
+	slices.Sort(saFields)
+	return append(fields, saFields...)
+}
+
+// searchAttributeValuesEqual compares the decoded search attribute values, since visibility stores
+// don't encode them identically. Empty values are equal to missing ones.
+func searchAttributeValuesEqual(a, b *commonpb.Payload) bool {
+	if proto.Equal(a, b) {
+		return true
+	}
+	av := decodeSearchAttributeValue(a)
+	bv := decodeSearchAttributeValue(b)
+	if at, ok := av.(time.Time); ok {
+		bt, ok := bv.(time.Time)
+		return ok && at.Truncate(consistencyTimePrecision).Equal(bt.Truncate(consistencyTimePrecision))
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+func decodeSearchAttributeValue(payload *commonpb.Payload) any {
+	if payload == nil {
+		return nil
+	}
+	value, err := searchattribute.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, true)
+	if err != nil {
+		// Undecodable values are compared as raw payloads.
+		return payload.GetData()
+	}
+	if list, ok := value.([]string); ok && len(list) == 0 {
+		return nil
+	}
+	return value
+}
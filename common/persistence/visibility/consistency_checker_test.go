@@ -0,0 +1,193 @@
+package visibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConsistencyChecker_FullScan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	checker := NewConsistencyChecker(primary, secondary, metrics.NoopMetricsHandler)
+
+	closeTime := time.Date(2025, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	consistent := newTestExecutionInfo("consistent", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, closeTime, "value")
+	missing := newTestExecutionInfo("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Time{}, "value")
+	stale := newTestExecutionInfo("stale", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, closeTime, "new-value")
+	orphan := newTestExecutionInfo("orphan", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Time{}, "value")
+
+	request := &CheckConsistencyRequest{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		Query:       "WorkflowType = 'visibility-workflow'",
+		PageSize:    10,
+	}
+	primary.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       "WorkflowType = 'visibility-workflow'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{consistent, missing, stale},
+	}, nil)
+	// Secondary store persists timestamps with a lower precision.
+	consistentInSecondary := newTestExecutionInfo("consistent", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, closeTime.Truncate(time.Millisecond), "value")
+	expectGetWorkflowExecution(secondary, consistent, consistentInSecondary, nil)
+	expectGetWorkflowExecution(secondary, missing, nil, serviceerror.NewNotFound("not found"))
+	expectGetWorkflowExecution(secondary, stale, newTestExecutionInfo("stale", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Time{}, "value"), nil)
+
+	resp, err := checker.Check(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.CheckedCount)
+	require.Len(t, resp.Mismatches, 2)
+	require.Equal(t, missing.Execution, resp.Mismatches[0].GetExecution())
+	require.Equal(t, enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY, resp.Mismatches[0].GetType())
+	require.Equal(t, stale.Execution, resp.Mismatches[1].GetExecution())
+	require.Equal(t, enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER, resp.Mismatches[1].GetType())
+	require.Equal(t, []string{"ExecutionStatus", "CloseTime", "CustomKeywordField"}, resp.Mismatches[1].GetFields())
+	require.NotNil(t, resp.NextPageToken)
+
+	// Then the secondary store is scanned for records missing in the primary store.
+	secondary.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{consistentInSecondary, orphan},
+	}, nil)
+	expectGetWorkflowExecution(primary, consistent, consistent, nil)
+	expectGetWorkflowExecution(primary, orphan, nil, serviceerror.NewNotFound("not found"))
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = checker.Check(context.Background(), request)
+	require.NoError(t, err)
+	require.Zero(t, resp.CheckedCount)
+	require.Len(t, resp.Mismatches, 1)
+	require.Equal(t, orphan.Execution, resp.Mismatches[0].GetExecution())
+	require.Equal(t, enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY, resp.Mismatches[0].GetType())
+	require.Nil(t, resp.NextPageToken)
+}
+
+func TestConsistencyChecker_Sampling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	checker := NewConsistencyChecker(primary, secondary, metrics.NoopMetricsHandler)
+
+	primary.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			newTestExecutionInfo("sampled-out", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, time.Time{}, "value"),
+		},
+	}, nil)
+
+	// Sampled checks don't scan the secondary store.
+	resp, err := checker.Check(context.Background(), &CheckConsistencyRequest{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		SampleRate:  1e-12,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Zero(t, resp.CheckedCount)
+	require.Empty(t, resp.Mismatches)
+	require.Nil(t, resp.NextPageToken)
+}
+
+func TestConsistencyChecker_InvalidPageToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	checker := NewConsistencyChecker(
+		manager.NewMockVisibilityManager(ctrl),
+		manager.NewMockVisibilityManager(ctrl),
+		metrics.NoopMetricsHandler,
+	)
+
+	_, err := checker.Check(context.Background(), &CheckConsistencyRequest{
+		NamespaceID:   testNamespaceUUID,
+		Namespace:     testNamespace,
+		NextPageToken: []byte("invalid"),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestSearchAttributeValuesEqual(t *testing.T) {
+	datetime := time.Date(2025, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	encode := func(value any, valueType enumspb.IndexedValueType) *commonpb.Payload {
+		p, err := searchattribute.EncodeValue(value, valueType)
+		require.NoError(t, err)
+		return p
+	}
+
+	require.True(t, searchAttributeValuesEqual(nil, nil))
+	require.True(t, searchAttributeValuesEqual(
+		encode("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		encode("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+	))
+	require.False(t, searchAttributeValuesEqual(
+		encode("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		encode("other", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+	))
+	require.True(t, searchAttributeValuesEqual(
+		encode(datetime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+		encode(datetime.Truncate(time.Millisecond).In(time.FixedZone("PST", -8*3600)), enumspb.INDEXED_VALUE_TYPE_DATETIME),
+	))
+	require.False(t, searchAttributeValuesEqual(
+		encode(datetime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+		encode(datetime.Add(time.Second), enumspb.INDEXED_VALUE_TYPE_DATETIME),
+	))
+	require.True(t, searchAttributeValuesEqual(nil, encode([]string{}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)))
+	require.False(t, searchAttributeValuesEqual(nil, encode(int64(1), enumspb.INDEXED_VALUE_TYPE_INT)))
+}
+
+func newTestExecutionInfo(
+	workflowID string,
+	status enumspb.WorkflowExecutionStatus,
+	closeTime time.Time,
+	keyword string,
+) *workflowpb.WorkflowExecutionInfo {
+	info := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      testWorkflowExecution.RunId + "-" + workflowID,
+		},
+		Status: status,
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{
+				"CustomKeywordField": payload.EncodeString(keyword),
+			},
+		},
+	}
+	if !closeTime.IsZero() {
+		info.CloseTime = timestamppb.New(closeTime)
+	}
+	return info
+}
+
+func expectGetWorkflowExecution(
+	visibilityManager *manager.MockVisibilityManager,
+	execution *workflowpb.WorkflowExecutionInfo,
+	result *workflowpb.WorkflowExecutionInfo,
+	err error,
+) {
+	var resp *manager.GetWorkflowExecutionResponse
+	if result != nil {
+		resp = &manager.GetWorkflowExecutionResponse{Execution: result}
+	}
+	visibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		WorkflowID:  execution.GetExecution().GetWorkflowId(),
+		RunID:       execution.GetExecution().GetRunId(),
+	}).Return(resp, err)
+}
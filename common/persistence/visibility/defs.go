@@ -5,6 +5,9 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/opensearch"
 )
 
 const (
@@ -33,3 +36,15 @@ func AllowListForValidation(
 		return allowList
 	}
 }
+
+// IsElasticsearchCompatibleStore returns whether the store is Elasticsearch, or a store using the
+// same APIs and search attributes mappings as Elasticsearch.
+func IsElasticsearchCompatibleStore(storeName string) bool {
+	return storeName == elasticsearch.PersistenceName || storeName == opensearch.PersistenceName
+}
+
+// HasElasticsearchCompatibleStore returns whether any store of the visibility manager is
+// Elasticsearch compatible.
+func HasElasticsearchCompatibleStore(visibilityMgr manager.VisibilityManager) bool {
+	return visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || visibilityMgr.HasStoreName(opensearch.PersistenceName)
+}
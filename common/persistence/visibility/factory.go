@@ -1,6 +1,8 @@
 package visibility
 
 import (
+	"errors"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -10,6 +12,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/opensearch"
 	"go.temporal.io/server/common/persistence/visibility/store/sql"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/searchattribute"
@@ -114,6 +117,57 @@ func NewManager(
 	return visibilityManager, nil
 }
 
+// NewManagerFromDataStoreConfig creates a visibility manager for a single data store, which doesn't
+// have to be the configured visibility store. It's used to write visibility records to a specific
+// store, e.g. when rebuilding a visibility store from primary persistence.
+//
+//nolint:revive // too many arguments
+func NewManagerFromDataStoreConfig(
+	dsConfig config.DataStore,
+	persistenceResolver resolver.ServiceResolver,
+	customVisibilityStoreFactory VisibilityStoreFactory,
+
+	esProcessorConfig *elasticsearch.ProcessorConfig,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	namespaceRegistry namespace.Registry,
+
+	maxReadQPS dynamicconfig.IntPropertyFn,
+	maxWriteQPS dynamicconfig.IntPropertyFn,
+	operatorRPSRatio dynamicconfig.FloatPropertyFn,
+	slowQueryThreshold dynamicconfig.DurationPropertyFn,
+	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (manager.VisibilityManager, error) {
+	visibilityManager, err := newVisibilityManagerFromDataStoreConfig(
+		dsConfig,
+		persistenceResolver,
+		customVisibilityStoreFactory,
+		esProcessorConfig,
+		searchAttributesProvider,
+		searchAttributesMapperProvider,
+		namespaceRegistry,
+		maxReadQPS,
+		maxWriteQPS,
+		operatorRPSRatio,
+		slowQueryThreshold,
+		visibilityDisableOrderByClause,
+		visibilityEnableManualPagination,
+		metricsHandler,
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if visibilityManager == nil {
+		return nil, errors.New("data store is not a visibility store")
+	}
+	return visibilityManager, nil
+}
+
 func newVisibilityManager(
 	visStore store.VisibilityStore,
 	maxReadQPS dynamicconfig.IntPropertyFn,
@@ -232,6 +286,7 @@ func newVisibilityStoreFromDataStoreConfig(
 			persistenceResolver,
 			searchAttributesProvider,
 			searchAttributesMapperProvider,
+			visibilityDisableOrderByClause,
 			logger,
 			metricsHandler,
 		)
@@ -246,6 +301,17 @@ func newVisibilityStoreFromDataStoreConfig(
 			metricsHandler,
 			logger,
 		)
+	} else if dsConfig.OpenSearch != nil {
+		visStore, err = opensearch.NewVisibilityStore(
+			dsConfig.OpenSearch,
+			esProcessorConfig,
+			searchAttributesProvider,
+			searchAttributesMapperProvider,
+			visibilityDisableOrderByClause,
+			visibilityEnableManualPagination,
+			metricsHandler,
+			logger,
+		)
 	} else if dsConfig.CustomDataStoreConfig != nil {
 		if customVisibilityStoreFactory == nil {
 			logger.Fatal("custom visibility store factory must be defined")
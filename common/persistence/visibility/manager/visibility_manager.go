@@ -11,6 +11,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 )
@@ -35,7 +36,9 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
+		ExplainQuery(ctx context.Context, request *ExplainQueryRequest) (*ExplainQueryResponse, error)
 	}
 
 	VisibilityRequestBase struct {
@@ -121,6 +124,44 @@ type (
 		Groups []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup
 	}
 
+	// AggregateWorkflowExecutionsRequest is request from AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		Query       string
+		// Keyword search attributes to group by, in the order of the group values in the response.
+		GroupBy       []string
+		Aggregations  []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// AggregateWorkflowExecutionsResponse is response to AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsResponse struct {
+		Groups        []*adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup
+		NextPageToken []byte
+	}
+
+	// ExplainQueryRequest is request to ExplainQuery
+	ExplainQueryRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		Query       string
+		PageSize    int
+	}
+
+	// ExplainQueryResponse is response to ExplainQuery
+	ExplainQueryResponse struct {
+		StoreName string
+		// Elasticsearch search source in JSON, or SQL statement of the first page of the query.
+		TranslatedQuery string
+		QueryArgs       []string
+		// Field names the custom search attribute aliases of the query are resolved to, keyed by alias.
+		SearchAttributeAliases map[string]string
+		// Plan of the SQL statement returned by the database. Empty for Elasticsearch.
+		Plan string
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID namespace.ID
@@ -41,6 +41,21 @@ func (m *MockVisibilityManager) EXPECT() *MockVisibilityManagerMockRecorder {
 	return m.recorder
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockVisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockVisibilityManagerMockRecorder) AggregateWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).AggregateWorkflowExecutions), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityManager) Close() {
 	m.ctrl.T.Helper()
@@ -82,6 +97,21 @@ func (mr *MockVisibilityManagerMockRecorder) DeleteWorkflowExecution(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockVisibilityManager)(nil).DeleteWorkflowExecution), ctx, request)
 }
 
+// ExplainQuery mocks base method.
+func (m *MockVisibilityManager) ExplainQuery(ctx context.Context, request *ExplainQueryRequest) (*ExplainQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, request)
+	ret0, _ := ret[0].(*ExplainQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockVisibilityManagerMockRecorder) ExplainQuery(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockVisibilityManager)(nil).ExplainQuery), ctx, request)
+}
+
 // GetIndexName mocks base method.
 func (m *MockVisibilityManager) GetIndexName() string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,101 @@
+package visibility
+
+import (
+	"errors"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/opensearch"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/persistence/visibility/store/sql"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// SavedQueryPrefix is the prefix of the queries referencing a saved visibility query of the namespace
+// by name, ie. "view:<name>".
+const SavedQueryPrefix = "view:"
+
+// ExpandSavedQuery returns the saved query of the namespace referenced by queryString. Queries which don't
+// reference a saved query are returned unchanged. The expanded query isn't validated here, it's validated by the
+// visibility store running it, like any other query.
+func ExpandSavedQuery(
+	nsEntry *namespace.Namespace,
+	queryString string,
+) (string, error) {
+	name, ok := strings.CutPrefix(strings.TrimSpace(queryString), SavedQueryPrefix)
+	if !ok {
+		return queryString, nil
+	}
+	savedQuery, ok := nsEntry.GetSavedVisibilityQuery(name)
+	if !ok {
+		return "", serviceerror.NewInvalidArgumentf("saved visibility query %q not found", name)
+	}
+	return savedQuery.GetQuery(), nil
+}
+
+// ValidateSavedQuery validates the query and the default columns of a saved visibility query against
+// the search attributes of the namespace. The query is validated with the query converter of each of the
+// visibility stores, queries of stores without a known converter are only validated when they run.
+func ValidateSavedQuery(
+	storeNames []string,
+	nsName namespace.Name,
+	nsID namespace.ID,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapperProvider searchattribute.MapperProvider,
+	savedQuery *persistencespb.SavedVisibilityQuery,
+) error {
+	queryString := strings.TrimSpace(savedQuery.GetQuery())
+	if queryString == "" {
+		return serviceerror.NewInvalidArgument("saved visibility query is empty")
+	}
+	if strings.HasPrefix(queryString, SavedQueryPrefix) {
+		return serviceerror.NewInvalidArgument("saved visibility query can't reference another saved query")
+	}
+
+	fnInterceptor := elasticsearch.NewNameInterceptor(nsName, saTypeMap, saMapperProvider)
+	for _, storeName := range storeNames {
+		if err := validateStoreQuery(storeName, nsName, nsID, saTypeMap, saMapperProvider, fnInterceptor, queryString); err != nil {
+			var converterErr *query.ConverterError
+			if errors.As(err, &converterErr) {
+				return converterErr.ToInvalidArgument()
+			}
+			return err
+		}
+	}
+	for _, column := range savedQuery.GetDefaultColumns() {
+		if _, err := fnInterceptor.Name(column, query.FieldNameFilter); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid default column %q: %v", column, err)
+		}
+	}
+	return nil
+}
+
+func validateStoreQuery(
+	storeName string,
+	nsName namespace.Name,
+	nsID namespace.ID,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapperProvider searchattribute.MapperProvider,
+	fnInterceptor query.FieldNameInterceptor,
+	queryString string,
+) error {
+	switch storeName {
+	case elasticsearch.PersistenceName, opensearch.PersistenceName:
+		converter := elasticsearch.NewQueryConverter(fnInterceptor, nil, saTypeMap)
+		_, err := converter.ConvertWhereOrderBy(queryString)
+		return err
+	}
+	saMapper, err := saMapperProvider.GetMapper(nsName)
+	if err != nil {
+		return err
+	}
+	converter := sql.NewQueryConverter(storeName, nsName, nsID, saTypeMap, saMapper, queryString)
+	if converter == nil {
+		return nil
+	}
+	_, err = converter.BuildCountStmt()
+	return err
+}
@@ -0,0 +1,82 @@
+package visibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestExpandSavedQuery(t *testing.T) {
+	nsEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "test-namespace"},
+		&persistencespb.NamespaceConfig{
+			SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+				"running":  {Query: "ExecutionStatus = 'Running' ORDER BY AliasForKeyword01"},
+				"obsolete": {Query: "AliasForRemoved01 = 'value'"},
+			},
+		},
+		"active",
+	)
+	expand := func(queryString string) (string, error) {
+		return ExpandSavedQuery(nsEntry, queryString)
+	}
+
+	queryString, err := expand("view:running")
+	require.NoError(t, err)
+	require.Equal(t, "ExecutionStatus = 'Running' ORDER BY AliasForKeyword01", queryString)
+
+	queryString, err = expand("WorkflowType = 'view:running'")
+	require.NoError(t, err)
+	require.Equal(t, "WorkflowType = 'view:running'", queryString)
+
+	// Search attributes removed since the query was saved are reported by the store running the query.
+	queryString, err = expand("view:obsolete")
+	require.NoError(t, err)
+	require.Equal(t, "AliasForRemoved01 = 'value'", queryString)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err = expand("view:unknown")
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestValidateSavedQuery(t *testing.T) {
+	saMapperProvider := searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{})
+	validate := func(storeName string, savedQuery *persistencespb.SavedVisibilityQuery) error {
+		return ValidateSavedQuery(
+			[]string{storeName},
+			"test-namespace",
+			"test-namespace-id",
+			searchattribute.TestNameTypeMap,
+			saMapperProvider,
+			savedQuery,
+		)
+	}
+
+	var invalidArgument *serviceerror.InvalidArgument
+	for _, storeName := range []string{elasticsearch.PersistenceName, sqlite.PluginName} {
+		t.Run(storeName, func(t *testing.T) {
+			require.NoError(t, validate(storeName, &persistencespb.SavedVisibilityQuery{
+				Query:          "ExecutionStatus = 'Running' AND AliasForKeyword01 = 'value'",
+				DefaultColumns: []string{"WorkflowId", "AliasForKeyword01"},
+			}))
+			require.ErrorAs(t, validate(storeName, &persistencespb.SavedVisibilityQuery{}), &invalidArgument)
+			require.ErrorAs(t, validate(storeName, &persistencespb.SavedVisibilityQuery{Query: "view:running"}), &invalidArgument)
+			require.ErrorAs(t, validate(storeName, &persistencespb.SavedVisibilityQuery{Query: "AliasForRemoved01 = 'value'"}), &invalidArgument)
+			require.ErrorAs(t, validate(storeName, &persistencespb.SavedVisibilityQuery{
+				Query:          "ExecutionStatus = 'Running'",
+				DefaultColumns: []string{"AliasForRemoved01"},
+			}), &invalidArgument)
+		})
+	}
+
+	// ORDER BY is only supported by some stores
+	require.NoError(t, validate(elasticsearch.PersistenceName, &persistencespb.SavedVisibilityQuery{Query: "ExecutionStatus = 'Running' ORDER BY StartTime"}))
+	// queries of unknown stores are validated when they run
+	require.NoError(t, validate("custom", &persistencespb.SavedVisibilityQuery{Query: "Unknown = 'value'"}))
+}
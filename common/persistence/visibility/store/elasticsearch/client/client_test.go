@@ -46,6 +46,6 @@ func Test_BuildPutMappingBody(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		assert.Equal(test.expected, fmt.Sprintf("%v", buildMappingBody(test.input)))
+		assert.Equal(test.expected, fmt.Sprintf("%v", BuildMappingBody(test.input)))
 	}
 }
@@ -261,7 +261,7 @@ func (c *clientImpl) RunBulkProcessor(ctx context.Context, p *BulkProcessorParam
 }
 
 func (c *clientImpl) PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
-	body := buildMappingBody(mapping)
+	body := BuildMappingBody(mapping)
 	resp, err := c.esClient.PutMapping().Index(index).BodyJson(body).Do(ctx)
 	if err != nil {
 		return false, err
@@ -303,7 +303,7 @@ func (c *clientImpl) GetMapping(ctx context.Context, index string) (map[string]s
 		return nil, err
 	}
 
-	return convertMappingBody(body, index), nil
+	return ConvertMappingBody(body, index), nil
 }
 
 func (c *clientImpl) GetDateFieldType() string {
@@ -404,7 +404,8 @@ func getLoggerOptions(logLevel string, logger log.Logger) []elastic.ClientOption
 	}
 }
 
-func buildMappingBody(mapping map[string]enumspb.IndexedValueType) map[string]interface{} {
+// BuildMappingBody builds the put mapping request body of the search attributes.
+func BuildMappingBody(mapping map[string]enumspb.IndexedValueType) map[string]interface{} {
 	properties := make(map[string]interface{}, len(mapping))
 	for fieldName, fieldType := range mapping {
 		var typeMap map[string]interface{}
@@ -436,7 +437,8 @@ func buildMappingBody(mapping map[string]enumspb.IndexedValueType) map[string]in
 	return body
 }
 
-func convertMappingBody(esMapping map[string]interface{}, indexName string) map[string]string {
+// ConvertMappingBody converts the get mapping response body of the index to field types.
+func ConvertMappingBody(esMapping map[string]interface{}, indexName string) map[string]string {
 	result := make(map[string]string)
 	index, ok := esMapping[indexName]
 	if !ok {
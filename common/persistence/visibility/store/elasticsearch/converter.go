@@ -35,11 +35,13 @@ func NewQueryConverter(
 	rangeCond := query.NewRangeCondConverter(fnInterceptor, fvInterceptor, true)
 	comparisonExpr := query.NewComparisonExprConverter(fnInterceptor, fvInterceptor, allowedComparisonOperators, saNameType)
 	is := query.NewIsConverter(fnInterceptor)
+	match := query.NewMatchConverter(fnInterceptor, fvInterceptor, saNameType)
 
 	whereConverter := &query.WhereConverter{
 		RangeCond:      rangeCond,
 		ComparisonExpr: comparisonExpr,
 		Is:             is,
+		Match:          match,
 	}
 	whereConverter.And = query.NewAndConverter(whereConverter)
 	whereConverter.Or = query.NewOrConverter(whereConverter)
@@ -12,21 +12,29 @@ import (
 )
 
 var errorCases = map[string]string{
-	"delete":                                 query.MalformedSqlQueryErrMessage,
-	"update x":                               query.MalformedSqlQueryErrMessage,
-	"insert ":                                query.MalformedSqlQueryErrMessage,
-	"insert into a values(1,2)":              query.NotSupportedErrMessage,
-	"update a set id = 1":                    query.NotSupportedErrMessage,
-	"delete from a where id=1":               query.NotSupportedErrMessage,
-	"select * from a where NOT(id=1)":        query.NotSupportedErrMessage,
-	"select * from a where 1 = 1":            query.InvalidExpressionErrMessage,
-	"select * from a where 1=a":              query.InvalidExpressionErrMessage,
-	"select * from a where zz(k=2)":          query.NotSupportedErrMessage,
-	"select * from a group by k, m":          query.NotSupportedErrMessage,
-	"select * from a group by k order by id": query.NotSupportedErrMessage,
-	"select * from a where a like '%a%'":     "operator 'like' not allowed in comparison expression",
-	"select * from a where a not like '%a%'": "operator 'not like' not allowed in comparison expression",
-	"invalid query":                          query.MalformedSqlQueryErrMessage,
+	"delete":                                                           query.MalformedSqlQueryErrMessage,
+	"update x":                                                         query.MalformedSqlQueryErrMessage,
+	"insert ":                                                          query.MalformedSqlQueryErrMessage,
+	"insert into a values(1,2)":                                        query.NotSupportedErrMessage,
+	"update a set id = 1":                                              query.NotSupportedErrMessage,
+	"delete from a where id=1":                                         query.NotSupportedErrMessage,
+	"select * from a where NOT(id=1)":                                  query.NotSupportedErrMessage,
+	"select * from a where 1 = 1":                                      query.InvalidExpressionErrMessage,
+	"select * from a where 1=a":                                        query.InvalidExpressionErrMessage,
+	"select * from a where zz(k=2)":                                    query.NotSupportedErrMessage,
+	"select * from a group by k, m":                                    query.NotSupportedErrMessage,
+	"select * from a group by k order by id":                           query.NotSupportedErrMessage,
+	"select * from a where a like '%a%'":                               "operator 'like' not allowed in comparison expression",
+	"select * from a where a not like '%a%'":                           "operator 'not like' not allowed in comparison expression",
+	"invalid query":                                                    query.MalformedSqlQueryErrMessage,
+	"select * from a where contains(a)":                                query.InvalidExpressionErrMessage,
+	"select * from a where contains(a, 'b', 'c')":                      query.InvalidExpressionErrMessage,
+	"select * from a where contains('a', 'b')":                         query.InvalidExpressionErrMessage,
+	"select * from a where contains(value, 'b')":                       "function 'contains' can only be used with Keyword type search attributes",
+	"select * from a where ends_with(a, 1)":                            "value must be a string",
+	"select * from a where match(a) against ('b')":                     "'match' can only be used with Text type search attributes",
+	"select * from a where match(value, content) against ('b')":        query.InvalidExpressionErrMessage,
+	"select * from a where match(value) against ('b' in boolean mode)": query.NotSupportedErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
 }
 
@@ -68,8 +76,14 @@ var supportedWhereCases = map[string]string{
 	"create_time BETWEEN '2015-01-01 00:00:00' and '2016-02-02 00:00:00'":     `{"bool":{"filter":{"range":{"create_time":{"from":"2015-01-01 00:00:00","include_lower":true,"include_upper":true,"to":"2016-02-02 00:00:00"}}}}}`,
 	"create_time nOt between '2015-01-01 00:00:00' and '2016-02-02 00:00:00'": `{"bool":{"must_not":{"range":{"create_time":{"from":"2015-01-01 00:00:00","include_lower":true,"include_upper":true,"to":"2016-02-02 00:00:00"}}}}}`,
 	"create_time between '2015-01-01T00:00:00+0800' and '2017-01-01T00:00:00+0800' and process_id = 0 and status >= 1 and content = '三个男人' and phone = '15810324322'": `{"bool":{"filter":[{"range":{"create_time":{"from":"2015-01-01T00:00:00+0800","include_lower":true,"include_upper":true,"to":"2017-01-01T00:00:00+0800"}}},{"term":{"process_id":0}},{"range":{"status":{"from":1,"include_lower":true,"include_upper":true,"to":null}}},{"match":{"content":{"query":"三个男人"}}},{"match":{"phone":{"query":"15810324322"}}}]}}`,
-	"value starts_with 'prefix'":     `{"bool":{"filter":{"prefix":{"value":"prefix"}}}}`,
-	"value not starts_with 'prefix'": `{"bool":{"must_not":{"prefix":{"value":"prefix"}}}}`,
+	"value starts_with 'prefix'":                              `{"bool":{"filter":{"prefix":{"value":"prefix"}}}}`,
+	"value not starts_with 'prefix'":                          `{"bool":{"must_not":{"prefix":{"value":"prefix"}}}}`,
+	"contains(a, 'b*c')":                                      `{"bool":{"filter":{"wildcard":{"a":{"value":"*b\\*c*"}}}}}`,
+	"not contains(a, 'bc')":                                   `{"bool":{"must_not":{"wildcard":{"a":{"value":"*bc*"}}}}}`,
+	"ends_with(a, 'bc?')":                                     `{"bool":{"filter":{"wildcard":{"a":{"value":"*bc\\?"}}}}}`,
+	"ILIKE(a, 'B_c%d*')":                                      `{"bool":{"filter":{"wildcard":{"a":{"case_insensitive":true,"value":"B?c*d\\*"}}}}}`,
+	"match(value) against ('hello world')":                    `{"bool":{"filter":{"match":{"value":{"operator":"and","query":"hello world"}}}}}`,
+	"not match(value) against ('hello') and contains(a, 'b')": `{"bool":{"filter":[{"bool":{"must_not":{"match":{"value":{"operator":"and","query":"hello"}}}}},{"wildcard":{"a":{"value":"*b*"}}}]}}`,
 }
 
 var supportedWhereOrderCases = map[string]struct {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +19,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -34,15 +37,17 @@ import (
 const (
 	PersistenceName = "elasticsearch"
 
-	delimiter                    = "~"
-	scrollKeepAliveInterval      = "1m"
-	pointInTimeKeepAliveInterval = "1m"
+	delimiter                          = "~"
+	aggregateWorkflowExecutionsAggName = "aggregate"
+	scrollKeepAliveInterval            = "1m"
+	pointInTimeKeepAliveInterval       = "1m"
 )
 
 type (
 	VisibilityStore struct {
 		esClient                       client.Client
 		index                          string
+		readIndex                      string
 		searchAttributesProvider       searchattribute.Provider
 		searchAttributesMapperProvider searchattribute.MapperProvider
 		processor                      Processor
@@ -60,6 +65,9 @@ type (
 		ScrollID string
 		// For ES>=7.10.0 and "default" flavor.
 		PointInTimeID string
+
+		// For AggregateWorkflowExecutions API.
+		AfterKey map[string]any
 	}
 
 	fieldSort struct {
@@ -139,9 +147,39 @@ func NewVisibilityStore(
 		processor.Start()
 		processorAckTimeout = processorConfig.ESProcessorAckTimeout
 	}
+	return NewVisibilityStoreWithClient(
+		esClient,
+		cfg.GetVisibilityIndex(),
+		cfg.GetVisibilityIndex(),
+		processor,
+		processorAckTimeout,
+		searchAttributesProvider,
+		searchAttributesMapperProvider,
+		disableOrderByClause,
+		enableManualPagination,
+		metricsHandler,
+	), nil
+}
+
+// NewVisibilityStoreWithClient creates a visibility store using an existing client and processor.
+// Workflow executions are written to index, and searched in readIndex, which can be an alias
+// spanning index and other indices.
+func NewVisibilityStoreWithClient(
+	esClient client.Client,
+	index string,
+	readIndex string,
+	processor Processor,
+	processorAckTimeout dynamicconfig.DurationPropertyFn,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	enableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	metricsHandler metrics.Handler,
+) *VisibilityStore {
 	return &VisibilityStore{
 		esClient:                       esClient,
-		index:                          cfg.GetVisibilityIndex(),
+		index:                          index,
+		readIndex:                      readIndex,
 		searchAttributesProvider:       searchAttributesProvider,
 		searchAttributesMapperProvider: searchAttributesMapperProvider,
 		processor:                      processor,
@@ -149,7 +187,7 @@ func NewVisibilityStore(
 		disableOrderByClause:           disableOrderByClause,
 		enableManualPagination:         enableManualPagination,
 		metricsHandler:                 metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)),
-	}, nil
+	}
 }
 
 func (s *VisibilityStore) Close() {
@@ -428,7 +466,7 @@ func (s *VisibilityStore) scanWorkflowExecutionsWithPit(
 
 	// The first call doesn't have a token with PointInTimeID.
 	if len(request.NextPageToken) == 0 {
-		pitID, err := s.esClient.OpenPointInTime(ctx, s.index, pointInTimeKeepAliveInterval)
+		pitID, err := s.esClient.OpenPointInTime(ctx, s.readIndex, pointInTimeKeepAliveInterval)
 		if err != nil {
 			return nil, ConvertElasticsearchClientError("Unable to create point in time", err)
 		}
@@ -466,7 +504,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		return s.countGroupByWorkflowExecutions(ctx, queryParams)
 	}
 
-	count, err := s.esClient.Count(ctx, s.index, queryParams.Query)
+	count, err := s.esClient.Count(ctx, s.readIndex, queryParams.Query)
 	if err != nil {
 		return nil, ConvertElasticsearchClientError("CountWorkflowExecutions failed", err)
 	}
@@ -507,7 +545,7 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	}
 	esResponse, err := s.esClient.CountGroupBy(
 		ctx,
-		s.index,
+		s.readIndex,
 		queryParams.Query,
 		groupByFields[0],
 		termsAgg,
@@ -518,12 +556,196 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return s.parseCountGroupByResponse(esResponse, groupByFields)
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	queryParams, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.Sorter) > 0 || len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("'order by' and 'group by' clauses are not supported by aggregation queries")
+	}
+
+	groupByFields, groupByTypes, subAggs, valueTypes, err := s.convertAggregations(request)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	// Without group by fields, all the workflow executions matching the query are a single group.
+	// Example: when grouping by (field1, field2) and computing the max of field3, the object looks like
+	// {
+	//   "aggs": {
+	//     "aggregate": {
+	//       "composite": {
+	//         "size": 100,
+	//         "sources": [
+	//           {"field1": {"terms": {"field": "field1", "missing_bucket": true}}},
+	//           {"field2": {"terms": {"field": "field2", "missing_bucket": true}}}
+	//         ]
+	//       },
+	//       "aggs": {
+	//         "0": {"max": {"field": "field3"}}
+	//       }
+	//     }
+	//   }
+	// }
+	var agg elastic.Aggregation
+	if len(groupByFields) == 0 {
+		filterAgg := elastic.NewFilterAggregation().Filter(elastic.NewMatchAllQuery())
+		for i, subAgg := range subAggs {
+			filterAgg.SubAggregation(strconv.Itoa(i), subAgg)
+		}
+		agg = filterAgg
+	} else {
+		token, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		sources := make([]elastic.CompositeAggregationValuesSource, len(groupByFields))
+		for i, fieldName := range groupByFields {
+			sources[i] = elastic.NewCompositeAggregationTermsValuesSource(fieldName).
+				Field(fieldName).
+				MissingBucket(true)
+		}
+		compositeAgg := elastic.NewCompositeAggregation().Sources(sources...).Size(request.PageSize)
+		if token != nil && len(token.AfterKey) > 0 {
+			compositeAgg.AggregateAfter(token.AfterKey)
+		}
+		for i, subAgg := range subAggs {
+			compositeAgg.SubAggregation(strconv.Itoa(i), subAgg)
+		}
+		agg = compositeAgg
+	}
+
+	esResponse, err := s.esClient.CountGroupBy(
+		ctx,
+		s.readIndex,
+		queryParams.Query,
+		aggregateWorkflowExecutionsAggName,
+		agg,
+	)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("AggregateWorkflowExecutions failed", err)
+	}
+	return s.parseAggregateResponse(esResponse, request.PageSize, groupByFields, groupByTypes, valueTypes)
+}
+
+// ExplainQuery returns the search source sent to Elasticsearch for the first page of the query.
+// Elasticsearch doesn't provide a query plan, so the plan of the response is always empty.
+func (s *VisibilityStore) ExplainQuery(
+	_ context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	aliasRecorder := searchattribute.NewAliasRecorder(s.searchAttributesMapperProvider)
+	queryParams, err := s.convertQueryWithMapperProvider(request.Namespace, request.NamespaceID, request.Query, aliasRecorder)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("GROUP BY clause is not supported")
+	}
+	sorter, err := s.GetListFieldSorter(queryParams.Sorter)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := elastic.NewSearchSource().
+		Query(queryParams.Query).
+		SortBy(sorter...).
+		Size(request.PageSize).
+		Source()
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to build search source: %v", err)
+	}
+	translatedQuery, err := json.Marshal(source)
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to serialize search source: %v", err)
+	}
+	return &manager.ExplainQueryResponse{
+		StoreName:              s.GetName(),
+		TranslatedQuery:        string(translatedQuery),
+		SearchAttributeAliases: aliasRecorder.Aliases(),
+	}, nil
+}
+
+// convertAggregations resolves the group by fields and the aggregated fields of the request, and
+// builds the Elasticsearch metrics aggregations to compute for each group.
+func (s *VisibilityStore) convertAggregations(
+	request *manager.AggregateWorkflowExecutionsRequest,
+) ([]string, []enumspb.IndexedValueType, []elastic.Aggregation, []enumspb.IndexedValueType, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, nil, nil, nil, serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
+	}
+	nameInterceptor := NewNameInterceptor(request.Namespace, saTypeMap, s.searchAttributesMapperProvider)
+	resolve := func(name string) (string, enumspb.IndexedValueType, error) {
+		fieldName, err := nameInterceptor.Name(name, query.FieldNameAggregation)
+		if err != nil {
+			return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, err
+		}
+		fieldType, err := saTypeMap.GetType(fieldName)
+		if err != nil {
+			return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, query.NewConverterError("invalid search attribute: %s", name)
+		}
+		return fieldName, fieldType, nil
+	}
+
+	groupByFields := make([]string, len(request.GroupBy))
+	groupByTypes := make([]enumspb.IndexedValueType, len(request.GroupBy))
+	for i, name := range request.GroupBy {
+		groupByFields[i], groupByTypes[i], err = resolve(name)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if err := query.ValidateAggregationGroupBy(name, groupByTypes[i]); err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if slices.Contains(groupByFields[:i], groupByFields[i]) {
+			return nil, nil, nil, nil, query.NewConverterError("duplicate group by search attribute: %s", name)
+		}
+	}
+
+	subAggs := make([]elastic.Aggregation, len(request.Aggregations))
+	valueTypes := make([]enumspb.IndexedValueType, len(request.Aggregations))
+	for i, aggregation := range request.Aggregations {
+		fieldName, fieldType, err := resolve(aggregation.GetSearchAttribute())
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if err := query.ValidateAggregationFunction(aggregation.GetFunction(), aggregation.GetSearchAttribute(), fieldType); err != nil {
+			return nil, nil, nil, nil, err
+		}
+		switch aggregation.GetFunction() {
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN:
+			subAggs[i] = elastic.NewMinAggregation().Field(fieldName)
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX:
+			subAggs[i] = elastic.NewMaxAggregation().Field(fieldName)
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG:
+			subAggs[i] = elastic.NewAvgAggregation().Field(fieldName)
+		}
+		valueTypes[i] = query.AggregationValueType(aggregation.GetFunction(), fieldType)
+	}
+	return groupByFields, groupByTypes, subAggs, valueTypes, nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
 ) (*store.InternalGetWorkflowExecutionResponse, error) {
 	docID := GetDocID(request.WorkflowID, request.RunID)
 	result, err := s.esClient.Get(ctx, s.index, docID)
+	if elastic.IsNotFound(err) {
+		return nil, serviceerror.NewNotFoundf(
+			"Workflow execution with RunId %s not found", request.RunID,
+		)
+	}
 	if err != nil {
 		return nil, ConvertElasticsearchClientError("GetWorkflowExecution failed", err)
 	}
@@ -589,7 +811,7 @@ func (s *VisibilityStore) buildSearchParameters(
 	}
 
 	params := &client.SearchParameters{
-		Index:    s.index,
+		Index:    s.readIndex,
 		Query:    boolQuery,
 		PageSize: request.PageSize,
 		Sorter:   defaultSorter,
@@ -616,7 +838,7 @@ func (s *VisibilityStore) BuildSearchParametersV2(
 	}
 
 	searchParams := &client.SearchParameters{
-		Index:    s.index,
+		Index:    s.readIndex,
 		PageSize: request.PageSize,
 		Query:    queryParams.Query,
 	}
@@ -723,12 +945,21 @@ func (s *VisibilityStore) convertQuery(
 	namespace namespace.Name,
 	namespaceID namespace.ID,
 	requestQueryStr string,
+) (*query.QueryParams, error) {
+	return s.convertQueryWithMapperProvider(namespace, namespaceID, requestQueryStr, s.searchAttributesMapperProvider)
+}
+
+func (s *VisibilityStore) convertQueryWithMapperProvider(
+	namespace namespace.Name,
+	namespaceID namespace.ID,
+	requestQueryStr string,
+	mapperProvider searchattribute.MapperProvider,
 ) (*query.QueryParams, error) {
 	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
 	if err != nil {
 		return nil, serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
 	}
-	nameInterceptor := NewNameInterceptor(namespace, saTypeMap, s.searchAttributesMapperProvider)
+	nameInterceptor := NewNameInterceptor(namespace, saTypeMap, mapperProvider)
 	queryConverter := NewQueryConverter(
 		nameInterceptor,
 		NewValuesInterceptor(namespace, saTypeMap),
@@ -1171,6 +1402,122 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 	return response, nil
 }
 
+func (s *VisibilityStore) parseAggregateResponse(
+	searchResult *elastic.SearchResult,
+	pageSize int,
+	groupByFields []string,
+	groupByTypes []enumspb.IndexedValueType,
+	valueTypes []enumspb.IndexedValueType,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	var aggJson map[string]any
+	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[aggregateWorkflowExecutionsAggName]))
+	dec.UseNumber()
+	if err := dec.Decode(&aggJson); err != nil {
+		return nil, serviceerror.NewInternalf("unable to unmarshal json response: %v", err)
+	}
+
+	parseBucket := func(bucket map[string]any, groupValues []*commonpb.Payload) (*adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup, error) {
+		count, isNumber := bucket["doc_count"].(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf("unable to parse 'doc_count' field: %w: expected json.Number, got %T", errUnexpectedJSONFieldType, bucket["doc_count"])
+		}
+		cnt, err := count.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse 'doc_count' field: %w", err)
+		}
+		values := make([]*commonpb.Payload, len(valueTypes))
+		for i, valueType := range valueTypes {
+			metric, _ := bucket[strconv.Itoa(i)].(map[string]any)
+			value, err := parseAggregationValue(metric["value"], valueType)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse value %v: %w", metric["value"], err)
+			}
+			values[i], err = searchattribute.EncodeValue(value, valueType)
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode value %v: %w", value, err)
+			}
+		}
+		return &adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+			GroupValues: groupValues,
+			Count:       cnt,
+			Values:      values,
+		}, nil
+	}
+
+	response := &manager.AggregateWorkflowExecutionsResponse{}
+	if len(groupByFields) == 0 {
+		group, err := parseBucket(aggJson, nil)
+		if err != nil {
+			return nil, err
+		}
+		response.Groups = append(response.Groups, group)
+		return response, nil
+	}
+
+	buckets, _ := aggJson["buckets"].([]any)
+	for i := range buckets {
+		bucket, _ := buckets[i].(map[string]any)
+		key, _ := bucket["key"].(map[string]any)
+		groupValues := make([]*commonpb.Payload, len(groupByFields))
+		for j, fieldName := range groupByFields {
+			var value any
+			if key[fieldName] != nil {
+				var err error
+				value, err = finishParseJSONValue(key[fieldName], groupByTypes[j])
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse value %v: %w", key[fieldName], err)
+				}
+			}
+			payload, err := searchattribute.EncodeValue(value, groupByTypes[j])
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode value %v: %w", value, err)
+			}
+			groupValues[j] = payload
+		}
+		group, err := parseBucket(bucket, groupValues)
+		if err != nil {
+			return nil, err
+		}
+		response.Groups = append(response.Groups, group)
+	}
+
+	// Number of buckets smaller than the page size indicates that this is the last page.
+	afterKey, _ := aggJson["after_key"].(map[string]any)
+	if len(buckets) == pageSize && len(afterKey) > 0 {
+		nextPageToken, err := s.serializePageToken(&visibilityPageToken{AfterKey: afterKey})
+		if err != nil {
+			return nil, err
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+// parseAggregationValue parses the value of a min, max or avg aggregation. Elasticsearch returns
+// the values as doubles, and datetime values as epoch milliseconds. Value is null if no document
+// of the bucket has a value for the field.
+func parseAggregationValue(val any, t enumspb.IndexedValueType) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	numberVal, isNumber := val.(json.Number)
+	if !isNumber {
+		return nil, fmt.Errorf("%w: expected json.Number got %T", errUnexpectedJSONFieldType, val)
+	}
+	floatVal, err := numberVal.Float64()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return int64(floatVal), nil
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return time.UnixMilli(int64(floatVal)).UTC(), nil
+	default:
+		return floatVal, nil
+	}
+}
+
 // finishParseJSONValue finishes JSON parsing after json.Decode.
 // json.Decode returns:
 //
@@ -18,6 +18,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/temporalproto"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
@@ -119,6 +121,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 	s.visibilityStore = &VisibilityStore{
 		esClient:                       s.mockESClient,
 		index:                          testIndex,
+		readIndex:                      testIndex,
 		searchAttributesProvider:       searchattribute.NewTestProvider(),
 		searchAttributesMapperProvider: searchattribute.NewTestMapperProvider(nil),
 		processor:                      s.mockProcessor,
@@ -1285,6 +1288,151 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 	s.Nil(resp)
 }
 
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions() {
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "WorkflowType = 'test-wf-type'",
+		GroupBy:     []string{searchattribute.ExecutionStatus},
+		Aggregations: []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+			{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX, SearchAttribute: searchattribute.CloseTime},
+			{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG, SearchAttribute: "CustomIntField"},
+		},
+		PageSize: 2,
+	}
+	closeTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockESClient.EXPECT().
+		CountGroupBy(gomock.Any(), testIndex, gomock.Any(), aggregateWorkflowExecutionsAggName, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ elastic.Query, _ string, agg elastic.Aggregation) (*elastic.SearchResult, error) {
+			source, err := agg.Source()
+			s.NoError(err)
+			aggJson, err := json.Marshal(source)
+			s.NoError(err)
+			s.JSONEq(
+				`{
+					"composite": {
+						"size": 2,
+						"sources": [{"ExecutionStatus": {"terms": {"field": "ExecutionStatus", "missing_bucket": true}}}]
+					},
+					"aggregations": {
+						"0": {"max": {"field": "CloseTime"}},
+						"1": {"avg": {"field": "CustomIntField"}}
+					}
+				}`,
+				string(aggJson),
+			)
+			return &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					aggregateWorkflowExecutionsAggName: json.RawMessage(fmt.Sprintf(
+						`{
+							"after_key": {"ExecutionStatus": "Running"},
+							"buckets": [
+								{"key": {"ExecutionStatus": "Completed"}, "doc_count": 100, "0": {"value": %d}, "1": {"value": 1.5}},
+								{"key": {"ExecutionStatus": "Running"}, "doc_count": 10, "0": {"value": null}, "1": {"value": 2}}
+							]
+						}`,
+						closeTime.UnixMilli(),
+					)),
+				},
+			}, nil
+		})
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+
+	encode := func(value any, t enumspb.IndexedValueType) *commonpb.Payload {
+		payload, err := searchattribute.EncodeValue(value, t)
+		s.NoError(err)
+		return payload
+	}
+	s.Len(resp.Groups, 2)
+	s.ProtoEqual(
+		&adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+			GroupValues: []*commonpb.Payload{encode(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+			Count:       100,
+			Values: []*commonpb.Payload{
+				encode(closeTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				encode(1.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE),
+			},
+		},
+		resp.Groups[0],
+	)
+	s.ProtoEqual(
+		&adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+			GroupValues: []*commonpb.Payload{encode(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+			Count:       10,
+			Values: []*commonpb.Payload{
+				encode(nil, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				encode(2.0, enumspb.INDEXED_VALUE_TYPE_DOUBLE),
+			},
+		},
+		resp.Groups[1],
+	)
+	token, err := s.visibilityStore.deserializePageToken(resp.NextPageToken)
+	s.NoError(err)
+	s.Equal(map[string]any{searchattribute.ExecutionStatus: "Running"}, token.AfterKey)
+}
+
+func (s *ESVisibilitySuite) TestExplainQuery() {
+	request := &manager.ExplainQueryRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "CustomKeywordField = 'value' ORDER BY StartTime",
+		PageSize:    10,
+	}
+	resp, err := s.visibilityStore.ExplainQuery(context.Background(), request)
+	s.NoError(err)
+	s.Equal(PersistenceName, resp.StoreName)
+	s.Equal(map[string]string{"CustomKeywordField": "CustomKeywordField"}, resp.SearchAttributeAliases)
+	s.Empty(resp.Plan)
+	s.JSONEq(
+		`{
+			"query": {
+				"bool": {
+					"filter": [
+						{"term": {"NamespaceId": "bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},
+						{"bool": {"filter": {"term": {"CustomKeywordField": "value"}}}}
+					],
+					"must_not": {"exists": {"field": "TemporalNamespaceDivision"}}
+				}
+			},
+			"size": 10,
+			"sort": [{"StartTime": {"order": "asc"}}, {"RunId": {"order": "desc"}}]
+		}`,
+		resp.TranslatedQuery,
+	)
+
+	request.Query = "GROUP BY ExecutionStatus"
+	_, err = s.visibilityStore.ExplainQuery(context.Background(), request)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions_InvalidRequest() {
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "ORDER BY StartTime",
+		PageSize:    10,
+	}
+	_, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.ErrorContains(err, "'order by' and 'group by' clauses are not supported by aggregation queries")
+
+	request.Query = ""
+	request.GroupBy = []string{"CustomIntField"}
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+	s.ErrorContains(err, "unable to group by search attribute CustomIntField of type Int")
+
+	request.GroupBy = nil
+	request.Aggregations = []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+		{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG, SearchAttribute: "CustomKeywordField"},
+	}
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.ErrorAs(err, &invalidArgErr)
+	s.ErrorContains(err, "unable to compute Avg of search attribute CustomKeywordField of type Keyword")
+}
+
 func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	statusCompletedPayload, _ := searchattribute.EncodeValue(
 		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
@@ -1609,6 +1757,13 @@ func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	_, ok := err.(*serviceerror.Unavailable)
 	s.True(ok)
 	s.Contains(err.Error(), "GetWorkflowExecution failed")
+
+	// test not found error
+	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).Return(nil, &elastic.Error{Status: 404})
+
+	_, err = s.visibilityStore.GetWorkflowExecution(context.Background(), request)
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
 }
 
 func (s *ESVisibilitySuite) Test_detailedErrorMessage() {
@@ -1761,6 +1916,7 @@ func (s *ESVisibilitySuite) TestProcessPageToken() {
 			visibilityStore := &VisibilityStore{
 				esClient:                       s.mockESClient,
 				index:                          testIndex,
+				readIndex:                      testIndex,
 				searchAttributesProvider:       searchattribute.NewTestProvider(),
 				searchAttributesMapperProvider: searchattribute.NewTestMapperProvider(nil),
 				processor:                      s.mockProcessor,
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+)
+
+const (
+	versionTypeExternal = "external"
+	// Flush can block indefinitely if we can't reach OpenSearch. Default fx app shutdown timeout is
+	// 15s, so use 5s.
+	bulkProcessorStopTimeout = 5 * time.Second
+)
+
+type (
+	// bulkProcessorImpl batches the requests in NumOfWorkers workers and sends them with the bulk API
+	// when a worker has BulkActions requests, BulkSize bytes, or every FlushInterval. Failed bulk
+	// requests aren't retried, the visibility task processor has its own retry logic.
+	bulkProcessorImpl struct {
+		client *clientImpl
+		params *esclient.BulkProcessorParameters
+
+		requestsC   chan elastic.BulkableRequest
+		stopC       chan struct{}
+		workersWG   sync.WaitGroup
+		executionID atomic.Int64
+		stopOnce    sync.Once
+	}
+
+	bulkWorker struct {
+		processor *bulkProcessorImpl
+		requests  []elastic.BulkableRequest
+		body      bytes.Buffer
+	}
+)
+
+var _ esclient.BulkProcessor = (*bulkProcessorImpl)(nil)
+
+func newBulkProcessor(client *clientImpl, p *esclient.BulkProcessorParameters) *bulkProcessorImpl {
+	processor := &bulkProcessorImpl{
+		client:    client,
+		params:    p,
+		requestsC: make(chan elastic.BulkableRequest),
+		stopC:     make(chan struct{}),
+	}
+	numOfWorkers := max(p.NumOfWorkers, 1)
+	processor.workersWG.Add(numOfWorkers)
+	for range numOfWorkers {
+		worker := &bulkWorker{processor: processor}
+		go worker.work()
+	}
+	return processor
+}
+
+func (p *bulkProcessorImpl) Add(request *esclient.BulkableRequest) {
+	var bulkableRequest elastic.BulkableRequest
+	switch request.RequestType {
+	case esclient.BulkableRequestTypeIndex:
+		bulkableRequest = elastic.NewBulkIndexRequest().
+			Index(request.Index).
+			Id(request.ID).
+			VersionType(versionTypeExternal).
+			Version(request.Version).
+			Doc(request.Doc)
+	case esclient.BulkableRequestTypeDelete:
+		bulkableRequest = elastic.NewBulkDeleteRequest().
+			Index(request.Index).
+			Id(request.ID).
+			VersionType(versionTypeExternal).
+			Version(request.Version)
+	default:
+		return
+	}
+	select {
+	case p.requestsC <- bulkableRequest:
+	case <-p.stopC:
+	}
+}
+
+// Stop commits the pending requests and stops the workers.
+func (p *bulkProcessorImpl) Stop() error {
+	p.stopOnce.Do(func() { close(p.stopC) })
+
+	doneC := make(chan struct{})
+	go func() {
+		p.workersWG.Wait()
+		close(doneC)
+	}()
+	timer := time.NewTimer(bulkProcessorStopTimeout)
+	defer timer.Stop()
+	select {
+	case <-doneC:
+		return nil
+	case <-timer.C:
+		return errors.New("OpenSearch bulk processor Stop timed out")
+	}
+}
+
+func (w *bulkWorker) work() {
+	defer w.processor.workersWG.Done()
+
+	var flushC <-chan time.Time
+	if w.processor.params.FlushInterval > 0 {
+		ticker := time.NewTicker(w.processor.params.FlushInterval)
+		defer ticker.Stop()
+		flushC = ticker.C
+	}
+
+	for {
+		select {
+		case request := <-w.processor.requestsC:
+			if err := w.add(request); err != nil {
+				// Same as a failed bulk request for this request only.
+				w.processor.after(w.processor.executionID.Add(1), []elastic.BulkableRequest{request}, nil, err)
+				continue
+			}
+			if w.commitRequired() {
+				w.commit()
+			}
+		case <-flushC:
+			w.commit()
+		case <-w.processor.stopC:
+			w.commit()
+			return
+		}
+	}
+}
+
+func (w *bulkWorker) add(request elastic.BulkableRequest) error {
+	lines, err := request.Source()
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		w.body.WriteString(line)
+		w.body.WriteByte('\n')
+	}
+	w.requests = append(w.requests, request)
+	return nil
+}
+
+func (w *bulkWorker) commitRequired() bool {
+	params := w.processor.params
+	if params.BulkActions > 0 && len(w.requests) >= params.BulkActions {
+		return true
+	}
+	if params.BulkSize > 0 && w.body.Len() >= params.BulkSize {
+		return true
+	}
+	return false
+}
+
+func (w *bulkWorker) commit() {
+	if len(w.requests) == 0 {
+		return
+	}
+	requests := w.requests
+	body := bytes.NewReader(bytes.Clone(w.body.Bytes()))
+	w.requests = nil
+	w.body.Reset()
+
+	executionID := w.processor.executionID.Add(1)
+	if w.processor.params.BeforeFunc != nil {
+		w.processor.params.BeforeFunc(executionID, requests)
+	}
+
+	var response elastic.BulkResponse
+	_, err := w.processor.client.perform(context.Background(), opensearchapi.BulkReq{Body: body}, &response)
+	if err != nil {
+		w.processor.after(executionID, requests, nil, err)
+		return
+	}
+	w.processor.after(executionID, requests, &response, nil)
+}
+
+func (p *bulkProcessorImpl) after(executionID int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+	if p.params.AfterFunc != nil {
+		p.params.AfterFunc(executionID, requests, response, err)
+	}
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package client
+
+import (
+	"context"
+
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+)
+
+type (
+	// Client is a wrapper around OpenSearch client library (opensearch-go). It implements the Elasticsearch
+	// client interface, so that the Elasticsearch visibility store queries OpenSearch: queries are still
+	// built with olivere/elastic and responses are decoded into its types.
+	Client interface {
+		esclient.Client
+
+		// EnsureIndexLifecycle creates or updates the ISM policy, the index template and the aliases of
+		// the closed workflow executions indices. It's a no-op if index lifecycle isn't enabled.
+		EnsureIndexLifecycle(ctx context.Context) error
+	}
+)
@@ -0,0 +1,659 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/opensearch-project/opensearch-go/v4"
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/opensearch-project/opensearch-go/v4/plugins/ism"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/log"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+)
+
+type (
+	clientImpl struct {
+		client *opensearch.Client
+		cfg    *Config
+	}
+
+	// jsonRequest is an OpenSearch request which isn't in opensearchapi, e.g. legacy index templates.
+	jsonRequest struct {
+		method string
+		path   string
+		params map[string]string
+		body   any
+	}
+
+	ismPolicyResponse struct {
+		SeqNo       int64 `json:"_seq_no"`
+		PrimaryTerm int64 `json:"_primary_term"`
+	}
+
+	acknowledgedResponse struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+)
+
+const (
+	// Priority of the closed workflow executions index template over the visibility index template,
+	// which usually matches the closed workflow executions indices too.
+	closedIndexTemplateOrder = 100
+	ismTemplatePriority      = 100
+
+	minimumCloseIdleConnectionsInterval = 15 * time.Second
+)
+
+var _ Client = (*clientImpl)(nil)
+
+var errPointInTimeNotSupported = errors.New("point in time is not supported by the OpenSearch client, use scroll instead")
+
+// NewClient creates an OpenSearch client.
+func NewClient(cfg *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
+	switch cfg.Version {
+	case "v1", "v2", "":
+	default:
+		return nil, fmt.Errorf("not supported OpenSearch version: %v", cfg.Version)
+	}
+
+	var urls []string
+	if len(cfg.URLs) > 0 {
+		urls = make([]string, len(cfg.URLs))
+		for i, u := range cfg.URLs {
+			urls[i] = u.String()
+		}
+	} else {
+		urls = []string{cfg.URL.String()}
+	}
+
+	var transport http.RoundTripper
+	if httpClient != nil {
+		transport = httpClient.Transport
+	} else if cfg.TLS != nil && cfg.TLS.Enabled {
+		tlsConfig, err := auth.NewTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create TLS HTTP client: %w", err)
+		}
+		transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if cfg.CloseIdleConnectionsInterval != time.Duration(0) {
+		if cfg.CloseIdleConnectionsInterval < minimumCloseIdleConnectionsInterval {
+			cfg.CloseIdleConnectionsInterval = minimumCloseIdleConnectionsInterval
+		}
+		if idleTransport, ok := transport.(interface{ CloseIdleConnections() }); ok {
+			go func(interval time.Duration) {
+				closeTimer := time.NewTimer(interval)
+				defer closeTimer.Stop()
+				for {
+					<-closeTimer.C
+					closeTimer.Reset(interval)
+					idleTransport.CloseIdleConnections()
+				}
+			}(cfg.CloseIdleConnectionsInterval)
+		}
+	}
+
+	osClient, err := opensearch.NewClient(opensearch.Config{
+		Addresses: urls,
+		Username:  cfg.Username,
+		Password:  cfg.Password,
+		Transport: transport,
+		RetryBackoff: func(attempt int) time.Duration {
+			return min(128*time.Millisecond<<(attempt-1), 513*time.Millisecond)
+		},
+		CompressRequestBody:  true,
+		DiscoverNodesOnStart: cfg.EnableSniff,
+		Logger:               newRoundTripLogger(cfg.LogLevel, logger),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &clientImpl{
+		client: osClient,
+		cfg:    cfg,
+	}, nil
+}
+
+func (c *clientImpl) Get(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
+	var result elastic.GetResult
+	if _, err := c.perform(ctx, opensearchapi.DocumentGetReq{
+		Index:      index,
+		DocumentID: url.PathEscape(docID),
+	}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientImpl) Search(ctx context.Context, p *esclient.SearchParameters) (*elastic.SearchResult, error) {
+	searchSource := elastic.NewSearchSource().
+		Query(p.Query).
+		SortBy(p.Sorter...).
+		TrackTotalHits(false)
+
+	if p.PageSize != 0 {
+		searchSource.Size(p.PageSize)
+	}
+
+	if len(p.SearchAfter) != 0 {
+		searchSource.SearchAfter(p.SearchAfter...)
+	}
+
+	body, err := sourceBody(searchSource)
+	if err != nil {
+		return nil, err
+	}
+
+	var result elastic.SearchResult
+	if _, err := c.perform(ctx, opensearchapi.SearchReq{
+		Indices: []string{p.Index},
+		Body:    body,
+	}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientImpl) OpenScroll(
+	ctx context.Context,
+	p *esclient.SearchParameters,
+	keepAliveInterval string,
+) (*elastic.SearchResult, error) {
+	keepAlive, err := time.ParseDuration(keepAliveInterval)
+	if err != nil {
+		return nil, err
+	}
+	searchSource := elastic.NewSearchSource().
+		Query(p.Query).
+		SortBy(p.Sorter...)
+	if p.PageSize != 0 {
+		searchSource.Size(p.PageSize)
+	}
+	body, err := sourceBody(searchSource)
+	if err != nil {
+		return nil, err
+	}
+
+	var result elastic.SearchResult
+	if _, err := c.perform(ctx, opensearchapi.SearchReq{
+		Indices: []string{p.Index},
+		Body:    body,
+		Params:  opensearchapi.SearchParams{Scroll: keepAlive},
+	}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientImpl) Scroll(
+	ctx context.Context,
+	id string,
+	keepAliveInterval string,
+) (*elastic.SearchResult, error) {
+	keepAlive, err := time.ParseDuration(keepAliveInterval)
+	if err != nil {
+		return nil, err
+	}
+	var result elastic.SearchResult
+	if _, err := c.perform(ctx, opensearchapi.ScrollGetReq{
+		ScrollID: id,
+		Params:   opensearchapi.ScrollGetParams{Scroll: keepAlive},
+	}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientImpl) CloseScroll(ctx context.Context, id string) error {
+	body, err := jsonBody(map[string]any{"scroll_id": []string{id}})
+	if err != nil {
+		return err
+	}
+	_, err = c.perform(ctx, opensearchapi.ScrollDeleteReq{Body: body}, nil)
+	return err
+}
+
+// IsPointInTimeSupported returns false: the point in time API of OpenSearch isn't the Elasticsearch one,
+// and search results are paginated with scroll instead.
+func (c *clientImpl) IsPointInTimeSupported(_ context.Context) bool {
+	return false
+}
+
+func (c *clientImpl) OpenPointInTime(_ context.Context, _ string, _ string) (string, error) {
+	return "", errPointInTimeNotSupported
+}
+
+func (c *clientImpl) ClosePointInTime(_ context.Context, _ string) (bool, error) {
+	return false, errPointInTimeNotSupported
+}
+
+func (c *clientImpl) Count(ctx context.Context, index string, query elastic.Query) (int64, error) {
+	body := map[string]any{}
+	if query != nil {
+		querySource, err := query.Source()
+		if err != nil {
+			return 0, err
+		}
+		body["query"] = querySource
+	}
+	reqBody, err := jsonBody(body)
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Count int64 `json:"count"`
+	}
+	if _, err := c.perform(ctx, opensearchapi.IndicesCountReq{
+		Indices: []string{index},
+		Body:    reqBody,
+	}, &result); err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+func (c *clientImpl) CountGroupBy(
+	ctx context.Context,
+	index string,
+	query elastic.Query,
+	aggName string,
+	agg elastic.Aggregation,
+) (*elastic.SearchResult, error) {
+	searchSource := elastic.NewSearchSource().
+		Query(query).
+		Size(0).
+		TrackTotalHits(false).
+		Aggregation(aggName, agg)
+	body, err := sourceBody(searchSource)
+	if err != nil {
+		return nil, err
+	}
+
+	var result elastic.SearchResult
+	if _, err := c.perform(ctx, opensearchapi.SearchReq{
+		Indices: []string{index},
+		Body:    body,
+	}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *clientImpl) RunBulkProcessor(_ context.Context, p *esclient.BulkProcessorParameters) (esclient.BulkProcessor, error) {
+	return newBulkProcessor(c, p), nil
+}
+
+// PutMapping adds the mapping to the visibility index. If index lifecycle is enabled, the mapping is
+// also added to the closed workflow executions indices and to their index template, so that the next
+// rolled over indices have it too.
+func (c *clientImpl) PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
+	ack, err := c.putMapping(ctx, index, mapping)
+	if err != nil || !c.cfg.IndexLifecycle.Enabled || index != c.cfg.GetVisibilityIndex() {
+		return ack, err
+	}
+	// Index template is built from the visibility index mapping.
+	if err := c.EnsureIndexLifecycle(ctx); err != nil {
+		return false, err
+	}
+	closedAck, err := c.putMapping(ctx, c.cfg.ClosedIndexAlias(), mapping)
+	if err != nil {
+		return false, err
+	}
+	return ack && closedAck, nil
+}
+
+func (c *clientImpl) putMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
+	body, err := jsonBody(esclient.BuildMappingBody(mapping))
+	if err != nil {
+		return false, err
+	}
+	var result acknowledgedResponse
+	if _, err := c.perform(ctx, opensearchapi.MappingPutReq{
+		Indices: []string{index},
+		Body:    body,
+	}, &result); err != nil {
+		return false, err
+	}
+	return result.Acknowledged, nil
+}
+
+func (c *clientImpl) WaitForYellowStatus(ctx context.Context, index string) (string, error) {
+	var result struct {
+		Status string `json:"status"`
+	}
+	if _, err := c.perform(ctx, opensearchapi.ClusterHealthReq{
+		Indices: []string{index},
+		Params:  opensearchapi.ClusterHealthParams{WaitForStatus: "yellow"},
+	}, &result); err != nil {
+		return "", err
+	}
+	return result.Status, nil
+}
+
+func (c *clientImpl) GetMapping(ctx context.Context, index string) (map[string]string, error) {
+	var body map[string]any
+	if _, err := c.perform(ctx, opensearchapi.MappingGetReq{
+		Indices: []string{index},
+	}, &body); err != nil {
+		return nil, err
+	}
+	return esclient.ConvertMappingBody(body, index), nil
+}
+
+func (c *clientImpl) IndexExists(ctx context.Context, indexName string) (bool, error) {
+	status, err := c.perform(ctx, opensearchapi.IndicesExistsReq{
+		Indices: []string{indexName},
+	}, nil, http.StatusNotFound)
+	if err != nil {
+		return false, err
+	}
+	return status == http.StatusOK, nil
+}
+
+func (c *clientImpl) CreateIndex(ctx context.Context, index string, body map[string]any) (bool, error) {
+	if body == nil {
+		body = make(map[string]any)
+	}
+	reqBody, err := jsonBody(body)
+	if err != nil {
+		return false, err
+	}
+	var result acknowledgedResponse
+	if _, err := c.perform(ctx, opensearchapi.IndicesCreateReq{
+		Index: index,
+		Body:  reqBody,
+	}, &result); err != nil {
+		return false, err
+	}
+	return result.Acknowledged, nil
+}
+
+func (c *clientImpl) DeleteIndex(ctx context.Context, indexName string) (bool, error) {
+	var result acknowledgedResponse
+	if _, err := c.perform(ctx, opensearchapi.IndicesDeleteReq{
+		Indices: []string{indexName},
+	}, &result); err != nil {
+		return false, err
+	}
+	return result.Acknowledged, nil
+}
+
+func (c *clientImpl) CatIndices(ctx context.Context, target string) (elastic.CatIndicesResponse, error) {
+	req := opensearchapi.CatIndicesReq{}
+	if target != "" {
+		req.Indices = []string{target}
+	}
+	var result elastic.CatIndicesResponse
+	if _, err := c.perform(ctx, req, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *clientImpl) EnsureIndexLifecycle(ctx context.Context) error {
+	if !c.cfg.IndexLifecycle.Enabled {
+		return nil
+	}
+	if err := c.putISMPolicy(ctx); err != nil {
+		return fmt.Errorf("unable to put ISM policy %s: %w", c.cfg.ismPolicyID(), err)
+	}
+	if err := c.putClosedIndexTemplate(ctx); err != nil {
+		return fmt.Errorf("unable to put index template %s: %w", c.cfg.closedIndexTemplateName(), err)
+	}
+	if err := c.createClosedIndex(ctx); err != nil {
+		return fmt.Errorf("unable to create index %s: %w", c.cfg.firstClosedIndex(), err)
+	}
+	if err := c.addReadAlias(ctx); err != nil {
+		return fmt.Errorf("unable to add alias %s: %w", c.cfg.ReadAlias(), err)
+	}
+	return nil
+}
+
+// putISMPolicy creates the ISM policy, or updates it if it already exists. The policy is attached to
+// the closed workflow executions indices by its ISM template when they are created.
+func (c *clientImpl) putISMPolicy(ctx context.Context) error {
+	var policy ismPolicyResponse
+	status, err := c.perform(ctx, ism.PoliciesGetReq{
+		Policy: c.cfg.ismPolicyID(),
+	}, &policy, http.StatusNotFound)
+	if err != nil {
+		return err
+	}
+	params := map[string]string{}
+	if status == http.StatusOK {
+		params["if_seq_no"] = fmt.Sprint(policy.SeqNo)
+		params["if_primary_term"] = fmt.Sprint(policy.PrimaryTerm)
+	}
+
+	_, err = c.perform(ctx, jsonRequest{
+		method: http.MethodPut,
+		path:   "/_plugins/_ism/policies/" + url.PathEscape(c.cfg.ismPolicyID()),
+		params: params,
+		body:   buildISMPolicyBody(c.cfg),
+	}, nil)
+	return err
+}
+
+// putClosedIndexTemplate puts the index template applied to the closed workflow executions indices
+// created by rollover. The indices have the same mapping as the visibility index.
+func (c *clientImpl) putClosedIndexTemplate(ctx context.Context) error {
+	var indexMappings map[string]struct {
+		Mappings map[string]any `json:"mappings"`
+	}
+	if _, err := c.perform(ctx, opensearchapi.MappingGetReq{
+		Indices: []string{c.cfg.GetVisibilityIndex()},
+	}, &indexMappings); err != nil {
+		return err
+	}
+	var mappings map[string]any
+	for _, indexMapping := range indexMappings {
+		mappings = indexMapping.Mappings
+	}
+
+	// Legacy index template, composable index templates don't merge with the visibility index one.
+	_, err := c.perform(ctx, jsonRequest{
+		method: http.MethodPut,
+		path:   "/_template/" + url.PathEscape(c.cfg.closedIndexTemplateName()),
+		body: map[string]any{
+			"index_patterns": []string{c.cfg.closedIndexPattern()},
+			"order":          closedIndexTemplateOrder,
+			"settings": map[string]any{
+				"plugins.index_state_management.rollover_alias": c.cfg.ClosedIndexAlias(),
+			},
+			"aliases": map[string]any{
+				c.cfg.ReadAlias(): map[string]any{},
+			},
+			"mappings": mappings,
+		},
+	}, nil)
+	return err
+}
+
+// createClosedIndex creates the first closed workflow executions index and makes it the write index
+// of the rollover alias, unless the alias already exists.
+func (c *clientImpl) createClosedIndex(ctx context.Context) error {
+	status, err := c.perform(ctx, opensearchapi.AliasExistsReq{
+		Indices: []string{"_all"},
+		Alias:   []string{c.cfg.ClosedIndexAlias()},
+	}, nil, http.StatusNotFound)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+
+	_, err = c.CreateIndex(ctx, c.cfg.firstClosedIndex(), map[string]any{
+		"aliases": map[string]any{
+			c.cfg.ClosedIndexAlias(): map[string]any{"is_write_index": true},
+		},
+	})
+	return err
+}
+
+// addReadAlias adds the visibility index to the read alias. Closed workflow executions indices are
+// added by their index template.
+func (c *clientImpl) addReadAlias(ctx context.Context) error {
+	body, err := jsonBody(map[string]any{
+		"actions": []any{
+			map[string]any{
+				"add": map[string]any{
+					"index": c.cfg.GetVisibilityIndex(),
+					"alias": c.cfg.ReadAlias(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.perform(ctx, opensearchapi.AliasesReq{Body: body}, nil)
+	return err
+}
+
+// perform sends the request and decodes the response body into result. Error responses are returned
+// as *elastic.Error, like the Elasticsearch client does, unless their status is one of ignoreStatuses.
+func (c *clientImpl) perform(ctx context.Context, req opensearch.Request, result any, ignoreStatuses ...int) (int, error) {
+	resp, err := c.client.Do(ctx, req, nil)
+	if err != nil {
+		return 0, err
+	}
+	var body []byte
+	if resp.Body != nil {
+		defer func() { _ = resp.Body.Close() }()
+		if body, err = io.ReadAll(resp.Body); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	if resp.IsError() {
+		if slices.Contains(ignoreStatuses, resp.StatusCode) {
+			return resp.StatusCode, nil
+		}
+		return resp.StatusCode, newResponseError(resp.StatusCode, body)
+	}
+	if result == nil || len(body) == 0 {
+		return resp.StatusCode, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// Critical to ensure decode of int64 won't lose precision.
+	decoder.UseNumber()
+	if err := decoder.Decode(result); err != nil {
+		return resp.StatusCode, fmt.Errorf("unable to decode OpenSearch response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+func newResponseError(status int, body []byte) error {
+	respErr := &elastic.Error{}
+	if err := json.Unmarshal(body, respErr); err != nil || respErr.Details == nil {
+		respErr.Details = &elastic.ErrorDetails{Reason: string(body)}
+	}
+	respErr.Status = status
+	return respErr
+}
+
+func (r jsonRequest) GetRequest() (*http.Request, error) {
+	body, err := jsonBody(r.body)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.BuildRequest(r.method, r.path, body, r.params, nil)
+}
+
+func jsonBody(body any) (io.Reader, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+func sourceBody(searchSource *elastic.SearchSource) (io.Reader, error) {
+	source, err := searchSource.Source()
+	if err != nil {
+		return nil, err
+	}
+	return jsonBody(source)
+}
+
+func buildISMPolicyBody(cfg *Config) map[string]any {
+	rollover := map[string]any{
+		"min_index_age": formatTimeValue(cfg.IndexLifecycle.RolloverMinIndexAge),
+	}
+	if cfg.IndexLifecycle.RolloverMinDocCount > 0 {
+		rollover["min_doc_count"] = cfg.IndexLifecycle.RolloverMinDocCount
+	}
+	if cfg.IndexLifecycle.RolloverMinSize != "" {
+		rollover["min_size"] = cfg.IndexLifecycle.RolloverMinSize
+	}
+
+	states := []any{
+		map[string]any{
+			"name":        "rollover",
+			"actions":     []any{map[string]any{"rollover": rollover}},
+			"transitions": []any{},
+		},
+	}
+	if cfg.IndexLifecycle.DeleteMinIndexAge > 0 {
+		states[0].(map[string]any)["transitions"] = []any{
+			map[string]any{
+				"state_name": "delete",
+				"conditions": map[string]any{
+					"min_index_age": formatTimeValue(cfg.IndexLifecycle.DeleteMinIndexAge),
+				},
+			},
+		}
+		states = append(states, map[string]any{
+			"name":        "delete",
+			"actions":     []any{map[string]any{"delete": map[string]any{}}},
+			"transitions": []any{},
+		})
+	}
+
+	return map[string]any{
+		"policy": map[string]any{
+			"description":   fmt.Sprintf("Rolls over the closed workflow executions indices of %s.", cfg.GetVisibilityIndex()),
+			"default_state": "rollover",
+			"states":        states,
+			"ism_template": []any{
+				map[string]any{
+					"index_patterns": []string{cfg.closedIndexPattern()},
+					"priority":       ismTemplatePriority,
+				},
+			},
+		},
+	}
+}
+
+// formatTimeValue formats the duration with the largest OpenSearch time unit which represents it exactly.
+func formatTimeValue(d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%ds", d/time.Second)
+	default:
+		return fmt.Sprintf("%dms", d/time.Millisecond)
+	}
+}
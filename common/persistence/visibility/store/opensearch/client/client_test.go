@@ -0,0 +1,291 @@
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+)
+
+func newTestConfig(indexLifecycle IndexLifecycleConfig) *Config {
+	return &Config{
+		Config: esclient.Config{
+			Indices: map[string]string{esclient.VisibilityAppName: "temporal_visibility_v1"},
+		},
+		IndexLifecycle: indexLifecycle,
+	}
+}
+
+func Test_BuildISMPolicyBody(t *testing.T) {
+	cfg := newTestConfig(IndexLifecycleConfig{
+		Enabled:             true,
+		RolloverMinIndexAge: 24 * time.Hour,
+		RolloverMinSize:     "50gb",
+	})
+	body, err := json.Marshal(buildISMPolicyBody(cfg))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"policy": {
+			"description": "Rolls over the closed workflow executions indices of temporal_visibility_v1.",
+			"default_state": "rollover",
+			"states": [
+				{"name": "rollover", "actions": [{"rollover": {"min_index_age": "1d", "min_size": "50gb"}}], "transitions": []}
+			],
+			"ism_template": [{"index_patterns": ["temporal_visibility_v1_closed-*"], "priority": 100}]
+		}
+	}`, string(body))
+
+	cfg.IndexLifecycle.RolloverMinSize = ""
+	cfg.IndexLifecycle.RolloverMinDocCount = 1000
+	cfg.IndexLifecycle.DeleteMinIndexAge = 90 * 24 * time.Hour
+	body, err = json.Marshal(buildISMPolicyBody(cfg))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"policy": {
+			"description": "Rolls over the closed workflow executions indices of temporal_visibility_v1.",
+			"default_state": "rollover",
+			"states": [
+				{
+					"name": "rollover",
+					"actions": [{"rollover": {"min_index_age": "1d", "min_doc_count": 1000}}],
+					"transitions": [{"state_name": "delete", "conditions": {"min_index_age": "90d"}}]
+				},
+				{"name": "delete", "actions": [{"delete": {}}], "transitions": []}
+			],
+			"ism_template": [{"index_patterns": ["temporal_visibility_v1_closed-*"], "priority": 100}]
+		}
+	}`, string(body))
+}
+
+func Test_FormatTimeValue(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{input: 7 * 24 * time.Hour, expected: "7d"},
+		{input: 36 * time.Hour, expected: "36h"},
+		{input: 90 * time.Minute, expected: "90m"},
+		{input: 45 * time.Second, expected: "45s"},
+		{input: 1500 * time.Millisecond, expected: "1500ms"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, formatTimeValue(test.input))
+	}
+}
+
+func Test_ConfigIndexNames(t *testing.T) {
+	cfg := newTestConfig(IndexLifecycleConfig{})
+	assert.Equal(t, "temporal_visibility_v1", cfg.GetReadIndex())
+
+	cfg.IndexLifecycle.Enabled = true
+	assert.Equal(t, "temporal_visibility_v1_all", cfg.GetReadIndex())
+	assert.Equal(t, "temporal_visibility_v1_closed", cfg.ClosedIndexAlias())
+	assert.Equal(t, "temporal_visibility_v1_closed-000001", cfg.firstClosedIndex())
+	assert.Equal(t, "temporal_visibility_v1_closed_rollover", cfg.ismPolicyID())
+	assert.Equal(t, "temporal_visibility_v1_closed_template", cfg.closedIndexTemplateName())
+}
+
+func Test_ConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:   "unsupported version",
+			modify: func(cfg *Config) { cfg.Version = "v7" },
+			errMsg: "not supported OpenSearch version",
+		},
+		{
+			name:   "secondary visibility index",
+			modify: func(cfg *Config) { cfg.Indices[esclient.SecondaryVisibilityAppName] = "secondary" },
+			errMsg: "use secondaryVisibilityStore instead",
+		},
+		{
+			name:   "missing rollover age",
+			modify: func(cfg *Config) { cfg.IndexLifecycle.RolloverMinIndexAge = 0 },
+			errMsg: "rolloverMinIndexAge must be positive",
+		},
+		{
+			name:   "delete before rollover",
+			modify: func(cfg *Config) { cfg.IndexLifecycle.DeleteMinIndexAge = time.Hour },
+			errMsg: "deleteMinIndexAge must be greater than rolloverMinIndexAge",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig(IndexLifecycleConfig{
+				Enabled:             true,
+				RolloverMinIndexAge: 24 * time.Hour,
+				DeleteMinIndexAge:   30 * 24 * time.Hour,
+			})
+			test.modify(cfg)
+			err := cfg.Validate()
+			if test.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errMsg)
+			}
+		})
+	}
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *clientImpl {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := newTestConfig(IndexLifecycleConfig{})
+	cfg.URL = *serverURL
+	osClient, err := NewClient(cfg, nil, log.NewTestLogger())
+	require.NoError(t, err)
+	return osClient.(*clientImpl)
+}
+
+// readBody reads the request body, which the client compresses.
+func readBody(t *testing.T, r *http.Request) string {
+	body := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		body = gzipReader
+	}
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func Test_Search(t *testing.T) {
+	osClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/temporal_visibility_v1/_search", r.URL.Path)
+		assert.JSONEq(t, `{"query":{"term":{"WorkflowType":"wf"}},"size":10,"sort":[{"RunId":{"order":"asc"}}],"track_total_hits":false}`, readBody(t, r))
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_id":"doc","_source":{"WorkflowType":"wf"},"sort":[1234567890123456789]}]}}`))
+	})
+
+	result, err := osClient.Search(context.Background(), &esclient.SearchParameters{
+		Index:    "temporal_visibility_v1",
+		Query:    elastic.NewTermQuery("WorkflowType", "wf"),
+		PageSize: 10,
+		Sorter:   []elastic.Sorter{elastic.NewFieldSort("RunId").Asc()},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Hits.Hits, 1)
+	assert.Equal(t, "doc", result.Hits.Hits[0].Id)
+	// Numbers must not lose precision.
+	assert.Equal(t, []any{json.Number("1234567890123456789")}, result.Hits.Hits[0].Sort)
+}
+
+func Test_ErrorResponse(t *testing.T) {
+	osClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception","reason":"no such index [temporal_visibility_v1]"},"status":404}`))
+	})
+
+	_, err := osClient.Get(context.Background(), "temporal_visibility_v1", "wid/rid")
+	assert.True(t, elastic.IsNotFound(err))
+	var osErr *elastic.Error
+	require.ErrorAs(t, err, &osErr)
+	assert.Equal(t, "index_not_found_exception", osErr.Details.Type)
+
+	exists, err := osClient.IndexExists(context.Background(), "temporal_visibility_v1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func Test_BulkProcessor(t *testing.T) {
+	osClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_bulk", r.URL.Path)
+		lines := strings.Split(strings.TrimSuffix(readBody(t, r), "\n"), "\n")
+		assert.Equal(t, []string{
+			`{"index":{"_index":"temporal_visibility_v1","_id":"doc1","version":1,"version_type":"external"}}`,
+			`{"WorkflowType":"wf"}`,
+			`{"delete":{"_index":"temporal_visibility_v1","_id":"doc2","version":2,"version_type":"external"}}`,
+		}, lines)
+		_, _ = w.Write([]byte(`{"took":3,"items":[{"index":{"_id":"doc1","status":201}},{"delete":{"_id":"doc2","status":200}}]}`))
+	})
+
+	var beforeRequests []elastic.BulkableRequest
+	afterC := make(chan *elastic.BulkResponse, 1)
+	processor, err := osClient.RunBulkProcessor(context.Background(), &esclient.BulkProcessorParameters{
+		Name:          "test",
+		NumOfWorkers:  1,
+		BulkActions:   2,
+		FlushInterval: time.Hour,
+		BeforeFunc: func(_ int64, requests []elastic.BulkableRequest) {
+			beforeRequests = requests
+		},
+		AfterFunc: func(_ int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+			assert.NoError(t, err)
+			assert.Len(t, requests, 2)
+			afterC <- response
+		},
+	})
+	require.NoError(t, err)
+
+	processor.Add(&esclient.BulkableRequest{
+		RequestType: esclient.BulkableRequestTypeIndex,
+		Index:       "temporal_visibility_v1",
+		ID:          "doc1",
+		Version:     1,
+		Doc:         map[string]any{"WorkflowType": "wf"},
+	})
+	processor.Add(&esclient.BulkableRequest{
+		RequestType: esclient.BulkableRequestTypeDelete,
+		Index:       "temporal_visibility_v1",
+		ID:          "doc2",
+		Version:     2,
+	})
+
+	select {
+	case response := <-afterC:
+		assert.Len(t, beforeRequests, 2)
+		assert.Equal(t, 3, response.Took)
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, 201, response.Items[0]["index"].Status)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "bulk request wasn't committed")
+	}
+	require.NoError(t, processor.Stop())
+}
+
+func Test_BulkProcessor_StopCommitsPendingRequests(t *testing.T) {
+	var bulkRequests atomic.Int32
+	osClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		bulkRequests.Add(1)
+		_, _ = w.Write([]byte(`{"took":1,"items":[{"delete":{"_id":"doc","status":200}}]}`))
+	})
+
+	processor, err := osClient.RunBulkProcessor(context.Background(), &esclient.BulkProcessorParameters{
+		Name:          "test",
+		NumOfWorkers:  2,
+		BulkActions:   100,
+		FlushInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	processor.Add(&esclient.BulkableRequest{
+		RequestType: esclient.BulkableRequestTypeDelete,
+		Index:       "temporal_visibility_v1",
+		ID:          "doc",
+		Version:     1,
+	})
+	require.NoError(t, processor.Stop())
+	assert.Equal(t, int32(1), bulkRequests.Load())
+}
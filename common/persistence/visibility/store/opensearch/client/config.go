@@ -0,0 +1,109 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+)
+
+const (
+	closedIndexAliasSuffix    = "_closed"
+	readAliasSuffix           = "_all"
+	ismPolicyIDSuffix         = "_closed_rollover"
+	closedIndexTemplateSuffix = "_closed_template"
+	firstClosedIndexSuffix    = "-000001"
+)
+
+// Config for connecting to OpenSearch
+type (
+	Config struct {
+		// Connection settings are the same as Elasticsearch ones. Version must be empty, "v1" or "v2".
+		esclient.Config `yaml:",inline"`
+		// IndexLifecycle configures rolling the closed workflow executions over time based indices.
+		IndexLifecycle IndexLifecycleConfig `yaml:"indexLifecycle"`
+	}
+
+	// IndexLifecycleConfig represents the Index State Management (ISM) policy of the closed workflow
+	// executions indices. When enabled, running workflow executions stay in the visibility index,
+	// closed ones are moved to the "<visibility index>_closed" rollover alias, and workflow executions
+	// are searched in the "<visibility index>_all" alias, which spans the visibility index and all the
+	// closed workflow executions indices.
+	IndexLifecycleConfig struct {
+		Enabled bool `yaml:"enabled"`
+		// RolloverMinIndexAge is the age of the closed workflow executions write index after which a
+		// new index is created. Required.
+		RolloverMinIndexAge time.Duration `yaml:"rolloverMinIndexAge"`
+		// RolloverMinDocCount rolls the write index over when it has this many documents even if it's
+		// younger than RolloverMinIndexAge. Optional.
+		RolloverMinDocCount int64 `yaml:"rolloverMinDocCount"`
+		// RolloverMinSize rolls the write index over when its primary shards reach this size (e.g. "50gb")
+		// even if it's younger than RolloverMinIndexAge. Optional.
+		RolloverMinSize string `yaml:"rolloverMinSize"`
+		// DeleteMinIndexAge is the age after which closed workflow executions indices are deleted. Zero
+		// keeps them until the workflow executions are deleted by the namespace retention.
+		DeleteMinIndexAge time.Duration `yaml:"deleteMinIndexAge"`
+	}
+)
+
+// ClosedIndexAlias returns the rollover alias the closed workflow executions are written to.
+func (cfg *Config) ClosedIndexAlias() string {
+	return cfg.GetVisibilityIndex() + closedIndexAliasSuffix
+}
+
+// ReadAlias returns the alias spanning the visibility index and all the closed workflow executions
+// indices.
+func (cfg *Config) ReadAlias() string {
+	return cfg.GetVisibilityIndex() + readAliasSuffix
+}
+
+// GetReadIndex returns the index or alias workflow executions are searched in.
+func (cfg *Config) GetReadIndex() string {
+	if cfg.IndexLifecycle.Enabled {
+		return cfg.ReadAlias()
+	}
+	return cfg.GetVisibilityIndex()
+}
+
+func (cfg *Config) ismPolicyID() string {
+	return cfg.GetVisibilityIndex() + ismPolicyIDSuffix
+}
+
+func (cfg *Config) closedIndexTemplateName() string {
+	return cfg.GetVisibilityIndex() + closedIndexTemplateSuffix
+}
+
+func (cfg *Config) closedIndexPattern() string {
+	return cfg.ClosedIndexAlias() + "-*"
+}
+
+func (cfg *Config) firstClosedIndex() string {
+	return cfg.ClosedIndexAlias() + firstClosedIndexSuffix
+}
+
+func (cfg *Config) Validate() error {
+	if cfg == nil {
+		return errors.New("opensearch config: config not found")
+	}
+	switch cfg.Version {
+	case "v1", "v2", "":
+	default:
+		return fmt.Errorf("opensearch config: not supported OpenSearch version: %v", cfg.Version)
+	}
+	if err := cfg.Config.Validate(); err != nil {
+		return err
+	}
+	if cfg.GetSecondaryVisibilityIndex() != "" {
+		return fmt.Errorf("opensearch config: indices configuration: %q key is not supported, use secondaryVisibilityStore instead", esclient.SecondaryVisibilityAppName)
+	}
+	if cfg.IndexLifecycle.Enabled {
+		if cfg.IndexLifecycle.RolloverMinIndexAge <= 0 {
+			return errors.New("opensearch config: indexLifecycle: rolloverMinIndexAge must be positive")
+		}
+		if cfg.IndexLifecycle.DeleteMinIndexAge != 0 && cfg.IndexLifecycle.DeleteMinIndexAge <= cfg.IndexLifecycle.RolloverMinIndexAge {
+			return errors.New("opensearch config: indexLifecycle: deleteMinIndexAge must be greater than rolloverMinIndexAge")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v4/opensearchtransport"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// roundTripLogger logs the OpenSearch requests with the same log levels as the Elasticsearch
+	// client: "error" (default) logs failed requests, "info" and "trace" log all of them.
+	roundTripLogger struct {
+		logger  log.Logger
+		logInfo bool
+	}
+)
+
+var _ opensearchtransport.Logger = (*roundTripLogger)(nil)
+
+func newRoundTripLogger(logLevel string, logger log.Logger) opensearchtransport.Logger {
+	switch {
+	case strings.EqualFold(logLevel, "trace"), strings.EqualFold(logLevel, "info"):
+		return &roundTripLogger{logger: logger, logInfo: true}
+	case strings.EqualFold(logLevel, "error"), logLevel == "": // Default is to log errors only.
+		return &roundTripLogger{logger: logger}
+	default:
+		return nil
+	}
+}
+
+func (l *roundTripLogger) LogRoundTrip(req *http.Request, res *http.Response, err error, _ time.Time, duration time.Duration) error {
+	if err != nil {
+		l.logger.Error("OpenSearch request failed.", tag.Error(err), tag.Value(requestLine(req)))
+		return nil
+	}
+	if l.logInfo && res != nil {
+		l.logger.Info("OpenSearch request.", tag.Value(requestLine(req)), tag.ESResponseStatus(res.StatusCode), tag.NewDurationTag("duration", duration))
+	}
+	return nil
+}
+
+func (l *roundTripLogger) RequestBodyEnabled() bool {
+	return false
+}
+
+func (l *roundTripLogger) ResponseBodyEnabled() bool {
+	return false
+}
+
+func requestLine(req *http.Request) string {
+	if req == nil || req.URL == nil {
+		return ""
+	}
+	return req.Method + " " + req.URL.Redacted()
+}
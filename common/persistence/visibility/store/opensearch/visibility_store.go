@@ -0,0 +1,298 @@
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/olivere/elastic/v7"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/persistence/visibility/store/opensearch/client"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	PersistenceName = "opensearch"
+)
+
+type (
+	// VisibilityStore is the visibility store backed by OpenSearch. Queries, documents and search
+	// attributes are the same as the Elasticsearch visibility store ones, and are sent by the OpenSearch
+	// client, see client.Client. Search results are paginated with scroll instead of point in time.
+	//
+	// If index lifecycle is enabled, closed workflow executions are moved from the visibility index to
+	// the write index of the closed workflow executions rollover alias, whose indices are rolled over
+	// and deleted by age by an ISM policy. Workflow executions are searched in an alias spanning the
+	// visibility index and all the closed workflow executions indices.
+	VisibilityStore struct {
+		*elasticsearch.VisibilityStore
+		osClient client.Client
+		cfg      *client.Config
+
+		indexLifecycleLock  sync.Mutex
+		indexLifecycleReady atomic.Bool
+	}
+)
+
+var _ store.VisibilityStore = (*VisibilityStore)(nil)
+
+// NewVisibilityStore create a visibility store connecting to OpenSearch
+func NewVisibilityStore(
+	cfg *client.Config,
+	processorConfig *elasticsearch.ProcessorConfig,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	enableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (*VisibilityStore, error) {
+	osHttpClient := cfg.GetHttpClient()
+	if osHttpClient == nil {
+		var err error
+		osHttpClient, err = esclient.NewAwsHttpClient(cfg.AWSRequestSigning)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create AWS HTTP client for OpenSearch: %w", err)
+		}
+	}
+	osClient, err := client.NewClient(cfg, osHttpClient, logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OpenSearch client (URL = %v, username = %q): %w",
+			cfg.URL.Redacted(), cfg.Username, err)
+	}
+	var (
+		processor           elasticsearch.Processor
+		processorAckTimeout dynamicconfig.DurationPropertyFn
+	)
+	if processorConfig != nil {
+		processor = elasticsearch.NewProcessor(processorConfig, osClient, logger, metricsHandler)
+		processor.Start()
+		processorAckTimeout = processorConfig.ESProcessorAckTimeout
+	}
+	return &VisibilityStore{
+		VisibilityStore: elasticsearch.NewVisibilityStoreWithClient(
+			osClient,
+			cfg.GetVisibilityIndex(),
+			cfg.GetReadIndex(),
+			processor,
+			processorAckTimeout,
+			searchAttributesProvider,
+			searchAttributesMapperProvider,
+			disableOrderByClause,
+			enableManualPagination,
+			metricsHandler,
+		),
+		osClient: osClient,
+		cfg:      cfg,
+	}, nil
+}
+
+func (s *VisibilityStore) GetName() string {
+	return PersistenceName
+}
+
+func (s *VisibilityStore) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionClosedRequest,
+) error {
+	if !s.cfg.IndexLifecycle.Enabled {
+		return s.VisibilityStore.RecordWorkflowExecutionClosed(ctx, request)
+	}
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return err
+	}
+
+	visibilityTaskKey := elasticsearch.GetVisibilityTaskKey(request.ShardID, request.TaskID)
+	doc, err := s.GenerateClosedESDoc(request, visibilityTaskKey)
+	if err != nil {
+		return err
+	}
+	docID := elasticsearch.GetDocID(request.WorkflowID, request.RunID)
+
+	// The closed workflow execution is indexed before it's deleted from the visibility index, so that
+	// it's always found by searches. Both requests use the close task ID as version: the deletion
+	// is ignored if the document was already deleted, and stale started or upsert requests processed
+	// later are rejected while the deletion is remembered by the visibility index.
+	err = s.AddBulkRequestAndWait(ctx, &esclient.BulkableRequest{
+		Index:       s.cfg.ClosedIndexAlias(),
+		ID:          docID,
+		Version:     request.TaskID,
+		RequestType: esclient.BulkableRequestTypeIndex,
+		Doc:         doc,
+	}, visibilityTaskKey)
+	if err != nil {
+		return err
+	}
+	return s.AddBulkRequestAndWait(ctx, &esclient.BulkableRequest{
+		Index:       s.GetIndexName(),
+		ID:          docID,
+		Version:     request.TaskID,
+		RequestType: esclient.BulkableRequestTypeDelete,
+	}, docID)
+}
+
+func (s *VisibilityStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *manager.VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	if err := s.VisibilityStore.DeleteWorkflowExecution(ctx, request); err != nil {
+		return err
+	}
+	if !s.cfg.IndexLifecycle.Enabled {
+		return nil
+	}
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return err
+	}
+
+	// Documents can only be deleted from a concrete index, which is looked up first because the
+	// closed workflow execution can be in any of the rolled over indices.
+	docID := elasticsearch.GetDocID(request.WorkflowID, request.RunID)
+	hits, err := s.searchDocID(ctx, s.cfg.ClosedIndexAlias(), docID)
+	if err != nil {
+		return err
+	}
+	for _, hit := range hits {
+		err := s.AddBulkRequestAndWait(ctx, &esclient.BulkableRequest{
+			Index:       hit.Index,
+			ID:          docID,
+			Version:     request.TaskID,
+			RequestType: esclient.BulkableRequestTypeDelete,
+		}, docID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *VisibilityStore) ListWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return nil, err
+	}
+	return s.VisibilityStore.ListWorkflowExecutions(ctx, request)
+}
+
+func (s *VisibilityStore) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return nil, err
+	}
+	return s.VisibilityStore.ScanWorkflowExecutions(ctx, request)
+}
+
+func (s *VisibilityStore) CountWorkflowExecutions(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsRequest,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return nil, err
+	}
+	return s.VisibilityStore.CountWorkflowExecutions(ctx, request)
+}
+
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return nil, err
+	}
+	return s.VisibilityStore.AggregateWorkflowExecutions(ctx, request)
+}
+
+func (s *VisibilityStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *manager.GetWorkflowExecutionRequest,
+) (*store.InternalGetWorkflowExecutionResponse, error) {
+	if !s.cfg.IndexLifecycle.Enabled {
+		return s.VisibilityStore.GetWorkflowExecution(ctx, request)
+	}
+	if err := s.ensureIndexLifecycle(ctx); err != nil {
+		return nil, err
+	}
+
+	// Get API doesn't support aliases pointing to multiple indices.
+	hits, err := s.searchDocID(ctx, s.cfg.ReadAlias(), elasticsearch.GetDocID(request.WorkflowID, request.RunID))
+	if err != nil {
+		return nil, err
+	}
+	if len(hits) == 0 {
+		return nil, serviceerror.NewNotFoundf(
+			"Workflow execution with RunId %s not found", request.RunID,
+		)
+	}
+
+	typeMap, err := s.GetSearchAttributesProvider().GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(
+			"unable to read search attribute types: %v", err,
+		)
+	}
+
+	// The workflow execution is in both the visibility index and a closed workflow executions index
+	// while it's being closed. The closed one is the latest.
+	var execution *store.InternalWorkflowExecutionInfo
+	for _, hit := range hits {
+		info, err := s.ParseESDoc(hit.Id, hit.Source, typeMap, request.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if execution == nil || !info.CloseTime.IsZero() {
+			execution = info
+		}
+	}
+	return &store.InternalGetWorkflowExecutionResponse{
+		Execution: execution,
+	}, nil
+}
+
+func (s *VisibilityStore) searchDocID(ctx context.Context, index string, docID string) ([]*elastic.SearchHit, error) {
+	// At most one document per index: the visibility index and the closed workflow executions index.
+	const maxHits = 2
+	result, err := s.osClient.Search(ctx, &esclient.SearchParameters{
+		Index:    index,
+		Query:    elastic.NewIdsQuery().Ids(docID),
+		PageSize: maxHits,
+	})
+	if err != nil {
+		return nil, elasticsearch.ConvertElasticsearchClientError("Search failed", err)
+	}
+	if result.Hits == nil {
+		return nil, nil
+	}
+	return result.Hits.Hits, nil
+}
+
+// ensureIndexLifecycle sets up the ISM policy and the aliases of the closed workflow executions
+// indices before they are first used.
+func (s *VisibilityStore) ensureIndexLifecycle(ctx context.Context) error {
+	if !s.cfg.IndexLifecycle.Enabled || s.indexLifecycleReady.Load() {
+		return nil
+	}
+
+	s.indexLifecycleLock.Lock()
+	defer s.indexLifecycleLock.Unlock()
+
+	if s.indexLifecycleReady.Load() {
+		return nil
+	}
+	if err := s.osClient.EnsureIndexLifecycle(ctx); err != nil {
+		return serviceerror.NewUnavailablef("unable to set up OpenSearch index lifecycle: %v", err)
+	}
+	s.indexLifecycleReady.Store(true)
+	return nil
+}
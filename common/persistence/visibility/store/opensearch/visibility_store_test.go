@@ -0,0 +1,277 @@
+package opensearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/future"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/persistence/visibility/store/opensearch/client"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	visibilityStoreSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockOSClient    *client.MockClient
+		mockProcessor   *elasticsearch.MockProcessor
+		cfg             *client.Config
+		visibilityStore *VisibilityStore
+	}
+)
+
+const (
+	testIndex       = "test-index"
+	testNamespace   = namespace.Name("test-namespace")
+	testNamespaceID = namespace.ID("bfd5c907-f899-4baf-a7b2-2ab85e623ebd")
+)
+
+func TestVisibilityStoreSuite(t *testing.T) {
+	suite.Run(t, new(visibilityStoreSuite))
+}
+
+func (s *visibilityStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockOSClient = client.NewMockClient(s.controller)
+	s.mockProcessor = elasticsearch.NewMockProcessor(s.controller)
+	s.cfg = &client.Config{
+		Config: esclient.Config{
+			Indices: map[string]string{esclient.VisibilityAppName: testIndex},
+		},
+		IndexLifecycle: client.IndexLifecycleConfig{
+			Enabled:             true,
+			RolloverMinIndexAge: 24 * time.Hour,
+		},
+	}
+	s.visibilityStore = s.newVisibilityStore()
+}
+
+func (s *visibilityStoreSuite) newVisibilityStore() *VisibilityStore {
+	return &VisibilityStore{
+		VisibilityStore: elasticsearch.NewVisibilityStoreWithClient(
+			s.mockOSClient,
+			s.cfg.GetVisibilityIndex(),
+			s.cfg.GetReadIndex(),
+			s.mockProcessor,
+			dynamicconfig.GetDurationPropertyFn(time.Minute),
+			searchattribute.NewTestProvider(),
+			searchattribute.NewTestMapperProvider(nil),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+			metrics.NoopMetricsHandler,
+		),
+		osClient: s.mockOSClient,
+		cfg:      s.cfg,
+	}
+}
+
+func (s *visibilityStoreSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityStoreSuite) expectBulkRequest(index string, requestType esclient.BulkableRequestType, visibilityTaskKey string) {
+	s.mockProcessor.EXPECT().Add(gomock.Any(), visibilityTaskKey).
+		DoAndReturn(func(bulkRequest *esclient.BulkableRequest, _ string) *future.FutureImpl[bool] {
+			s.Equal(index, bulkRequest.Index)
+			s.Equal(requestType, bulkRequest.RequestType)
+			s.Equal("wid~rid", bulkRequest.ID)
+			s.EqualValues(111, bulkRequest.Version)
+			f := future.NewFuture[bool]()
+			f.Set(true, nil)
+			return f
+		})
+}
+
+func (s *visibilityStoreSuite) TestGetName() {
+	s.Equal(PersistenceName, s.visibilityStore.GetName())
+	s.Equal(testIndex, s.visibilityStore.GetIndexName())
+}
+
+func (s *visibilityStoreSuite) TestRecordWorkflowExecutionClosed() {
+	request := &store.InternalRecordWorkflowExecutionClosedRequest{
+		InternalVisibilityRequestBase: &store.InternalVisibilityRequestBase{
+			NamespaceID: testNamespaceID.String(),
+			WorkflowID:  "wid",
+			RunID:       "rid",
+			TaskID:      111,
+			ShardID:     2208,
+			Status:      enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		CloseTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s.mockOSClient.EXPECT().EnsureIndexLifecycle(gomock.Any()).Return(nil)
+	gomock.InOrder(
+		s.mockProcessor.EXPECT().Add(gomock.Any(), "2208~111").
+			DoAndReturn(func(bulkRequest *esclient.BulkableRequest, _ string) *future.FutureImpl[bool] {
+				s.Equal("test-index_closed", bulkRequest.Index)
+				s.Equal(esclient.BulkableRequestTypeIndex, bulkRequest.RequestType)
+				s.Equal("wid~rid", bulkRequest.ID)
+				s.EqualValues(111, bulkRequest.Version)
+				s.Equal(request.CloseTime, bulkRequest.Doc[searchattribute.CloseTime])
+				f := future.NewFuture[bool]()
+				f.Set(true, nil)
+				return f
+			}),
+		s.mockProcessor.EXPECT().Add(gomock.Any(), "wid~rid").
+			DoAndReturn(func(bulkRequest *esclient.BulkableRequest, _ string) *future.FutureImpl[bool] {
+				s.Equal(testIndex, bulkRequest.Index)
+				s.Equal(esclient.BulkableRequestTypeDelete, bulkRequest.RequestType)
+				s.EqualValues(111, bulkRequest.Version)
+				f := future.NewFuture[bool]()
+				f.Set(true, nil)
+				return f
+			}),
+	)
+	s.NoError(s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request))
+
+	// Index lifecycle is set up only once.
+	s.expectBulkRequest("test-index_closed", esclient.BulkableRequestTypeIndex, "2208~111")
+	s.expectBulkRequest(testIndex, esclient.BulkableRequestTypeDelete, "wid~rid")
+	s.NoError(s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request))
+}
+
+func (s *visibilityStoreSuite) TestRecordWorkflowExecutionClosed_IndexLifecycleDisabled() {
+	s.cfg.IndexLifecycle.Enabled = false
+	s.visibilityStore = s.newVisibilityStore()
+
+	request := &store.InternalRecordWorkflowExecutionClosedRequest{
+		InternalVisibilityRequestBase: &store.InternalVisibilityRequestBase{
+			WorkflowID: "wid",
+			RunID:      "rid",
+			TaskID:     111,
+			ShardID:    2208,
+			Status:     enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}
+	s.expectBulkRequest(testIndex, esclient.BulkableRequestTypeIndex, "2208~111")
+	s.NoError(s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request))
+}
+
+func (s *visibilityStoreSuite) TestRecordWorkflowExecutionClosed_IndexLifecycleSetupFailed() {
+	request := &store.InternalRecordWorkflowExecutionClosedRequest{
+		InternalVisibilityRequestBase: &store.InternalVisibilityRequestBase{
+			WorkflowID: "wid",
+			RunID:      "rid",
+			TaskID:     111,
+		},
+	}
+	s.mockOSClient.EXPECT().EnsureIndexLifecycle(gomock.Any()).Return(errors.New("boom"))
+	err := s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request)
+	var unavailableErr *serviceerror.Unavailable
+	s.ErrorAs(err, &unavailableErr)
+}
+
+func (s *visibilityStoreSuite) TestDeleteWorkflowExecution() {
+	request := &manager.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  "wid",
+		RunID:       "rid",
+		TaskID:      111,
+	}
+
+	s.mockOSClient.EXPECT().EnsureIndexLifecycle(gomock.Any()).Return(nil)
+	s.mockOSClient.EXPECT().Search(gomock.Any(), &esclient.SearchParameters{
+		Index:    "test-index_closed",
+		Query:    elastic.NewIdsQuery().Ids("wid~rid"),
+		PageSize: 2,
+	}).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{{Index: "test-index_closed-000002", Id: "wid~rid"}},
+		},
+	}, nil)
+	gomock.InOrder(
+		s.expectBulkRequestCall(testIndex),
+		s.expectBulkRequestCall("test-index_closed-000002"),
+	)
+	s.NoError(s.visibilityStore.DeleteWorkflowExecution(context.Background(), request))
+}
+
+func (s *visibilityStoreSuite) expectBulkRequestCall(index string) *gomock.Call {
+	return s.mockProcessor.EXPECT().Add(gomock.Any(), "wid~rid").
+		DoAndReturn(func(bulkRequest *esclient.BulkableRequest, _ string) *future.FutureImpl[bool] {
+			s.Equal(index, bulkRequest.Index)
+			s.Equal(esclient.BulkableRequestTypeDelete, bulkRequest.RequestType)
+			s.EqualValues(111, bulkRequest.Version)
+			f := future.NewFuture[bool]()
+			f.Set(true, nil)
+			return f
+		})
+}
+
+func (s *visibilityStoreSuite) TestGetWorkflowExecution() {
+	request := &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		WorkflowID:  "wid",
+		RunID:       "rid",
+	}
+	runningDoc, err := json.Marshal(map[string]any{
+		searchattribute.WorkflowID:      "wid",
+		searchattribute.RunID:           "rid",
+		searchattribute.ExecutionStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+		searchattribute.StartTime:       "2024-01-01T00:00:00Z",
+	})
+	s.NoError(err)
+	closedDoc, err := json.Marshal(map[string]any{
+		searchattribute.WorkflowID:      "wid",
+		searchattribute.RunID:           "rid",
+		searchattribute.ExecutionStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(),
+		searchattribute.StartTime:       "2024-01-01T00:00:00Z",
+		searchattribute.CloseTime:       "2024-01-02T00:00:00Z",
+	})
+	s.NoError(err)
+
+	s.mockOSClient.EXPECT().EnsureIndexLifecycle(gomock.Any()).Return(nil)
+	s.mockOSClient.EXPECT().Search(gomock.Any(), &esclient.SearchParameters{
+		Index:    "test-index_all",
+		Query:    elastic.NewIdsQuery().Ids("wid~rid"),
+		PageSize: 2,
+	}).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{
+				{Index: "test-index_closed-000001", Id: "wid~rid", Source: closedDoc},
+				{Index: testIndex, Id: "wid~rid", Source: runningDoc},
+			},
+		},
+	}, nil)
+	resp, err := s.visibilityStore.GetWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.Execution.Status)
+	s.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), resp.Execution.CloseTime)
+
+	s.mockOSClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&elastic.SearchResult{}, nil)
+	_, err = s.visibilityStore.GetWorkflowExecution(context.Background(), request)
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
+}
+
+func (s *visibilityStoreSuite) TestCountWorkflowExecutions() {
+	s.mockOSClient.EXPECT().EnsureIndexLifecycle(gomock.Any()).Return(nil)
+	s.mockOSClient.EXPECT().Count(gomock.Any(), "test-index_all", gomock.Any()).Return(int64(10), nil)
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+	})
+	s.NoError(err)
+	s.EqualValues(10, resp.Count)
+}
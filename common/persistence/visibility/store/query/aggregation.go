@@ -0,0 +1,63 @@
+package query
+
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+// ValidateAggregationGroupBy returns an error if the search attribute can't be used as a group key
+// of an aggregation query. Only Keyword search attributes can be grouped by.
+func ValidateAggregationGroupBy(name string, saType enumspb.IndexedValueType) error {
+	if saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		return NewConverterError(
+			"%s: unable to group by search attribute %s of type %s",
+			NotSupportedErrMessage,
+			name,
+			saType,
+		)
+	}
+	return nil
+}
+
+// ValidateAggregationFunction returns an error if the aggregation function can't be computed over
+// the search attribute. Min and max are supported for Int, Double and Datetime search attributes,
+// avg only for Int and Double search attributes.
+func ValidateAggregationFunction(
+	function enumsspb.VisibilityAggregationFunction,
+	name string,
+	saType enumspb.IndexedValueType,
+) error {
+	switch function {
+	case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN, enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX:
+		switch saType {
+		case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+			return nil
+		}
+	case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG:
+		switch saType {
+		case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+			return nil
+		}
+	default:
+		return NewConverterError("%s: aggregation function %s", NotSupportedErrMessage, function)
+	}
+	return NewConverterError(
+		"%s: unable to compute %s of search attribute %s of type %s",
+		NotSupportedErrMessage,
+		function,
+		name,
+		saType,
+	)
+}
+
+// AggregationValueType returns the type of the result of the aggregation function computed over a
+// search attribute of the given type.
+func AggregationValueType(
+	function enumsspb.VisibilityAggregationFunction,
+	saType enumspb.IndexedValueType,
+) enumspb.IndexedValueType {
+	if function == enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG {
+		return enumspb.INDEXED_VALUE_TYPE_DOUBLE
+	}
+	return saType
+}
@@ -29,6 +29,7 @@ type (
 		RangeCond      ExprConverter
 		ComparisonExpr ExprConverter
 		Is             ExprConverter
+		Match          ExprConverter
 	}
 
 	andConverter struct {
@@ -56,6 +57,12 @@ type (
 		fnInterceptor FieldNameInterceptor
 	}
 
+	matchConverter struct {
+		fnInterceptor FieldNameInterceptor
+		fvInterceptor FieldValuesInterceptor
+		saNameType    searchattribute.NameTypeMap
+	}
+
 	notSupportedExprConverter struct{}
 
 	QueryParams struct {
@@ -65,6 +72,19 @@ type (
 	}
 )
 
+const (
+	// ContainsFuncName, EndsWithFuncName and ILikeFuncName are the pattern matching
+	// functions supported on Keyword search attributes:
+	//   - contains(WorkflowId, 'abc') matches values containing abc;
+	//   - ends_with(WorkflowId, 'abc') matches values ending with abc;
+	//   - ilike(WorkflowId, 'a%b_c') matches values against a case-insensitive pattern
+	//     where % matches any sequence of characters and _ matches a single one.
+	// contains and ends_with are case-sensitive like the other Keyword operators.
+	ContainsFuncName = "contains"
+	EndsWithFuncName = "ends_with"
+	ILikeFuncName    = "ilike"
+)
+
 func NewConverter(fnInterceptor FieldNameInterceptor, whereConverter ExprConverter) *Converter {
 	if fnInterceptor == nil {
 		fnInterceptor = &NopFieldNameInterceptor{}
@@ -80,7 +100,8 @@ func NewWhereConverter(
 	or ExprConverter,
 	rangeCond ExprConverter,
 	comparisonExpr ExprConverter,
-	is ExprConverter) ExprConverter {
+	is ExprConverter,
+	match ExprConverter) ExprConverter {
 	if and == nil {
 		and = &notSupportedExprConverter{}
 	}
@@ -101,12 +122,17 @@ func NewWhereConverter(
 		is = &notSupportedExprConverter{}
 	}
 
+	if match == nil {
+		match = &notSupportedExprConverter{}
+	}
+
 	return &WhereConverter{
 		And:            and,
 		Or:             or,
 		RangeCond:      rangeCond,
 		ComparisonExpr: comparisonExpr,
 		Is:             is,
+		Match:          match,
 	}
 }
 
@@ -166,6 +192,27 @@ func NewIsConverter(fnInterceptor FieldNameInterceptor) ExprConverter {
 	}
 }
 
+// NewMatchConverter returns a converter for the pattern matching functions
+// (see ContainsFuncName) and for the full-text `match(TextAttribute) against ('words')`
+// expression, which matches Text search attributes containing all the words.
+func NewMatchConverter(
+	fnInterceptor FieldNameInterceptor,
+	fvInterceptor FieldValuesInterceptor,
+	saNameType searchattribute.NameTypeMap,
+) ExprConverter {
+	if fnInterceptor == nil {
+		fnInterceptor = &NopFieldNameInterceptor{}
+	}
+	if fvInterceptor == nil {
+		fvInterceptor = &NopFieldValuesInterceptor{}
+	}
+	return &matchConverter{
+		fnInterceptor: fnInterceptor,
+		fvInterceptor: fvInterceptor,
+		saNameType:    saNameType,
+	}
+}
+
 func NewNotSupportedExprConverter() ExprConverter {
 	return &notSupportedExprConverter{}
 }
@@ -270,9 +317,24 @@ func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	case *sqlparser.IsExpr:
 		return w.Is.Convert(e)
 	case *sqlparser.NotExpr:
+		// Only the match expressions can be negated: other expressions have their own negated operators.
+		notExpr := e.Expr
+		for parenExpr, ok := notExpr.(*sqlparser.ParenExpr); ok; parenExpr, ok = notExpr.(*sqlparser.ParenExpr) {
+			notExpr = parenExpr.Expr
+		}
+		switch notExpr.(type) {
+		case *sqlparser.FuncExpr, *sqlparser.MatchExpr:
+			query, err := w.Match.Convert(notExpr)
+			if err != nil {
+				return nil, err
+			}
+			return elastic.NewBoolQuery().MustNot(query), nil
+		}
 		return nil, NewConverterError("%s: 'not' expression", NotSupportedErrMessage)
 	case *sqlparser.FuncExpr:
-		return nil, NewConverterError("%s: function expression", NotSupportedErrMessage)
+		return w.Match.Convert(e)
+	case *sqlparser.MatchExpr:
+		return w.Match.Convert(e)
 	case *sqlparser.ColName:
 		return nil, NewConverterError("incomplete expression")
 	default:
@@ -499,6 +561,207 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 	return query, nil
 }
 
+func (m *matchConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
+	switch e := expr.(type) {
+	case *sqlparser.FuncExpr:
+		return m.convertFuncExpr(e)
+	case *sqlparser.MatchExpr:
+		return m.convertMatchExpr(e)
+	default:
+		return nil, NewConverterError("%v is not a match expression", sqlparser.String(expr))
+	}
+}
+
+func (m *matchConverter) convertFuncExpr(funcExpr *sqlparser.FuncExpr) (elastic.Query, error) {
+	colNameExpr, valueExpr, err := GetFuncExprArgs(funcExpr)
+	if err != nil {
+		return nil, err
+	}
+	funcName := funcExpr.Name.Lowered()
+
+	alias, colName, err := convertColName(m.fnInterceptor, colNameExpr, FieldNameFilter)
+	if err != nil {
+		return nil, wrapConverterError(
+			fmt.Sprintf("unable to convert first argument of %q", sqlparser.String(funcExpr)),
+			err,
+		)
+	}
+	tp, err := m.saNameType.GetType(colName)
+	if err != nil {
+		return nil, err
+	}
+	if tp != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		return nil, NewConverterError(
+			"%s: function '%s' can only be used with Keyword type search attributes, but %s is of type %s",
+			InvalidExpressionErrMessage,
+			funcName,
+			alias,
+			tp.String(),
+		)
+	}
+
+	value, err := m.convertStringValue(alias, colName, valueExpr)
+	if err != nil {
+		return nil, wrapConverterError(
+			fmt.Sprintf("unable to convert second argument of %q", sqlparser.String(funcExpr)),
+			err,
+		)
+	}
+
+	switch funcName {
+	case ContainsFuncName:
+		return elastic.NewWildcardQuery(colName, "*"+escapeWildcardValue(value)+"*"), nil
+	case EndsWithFuncName:
+		return elastic.NewWildcardQuery(colName, "*"+escapeWildcardValue(value)), nil
+	case ILikeFuncName:
+		return elastic.NewWildcardQuery(colName, likePatternToWildcard(value)).CaseInsensitive(true), nil
+	default:
+		// this should never happen since GetFuncExprArgs should already fail
+		return nil, NewConverterError("%s: function '%s'", NotSupportedErrMessage, funcName)
+	}
+}
+
+func (m *matchConverter) convertMatchExpr(matchExpr *sqlparser.MatchExpr) (elastic.Query, error) {
+	colNameExpr, valueExpr, err := GetMatchExprArgs(matchExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	alias, colName, err := convertColName(m.fnInterceptor, colNameExpr, FieldNameFilter)
+	if err != nil {
+		return nil, wrapConverterError(
+			fmt.Sprintf("unable to convert column of %q", sqlparser.String(matchExpr)),
+			err,
+		)
+	}
+	tp, err := m.saNameType.GetType(colName)
+	if err != nil {
+		return nil, err
+	}
+	if tp != enumspb.INDEXED_VALUE_TYPE_TEXT {
+		return nil, NewConverterError(
+			"%s: 'match' can only be used with Text type search attributes, but %s is of type %s",
+			InvalidExpressionErrMessage,
+			alias,
+			tp.String(),
+		)
+	}
+
+	value, err := m.convertStringValue(alias, colName, valueExpr)
+	if err != nil {
+		return nil, wrapConverterError(
+			fmt.Sprintf("unable to convert value of %q", sqlparser.String(matchExpr)),
+			err,
+		)
+	}
+	return elastic.NewMatchQuery(colName, value).Operator("and"), nil
+}
+
+func (m *matchConverter) convertStringValue(alias string, colName string, expr sqlparser.Expr) (string, error) {
+	colValue, err := convertComparisonExprValue(expr)
+	if err != nil {
+		return "", err
+	}
+	colValues, err := m.fvInterceptor.Values(alias, colName, colValue)
+	if err != nil {
+		return "", err
+	}
+	value, ok := colValues[0].(string)
+	if !ok {
+		return "", NewConverterError("%s: value must be a string", InvalidExpressionErrMessage)
+	}
+	return value, nil
+}
+
+// GetFuncExprArgs validates that funcExpr is one of the pattern matching functions
+// and returns its column name and value arguments.
+func GetFuncExprArgs(funcExpr *sqlparser.FuncExpr) (sqlparser.Expr, sqlparser.Expr, error) {
+	funcName := funcExpr.Name.Lowered()
+	switch funcName {
+	case ContainsFuncName, EndsWithFuncName, ILikeFuncName:
+	default:
+		return nil, nil, NewConverterError("%s: function '%s'", NotSupportedErrMessage, funcName)
+	}
+	if !funcExpr.Qualifier.IsEmpty() || funcExpr.Distinct || len(funcExpr.Exprs) != 2 {
+		return nil, nil, NewConverterError(
+			"%s: function '%s' takes a search attribute name and a string value (got %s)",
+			InvalidExpressionErrMessage,
+			funcName,
+			sqlparser.String(funcExpr),
+		)
+	}
+	args := make([]sqlparser.Expr, 0, 2)
+	for _, arg := range funcExpr.Exprs {
+		aliasedExpr, ok := arg.(*sqlparser.AliasedExpr)
+		if !ok || !aliasedExpr.As.IsEmpty() {
+			return nil, nil, NewConverterError(
+				"%s: unexpected argument %s of function '%s'",
+				InvalidExpressionErrMessage,
+				sqlparser.String(arg),
+				funcName,
+			)
+		}
+		args = append(args, aliasedExpr.Expr)
+	}
+	return args[0], args[1], nil
+}
+
+// GetMatchExprArgs validates a `match(column) against (value)` expression
+// and returns its column name and value.
+func GetMatchExprArgs(matchExpr *sqlparser.MatchExpr) (sqlparser.Expr, sqlparser.Expr, error) {
+	if matchExpr.Option != "" {
+		return nil, nil, NewConverterError("%s: 'match' with '%s'", NotSupportedErrMessage, strings.TrimSpace(matchExpr.Option))
+	}
+	if len(matchExpr.Columns) != 1 {
+		return nil, nil, NewConverterError(
+			"%s: 'match' takes a single search attribute name (got %s)",
+			InvalidExpressionErrMessage,
+			sqlparser.String(matchExpr.Columns),
+		)
+	}
+	aliasedExpr, ok := matchExpr.Columns[0].(*sqlparser.AliasedExpr)
+	if !ok || !aliasedExpr.As.IsEmpty() {
+		return nil, nil, NewConverterError(
+			"%s: unexpected column %s in 'match'",
+			InvalidExpressionErrMessage,
+			sqlparser.String(matchExpr.Columns[0]),
+		)
+	}
+	return aliasedExpr.Expr, matchExpr.Expr, nil
+}
+
+// escapeWildcardValue escapes the special characters of the Elasticsearch wildcard query.
+func escapeWildcardValue(in string) string {
+	sb := strings.Builder{}
+	for _, c := range in {
+		if c == '*' || c == '?' || c == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// likePatternToWildcard converts the % and _ wildcards of an ilike pattern
+// to the Elasticsearch wildcard query ones.
+func likePatternToWildcard(in string) string {
+	sb := strings.Builder{}
+	for _, c := range in {
+		switch c {
+		case '%':
+			sb.WriteByte('*')
+		case '_':
+			sb.WriteByte('?')
+		case '*', '?', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(c)
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
 // convertComparisonExprValue returns a string, int64, float64, bool or
 // a slice with each value of one of those types.
 func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
@@ -19,6 +19,7 @@ const (
 	FieldNameFilter FieldNameUsage = iota
 	FieldNameSorter
 	FieldNameGroupBy
+	FieldNameAggregation
 )
 
 func (n *NopFieldNameInterceptor) Name(name string, _ FieldNameUsage) (string, error) {
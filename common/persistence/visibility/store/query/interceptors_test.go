@@ -102,6 +102,7 @@ func getTestConverter(fnInterceptor FieldNameInterceptor, fvInterceptor FieldVal
 		nil,
 		NewRangeCondConverter(fnInterceptor, fvInterceptor, false),
 		NewComparisonExprConverter(fnInterceptor, fvInterceptor, map[string]struct{}{sqlparser.EqualStr: {}, sqlparser.InStr: {}}, testNameTypeMap),
+		nil,
 		nil)
 	return NewConverter(fnInterceptor, whereConverter)
 }
@@ -2,7 +2,13 @@ package sql
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -10,6 +16,17 @@ type (
 		CloseTime time.Time
 		StartTime time.Time
 		RunID     string
+
+		// OrderBy is the search attribute (field name) of the custom order of the query, and
+		// SortValue its value in the last row. SortValue is empty if the last row has no value.
+		OrderBy   string          `json:",omitempty"`
+		SortValue json.RawMessage `json:",omitempty"`
+	}
+
+	// aggregatePageToken is the page token of aggregation queries, which are ordered by the
+	// group by columns.
+	aggregatePageToken struct {
+		Offset int
 	}
 )
 
@@ -26,3 +43,99 @@ func serializePageToken(token *pageToken) ([]byte, error) {
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+func deserializeAggregatePageToken(data []byte) (*aggregatePageToken, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var token *aggregatePageToken
+	err := json.Unmarshal(data, &token)
+	return token, err
+}
+
+func serializeAggregatePageToken(token *aggregatePageToken) ([]byte, error) {
+	data, err := json.Marshal(token)
+	return data, err
+}
+
+// getSortValue returns the value of the search attribute col of the row, or nil if the row
+// has no value.
+func getSortValue(row *sqlplugin.VisibilityRow, col *saColName) (any, error) {
+	switch col.fieldName {
+	case searchattribute.WorkflowID:
+		return row.WorkflowID, nil
+	case searchattribute.RunID:
+		return row.RunID, nil
+	case searchattribute.WorkflowType:
+		return row.WorkflowTypeName, nil
+	case searchattribute.StartTime:
+		return row.StartTime.UTC(), nil
+	case searchattribute.ExecutionTime:
+		return row.ExecutionTime.UTC(), nil
+	case searchattribute.CloseTime:
+		if row.CloseTime == nil {
+			return nil, nil
+		}
+		return row.CloseTime.UTC(), nil
+	case searchattribute.ExecutionStatus:
+		return int64(row.Status), nil
+	case searchattribute.TaskQueue:
+		return row.TaskQueue, nil
+	case searchattribute.HistoryLength:
+		return derefOrNil(row.HistoryLength), nil
+	case searchattribute.HistorySizeBytes:
+		return derefOrNil(row.HistorySizeBytes), nil
+	case searchattribute.StateTransitionCount:
+		return derefOrNil(row.StateTransitionCount), nil
+	case searchattribute.ExecutionDuration:
+		if row.ExecutionDuration == nil {
+			return nil, nil
+		}
+		return row.ExecutionDuration.Nanoseconds(), nil
+	case searchattribute.ParentWorkflowID:
+		return derefOrNil(row.ParentWorkflowID), nil
+	case searchattribute.ParentRunID:
+		return derefOrNil(row.ParentRunID), nil
+	case searchattribute.RootWorkflowID:
+		return row.RootWorkflowID, nil
+	case searchattribute.RootRunID:
+		return row.RootRunID, nil
+	}
+
+	if row.SearchAttributes == nil {
+		return nil, nil
+	}
+	// Values of the search attributes column are decoded from JSON.
+	switch v := (*row.SearchAttributes)[col.fieldName].(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		if col.valueType == enumspb.INDEXED_VALUE_TYPE_INT {
+			return int64(v), nil
+		}
+		return v, nil
+	case string:
+		if col.valueType == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			tm, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse datetime value %q of %s: %w", v, col.fieldName, err)
+			}
+			return tm.UTC(), nil
+		}
+		if col.valueType == enumspb.INDEXED_VALUE_TYPE_INT {
+			return strconv.ParseInt(v, 10, 64)
+		}
+		return v, nil
+	case bool, int64:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unexpected value %v of type %T for %s", v, v, col.fieldName)
+	}
+}
+
+func derefOrNil[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
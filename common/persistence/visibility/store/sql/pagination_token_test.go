@@ -48,3 +48,23 @@ func TestDeserializePageToken(t *testing.T) {
 		*token,
 	)
 }
+
+func TestSerializePageToken_OrderBy(t *testing.T) {
+	s := assert.New(t)
+
+	token := pageToken{
+		RunID:     "test-run-id",
+		OrderBy:   "Keyword01",
+		SortValue: []byte(`"test-value"`),
+	}
+	data, err := serializePageToken(&token)
+	s.NoError(err)
+	s.Equal(
+		[]byte(`{"CloseTime":"0001-01-01T00:00:00Z","StartTime":"0001-01-01T00:00:00Z","RunID":"test-run-id","OrderBy":"Keyword01","SortValue":"test-value"}`),
+		data,
+	)
+
+	deserialized, err := deserializePageToken(data)
+	s.NoError(err)
+	s.Equal(token, *deserialized)
+}
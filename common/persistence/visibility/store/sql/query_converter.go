@@ -1,14 +1,18 @@
 package sql
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/temporalio/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
@@ -23,15 +27,36 @@ type (
 
 		convertTextComparisonExpr(expr *sqlparser.ComparisonExpr) (sqlparser.Expr, error)
 
+		// convertSubstringMatchExpr builds a case-sensitive expression matching the values of
+		// the Keyword column that contain value, or that end with value if suffix is true.
+		convertSubstringMatchExpr(col *saColName, value string, suffix bool) sqlparser.Expr
+
+		// convertFullTextMatchExpr builds an expression matching the values of the Text column
+		// that contain all the tokens.
+		convertFullTextMatchExpr(col *saColName, tokens []string) sqlparser.Expr
+
 		buildSelectStmt(
 			namespaceID namespace.ID,
 			queryString string,
 			pageSize int,
 			token *pageToken,
+			orderBy *orderByParams,
 		) (string, []any)
 
 		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
 
+		// buildAggregateStmt builds the statement selecting the groupBy columns, the count and the
+		// aggregates of each group. If groupBy is not empty, groups are ordered by the groupBy columns
+		// and paginated with pageSize and offset.
+		buildAggregateStmt(
+			namespaceID namespace.ID,
+			queryString string,
+			groupBy []string,
+			aggregates []string,
+			pageSize int,
+			offset int,
+		) (string, []any)
+
 		getDatetimeFormat() string
 
 		getCoalesceCloseTimeExpr() sqlparser.Expr
@@ -46,12 +71,26 @@ type (
 		queryString   string
 
 		seenNamespaceDivision bool
+		// orderBy is the custom order of the query, set by BuildSelectStmt.
+		orderBy *orderByParams
 	}
 
 	queryParams struct {
 		queryString string
 		// List of search attributes to group by (field name, not db name).
 		groupBy []string
+		// Custom order of the rows, nil if the query has no ORDER BY clause.
+		orderBy *orderByParams
+	}
+
+	// orderByParams is the custom order of the rows by a single search attribute, then by run ID.
+	// Rows without a value for the search attribute are sorted last, like in Elasticsearch.
+	orderByParams struct {
+		col  *saColName
+		desc bool
+		// lastValue is the value of the search attribute of the last row of the previous page,
+		// or nil if that row has no value.
+		lastValue any
 	}
 )
 
@@ -62,6 +101,8 @@ const (
 	// Thus, in order to avoid having specific code for each DB, it's better to
 	// set the escape char to a simpler char that doesn't require escaping.
 	defaultLikeEscapeChar = '!'
+
+	lowerFuncName = "lower"
 )
 
 var (
@@ -146,15 +187,106 @@ func (c *QueryConverter) BuildSelectStmt(
 	if len(qp.groupBy) > 0 {
 		return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
 	}
+	if err := c.validatePageToken(token, qp.orderBy); err != nil {
+		return nil, err
+	}
+	c.orderBy = qp.orderBy
 	queryString, queryArgs := c.buildSelectStmt(
 		c.namespaceID,
 		qp.queryString,
 		pageSize,
 		token,
+		qp.orderBy,
 	)
 	return &sqlplugin.VisibilitySelectFilter{Query: queryString, QueryArgs: queryArgs}, nil
 }
 
+// BuildNextPageToken builds the page token of the page following lastRow. It must be called
+// after BuildSelectStmt.
+func (c *QueryConverter) BuildNextPageToken(lastRow *sqlplugin.VisibilityRow) ([]byte, error) {
+	if c.orderBy == nil {
+		closeTime := maxTime
+		if lastRow.CloseTime != nil {
+			closeTime = *lastRow.CloseTime
+		}
+		return serializePageToken(&pageToken{
+			CloseTime: closeTime,
+			StartTime: lastRow.StartTime,
+			RunID:     lastRow.RunID,
+		})
+	}
+
+	token := &pageToken{
+		RunID:   lastRow.RunID,
+		OrderBy: c.orderBy.col.fieldName,
+	}
+	value, err := getSortValue(lastRow, c.orderBy.col)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		token.SortValue, err = json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return serializePageToken(token)
+}
+
+// validatePageToken checks that the token was built for the order of the query, and sets the
+// value of the last row of the previous page in orderBy.
+func (c *QueryConverter) validatePageToken(token *pageToken, orderBy *orderByParams) error {
+	if token == nil {
+		return nil
+	}
+	var orderByFieldName string
+	if orderBy != nil {
+		orderByFieldName = orderBy.col.fieldName
+	}
+	if token.OrderBy != orderByFieldName {
+		return query.NewConverterError("invalid page token: the order of the query has changed")
+	}
+	if orderBy == nil || len(token.SortValue) == 0 {
+		return nil
+	}
+
+	var value any
+	var err error
+	switch orderBy.col.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		var tm time.Time
+		err = json.Unmarshal(token.SortValue, &tm)
+		value = tm.UTC().Format(c.getDatetimeFormat())
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		var v int64
+		err = json.Unmarshal(token.SortValue, &v)
+		value = v
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		var v float64
+		err = json.Unmarshal(token.SortValue, &v)
+		value = v
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		var v bool
+		err = json.Unmarshal(token.SortValue, &v)
+		value = v
+	default:
+		if orderBy.col.fieldName == searchattribute.ExecutionStatus {
+			var v int64
+			err = json.Unmarshal(token.SortValue, &v)
+			value = v
+			break
+		}
+		var v string
+		err = json.Unmarshal(token.SortValue, &v)
+		value = v
+	}
+	if err != nil {
+		return query.NewConverterError("invalid page token: %v", err)
+	}
+	orderBy.lastValue = value
+	return nil
+}
+
 func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, error) {
 	qp, err := c.convertWhereString(c.queryString)
 	if err != nil {
@@ -172,6 +304,86 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	}, nil
 }
 
+// BuildAggregateStmt builds the statement computing the aggregations over the workflow executions
+// matching the query, grouped by the groupBy search attributes. It also returns the types of the
+// values of the aggregations.
+func (c *QueryConverter) BuildAggregateStmt(
+	groupBy []string,
+	aggregations []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation,
+	pageSize int,
+	offset int,
+) (*sqlplugin.VisibilitySelectFilter, []enumspb.IndexedValueType, error) {
+	qp, err := c.convertWhereString(c.queryString)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(qp.groupBy) > 0 {
+		return nil, nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	if qp.orderBy != nil {
+		return nil, nil, query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+	}
+
+	groupByFieldNames := make([]string, len(groupBy))
+	groupByDbNames := make([]string, len(groupBy))
+	for i, name := range groupBy {
+		col, err := c.convertAggregationColName(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := query.ValidateAggregationGroupBy(name, col.valueType); err != nil {
+			return nil, nil, err
+		}
+		if slices.Contains(groupByFieldNames[:i], col.fieldName) {
+			return nil, nil, query.NewConverterError("duplicate group by search attribute: %s", name)
+		}
+		groupByFieldNames[i] = col.fieldName
+		groupByDbNames[i] = col.dbColName.Name
+	}
+
+	aggregates := make([]string, len(aggregations))
+	valueTypes := make([]enumspb.IndexedValueType, len(aggregations))
+	for i, aggregation := range aggregations {
+		col, err := c.convertAggregationColName(aggregation.GetSearchAttribute())
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := query.ValidateAggregationFunction(aggregation.GetFunction(), col.alias, col.valueType); err != nil {
+			return nil, nil, err
+		}
+		switch aggregation.GetFunction() {
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN:
+			aggregates[i] = fmt.Sprintf("MIN(%s)", col.dbColName.Name)
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX:
+			aggregates[i] = fmt.Sprintf("MAX(%s)", col.dbColName.Name)
+		case enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG:
+			aggregates[i] = fmt.Sprintf("AVG(%s)", col.dbColName.Name)
+		}
+		valueTypes[i] = query.AggregationValueType(aggregation.GetFunction(), col.valueType)
+	}
+
+	queryString, queryArgs := c.buildAggregateStmt(
+		c.namespaceID,
+		qp.queryString,
+		groupByDbNames,
+		aggregates,
+		pageSize,
+		offset,
+	)
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   groupByFieldNames,
+	}, valueTypes, nil
+}
+
+// convertAggregationColName resolves the search attribute name of a group by field or an aggregated
+// field. Unlike in filters, CloseTime of running workflows is null so that it's not aggregated.
+func (c *QueryConverter) convertAggregationColName(name string) (*saColName, error) {
+	var expr sqlparser.Expr = &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}
+	return c.convertColName(&expr)
+}
+
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
 	where := strings.TrimSpace(queryString)
 	if where != "" &&
@@ -193,6 +405,13 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 	}
 
 	res := &queryParams{}
+	for _, orderByExpr := range selectStmt.OrderBy {
+		// The parser already ensures the type is saColName.
+		res.orderBy = &orderByParams{
+			col:  orderByExpr.Expr.(*saColName),
+			desc: orderByExpr.Direction == sqlparser.DescScr,
+		}
+	}
 	if selectStmt.Where != nil {
 		res.queryString = sqlparser.String(selectStmt.Where.Expr)
 	}
@@ -205,10 +424,6 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 }
 
 func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
-	if sel.OrderBy != nil {
-		return query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
-	}
-
 	if sel.Limit != nil {
 		return query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
 	}
@@ -278,6 +493,40 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		}
 	}
 
+	if len(sel.OrderBy) > 1 {
+		return query.NewConverterError(
+			"%s: 'order by' clause supports only a single field",
+			query.NotSupportedErrMessage,
+		)
+	}
+	if len(sel.OrderBy) > 0 && len(sel.GroupBy) > 0 {
+		return query.NewConverterError(
+			"%s: 'order by' clause is not supported with 'group by' clause",
+			query.NotSupportedErrMessage,
+		)
+	}
+	for _, orderByExpr := range sel.OrderBy {
+		// Sorting by TemporalNamespaceDivision doesn't filter by it.
+		seenNamespaceDivision := c.seenNamespaceDivision
+		colName, err := c.convertColName(&orderByExpr.Expr)
+		c.seenNamespaceDivision = seenNamespaceDivision
+		if err != nil {
+			return err
+		}
+		switch colName.valueType {
+		case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+			return query.NewConverterError(
+				"%s: unable to sort by search attribute %s of type %s",
+				query.NotSupportedErrMessage,
+				colName.alias,
+				colName.valueType.String(),
+			)
+		}
+		// Sort by the close time column, not the coalesced expression, so that running
+		// workflows are sorted last.
+		orderByExpr.Expr = colName
+	}
+
 	return nil
 }
 
@@ -302,7 +551,9 @@ func (c *QueryConverter) convertWhereExpr(expr *sqlparser.Expr) error {
 	case *sqlparser.IsExpr:
 		return c.convertIsExpr(expr)
 	case *sqlparser.FuncExpr:
-		return query.NewConverterError("%s: function expression", query.NotSupportedErrMessage)
+		return c.convertFuncExpr(expr)
+	case *sqlparser.MatchExpr:
+		return c.convertMatchExpr(expr)
 	case *sqlparser.ColName:
 		return query.NewConverterError("%s: incomplete expression", query.InvalidExpressionErrMessage)
 	default:
@@ -400,6 +651,115 @@ func (c *QueryConverter) convertComparisonExpr(exprRef *sqlparser.Expr) error {
 	return nil
 }
 
+// convertFuncExpr converts the pattern matching functions. See query.ContainsFuncName.
+func (c *QueryConverter) convertFuncExpr(exprRef *sqlparser.Expr) error {
+	expr, ok := (*exprRef).(*sqlparser.FuncExpr)
+	if !ok {
+		return query.NewConverterError("`%s` is not a function expression", sqlparser.String(*exprRef))
+	}
+	colNameExpr, valueExpr, err := query.GetFuncExprArgs(expr)
+	if err != nil {
+		return err
+	}
+	funcName := expr.Name.Lowered()
+
+	saColNameExpr, err := c.convertColName(&colNameExpr)
+	if err != nil {
+		return err
+	}
+	if saColNameExpr.valueType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		return query.NewConverterError(
+			"%s: function '%s' can only be used with Keyword type search attributes, but %s is of type %s",
+			query.InvalidExpressionErrMessage,
+			funcName,
+			saColNameExpr.alias,
+			saColNameExpr.valueType.String(),
+		)
+	}
+	value, err := c.convertStringValueExpr(valueExpr, saColNameExpr)
+	if err != nil {
+		return err
+	}
+
+	switch funcName {
+	case query.ContainsFuncName:
+		*exprRef = c.convertSubstringMatchExpr(saColNameExpr, value, false)
+	case query.EndsWithFuncName:
+		*exprRef = c.convertSubstringMatchExpr(saColNameExpr, value, true)
+	case query.ILikeFuncName:
+		*exprRef = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.LikeStr,
+			Left:     newFuncExpr(lowerFuncName, saColNameExpr),
+			Right:    newUnsafeSQLString(escapeLikeEscapeChar(strings.ToLower(value), defaultLikeEscapeChar)),
+			Escape:   defaultLikeEscapeExpr,
+		}
+	default:
+		// this should never happen since query.GetFuncExprArgs should already fail
+		return query.NewConverterError("%s: function '%s'", query.NotSupportedErrMessage, funcName)
+	}
+	return nil
+}
+
+// convertMatchExpr converts `match(TextAttribute) against ('words')` to an expression
+// matching the values that contain all the words.
+func (c *QueryConverter) convertMatchExpr(exprRef *sqlparser.Expr) error {
+	expr, ok := (*exprRef).(*sqlparser.MatchExpr)
+	if !ok {
+		return query.NewConverterError("`%s` is not a 'MATCH' expression", sqlparser.String(*exprRef))
+	}
+	colNameExpr, valueExpr, err := query.GetMatchExprArgs(expr)
+	if err != nil {
+		return err
+	}
+
+	saColNameExpr, err := c.convertColName(&colNameExpr)
+	if err != nil {
+		return err
+	}
+	if saColNameExpr.valueType != enumspb.INDEXED_VALUE_TYPE_TEXT {
+		return query.NewConverterError(
+			"%s: 'MATCH' can only be used with Text type search attributes, but %s is of type %s",
+			query.InvalidExpressionErrMessage,
+			saColNameExpr.alias,
+			saColNameExpr.valueType.String(),
+		)
+	}
+	value, err := c.convertStringValueExpr(valueExpr, saColNameExpr)
+	if err != nil {
+		return err
+	}
+	// Double quotes are used to delimit the tokens in the full-text queries of all the DBs.
+	tokens := tokenizeTextQueryString(strings.ReplaceAll(value, `\"`, " "))
+	if len(tokens) == 0 {
+		return query.NewConverterError(
+			"%s: unexpected value for Text type search attribute (no tokens found in %s)",
+			query.InvalidExpressionErrMessage,
+			sqlparser.String(valueExpr),
+		)
+	}
+	*exprRef = c.convertFullTextMatchExpr(saColNameExpr, tokens)
+	return nil
+}
+
+// convertStringValueExpr converts the value of a search attribute and returns it
+// escaped like the other string values.
+func (c *QueryConverter) convertStringValueExpr(expr sqlparser.Expr, col *saColName) (string, error) {
+	err := c.convertValueExpr(&expr, col.alias, col.fieldName, col.valueType)
+	if err != nil {
+		return "", err
+	}
+	valueExpr, ok := expr.(*unsafeSQLString)
+	if !ok {
+		return "", query.NewConverterError(
+			"%s: value of search attribute %s must be a literal string (got: %v)",
+			query.InvalidExpressionErrMessage,
+			col.alias,
+			sqlparser.String(expr),
+		)
+	}
+	return valueExpr.Val, nil
+}
+
 func (c *QueryConverter) convertRangeCond(exprRef *sqlparser.Expr) error {
 	expr, ok := (*exprRef).(*sqlparser.RangeCond)
 	if !ok {
@@ -669,6 +1029,11 @@ func (c *QueryConverter) convertIsExpr(exprRef *sqlparser.Expr) error {
 }
 
 func escapeLikeValueForPrefixSearch(in string, escape byte) string {
+	return escapeLikeValue(in, escape) + "%"
+}
+
+// escapeLikeValue escapes the wildcards and the escape char so that in is matched literally.
+func escapeLikeValue(in string, escape byte) string {
 	sb := strings.Builder{}
 	for _, c := range in {
 		if c == '%' || c == '_' || c == rune(escape) {
@@ -676,10 +1041,24 @@ func escapeLikeValueForPrefixSearch(in string, escape byte) string {
 		}
 		sb.WriteRune(c)
 	}
-	sb.WriteByte('%')
 	return sb.String()
 }
 
+// buildSubstringLikePattern builds a LIKE pattern matching the values containing in,
+// or ending with in if suffix is true.
+func buildSubstringLikePattern(in string, suffix bool, escape byte) string {
+	pattern := "%" + escapeLikeValue(in, escape)
+	if !suffix {
+		pattern += "%"
+	}
+	return pattern
+}
+
+// escapeLikeEscapeChar escapes only the escape char so that the wildcards of in are kept.
+func escapeLikeEscapeChar(in string, escape byte) string {
+	return strings.ReplaceAll(in, string(escape), string([]byte{escape, escape}))
+}
+
 func isSupportedOperator(supportedOperators []string, operator string) bool {
 	for _, op := range supportedOperators {
 		if operator == op {
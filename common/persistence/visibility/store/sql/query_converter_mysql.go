@@ -3,6 +3,7 @@ package sql
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
@@ -37,6 +38,7 @@ type (
 var (
 	convertTypeDatetime = &sqlparser.ConvertType{Type: "datetime"}
 	convertTypeJSON     = &sqlparser.ConvertType{Type: "json"}
+	convertTypeBinary   = &sqlparser.ConvertType{Type: "binary"}
 )
 
 var _ sqlparser.Expr = (*castExpr)(nil)
@@ -185,11 +187,47 @@ func (c *mysqlQueryConverter) convertTextComparisonExpr(
 	return newExpr, nil
 }
 
+func (c *mysqlQueryConverter) convertSubstringMatchExpr(
+	col *saColName,
+	value string,
+	suffix bool,
+) sqlparser.Expr {
+	// Keyword columns use the case-insensitive collation of the database:
+	// compare the bytes instead to be case-sensitive.
+	return &sqlparser.ComparisonExpr{
+		Operator: sqlparser.LikeStr,
+		Left: &castExpr{
+			Value: col,
+			Type:  convertTypeBinary,
+		},
+		Right:  newUnsafeSQLString(buildSubstringLikePattern(value, suffix, defaultLikeEscapeChar)),
+		Escape: defaultLikeEscapeExpr,
+	}
+}
+
+func (c *mysqlQueryConverter) convertFullTextMatchExpr(
+	col *saColName,
+	tokens []string,
+) sqlparser.Expr {
+	// build the following expression:
+	// `match ({col}) against ('+"token1" +"token2" ...' in boolean mode)`
+	terms := make([]string, len(tokens))
+	for i, token := range tokens {
+		terms[i] = fmt.Sprintf(`+"%s"`, token)
+	}
+	return &sqlparser.MatchExpr{
+		Columns: []sqlparser.SelectExpr{&sqlparser.AliasedExpr{Expr: col}},
+		Expr:    newUnsafeSQLString(strings.Join(terms, " ")),
+		Option:  sqlparser.BooleanModeStr,
+	}
+}
+
 func (c *mysqlQueryConverter) buildSelectStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	pageSize int,
 	token *pageToken,
+	orderBy *orderByParams,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -204,7 +242,11 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
+	if token != nil && orderBy != nil {
+		paginationClause, paginationArgs := orderBy.buildPaginationClause(token)
+		whereClauses = append(whereClauses, paginationClause)
+		queryArgs = append(queryArgs, paginationArgs...)
+	} else if token != nil {
 		whereClauses = append(
 			whereClauses,
 			fmt.Sprintf(
@@ -228,6 +270,16 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		)
 	}
 
+	orderByClause := fmt.Sprintf(
+		"%s DESC, %s DESC, %s",
+		sqlparser.String(c.getCoalesceCloseTimeExpr()),
+		searchattribute.GetSqlDbColName(searchattribute.StartTime),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if orderBy != nil {
+		orderByClause = orderBy.buildOrderByClause()
+	}
+
 	queryArgs = append(queryArgs, pageSize)
 
 	return fmt.Sprintf(
@@ -236,15 +288,13 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		LEFT JOIN custom_search_attributes
 		USING (%s, %s)
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
+		ORDER BY %s
 		LIMIT ?`,
 		strings.Join(addPrefix("ev.", sqlplugin.DbFields), ", "),
 		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
 		searchattribute.GetSqlDbColName(searchattribute.RunID),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
 	), queryArgs
 }
 
@@ -285,3 +335,50 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *mysqlQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	groupBy []string,
+	aggregates []string,
+	pageSize int,
+	offset int,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	groupByClause := ""
+	if len(groupBy) > 0 {
+		groupByClause = fmt.Sprintf(
+			"GROUP BY %s ORDER BY %s LIMIT ? OFFSET ?",
+			strings.Join(groupBy, ", "),
+			strings.Join(groupBy, ", "),
+		)
+		queryArgs = append(queryArgs, pageSize, offset)
+	}
+
+	selectExprs := append(append(slices.Clone(groupBy), "COUNT(*)"), aggregates...)
+	return fmt.Sprintf(
+		`SELECT %s
+		FROM executions_visibility ev
+		LEFT JOIN custom_search_attributes
+		USING (%s, %s)
+		WHERE %s
+		%s`,
+		strings.Join(selectExprs, ", "),
+		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		strings.Join(whereClauses, " AND "),
+		groupByClause,
+	), queryArgs
+}
@@ -133,3 +133,61 @@ func (s *mysqlQueryConverterSuite) TestConvertTextComparisonExpr() {
 		})
 	}
 }
+
+func (s *mysqlQueryConverterSuite) TestConvertSubstringMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "contains expression",
+			input:  "contains(AliasForKeyword01, 'foo_b*r%')",
+			output: `cast(Keyword01 as binary) like '%foo!_b*r!%%' escape '!'`,
+			err:    nil,
+		},
+		{
+			name:   "not ends_with expression",
+			input:  "not ends_with(AliasForKeyword01, 'foo')",
+			output: `not cast(Keyword01 as binary) like '%foo' escape '!'`,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.NoError(err)
+			s.Equal(tc.output, sqlparser.String(expr))
+		})
+	}
+}
+
+func (s *mysqlQueryConverterSuite) TestConvertFullTextMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "match expression",
+			input:  "match(AliasForText01) against ('foo  \"bar\"')",
+			output: `match(Text01) against ('+"foo" +"bar"' in boolean mode)`,
+			err:    nil,
+		},
+		{
+			name:   "not match expression",
+			input:  "not match(AliasForText01) against ('foo')",
+			output: `not match(Text01) against ('+"foo"' in boolean mode)`,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.NoError(err)
+			s.Equal(tc.output, sqlparser.String(expr))
+		})
+	}
+}
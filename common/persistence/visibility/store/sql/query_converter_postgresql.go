@@ -2,6 +2,7 @@ package sql
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
@@ -181,6 +182,33 @@ func (c *pgQueryConverter) convertTextComparisonExpr(
 	return newExpr, nil
 }
 
+func (c *pgQueryConverter) convertSubstringMatchExpr(
+	col *saColName,
+	value string,
+	suffix bool,
+) sqlparser.Expr {
+	return &sqlparser.ComparisonExpr{
+		Operator: sqlparser.LikeStr,
+		Left:     col,
+		Right:    newUnsafeSQLString(buildSubstringLikePattern(value, suffix, defaultLikeEscapeChar)),
+		Escape:   defaultLikeEscapeExpr,
+	}
+}
+
+func (c *pgQueryConverter) convertFullTextMatchExpr(
+	col *saColName,
+	tokens []string,
+) sqlparser.Expr {
+	return &sqlparser.ComparisonExpr{
+		Operator: ftsMatchOp,
+		Left:     col,
+		Right: &pgCastExpr{
+			Value: newUnsafeSQLString(strings.Join(tokens, " & ")),
+			Type:  convertTypeTSQuery,
+		},
+	}
+}
+
 func (c *pgQueryConverter) newJsonContainsExpr(
 	jsonExpr sqlparser.Expr,
 	valueExpr sqlparser.Expr,
@@ -197,6 +225,7 @@ func (c *pgQueryConverter) buildSelectStmt(
 	queryString string,
 	pageSize int,
 	token *pageToken,
+	orderBy *orderByParams,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -211,7 +240,11 @@ func (c *pgQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
+	if token != nil && orderBy != nil {
+		paginationClause, paginationArgs := orderBy.buildPaginationClause(token)
+		whereClauses = append(whereClauses, paginationClause)
+		queryArgs = append(queryArgs, paginationArgs...)
+	} else if token != nil {
 		whereClauses = append(
 			whereClauses,
 			fmt.Sprintf(
@@ -235,19 +268,27 @@ func (c *pgQueryConverter) buildSelectStmt(
 		)
 	}
 
+	orderByClause := fmt.Sprintf(
+		"%s DESC, %s DESC, %s",
+		sqlparser.String(c.getCoalesceCloseTimeExpr()),
+		searchattribute.GetSqlDbColName(searchattribute.StartTime),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if orderBy != nil {
+		orderByClause = orderBy.buildOrderByClause()
+	}
+
 	queryArgs = append(queryArgs, pageSize)
 
 	return fmt.Sprintf(
 		`SELECT %s
 		FROM executions_visibility
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
+		ORDER BY %s
 		LIMIT ?`,
 		strings.Join(sqlplugin.DbFields, ", "),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
 	), queryArgs
 }
 
@@ -281,3 +322,43 @@ func (c *pgQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *pgQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	groupBy []string,
+	aggregates []string,
+	pageSize int,
+	offset int,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	groupByClause := ""
+	if len(groupBy) > 0 {
+		groupByClause = fmt.Sprintf(
+			"GROUP BY %s ORDER BY %s LIMIT ? OFFSET ?",
+			strings.Join(groupBy, ", "),
+			strings.Join(groupBy, ", "),
+		)
+		queryArgs = append(queryArgs, pageSize, offset)
+	}
+
+	selectExprs := append(append(slices.Clone(groupBy), "COUNT(*)"), aggregates...)
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s %s",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+		groupByClause,
+	), queryArgs
+}
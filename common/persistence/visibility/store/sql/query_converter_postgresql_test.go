@@ -133,3 +133,61 @@ func (s *postgresqlQueryConverterSuite) TestConvertTextComparisonExpr() {
 		})
 	}
 }
+
+func (s *postgresqlQueryConverterSuite) TestConvertSubstringMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "contains expression",
+			input:  "contains(AliasForKeyword01, 'foo_b*r%')",
+			output: `Keyword01 like '%foo!_b*r!%%' escape '!'`,
+			err:    nil,
+		},
+		{
+			name:   "not ends_with expression",
+			input:  "not ends_with(AliasForKeyword01, 'foo')",
+			output: `not Keyword01 like '%foo' escape '!'`,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.NoError(err)
+			s.Equal(tc.output, sqlparser.String(expr))
+		})
+	}
+}
+
+func (s *postgresqlQueryConverterSuite) TestConvertFullTextMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "match expression",
+			input:  "match(AliasForText01) against ('foo  \"bar\"')",
+			output: `Text01 @@ 'foo & bar'::tsquery`,
+			err:    nil,
+		},
+		{
+			name:   "not match expression",
+			input:  "not match(AliasForText01) against ('foo')",
+			output: `not Text01 @@ 'foo'::tsquery`,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.NoError(err)
+			s.Equal(tc.output, sqlparser.String(expr))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
@@ -18,6 +19,8 @@ type (
 var _ pluginQueryConverter = (*sqliteQueryConverter)(nil)
 
 const (
+	globOperator = "glob"
+
 	keywordListTypeFtsTableName = "executions_visibility_fts_keyword_list"
 	textTypeFtsTableName        = "executions_visibility_fts_text"
 )
@@ -201,11 +204,44 @@ func (c *sqliteQueryConverter) convertTextComparisonExpr(
 	return &newExpr, nil
 }
 
+func (c *sqliteQueryConverter) convertSubstringMatchExpr(
+	col *saColName,
+	value string,
+	suffix bool,
+) sqlparser.Expr {
+	// LIKE is case-insensitive in SQLite, unlike GLOB.
+	pattern := "*" + escapeGlobValue(value)
+	if !suffix {
+		pattern += "*"
+	}
+	return &sqlparser.ComparisonExpr{
+		Operator: globOperator,
+		Left:     col,
+		Right:    newUnsafeSQLString(pattern),
+	}
+}
+
+func (c *sqliteQueryConverter) convertFullTextMatchExpr(
+	col *saColName,
+	tokens []string,
+) sqlparser.Expr {
+	// FTS query format: 'colname : ("token1" AND "token2" AND ...)'
+	ftsQuery := fmt.Sprintf(`%s : ("%s")`, col.dbColName.Name, strings.Join(tokens, `" AND "`))
+	return &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     newColName("rowid"),
+		Right: &sqlparser.Subquery{
+			Select: c.buildFtsSelectStmt(textTypeFtsTableName, ftsQuery),
+		},
+	}
+}
+
 func (c *sqliteQueryConverter) buildSelectStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	pageSize int,
 	token *pageToken,
+	orderBy *orderByParams,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -220,7 +256,11 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
+	if token != nil && orderBy != nil {
+		paginationClause, paginationArgs := orderBy.buildPaginationClause(token)
+		whereClauses = append(whereClauses, paginationClause)
+		queryArgs = append(queryArgs, paginationArgs...)
+	} else if token != nil {
 		whereClauses = append(
 			whereClauses,
 			fmt.Sprintf(
@@ -244,19 +284,27 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 		)
 	}
 
+	orderByClause := fmt.Sprintf(
+		"%s DESC, %s DESC, %s",
+		sqlparser.String(c.getCoalesceCloseTimeExpr()),
+		searchattribute.GetSqlDbColName(searchattribute.StartTime),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if orderBy != nil {
+		orderByClause = orderBy.buildOrderByClause()
+	}
+
 	queryArgs = append(queryArgs, pageSize)
 
 	return fmt.Sprintf(
 		`SELECT %s
 		FROM executions_visibility
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
+		ORDER BY %s
 		LIMIT ?`,
 		strings.Join(sqlplugin.DbFields, ", "),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
 	), queryArgs
 }
 
@@ -322,7 +370,62 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	), queryArgs
 }
 
+func (c *sqliteQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	groupBy []string,
+	aggregates []string,
+	pageSize int,
+	offset int,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	groupByClause := ""
+	if len(groupBy) > 0 {
+		groupByClause = fmt.Sprintf(
+			"GROUP BY %s ORDER BY %s LIMIT ? OFFSET ?",
+			strings.Join(groupBy, ", "),
+			strings.Join(groupBy, ", "),
+		)
+		queryArgs = append(queryArgs, pageSize, offset)
+	}
+
+	selectExprs := append(append(slices.Clone(groupBy), "COUNT(*)"), aggregates...)
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s %s",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+		groupByClause,
+	), queryArgs
+}
+
 func buildFtsQueryString(colname string, values ...string) string {
 	// FTS query format: 'colname : ("token1" OR "token2" OR ...)'
 	return fmt.Sprintf(`%s : ("%s")`, colname, strings.Join(values, `" OR "`))
 }
+
+// escapeGlobValue escapes the wildcards of GLOB so that in is matched literally.
+func escapeGlobValue(in string) string {
+	sb := strings.Builder{}
+	for _, c := range in {
+		if c == '*' || c == '?' || c == '[' {
+			sb.WriteByte('[')
+			sb.WriteRune(c)
+			sb.WriteByte(']')
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
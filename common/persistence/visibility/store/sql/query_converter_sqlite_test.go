@@ -133,3 +133,61 @@ func (s *sqliteQueryConverterSuite) TestConvertTextComparisonExpr() {
 		})
 	}
 }
+
+func (s *sqliteQueryConverterSuite) TestConvertSubstringMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "contains expression",
+			input:  "contains(AliasForKeyword01, 'foo_b*r%')",
+			output: `Keyword01 glob '*foo_b[*]r%*'`,
+			err:    nil,
+		},
+		{
+			name:   "not ends_with expression",
+			input:  "not ends_with(AliasForKeyword01, 'foo')",
+			output: `not Keyword01 glob '*foo'`,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.NoError(err)
+			s.Equal(tc.output, sqlparser.String(expr))
+		})
+	}
+}
+
+func (s *sqliteQueryConverterSuite) TestConvertFullTextMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "match expression",
+			input:  "match(AliasForText01) against ('foo  \"bar\"')",
+			output: `rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("foo" AND "bar")')`,
+			err:    nil,
+		},
+		{
+			name:   "not match expression",
+			input:  "not match(AliasForText01) against ('foo')",
+			output: `not rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("foo")')`,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.NoError(err)
+			s.Equal(tc.output, sqlparser.String(expr))
+		})
+	}
+}
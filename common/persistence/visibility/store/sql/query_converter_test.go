@@ -11,7 +11,10 @@ import (
 	"github.com/temporalio/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
@@ -123,16 +126,82 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			),
 		},
 		{
-			name:   "order by not supported",
-			input:  "ORDER BY StartTime",
+			name:  "order by one field",
+			input: "AliasForInt01 = 1 ORDER BY AliasForKeyword01 DESC",
+			output: &queryParams{
+				queryString: "(Int01 = 1) and TemporalNamespaceDivision is null",
+				orderBy: &orderByParams{
+					col: newSAColName(
+						"Keyword01",
+						"AliasForKeyword01",
+						"Keyword01",
+						enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					),
+					desc: true,
+				},
+			},
+			err: nil,
+		},
+		{
+			name:  "order by close time",
+			input: "ORDER BY CloseTime",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				orderBy:     &orderByParams{col: closeTimeSaColName},
+			},
+			err: nil,
+		},
+		{
+			name:  "order by namespace division",
+			input: "ORDER BY TemporalNamespaceDivision",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				orderBy: &orderByParams{
+					col: newSAColName(
+						"TemporalNamespaceDivision",
+						"TemporalNamespaceDivision",
+						"TemporalNamespaceDivision",
+						enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					),
+				},
+			},
+			err: nil,
+		},
+		{
+			name:   "order by two fields not supported",
+			input:  "ORDER BY StartTime, CloseTime",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: 'order by' clause supports only a single field",
+				query.NotSupportedErrMessage,
+			),
+		},
+		{
+			name:   "order by text not supported",
+			input:  "ORDER BY AliasForText01",
 			output: nil,
-			err:    query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage),
+			err: query.NewConverterError(
+				"%s: unable to sort by search attribute AliasForText01 of type Text",
+				query.NotSupportedErrMessage,
+			),
+		},
+		{
+			name:   "order by keyword list not supported",
+			input:  "ORDER BY AliasForKeywordList01",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: unable to sort by search attribute AliasForKeywordList01 of type KeywordList",
+				query.NotSupportedErrMessage,
+			),
 		},
 		{
 			name:   "group by with order by not supported",
 			input:  "GROUP BY ExecutionStatus ORDER BY StartTime",
 			output: nil,
-			err:    query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage),
+			err: query.NewConverterError(
+				"%s: 'order by' clause is not supported with 'group by' clause",
+				query.NotSupportedErrMessage,
+			),
 		},
 	}
 
@@ -378,6 +447,123 @@ func (s *queryConverterSuite) TestConvertComparisonExpr() {
 	}
 }
 
+func (s *queryConverterSuite) TestConvertFuncExpr() {
+	var tests = []testCase{
+		{
+			name:   "ilike expression",
+			input:  "ilike(AliasForKeyword01, 'Foo_b!r%')",
+			output: `lower(Keyword01) like 'foo_b!!r%' escape '!'`,
+			err:    nil,
+		},
+		{
+			name:   "not ilike expression",
+			input:  "not ILIKE(AliasForKeyword01, 'foo%')",
+			output: `not lower(Keyword01) like 'foo%' escape '!'`,
+			err:    nil,
+		},
+		{
+			name:   "unknown function",
+			input:  "foo(AliasForKeyword01, 'foo')",
+			output: "",
+			err:    query.NewConverterError("%s: function 'foo'", query.NotSupportedErrMessage),
+		},
+		{
+			name:   "wrong number of arguments",
+			input:  "contains(AliasForKeyword01)",
+			output: "",
+			err: query.NewConverterError(
+				"%s: function 'contains' takes a search attribute name and a string value (got contains(AliasForKeyword01))",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+		{
+			name:   "not a Keyword search attribute",
+			input:  "contains(AliasForText01, 'foo')",
+			output: "",
+			err: query.NewConverterError(
+				"%s: function 'contains' can only be used with Keyword type search attributes, but AliasForText01 is of type Text",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+		{
+			name:   "not a string value",
+			input:  "ends_with(AliasForKeyword01, 123)",
+			output: "",
+			err: query.NewConverterError(
+				"%s: value of search attribute AliasForKeyword01 must be a literal string (got: 123)",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			if tc.err == nil {
+				s.NoError(err)
+				s.Equal(tc.output, sqlparser.String(expr))
+			} else {
+				s.Error(err)
+				s.Equal(err, tc.err)
+			}
+		})
+	}
+}
+
+func (s *queryConverterSuite) TestConvertMatchExpr() {
+	var tests = []testCase{
+		{
+			name:   "not a Text search attribute",
+			input:  "match(AliasForKeyword01) against ('foo')",
+			output: "",
+			err: query.NewConverterError(
+				"%s: 'MATCH' can only be used with Text type search attributes, but AliasForKeyword01 is of type Keyword",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+		{
+			name:   "multiple columns",
+			input:  "match(AliasForText01, AliasForText02) against ('foo')",
+			output: "",
+			err: query.NewConverterError(
+				"%s: 'match' takes a single search attribute name (got AliasForText01, AliasForText02)",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+		{
+			name:   "search modifier",
+			input:  "match(AliasForText01) against ('foo' in boolean mode)",
+			output: "",
+			err:    query.NewConverterError("%s: 'match' with 'in boolean mode'", query.NotSupportedErrMessage),
+		},
+		{
+			name:   "no tokens",
+			input:  "match(AliasForText01) against (' ')",
+			output: "",
+			err: query.NewConverterError(
+				"%s: unexpected value for Text type search attribute (no tokens found in ' ')",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
+			stmt, err := sqlparser.Parse(sql)
+			s.NoError(err)
+			expr := stmt.(*sqlparser.Select).Where.Expr
+			err = s.queryConverter.convertWhereExpr(&expr)
+			s.Error(err)
+			s.Equal(err, tc.err)
+		})
+	}
+}
+
 func (s *queryConverterSuite) TestConvertRangeCond() {
 	fromDatetime, _ := time.Parse(time.RFC3339Nano, "2020-02-15T20:30:40Z")
 	toDatetime, _ := time.Parse(time.RFC3339Nano, "2020-02-16T20:30:40Z")
@@ -673,6 +859,146 @@ func (s *queryConverterSuite) TestConvertColName() {
 	}
 }
 
+func (s *queryConverterSuite) TestBuildSelectStmt_OrderBy() {
+	s.queryConverter.queryString = "AliasForInt01 = 1 ORDER BY StartTime DESC"
+	filter, err := s.queryConverter.BuildSelectStmt(10, nil)
+	s.NoError(err)
+	s.Contains(filter.Query, "ORDER BY start_time IS NULL, start_time DESC, run_id")
+	s.Equal([]any{testNamespaceID.String(), 10}, filter.QueryArgs)
+
+	startTime := time.Date(2023, 3, 21, 14, 10, 32, 123456000, time.UTC)
+	token, err := s.queryConverter.BuildNextPageToken(&sqlplugin.VisibilityRow{
+		RunID:     "test-run-id",
+		StartTime: startTime,
+	})
+	s.NoError(err)
+	filter, err = s.queryConverter.BuildSelectStmt(10, token)
+	s.NoError(err)
+	s.Contains(filter.Query, "(start_time < ? OR (start_time = ? AND run_id > ?) OR start_time IS NULL)")
+	formattedStartTime := startTime.Format(s.queryConverter.getDatetimeFormat())
+	s.Equal(
+		[]any{testNamespaceID.String(), formattedStartTime, formattedStartTime, "test-run-id", 10},
+		filter.QueryArgs,
+	)
+
+	// Running workflows have no close time and are sorted last.
+	s.queryConverter.queryString = "ORDER BY CloseTime"
+	_, err = s.queryConverter.BuildSelectStmt(10, nil)
+	s.NoError(err)
+	token, err = s.queryConverter.BuildNextPageToken(&sqlplugin.VisibilityRow{RunID: "test-run-id"})
+	s.NoError(err)
+	filter, err = s.queryConverter.BuildSelectStmt(10, token)
+	s.NoError(err)
+	s.Contains(filter.Query, "ORDER BY close_time IS NULL, close_time ASC, run_id")
+	s.Contains(filter.Query, "(close_time IS NULL AND run_id > ?)")
+	s.Equal([]any{testNamespaceID.String(), "test-run-id", 10}, filter.QueryArgs)
+
+	s.queryConverter.queryString = "ORDER BY AliasForInt01"
+	_, err = s.queryConverter.BuildSelectStmt(10, nil)
+	s.NoError(err)
+	token, err = s.queryConverter.BuildNextPageToken(&sqlplugin.VisibilityRow{
+		RunID:            "test-run-id",
+		SearchAttributes: &sqlplugin.VisibilitySearchAttributes{"Int01": float64(5)},
+	})
+	s.NoError(err)
+	filter, err = s.queryConverter.BuildSelectStmt(10, token)
+	s.NoError(err)
+	s.Contains(filter.Query, "(Int01 > ? OR (Int01 = ? AND run_id > ?) OR Int01 IS NULL)")
+	s.Equal([]any{testNamespaceID.String(), int64(5), int64(5), "test-run-id", 10}, filter.QueryArgs)
+
+	// The page token of a query must not be used with a different order.
+	s.queryConverter.queryString = "ORDER BY AliasForKeyword01"
+	_, err = s.queryConverter.BuildSelectStmt(10, token)
+	var converterErr *query.ConverterError
+	s.ErrorAs(err, &converterErr)
+	s.queryConverter.queryString = ""
+	_, err = s.queryConverter.BuildSelectStmt(10, token)
+	s.ErrorAs(err, &converterErr)
+}
+
+func (s *queryConverterSuite) TestBuildAggregateStmt() {
+	s.queryConverter.queryString = "AliasForKeyword01 = 'foo'"
+	filter, valueTypes, err := s.queryConverter.BuildAggregateStmt(
+		[]string{"ExecutionStatus", "AliasForKeyword01"},
+		[]*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+			{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX, SearchAttribute: "CloseTime"},
+			{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG, SearchAttribute: "AliasForInt01"},
+		},
+		10,
+		20,
+	)
+	s.NoError(err)
+	s.Equal([]string{"ExecutionStatus", "Keyword01"}, filter.GroupBy)
+	s.Equal(
+		[]enumspb.IndexedValueType{enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_DOUBLE},
+		valueTypes,
+	)
+	s.Contains(filter.Query, "MAX(close_time)")
+	s.Contains(filter.Query, "AVG(Int01)")
+	s.Contains(filter.Query, "GROUP BY status, Keyword01")
+	s.Equal([]any{10, 20}, filter.QueryArgs[len(filter.QueryArgs)-2:])
+
+	filter, _, err = s.queryConverter.BuildAggregateStmt(nil, nil, 10, 0)
+	s.NoError(err)
+	s.Empty(filter.GroupBy)
+	s.NotContains(filter.Query, "GROUP BY")
+
+	var tests = []struct {
+		name         string
+		query        string
+		groupBy      []string
+		aggregations []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation
+		err          string
+	}{
+		{
+			name:    "group by in query",
+			query:   "GROUP BY ExecutionStatus",
+			groupBy: []string{"ExecutionStatus"},
+			err:     "operation is not supported: 'group by' clause",
+		},
+		{
+			name:    "group by non keyword",
+			groupBy: []string{"AliasForInt01"},
+			err:     "operation is not supported: unable to group by search attribute AliasForInt01 of type Int",
+		},
+		{
+			name:    "duplicate group by",
+			groupBy: []string{"ExecutionStatus", "ExecutionStatus"},
+			err:     "duplicate group by search attribute: ExecutionStatus",
+		},
+		{
+			name: "avg of datetime",
+			aggregations: []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+				{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_AVG, SearchAttribute: "StartTime"},
+			},
+			err: "operation is not supported: unable to compute Avg of search attribute StartTime of type Datetime",
+		},
+		{
+			name: "unspecified function",
+			aggregations: []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+				{SearchAttribute: "AliasForInt01"},
+			},
+			err: "operation is not supported: aggregation function Unspecified",
+		},
+		{
+			name: "invalid search attribute",
+			aggregations: []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+				{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MIN, SearchAttribute: "InvalidName"},
+			},
+			err: "invalid expression: column name 'InvalidName' is not a valid search attribute",
+		},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.queryConverter.queryString = tc.query
+			_, _, err := s.queryConverter.BuildAggregateStmt(tc.groupBy, tc.aggregations, 10, 0)
+			var converterErr *query.ConverterError
+			s.ErrorAs(err, &converterErr)
+			s.Equal(tc.err, err.Error())
+		})
+	}
+}
+
 func (s *queryConverterSuite) TestConvertValueExpr() {
 	dt, _ := time.Parse(time.RFC3339Nano, "2020-02-15T20:30:40.123456789Z")
 	var tests = []testCase{
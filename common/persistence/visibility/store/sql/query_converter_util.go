@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -148,3 +149,41 @@ func getUnsafeStringTupleValues(valTuple sqlparser.ValTuple) ([]string, error) {
 	}
 	return values, nil
 }
+
+// buildOrderByClause returns the ORDER BY clause of the custom order. The null values are sorted
+// last, and the rows with the same value are sorted by run ID to make the order stable.
+func (o *orderByParams) buildOrderByClause() string {
+	direction := "ASC"
+	if o.desc {
+		direction = "DESC"
+	}
+	return fmt.Sprintf(
+		"%s IS NULL, %s %s, %s",
+		o.col.dbColName.Name,
+		o.col.dbColName.Name,
+		direction,
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+}
+
+// buildPaginationClause returns the condition selecting the rows after the last row of the
+// previous page, identified by lastValue and the run ID of the token.
+func (o *orderByParams) buildPaginationClause(token *pageToken) (string, []any) {
+	col := o.col.dbColName.Name
+	runIDCol := searchattribute.GetSqlDbColName(searchattribute.RunID)
+	if o.lastValue == nil {
+		return fmt.Sprintf("(%s IS NULL AND %s > ?)", col, runIDCol), []any{token.RunID}
+	}
+	operator := sqlparser.GreaterThanStr
+	if o.desc {
+		operator = sqlparser.LessThanStr
+	}
+	return fmt.Sprintf(
+		"(%s %s ? OR (%s = ? AND %s > ?) OR %s IS NULL)",
+		col,
+		operator,
+		col,
+		runIDCol,
+		col,
+	), []any{o.lastValue, o.lastValue, token.RunID}
+}
@@ -2,15 +2,20 @@ package sql
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -29,6 +34,7 @@ type (
 		sqlStore                       persistencesql.SqlStore
 		searchAttributesProvider       searchattribute.Provider
 		searchAttributesMapperProvider searchattribute.MapperProvider
+		disableOrderByClause           dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -36,12 +42,16 @@ var _ store.VisibilityStore = (*VisibilityStore)(nil)
 
 var maxTime, _ = time.Parse(time.RFC3339, "9999-12-31T23:59:59Z")
 
+// sqliteDatetimeLayout is the layout of datetime values written by the SQLite driver.
+const sqliteDatetimeLayout = "2006-01-02 15:04:05.999999999-07:00"
+
 // NewSQLVisibilityStore creates an instance of VisibilityStore
 func NewSQLVisibilityStore(
 	cfg config.SQL,
 	r resolver.ServiceResolver,
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (*VisibilityStore, error) {
@@ -54,6 +64,7 @@ func NewSQLVisibilityStore(
 		sqlStore:                       persistencesql.NewSqlStore(db, logger),
 		searchAttributesProvider:       searchAttributesProvider,
 		searchAttributesMapperProvider: searchAttributesMapperProvider,
+		disableOrderByClause:           disableOrderByClause,
 	}, nil
 }
 
@@ -188,6 +199,11 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 		}
 		return nil, err
 	}
+	// Like in Elasticsearch, ORDER BY clause can be disabled to prevent slow queries sorting
+	// by columns without index.
+	if converter.orderBy != nil && s.disableOrderByClause(request.Namespace.String()) {
+		return nil, serviceerror.NewInvalidArgument("ORDER BY clause is not supported")
+	}
 
 	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
 	if err != nil {
@@ -208,16 +224,7 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 
 	var nextPageToken []byte
 	if len(rows) == request.PageSize {
-		lastRow := rows[len(rows)-1]
-		closeTime := maxTime
-		if lastRow.CloseTime != nil {
-			closeTime = *lastRow.CloseTime
-		}
-		nextPageToken, err = serializePageToken(&pageToken{
-			CloseTime: closeTime,
-			StartTime: lastRow.StartTime,
-			RunID:     lastRow.RunID,
-		})
+		nextPageToken, err = converter.BuildNextPageToken(&rows[len(rows)-1])
 		if err != nil {
 			return nil, err
 		}
@@ -323,6 +330,161 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := deserializeAggregatePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Unable to deserialize page token: %v", err))
+	}
+	offset := 0
+	if token != nil {
+		offset = token.Offset
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	selectFilter, valueTypes, err := converter.BuildAggregateStmt(
+		request.GroupBy,
+		request.Aggregations,
+		request.PageSize,
+		offset,
+	)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	groupByTypes := make([]enumspb.IndexedValueType, len(selectFilter.GroupBy))
+	for i, fieldName := range selectFilter.GroupBy {
+		groupByTypes[i], err = saTypeMap.GetType(fieldName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := s.sqlStore.Db.AggregateFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+	resp := &manager.AggregateWorkflowExecutionsResponse{
+		Groups: make([]*adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup, 0, len(rows)),
+	}
+	for _, row := range rows {
+		groupValues := make([]*commonpb.Payload, len(row.GroupValues))
+		for i, val := range row.GroupValues {
+			groupValues[i], err = searchattribute.EncodeValue(val, groupByTypes[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		values := make([]*commonpb.Payload, len(row.Values))
+		for i, val := range row.Values {
+			val, err = parseAggregateValue(val, valueTypes[i])
+			if err != nil {
+				return nil, serviceerror.NewInternal(
+					fmt.Sprintf("AggregateWorkflowExecutions operation failed. Unable to parse value: %v", err))
+			}
+			values[i], err = searchattribute.EncodeValue(val, valueTypes[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.Groups = append(
+			resp.Groups,
+			&adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+				GroupValues: groupValues,
+				Count:       row.Count,
+				Values:      values,
+			},
+		)
+	}
+
+	// Number of groups smaller than the page size indicates that this is the last page.
+	if len(request.GroupBy) > 0 && len(rows) == request.PageSize {
+		resp.NextPageToken, err = serializeAggregatePageToken(&aggregatePageToken{Offset: offset + len(rows)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *VisibilityStore) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasRecorder := searchattribute.NewAliasRecorder(s.searchAttributesMapperProvider)
+	saMapper, err := aliasRecorder.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	selectFilter, err := converter.BuildSelectStmt(request.PageSize, nil)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	plan, err := s.sqlStore.Db.ExplainFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("ExplainQuery operation failed. Explain failed: %v", err))
+	}
+
+	queryArgs := make([]string, len(selectFilter.QueryArgs))
+	for i, arg := range selectFilter.QueryArgs {
+		queryArgs[i] = fmt.Sprint(arg)
+	}
+	return &manager.ExplainQueryResponse{
+		StoreName:              s.GetName(),
+		TranslatedQuery:        selectFilter.Query,
+		QueryArgs:              queryArgs,
+		SearchAttributeAliases: aliasRecorder.Aliases(),
+		Plan:                   strings.Join(plan, "\n"),
+	}, nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -331,6 +493,11 @@ func (s *VisibilityStore) GetWorkflowExecution(
 		NamespaceID: request.NamespaceID.String(),
 		RunID:       request.RunID,
 	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, serviceerror.NewNotFoundf(
+			"Workflow execution with RunId %s not found", request.RunID,
+		)
+	}
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("GetWorkflowExecution operation failed. Select failed: %v", err))
@@ -372,6 +539,42 @@ func (s *VisibilityStore) generateVisibilityRow(
 	}, nil
 }
 
+// parseAggregateValue converts the value of an aggregate returned by the DB to the type of the
+// aggregation value. Depending on the DB and the column, numbers can be returned as strings and
+// datetimes as strings in the SQLite format.
+func parseAggregateValue(value any, t enumspb.IndexedValueType) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		if t == enumspb.INDEXED_VALUE_TYPE_DOUBLE {
+			return float64(v), nil
+		}
+		return v, nil
+	case float64:
+		if t == enumspb.INDEXED_VALUE_TYPE_INT {
+			return int64(v), nil
+		}
+		return v, nil
+	case time.Time:
+		return v.UTC(), nil
+	case string:
+		switch t {
+		case enumspb.INDEXED_VALUE_TYPE_INT:
+			return strconv.ParseInt(v, 10, 64)
+		case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+			return strconv.ParseFloat(v, 64)
+		case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+			for _, layout := range []string{time.RFC3339Nano, sqliteDatetimeLayout} {
+				if tm, err := time.Parse(layout, v); err == nil {
+					return tm.UTC(), nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("unexpected value %v of type %T for %s", value, value, t)
+}
+
 func (s *VisibilityStore) prepareSearchAttributesForDb(
 	request *store.InternalVisibilityRequestBase,
 ) (*sqlplugin.VisibilitySearchAttributes, error) {
@@ -213,6 +213,26 @@ func (v *VisibilityManagerDual) CountWorkflowExecutions(
 	return v.managerSelector.readManager(request.Namespace).CountWorkflowExecutions(ctx, request)
 }
 
+func (v *VisibilityManagerDual) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	if v.enableShadowReadMode() {
+		ms, err := v.managerSelector.readManagers(request.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		//nolint:errcheck // ignore error since it's shadow request
+		go ms[1].AggregateWorkflowExecutions(ctx, request)
+		res, err := ms[0].AggregateWorkflowExecutions(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return res, err
+	}
+	return v.managerSelector.readManager(request.Namespace).AggregateWorkflowExecutions(ctx, request)
+}
+
 func (v *VisibilityManagerDual) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -232,3 +252,10 @@ func (v *VisibilityManagerDual) GetWorkflowExecution(
 	}
 	return v.managerSelector.readManager(request.Namespace).GetWorkflowExecution(ctx, request)
 }
+
+func (v *VisibilityManagerDual) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	return v.managerSelector.readManager(request.Namespace).ExplainQuery(ctx, request)
+}
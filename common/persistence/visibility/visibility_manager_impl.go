@@ -164,6 +164,13 @@ func (p *visibilityManagerImpl) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *visibilityManagerImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	return p.store.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (p *visibilityManagerImpl) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -179,6 +186,13 @@ func (p *visibilityManagerImpl) GetWorkflowExecution(
 	return &manager.GetWorkflowExecutionResponse{Execution: execution}, err
 }
 
+func (p *visibilityManagerImpl) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	return p.store.ExplainQuery(ctx, request)
+}
+
 func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(
 	request *manager.VisibilityRequestBase,
 ) (*store.InternalVisibilityRequestBase, error) {
@@ -136,6 +136,16 @@ func (m *visibilityManagerRateLimited) CountWorkflowExecutions(
 	return m.delegate.CountWorkflowExecutions(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	if ok := allow(ctx, "AggregateWorkflowExecutions", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -146,6 +156,16 @@ func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	return m.delegate.GetWorkflowExecution(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	if ok := allow(ctx, "ExplainQuery", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.ExplainQuery(ctx, request)
+}
+
 func allow(
 	ctx context.Context,
 	api string,
@@ -157,6 +157,16 @@ func (m *visibilityManagerMetrics) CountWorkflowExecutions(
 	return response, m.updateErrorMetric(handler, err)
 }
 
+func (m *visibilityManagerMetrics) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceAggregateWorkflowExecutionsScope)
+	response, err := m.delegate.AggregateWorkflowExecutions(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
 func (m *visibilityManagerMetrics) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -167,6 +177,16 @@ func (m *visibilityManagerMetrics) GetWorkflowExecution(
 	return response, m.updateErrorMetric(handler, err)
 }
 
+func (m *visibilityManagerMetrics) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceExplainQueryScope)
+	response, err := m.delegate.ExplainQuery(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
 func (m *visibilityManagerMetrics) tagScope(operation string) (metrics.Handler, time.Time) {
 	taggedHandler := m.metricHandler.WithTags(metrics.OperationTag(operation), m.visibilityPluginNameMetricsTag, m.visibilityIndexNameMetricsTag)
 	metrics.VisibilityPersistenceRequests.With(taggedHandler).Record(1)
@@ -0,0 +1,59 @@
+package priorities
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// MinFairnessWeight and MaxFairnessWeight bound the weight of a fairness key, so that a key can't get all or
+	// none of the dispatches of a task queue.
+	MinFairnessWeight = 0.001
+	MaxFairnessWeight = 1000
+)
+
+// MakeTaskFairness returns the fairness key and weight of the tasks of a workflow, read from its search attributes
+// named keySearchAttribute and weightSearchAttribute. It returns nil when the workflow has no fairness key.
+func MakeTaskFairness(
+	ns *namespace.Namespace,
+	searchAttributes map[string]*commonpb.Payload,
+	keySearchAttribute string,
+	weightSearchAttribute string,
+) *taskqueuespb.TaskFairness {
+	if keySearchAttribute == "" {
+		return nil
+	}
+	var key string
+	if err := payload.Decode(searchAttributes[fieldName(ns, keySearchAttribute)], &key); err != nil || key == "" {
+		return nil
+	}
+	fairness := &taskqueuespb.TaskFairness{Key: key}
+	if weightSearchAttribute != "" {
+		var weight float64
+		if err := payload.Decode(searchAttributes[fieldName(ns, weightSearchAttribute)], &weight); err == nil && weight > 0 {
+			fairness.Weight = float32(weight)
+		}
+	}
+	return fairness
+}
+
+// FairnessWeight returns the weight of a fairness key, 1 when it isn't set.
+func FairnessWeight(fairness *taskqueuespb.TaskFairness) float64 {
+	weight := float64(fairness.GetWeight())
+	if weight <= 0 {
+		return 1
+	}
+	return min(max(weight, MinFairnessWeight), MaxFairnessWeight)
+}
+
+// fieldName returns the name the search attribute is stored with in mutable state, which is the alias itself when
+// the namespace doesn't map custom search attributes.
+func fieldName(ns *namespace.Namespace, alias string) string {
+	mapper := ns.CustomSearchAttributesMapper()
+	if name, err := mapper.GetFieldName(alias, ns.Name().String()); err == nil {
+		return name
+	}
+	return alias
+}
@@ -0,0 +1,58 @@
+package priorities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestMakeTaskFairness(t *testing.T) {
+	ns := namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Name: "ns"},
+		Config: &persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{
+				"Keyword01": "TenantId",
+				"Double01":  "TenantWeight",
+			},
+		},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+	})
+	searchAttributes := map[string]*commonpb.Payload{
+		"Keyword01": payload.EncodeString("tenant-1"),
+		"Double01":  mustEncode(t, 2.5),
+		"Keyword02": payload.EncodeString(""),
+	}
+
+	require.Nil(t, MakeTaskFairness(ns, searchAttributes, "", ""))
+	require.Nil(t, MakeTaskFairness(ns, searchAttributes, "Missing", ""))
+	require.Nil(t, MakeTaskFairness(ns, searchAttributes, "Keyword02", ""))
+	require.Equal(t, "tenant-1", MakeTaskFairness(ns, searchAttributes, "TenantId", "").GetKey())
+
+	fairness := MakeTaskFairness(ns, searchAttributes, "TenantId", "TenantWeight")
+	require.Equal(t, "tenant-1", fairness.GetKey())
+	require.InDelta(t, 2.5, fairness.GetWeight(), 1e-6)
+
+	// an invalid weight is ignored
+	fairness = MakeTaskFairness(ns, searchAttributes, "TenantId", "TenantId")
+	require.Equal(t, "tenant-1", fairness.GetKey())
+	require.Zero(t, fairness.GetWeight())
+}
+
+func TestFairnessWeight(t *testing.T) {
+	require.Equal(t, 1.0, FairnessWeight(nil))
+	require.Equal(t, 1.0, FairnessWeight(&taskqueuespb.TaskFairness{Key: "a", Weight: -1}))
+	require.Equal(t, 3.0, FairnessWeight(&taskqueuespb.TaskFairness{Key: "a", Weight: 3}))
+	require.Equal(t, MinFairnessWeight, FairnessWeight(&taskqueuespb.TaskFairness{Key: "a", Weight: 1e-9}))
+	require.Equal(t, float64(MaxFairnessWeight), FairnessWeight(&taskqueuespb.TaskFairness{Key: "a", Weight: 1e9}))
+}
+
+func mustEncode(t *testing.T, value any) *commonpb.Payload {
+	p, err := payload.Encode(value)
+	require.NoError(t, err)
+	return p
+}
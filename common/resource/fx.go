@@ -277,6 +277,8 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.HistoryEventBlobCompression = dynamicconfig.HistoryEventBlobCompression.Get(dc)
+	persistenceConfig.PayloadEncryption = dynamicconfig.PersistencePayloadEncryption.Get(dc)
 	return &persistenceConfig
 }
 
@@ -293,17 +295,23 @@ func ArchivalMetadataProvider(dc *dynamicconfig.Collection, cfg *config.Config)
 
 func ArchiverProviderProvider(
 	cfg *config.Config,
+	archiverFactories provider.ArchiverFactories,
 	persistenceExecutionManager persistence.ExecutionManager,
 	logger log.SnTaggedLogger,
 	metricsHandler metrics.Handler,
-) provider.ArchiverProvider {
-	return provider.NewArchiverProvider(
+) (provider.ArchiverProvider, error) {
+	archiverProvider := provider.NewArchiverProvider(
 		cfg.Archival.History.Provider,
 		cfg.Archival.Visibility.Provider,
+		archiverFactories,
 		persistenceExecutionManager,
 		logger,
 		metricsHandler,
 	)
+	if err := provider.ValidateNamespaceDefaultURIs(archiverProvider, archiverFactories, &cfg.NamespaceDefaults.Archival); err != nil {
+		return nil, err
+	}
+	return archiverProvider, nil
 }
 
 func SdkClientFactoryProvider(
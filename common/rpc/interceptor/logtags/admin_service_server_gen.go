@@ -21,10 +21,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.AddTasksResponse:
 		return nil
+	case *adminservice.AggregateWorkflowExecutionsRequest:
+		return nil
+	case *adminservice.AggregateWorkflowExecutionsResponse:
+		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
 	case *adminservice.CancelDLQJobResponse:
 		return nil
+	case *adminservice.CheckVisibilityConsistencyRequest:
+		return nil
+	case *adminservice.CheckVisibilityConsistencyResponse:
+		return nil
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.CloseShardResponse:
@@ -33,6 +41,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.DeleteSavedVisibilityQueryResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -62,10 +74,25 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeTaskQueueBacklogRequest:
+		return nil
+	case *adminservice.DescribeTaskQueueBacklogResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DropSearchAttributeRequest:
+		return nil
+	case *adminservice.DropSearchAttributeResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.ExplainVisibilityQueryRequest:
+		return nil
+	case *adminservice.ExplainVisibilityQueryResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -150,6 +177,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListSavedVisibilityQueriesRequest:
+		return nil
+	case *adminservice.ListSavedVisibilityQueriesResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -158,6 +189,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.PauseTaskQueueRequest:
+		return nil
+	case *adminservice.PauseTaskQueueResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -166,6 +201,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.PurgeDLQTasksResponse:
 		return nil
+	case *adminservice.PurgeTaskQueueTasksRequest:
+		return nil
+	case *adminservice.PurgeTaskQueueTasksResponse:
+		return nil
 	case *adminservice.ReapplyEventsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -206,6 +245,17 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.RestoreArchivedWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.RestoreArchivedWorkflowExecutionResponse:
+		return nil
+	case *adminservice.ResumeTaskQueueRequest:
+		return nil
+	case *adminservice.ResumeTaskQueueResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -213,6 +263,17 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateSearchAttributeRequest:
+		return nil
+	case *adminservice.UpdateSearchAttributeResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.UpsertSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.UpsertSavedVisibilityQueryResponse:
+		return nil
 	default:
 		return nil
 	}
@@ -47,6 +47,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.DescribeTaskQueueResponse:
 		return nil
+	case *matchingservice.DescribeTaskQueueBacklogRequest:
+		return nil
+	case *matchingservice.DescribeTaskQueueBacklogResponse:
+		return nil
 	case *matchingservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *matchingservice.DescribeTaskQueuePartitionResponse:
@@ -109,6 +113,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetWorkflowExecution().GetRunId()),
 		}
+	case *matchingservice.PurgeTaskQueueTasksRequest:
+		return nil
+	case *matchingservice.PurgeTaskQueueTasksResponse:
+		return nil
 	case *matchingservice.QueryWorkflowRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetQueryRequest().GetExecution().GetWorkflowId()),
@@ -140,6 +148,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateNexusEndpointResponse:
 		return nil
+	case *matchingservice.UpdateTaskQueuePauseStateRequest:
+		return nil
+	case *matchingservice.UpdateTaskQueuePauseStateResponse:
+		return nil
 	case *matchingservice.UpdateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.UpdateTaskQueueUserDataResponse:
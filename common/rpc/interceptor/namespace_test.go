@@ -57,6 +57,7 @@ var (
 		"ListTasks":                 {},
 		"CompleteNexusOperation":    {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":           {},
+		"StreamVisibilityChanges":   {}, // Server streaming, the request is the 0th parameter.
 	}
 )
 
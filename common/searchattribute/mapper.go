@@ -38,12 +38,25 @@ type (
 		searchAttributesProvider  Provider
 		enableMapperFromNamespace bool
 	}
+
+	// AliasRecorder is a MapperProvider recording the field names the aliases of custom search attributes
+	// are resolved to by the mappers it provides. It is not safe for concurrent use.
+	AliasRecorder struct {
+		provider MapperProvider
+		aliases  map[string]string
+	}
+
+	recordingMapper struct {
+		Mapper
+		aliases map[string]string
+	}
 )
 
 var _ Mapper = (*noopMapper)(nil)
 var _ Mapper = (*backCompMapper_v1_20)(nil)
 var _ Mapper = (*namespace.CustomSearchAttributesMapper)(nil)
 var _ MapperProvider = (*mapperProviderImpl)(nil)
+var _ MapperProvider = (*AliasRecorder)(nil)
 
 func (m *noopMapper) GetAlias(fieldName string, _ string) (string, error) {
 	return fieldName, nil
@@ -112,6 +125,34 @@ func (m *mapperProviderImpl) GetMapper(nsName namespace.Name) (Mapper, error) {
 	}, nil
 }
 
+func NewAliasRecorder(provider MapperProvider) *AliasRecorder {
+	return &AliasRecorder{
+		provider: provider,
+		aliases:  make(map[string]string),
+	}
+}
+
+func (r *AliasRecorder) GetMapper(nsName namespace.Name) (Mapper, error) {
+	mapper, err := r.provider.GetMapper(nsName)
+	if err != nil || mapper == nil {
+		return mapper, err
+	}
+	return &recordingMapper{Mapper: mapper, aliases: r.aliases}, nil
+}
+
+// Aliases returns the field names the aliases were resolved to, keyed by alias.
+func (r *AliasRecorder) Aliases() map[string]string {
+	return r.aliases
+}
+
+func (m *recordingMapper) GetFieldName(alias string, namespace string) (string, error) {
+	fieldName, err := m.Mapper.GetFieldName(alias, namespace)
+	if err == nil {
+		m.aliases[alias] = fieldName
+	}
+	return fieldName, err
+}
+
 // AliasFields returns SearchAttributes struct where each search attribute name is replaced with alias.
 // If no replacement where made, it returns nil which means that original SearchAttributes struct should be used.
 func AliasFields(
@@ -138,3 +138,20 @@ func Test_UnaliasFields(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, sb, sb, "when there is nothin to unalias should return received attributes")
 }
+
+func Test_AliasRecorder(t *testing.T) {
+	recorder := NewAliasRecorder(NewTestMapperProvider(&TestMapper{}))
+	mapper, err := recorder.GetMapper("test-namespace")
+	assert.NoError(t, err)
+
+	fieldName, err := mapper.GetFieldName("AliasForField1", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "Field1", fieldName)
+	_, err = mapper.GetFieldName("wrong_alias", "test-namespace")
+	assert.Error(t, err)
+	alias, err := mapper.GetAlias("Field2", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "AliasForField2", alias)
+
+	assert.Equal(t, map[string]string{"AliasForField1": "Field1"}, recorder.Aliases())
+}
@@ -0,0 +1,176 @@
+package searchattribute
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// IsMigrationField returns true if the field is the source or the target of a custom search attribute field migration.
+// Such fields are not allocated to other search attributes: values of the source fields may remain in mutable states,
+// and the target fields are being backfilled.
+func IsMigrationField(
+	field string,
+	migrations map[string]*persistencespb.CustomSearchAttributeFieldMigration,
+) bool {
+	if field == "" {
+		return false
+	}
+	if _, ok := migrations[field]; ok {
+		return true
+	}
+	for _, migration := range migrations {
+		if migration.GetTargetField() == field {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateFields applies the custom search attribute field migrations of a namespace to search attributes keyed by
+// field name, i.e. the ones of a mutable state. Values of the source fields are converted and copied to the target
+// fields which are not set, and removed once the source fields are dropped. Values which can't be converted to the
+// target type are not copied.
+// The input map is not modified: a new map is returned if any field is migrated.
+func MigrateFields(
+	indexedFields map[string]*commonpb.Payload,
+	migrations map[string]*persistencespb.CustomSearchAttributeFieldMigration,
+) map[string]*commonpb.Payload {
+	if len(indexedFields) == 0 || len(migrations) == 0 {
+		return indexedFields
+	}
+
+	var result map[string]*commonpb.Payload
+	for sourceField, migration := range migrations {
+		sourcePayload, ok := indexedFields[sourceField]
+		if !ok {
+			continue
+		}
+		if result == nil {
+			result = maps.Clone(indexedFields)
+		}
+		if migration.GetSourceDropped() {
+			delete(result, sourceField)
+		}
+		targetField := migration.GetTargetField()
+		if targetField == "" {
+			continue
+		}
+		if _, ok := indexedFields[targetField]; ok {
+			// Value set after the migration started is never overwritten.
+			continue
+		}
+		targetPayload, err := ConvertValue(sourcePayload, migration.GetSourceType(), migration.GetTargetType())
+		if err != nil || targetPayload == nil {
+			continue
+		}
+		result[targetField] = targetPayload
+	}
+
+	if result == nil {
+		return indexedFields
+	}
+	return result
+}
+
+// ConvertValue converts search attribute value of one type to another. Strings are parsed and formatted the same
+// way Stringify and Parse do. It returns nil if the value is nil.
+func ConvertValue(
+	value *commonpb.Payload,
+	sourceType enumspb.IndexedValueType,
+	targetType enumspb.IndexedValueType,
+) (*commonpb.Payload, error) {
+	decoded, err := DecodeValue(value, sourceType, false)
+	if err != nil {
+		return nil, err
+	}
+	if decoded == nil {
+		return nil, nil
+	}
+	converted, err := convertValue(decoded, targetType)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeValue(converted, targetType)
+}
+
+func convertValue(value any, t enumspb.IndexedValueType) (any, error) {
+	if list, ok := value.([]string); ok && t != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		if len(list) != 1 {
+			return nil, fmt.Errorf("%w: unable to convert list of %d values to %v", ErrInvalidType, len(list), t)
+		}
+		value = list[0]
+	}
+
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return formatValue(value)
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		if list, ok := value.([]string); ok {
+			return list, nil
+		}
+		str, err := formatValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return []string{str}, nil
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+				return nil, fmt.Errorf("%w: unable to convert %v to %v", ErrInvalidType, v, t)
+			}
+			return int64(v), nil
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case string:
+			return strconv.ParseFloat(v, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			return time.Parse(time.RFC3339Nano, v)
+		}
+	}
+	return nil, fmt.Errorf("%w: unable to convert %T to %v", ErrInvalidType, value, t)
+}
+
+func formatValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	}
+	return "", fmt.Errorf("%w: unable to convert %T to string", ErrInvalidType, value)
+}
@@ -0,0 +1,152 @@
+package searchattribute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func mustEncodeValue(t *testing.T, val any, valueType enumspb.IndexedValueType) *commonpb.Payload {
+	p, err := EncodeValue(val, valueType)
+	require.NoError(t, err)
+	return p
+}
+
+func mustDecodeValue(t *testing.T, p *commonpb.Payload, valueType enumspb.IndexedValueType) any {
+	val, err := DecodeValue(p, valueType, true)
+	require.NoError(t, err)
+	return val
+}
+
+func TestConvertValue(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	testCases := []struct {
+		name       string
+		value      any
+		sourceType enumspb.IndexedValueType
+		targetType enumspb.IndexedValueType
+		expected   any
+		expectErr  bool
+	}{
+		{"keyword to int", "42", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT, int64(42), false},
+		{"keyword to int invalid", "abc", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT, nil, true},
+		{"int to keyword", int64(42), enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "42", false},
+		{"int to double", int64(42), enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, float64(42), false},
+		{"double to int", 42.0, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT, int64(42), false},
+		{"double to int fraction", 42.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT, nil, true},
+		{"keyword to bool", "true", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_BOOL, true, false},
+		{"bool to int", true, enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_INT, nil, true},
+		{"keyword to datetime", ts.Format(time.RFC3339Nano), enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DATETIME, ts, false},
+		{"datetime to text", ts, enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_TEXT, ts.Format(time.RFC3339Nano), false},
+		{"keyword to keyword list", "a", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, []string{"a"}, false},
+		{"keyword list to keyword", []string{"a"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "a", false},
+		{"keyword list of many to keyword", []string{"a", "b"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := ConvertValue(mustEncodeValue(t, tc.value, tc.sourceType), tc.sourceType, tc.targetType)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.targetType.String(), string(converted.Metadata[MetadataType]))
+			assert.Equal(t, tc.expected, mustDecodeValue(t, converted, tc.targetType))
+		})
+	}
+}
+
+func TestConvertValue_Nil(t *testing.T) {
+	converted, err := ConvertValue(mustEncodeValue(t, nil, enumspb.INDEXED_VALUE_TYPE_KEYWORD), enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT)
+	assert.NoError(t, err)
+	assert.Nil(t, converted)
+}
+
+func TestMigrateFields(t *testing.T) {
+	migrations := map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+		"Keyword01": {
+			TargetField: "Int01",
+			SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+		"Keyword02": {
+			SourceType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			SourceDropped: true,
+		},
+	}
+
+	t.Run("no migrated field", func(t *testing.T) {
+		fields := map[string]*commonpb.Payload{
+			"Keyword03": mustEncodeValue(t, "a", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		}
+		assert.Equal(t, fields, MigrateFields(fields, migrations))
+	})
+
+	t.Run("copy to target field", func(t *testing.T) {
+		fields := map[string]*commonpb.Payload{
+			"Keyword01": mustEncodeValue(t, "42", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		}
+		migrated := MigrateFields(fields, migrations)
+		assert.Len(t, fields, 1)
+		assert.Len(t, migrated, 2)
+		assert.Equal(t, "42", mustDecodeValue(t, migrated["Keyword01"], enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+		assert.Equal(t, int64(42), mustDecodeValue(t, migrated["Int01"], enumspb.INDEXED_VALUE_TYPE_INT))
+	})
+
+	t.Run("target field is set", func(t *testing.T) {
+		fields := map[string]*commonpb.Payload{
+			"Keyword01": mustEncodeValue(t, "42", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			"Int01":     mustEncodeValue(t, int64(7), enumspb.INDEXED_VALUE_TYPE_INT),
+		}
+		migrated := MigrateFields(fields, migrations)
+		assert.Equal(t, int64(7), mustDecodeValue(t, migrated["Int01"], enumspb.INDEXED_VALUE_TYPE_INT))
+	})
+
+	t.Run("value not convertible", func(t *testing.T) {
+		fields := map[string]*commonpb.Payload{
+			"Keyword01": mustEncodeValue(t, "abc", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		}
+		migrated := MigrateFields(fields, migrations)
+		assert.Len(t, migrated, 1)
+		assert.NotContains(t, migrated, "Int01")
+	})
+
+	t.Run("source dropped", func(t *testing.T) {
+		fields := map[string]*commonpb.Payload{
+			"Keyword01": mustEncodeValue(t, "42", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			"Keyword02": mustEncodeValue(t, "b", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		}
+		droppedMigrations := map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Keyword01": {
+				TargetField:   "Int01",
+				SourceType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetType:    enumspb.INDEXED_VALUE_TYPE_INT,
+				SourceDropped: true,
+			},
+			"Keyword02": migrations["Keyword02"],
+		}
+		migrated := MigrateFields(fields, droppedMigrations)
+		assert.Len(t, fields, 2)
+		assert.Len(t, migrated, 1)
+		assert.Equal(t, int64(42), mustDecodeValue(t, migrated["Int01"], enumspb.INDEXED_VALUE_TYPE_INT))
+	})
+}
+
+func TestIsMigrationField(t *testing.T) {
+	migrations := map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+		"Keyword01": {TargetField: "Int01"},
+		"Keyword02": {SourceDropped: true},
+	}
+	assert.True(t, IsMigrationField("Keyword01", migrations))
+	assert.True(t, IsMigrationField("Int01", migrations))
+	assert.True(t, IsMigrationField("Keyword02", migrations))
+	assert.False(t, IsMigrationField("Keyword03", migrations))
+	assert.False(t, IsMigrationField("", migrations))
+	assert.False(t, IsMigrationField("Keyword01", nil))
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"maps"
 	"net"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -35,6 +36,9 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -49,9 +53,9 @@ import (
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
-	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
@@ -62,8 +66,10 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/updatesearchattribute"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -71,6 +77,10 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+
+	restoreArchivedHistoryPageSize    = 100
+	restoreArchivedHistoryMaxBatches  = 16
+	restoreArchivedHistoryMaxBlobSize = 256 * 1024 // 256K
 )
 
 type (
@@ -107,6 +117,9 @@ type (
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		archivalMetadata           archiver.ArchivalMetadata
+		archiverProvider           provider.ArchiverProvider
+		namespaceHandler           *namespaceHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -141,12 +154,19 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		ArchiverProvider                    provider.ArchiverProvider
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
 		CategoryRegistry tasks.TaskCategoryRegistry
 		matchingClient   matchingservice.MatchingServiceClient
 	}
+
+	visibilityChangeShardResponse struct {
+		shardID  int32
+		response *historyservice.StreamVisibilityChangesResponse
+	}
 )
 
 var (
@@ -155,6 +175,10 @@ var (
 	resendStartEventID = int64(0)
 )
 
+var visibilityChangeStreamRetryPolicy = backoff.NewExponentialRetryPolicy(100 * time.Millisecond).
+	WithMaximumInterval(10 * time.Second).
+	WithExpirationInterval(backoff.NoInterval)
+
 // NewAdminHandler creates a gRPC handler for the adminservice
 func NewAdminHandler(
 	args NewAdminHandlerArgs,
@@ -225,6 +249,18 @@ func NewAdminHandler(
 		clusterMetadata:      args.ClusterMetadata,
 		healthServer:         args.HealthServer,
 		historyHealthChecker: historyHealthChecker,
+		archivalMetadata:     args.ArchivalMetadata,
+		archiverProvider:     args.ArchiverProvider,
+		namespaceHandler: newNamespaceHandler(
+			args.Logger,
+			args.PersistenceMetadataManager,
+			args.ClusterMetadata,
+			nsreplication.NewReplicator(args.NamespaceReplicationQueue, args.Logger),
+			args.ArchivalMetadata,
+			args.ArchiverProvider,
+			args.TimeSource,
+			args.Config,
+		),
 		taskCategoryRegistry: args.CategoryRegistry,
 		matchingClient:       args.matchingClient,
 	}
@@ -308,7 +344,7 @@ func (adh *AdminHandler) AddSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if visibility.HasElasticsearchCompatibleStore(adh.visibilityMgr) || indexName == "" {
 		err = adh.addSearchAttributesElasticsearch(ctx, request, indexName)
 	} else {
 		err = adh.addSearchAttributesSQL(ctx, request, currentSearchAttributes)
@@ -383,6 +419,12 @@ func (adh *AdminHandler) addSearchAttributesSQL(
 		return serviceerror.NewUnavailablef(errUnableToGetNamespaceInfoMessage, nsName, err)
 	}
 
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	fieldMigrations := nsEntry.CustomSearchAttributeFieldMigrations()
+
 	dbCustomSearchAttributes := searchattribute.GetSqlDbIndexSearchAttributes().CustomSearchAttributes
 	cmCustomSearchAttributes := currentSearchAttributes.Custom()
 	upsertFieldToAliasMap := make(map[string]string)
@@ -410,6 +452,8 @@ func (adh *AdminHandler) addSearchAttributesSQL(
 				cntUsed++
 			} else if _, ok := upsertFieldToAliasMap[fieldName]; ok {
 				cntUsed++
+			} else if searchattribute.IsMigrationField(fieldName, fieldMigrations) {
+				cntUsed++
 			} else {
 				targetFieldName = fieldName
 				break
@@ -466,7 +510,7 @@ func (adh *AdminHandler) RemoveSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if visibility.HasElasticsearchCompatibleStore(adh.visibilityMgr) || indexName == "" {
 		err = adh.removeSearchAttributesElasticsearch(ctx, request, indexName, currentSearchAttributes)
 	} else {
 		err = adh.removeSearchAttributesSQL(ctx, request, currentSearchAttributes)
@@ -577,7 +621,7 @@ func (adh *AdminHandler) GetSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if visibility.HasElasticsearchCompatibleStore(adh.visibilityMgr) || indexName == "" {
 		return adh.getSearchAttributesElasticsearch(ctx, indexName, searchAttributes)
 	}
 	return adh.getSearchAttributesSQL(ctx, request, searchAttributes)
@@ -729,6 +773,794 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// RestoreArchivedWorkflowExecution reads the history of a workflow from the archival store and imports it
+// back into primary persistence. The history is imported in chunks via history service ImportWorkflowExecution,
+// which rebuilds the mutable state of the workflow when the import is committed.
+func (adh *AdminHandler) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+) (_ *adminservice.RestoreArchivedWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.Execution.GetRunId() == "" {
+		return nil, errRunIDNotSet
+	}
+
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	URIString := nsEntry.HistoryArchivalState().URI
+	if URIString == "" {
+		// namespace has never enabled history archival, so there is nothing to restore from
+		return nil, errHistoryNotFound
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(URI.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	// readArchivedHistory calls fn with each archived history batch, one page is held in memory at a time.
+	readArchivedHistory := func(fn func(historyBatch *historypb.History) error) error {
+		var nextPageToken []byte
+		for {
+			resp, err := historyArchiver.Get(ctx, URI, &archiver.GetHistoryRequest{
+				NamespaceID:   nsEntry.ID().String(),
+				WorkflowID:    request.Execution.GetWorkflowId(),
+				RunID:         request.Execution.GetRunId(),
+				NextPageToken: nextPageToken,
+				PageSize:      restoreArchivedHistoryPageSize,
+			})
+			if err != nil {
+				return err
+			}
+			for _, historyBatch := range resp.HistoryBatches {
+				if err := fn(historyBatch); err != nil {
+					return err
+				}
+			}
+			nextPageToken = resp.NextPageToken
+			if len(nextPageToken) == 0 {
+				return nil
+			}
+		}
+	}
+
+	// The version history sent along with each import request must cover the whole history,
+	// so it's built from a first pass over the archived history before the second pass imports it.
+	versionHistory := &historyspb.VersionHistory{}
+	historyLength := int64(0)
+	if err := readArchivedHistory(func(historyBatch *historypb.History) error {
+		for _, event := range historyBatch.Events {
+			item := versionhistory.NewVersionHistoryItem(event.GetEventId(), event.GetVersion())
+			if err := versionhistory.AddOrUpdateVersionHistoryItem(versionHistory, item); err != nil {
+				return serviceerror.NewInternal(fmt.Sprintf("Unable to build version history from archived history: %v", err))
+			}
+			historyLength++
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if historyLength == 0 {
+		return nil, errHistoryNotFound
+	}
+
+	var token []byte
+	importFn := func(blobs []*commonpb.DataBlob) error {
+		resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+			NamespaceId:    nsEntry.ID().String(),
+			Execution:      request.Execution,
+			HistoryBatches: blobs,
+			VersionHistory: versionHistory,
+			Token:          token,
+		})
+		if err != nil {
+			return err
+		}
+		token = resp.Token
+		return nil
+	}
+
+	var blobs []*commonpb.DataBlob
+	blobSize := 0
+	eventsVersion := common.EmptyVersion
+	if err := readArchivedHistory(func(historyBatch *historypb.History) error {
+		if len(historyBatch.Events) == 0 {
+			return nil
+		}
+		// events of different versions are imported in separate requests
+		if eventsVersion != common.EmptyVersion && eventsVersion != historyBatch.Events[0].GetVersion() {
+			if err := importFn(blobs); err != nil {
+				return err
+			}
+			blobs = nil
+			blobSize = 0
+		}
+		blob, err := adh.eventSerializer.SerializeEvents(historyBatch.Events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return err
+		}
+		eventsVersion = historyBatch.Events[0].GetVersion()
+		blobSize += len(blob.Data)
+		blobs = append(blobs, blob)
+
+		if blobSize >= restoreArchivedHistoryMaxBlobSize || len(blobs) >= restoreArchivedHistoryMaxBatches {
+			if err := importFn(blobs); err != nil {
+				return err
+			}
+			blobs = nil
+			blobSize = 0
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(blobs) != 0 {
+		if err := importFn(blobs); err != nil {
+			return nil, err
+		}
+	}
+
+	// call with empty history to commit the import
+	if err := importFn(nil); err != nil {
+		return nil, err
+	}
+	if len(token) != 0 {
+		return nil, serviceerror.NewInternal("Failed to commit restored workflow history.")
+	}
+
+	adh.logger.Info("Restored archived workflow execution.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowID(request.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(request.Execution.GetRunId()),
+		tag.Counter(int(historyLength)),
+	)
+	return &adminservice.RestoreArchivedWorkflowExecutionResponse{
+		HistoryLength: historyLength,
+	}, nil
+}
+
+func (adh *AdminHandler) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	pageSize := int(request.GetPageSize())
+	maxPageSize := adh.config.VisibilityMaxPageSize(request.GetNamespace())
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	persistenceResp, err := adh.visibilityMgr.AggregateWorkflowExecutions(ctx, &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID:   namespaceID,
+		Namespace:     namespaceName,
+		Query:         request.GetQuery(),
+		GroupBy:       request.GetGroupBy(),
+		Aggregations:  request.GetAggregations(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.AggregateWorkflowExecutionsResponse{
+		Groups:        persistenceResp.Groups,
+		NextPageToken: persistenceResp.NextPageToken,
+	}, nil
+}
+
+func (adh *AdminHandler) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+) (_ *adminservice.CheckVisibilityConsistencyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetSampleRate() < 0 || request.GetSampleRate() > 1 {
+		return nil, errInvalidSampleRate
+	}
+	visManagerDual, ok := adh.visibilityMgr.(*visibility.VisibilityManagerDual)
+	if !ok {
+		return nil, errClusterIsNotConfiguredForSecondaryVisibility
+	}
+
+	pageSize := int(request.GetPageSize())
+	maxPageSize := adh.config.VisibilityMaxPageSize(request.GetNamespace())
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := visibility.NewConsistencyChecker(
+		visManagerDual.GetPrimaryVisibility(),
+		visManagerDual.GetSecondaryVisibility(),
+		adh.metricsHandler,
+	)
+	checkResp, err := checker.Check(ctx, &visibility.CheckConsistencyRequest{
+		NamespaceID:   namespaceID,
+		Namespace:     namespaceName,
+		Query:         request.GetQuery(),
+		SampleRate:    request.GetSampleRate(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetRepair() {
+		for _, mismatch := range checkResp.Mismatches {
+			err := adh.repairVisibilityRecord(ctx, namespaceID, mismatch.GetExecution())
+			if err != nil {
+				adh.logger.Warn("Failed to repair visibility record.",
+					tag.WorkflowNamespaceID(namespaceID.String()),
+					tag.WorkflowID(mismatch.GetExecution().GetWorkflowId()),
+					tag.WorkflowRunID(mismatch.GetExecution().GetRunId()),
+					tag.Error(err))
+				continue
+			}
+			mismatch.Repaired = true
+		}
+	}
+
+	return &adminservice.CheckVisibilityConsistencyResponse{
+		CheckedCount:  checkResp.CheckedCount,
+		Mismatches:    checkResp.Mismatches,
+		NextPageToken: checkResp.NextPageToken,
+	}, nil
+}
+
+func (adh *AdminHandler) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+) (_ *adminservice.ExplainVisibilityQueryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	pageSize := int(request.GetPageSize())
+	maxPageSize := adh.config.VisibilityMaxPageSize(request.GetNamespace())
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	persistenceResp, err := adh.visibilityMgr.ExplainQuery(ctx, &manager.ExplainQueryRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       request.GetQuery(),
+		PageSize:    pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.ExplainVisibilityQueryResponse{
+		StoreName:              persistenceResp.StoreName,
+		TranslatedQuery:        persistenceResp.TranslatedQuery,
+		QueryArgs:              persistenceResp.QueryArgs,
+		SearchAttributeAliases: persistenceResp.SearchAttributeAliases,
+		Plan:                   persistenceResp.Plan,
+	}, nil
+}
+
+// StreamVisibilityChanges streams the changes made to the visibility records of the workflow executions of a
+// namespace by the visibility queues of all the history shards. Changes are sent at least once, in the order the
+// visibility tasks were executed, so the changes of a workflow execution have to be ordered by task ID. The stream of
+// a history shard is opened again when the shard moves to another host. The cursor of a response resumes the stream
+// after the changes sent so far.
+func (adh *AdminHandler) StreamVisibilityChanges(
+	request *adminservice.StreamVisibilityChangesRequest,
+	server adminservice.AdminService_StreamVisibilityChangesServer,
+) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return errNamespaceNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return err
+	}
+	cursor := &adminservice.VisibilityChangeCursor{}
+	if err := proto.Unmarshal(request.GetCursor(), cursor); err != nil {
+		return errInvalidVisibilityChangeCursor
+	}
+	if cursor.Shards == nil {
+		cursor.Shards = make(map[int32]*adminservice.VisibilityChangeShardCursor)
+	}
+
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	responseCh := make(chan visibilityChangeShardResponse)
+	errCh := make(chan error, adh.numberOfHistoryShards)
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		go adh.streamShardVisibilityChanges(ctx, namespaceID, shardID, cursor.Shards[shardID], responseCh, errCh)
+	}
+
+	for {
+		var shardResp visibilityChangeShardResponse
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			return err
+		case shardResp = <-responseCh:
+		}
+
+		changes := shardResp.response.GetChanges()
+		if err := adh.aliasVisibilityChanges(request.GetNamespace(), changes); err != nil {
+			return err
+		}
+		cursor.Shards[shardResp.shardID] = shardResp.response.GetCursor()
+		cursorBytes, err := proto.Marshal(cursor)
+		if err != nil {
+			return err
+		}
+		if err := server.Send(&adminservice.StreamVisibilityChangesResponse{
+			Changes: changes,
+			Cursor:  cursorBytes,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// streamShardVisibilityChanges forwards the visibility changes of a history shard until the context is done. The stream
+// of the shard is opened again from the last forwarded cursor when it ends or fails with a transient error, e.g.
+// because the shard was unloaded. Other errors are sent to errCh.
+func (adh *AdminHandler) streamShardVisibilityChanges(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	shardID int32,
+	cursor *adminservice.VisibilityChangeShardCursor,
+	responseCh chan<- visibilityChangeShardResponse,
+	errCh chan<- error,
+) {
+	retrier := backoff.NewRetrier(visibilityChangeStreamRetryPolicy, clock.NewRealTimeSource())
+	for {
+		err := adh.forwardShardVisibilityChanges(ctx, namespaceID, shardID, &cursor, retrier, responseCh)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != io.EOF && !common.IsServiceClientTransientError(err) {
+			errCh <- err
+			return
+		}
+		adh.logger.Warn("Visibility change stream of a history shard failed, opening it again.",
+			tag.ShardID(shardID),
+			tag.Error(err))
+		timer := time.NewTimer(retrier.NextBackOff(err))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// forwardShardVisibilityChanges opens the stream of a history shard at the cursor and forwards its responses, until
+// the stream fails. The cursor is advanced with each forwarded response.
+func (adh *AdminHandler) forwardShardVisibilityChanges(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	shardID int32,
+	cursor **adminservice.VisibilityChangeShardCursor,
+	retrier backoff.Retrier,
+	responseCh chan<- visibilityChangeShardResponse,
+) error {
+	stream, err := adh.historyClient.StreamVisibilityChanges(ctx, &historyservice.StreamVisibilityChangesRequest{
+		NamespaceId: namespaceID.String(),
+		ShardId:     shardID,
+		Cursor:      *cursor,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		retrier.Reset()
+		select {
+		case responseCh <- visibilityChangeShardResponse{shardID: shardID, response: response}:
+			*cursor = response.GetCursor()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// aliasVisibilityChanges replaces the field names of the search attributes of the changes with their aliases.
+func (adh *AdminHandler) aliasVisibilityChanges(
+	namespaceName string,
+	changes []*adminservice.VisibilityChange,
+) error {
+	if !slices.ContainsFunc(changes, func(change *adminservice.VisibilityChange) bool {
+		return change.GetSearchAttributes() != nil
+	}) {
+		return nil
+	}
+	saTypeMap, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), false)
+	if err != nil {
+		return serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	for _, change := range changes {
+		if change.GetSearchAttributes() == nil {
+			continue
+		}
+		searchattribute.ApplyTypeMap(change.GetSearchAttributes(), saTypeMap)
+		aliasedSas, err := searchattribute.AliasFields(adh.saMapperProvider, change.GetSearchAttributes(), namespaceName)
+		if err != nil {
+			return err
+		}
+		change.SearchAttributes = aliasedSas
+	}
+	return nil
+}
+
+// repairVisibilityRecord regenerates the visibility tasks of the workflow execution, which rewrite its
+// records in all the visibility stores written by history service. Records of workflow executions which
+// don't exist anymore are deleted.
+func (adh *AdminHandler) repairVisibilityRecord(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) error {
+	_, err := adh.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: namespaceID.String(),
+			Execution:   execution,
+		},
+	})
+	var notFound *serviceerror.NotFound
+	if !errors.As(err, &notFound) {
+		return err
+	}
+	_, err = adh.historyClient.DeleteWorkflowVisibilityRecord(ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	return err
+}
+
+func (adh *AdminHandler) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.UpsertSavedVisibilityQueryRequest,
+) (_ *adminservice.UpsertSavedVisibilityQueryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetName() == "" {
+		return nil, errSavedVisibilityQueryNameNotSet
+	}
+	if request.GetSavedQuery() == nil {
+		return nil, errSavedVisibilityQueryNotSet
+	}
+	saTypeMap, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	nsID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	err = visibility.ValidateSavedQuery(
+		adh.visibilityMgr.GetStoreNames(),
+		namespace.Name(request.GetNamespace()),
+		nsID,
+		saTypeMap,
+		adh.saMapperProvider,
+		request.GetSavedQuery(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = adh.namespaceHandler.UpsertSavedVisibilityQuery(ctx, request.GetNamespace(), request.GetName(), request.GetSavedQuery())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpsertSavedVisibilityQueryResponse{}, nil
+}
+
+func (adh *AdminHandler) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+) (_ *adminservice.DeleteSavedVisibilityQueryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetName() == "" {
+		return nil, errSavedVisibilityQueryNameNotSet
+	}
+
+	err := adh.namespaceHandler.DeleteSavedVisibilityQuery(ctx, request.GetNamespace(), request.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteSavedVisibilityQueryResponse{}, nil
+}
+
+func (adh *AdminHandler) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+) (_ *adminservice.ListSavedVisibilityQueriesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	savedQueries, err := adh.namespaceHandler.ListSavedVisibilityQueries(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListSavedVisibilityQueriesResponse{
+		SavedQueries: savedQueries,
+	}, nil
+}
+
+func (adh *AdminHandler) UpdateSearchAttribute(
+	ctx context.Context,
+	request *adminservice.UpdateSearchAttributeRequest,
+) (_ *adminservice.UpdateSearchAttributeResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNewName() == "" && request.GetNewType() == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return nil, errSearchAttributeUpdateNotSet
+	}
+	if searchattribute.IsReserved(request.GetNewName()) {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeIsReservedMessage, request.GetNewName())
+	}
+	if _, ok := enumspb.IndexedValueType_name[int32(request.GetNewType())]; !ok {
+		return nil, serviceerror.NewInvalidArgumentf(errUnknownSearchAttributeTypeMessage, request.GetNewType())
+	}
+	params := updatesearchattribute.WorkflowParams{
+		Namespace: namespace.Name(request.GetNamespace()),
+		Name:      request.GetName(),
+		NewName:   request.GetNewName(),
+		NewType:   request.GetNewType(),
+	}
+	elasticsearch, err := adh.validateSearchAttributeUpdate(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !elasticsearch && request.GetNewType() == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		// A rename only changes the alias of the field, there are no values to backfill.
+		err := adh.namespaceHandler.updateNamespaceConfig(ctx, request.GetNamespace(), func(config *persistencespb.NamespaceConfig) error {
+			aliasToField := util.InverseMap(config.GetCustomSearchAttributeAliases())
+			field, ok := aliasToField[request.GetName()]
+			if !ok {
+				return serviceerror.NewInvalidArgumentf(errSearchAttributeDoesntExistMessage, request.GetName())
+			}
+			if _, ok := aliasToField[request.GetNewName()]; ok {
+				return serviceerror.NewAlreadyExistsf(errSearchAttributeAlreadyExistsMessage, request.GetNewName())
+			}
+			config.CustomSearchAttributeAliases[field] = request.GetNewName()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &adminservice.UpdateSearchAttributeResponse{}, nil
+	}
+
+	execution, err := adh.startUpdateSearchAttributeWorkflow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateSearchAttributeResponse{Execution: execution}, nil
+}
+
+func (adh *AdminHandler) DropSearchAttribute(
+	ctx context.Context,
+	request *adminservice.DropSearchAttributeRequest,
+) (_ *adminservice.DropSearchAttributeResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	params := updatesearchattribute.WorkflowParams{
+		Namespace: namespace.Name(request.GetNamespace()),
+		Name:      request.GetName(),
+		Drop:      true,
+	}
+	if _, err := adh.validateSearchAttributeUpdate(ctx, &params); err != nil {
+		return nil, err
+	}
+
+	execution, err := adh.startUpdateSearchAttributeWorkflow(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DropSearchAttributeResponse{Execution: execution}, nil
+}
+
+// validateSearchAttributeUpdate checks that the custom search attribute exists in the namespace, that its new name
+// isn't used, and that it isn't being migrated already. It sets the ID of the namespace in the workflow params, and
+// returns whether the visibility stores are Elasticsearch compatible.
+//
+// The custom search attributes of Elasticsearch are the fields of the index mappings, shared by all the namespaces,
+// instead of aliases of pre-allocated fields. Their values are migrated to a field with the new name, which is added
+// to the index mappings if it doesn't exist yet, so a new name is required. The new type defaults to the current one.
+func (adh *AdminHandler) validateSearchAttributeUpdate(
+	ctx context.Context,
+	params *updatesearchattribute.WorkflowParams,
+) (bool, error) {
+	if params.Namespace == "" {
+		return false, errNamespaceNotSet
+	}
+	if params.Name == "" {
+		return false, errSearchAttributeNameNotSet
+	}
+	indexNames, elasticsearch, err := adh.searchAttributeUpdateIndexNames()
+	if err != nil {
+		return false, err
+	}
+	if elasticsearch && !params.Drop && (params.NewName == "" || params.NewName == params.Name) {
+		return false, errSearchAttributeNewNameNotSet
+	}
+
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: params.Namespace.String()})
+	if err != nil {
+		return false, err
+	}
+	config := resp.Namespace.GetConfig()
+	field := params.Name
+	if elasticsearch {
+		for _, indexName := range indexNames {
+			searchAttributes, err := adh.saProvider.GetSearchAttributes(indexName, true)
+			if err != nil {
+				return false, serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+			}
+			currentType, ok := searchAttributes.Custom()[params.Name]
+			if !ok {
+				return false, serviceerror.NewInvalidArgumentf(errSearchAttributeDoesntExistMessage, params.Name)
+			}
+			if params.Drop {
+				continue
+			}
+			if params.NewType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+				params.NewType = currentType
+			}
+			if newType, ok := searchAttributes.Custom()[params.NewName]; ok && newType != params.NewType {
+				return false, serviceerror.NewAlreadyExistsf(errSearchAttributeAlreadyExistsMessage, params.NewName)
+			}
+		}
+		if searchattribute.IsMigrationField(params.NewName, config.GetCustomSearchAttributeFieldMigrations()) {
+			return false, serviceerror.NewFailedPreconditionf(errSearchAttributeIsBeingUpdatedMessage, params.NewName)
+		}
+	} else {
+		aliasToField := util.InverseMap(config.GetCustomSearchAttributeAliases())
+		var ok bool
+		field, ok = aliasToField[params.Name]
+		if !ok {
+			return false, serviceerror.NewInvalidArgumentf(errSearchAttributeDoesntExistMessage, params.Name)
+		}
+		if _, ok := aliasToField[params.NewName]; ok && params.NewName != params.Name {
+			return false, serviceerror.NewAlreadyExistsf(errSearchAttributeAlreadyExistsMessage, params.NewName)
+		}
+	}
+	if _, ok := config.GetCustomSearchAttributeFieldMigrations()[field]; ok {
+		return false, serviceerror.NewFailedPreconditionf(errSearchAttributeIsBeingUpdatedMessage, params.Name)
+	}
+	params.NamespaceID = namespace.ID(resp.Namespace.GetInfo().GetId())
+	return elasticsearch, nil
+}
+
+// searchAttributeUpdateIndexNames returns the index names of the visibility stores, and whether they are Elasticsearch
+// compatible. Search attributes can't be updated if only some of them are.
+func (adh *AdminHandler) searchAttributeUpdateIndexNames() ([]string, bool, error) {
+	visManagers := []manager.VisibilityManager{adh.visibilityMgr}
+	if visManagerDual, ok := adh.visibilityMgr.(*visibility.VisibilityManagerDual); ok {
+		visManagers = []manager.VisibilityManager{
+			visManagerDual.GetPrimaryVisibility(),
+			visManagerDual.GetSecondaryVisibility(),
+		}
+	}
+	var indexNames []string
+	var elasticsearchCount int
+	for _, visManager := range visManagers {
+		indexNames = append(indexNames, visManager.GetIndexName())
+		if visibility.IsElasticsearchCompatibleStore(visManager.GetStoreNames()[0]) {
+			elasticsearchCount++
+		}
+	}
+	if elasticsearchCount > 0 && elasticsearchCount < len(visManagers) {
+		return nil, false, errSearchAttributeUpdateNotSupported
+	}
+	return indexNames, elasticsearchCount > 0, nil
+}
+
+func (adh *AdminHandler) startUpdateSearchAttributeWorkflow(
+	ctx context.Context,
+	params updatesearchattribute.WorkflowParams,
+) (*commonpb.WorkflowExecution, error) {
+	// Only one search attribute of a namespace is updated at a time.
+	workflowID := fmt.Sprintf("%s-%s", updatesearchattribute.WorkflowName, params.NamespaceID)
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                                primitives.DefaultWorkerTaskQueue,
+			ID:                                       workflowID,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		updatesearchattribute.WorkflowName,
+		params,
+	)
+	if err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil, serviceerror.NewFailedPreconditionf(errSearchAttributeUpdateRunningMessage, params.Namespace)
+		}
+		return nil, serviceerror.NewUnavailablef(errUnableToStartWorkflowMessage, updatesearchattribute.WorkflowName, err)
+	}
+	return &commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
 func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
 	var unaliasedBatches []*commonpb.DataBlob
 	for _, historyBatch := range historyBatches {
@@ -1733,6 +2565,143 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// PauseTaskQueue pauses the dispatch of tasks of a task queue in all its partitions
+func (adh *AdminHandler) PauseTaskQueue(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueRequest,
+) (_ *adminservice.PauseTaskQueueResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.updateTaskQueuePauseState(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueTypes(), true, request.GetReason(), request.GetIdentity()); err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseTaskQueueResponse{}, nil
+}
+
+// ResumeTaskQueue resumes the dispatch of tasks of a task queue paused by PauseTaskQueue
+func (adh *AdminHandler) ResumeTaskQueue(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueRequest,
+) (_ *adminservice.ResumeTaskQueueResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.updateTaskQueuePauseState(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueTypes(), false, "", request.GetIdentity()); err != nil {
+		return nil, err
+	}
+	return &adminservice.ResumeTaskQueueResponse{}, nil
+}
+
+func (adh *AdminHandler) updateTaskQueuePauseState(
+	ctx context.Context,
+	namespaceName string,
+	taskQueue string,
+	taskQueueTypes []enumspb.TaskQueueType,
+	paused bool,
+	reason string,
+	identity string,
+) error {
+	if len(namespaceName) == 0 {
+		return errNamespaceNotSet
+	}
+	if len(taskQueue) == 0 {
+		return errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return err
+	}
+
+	_, err = adh.matchingClient.UpdateTaskQueuePauseState(ctx, &matchingservice.UpdateTaskQueuePauseStateRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      taskQueue,
+		TaskQueueTypes: taskQueueTypes,
+		Paused:         paused,
+		Reason:         reason,
+		Identity:       identity,
+	})
+	return err
+}
+
+// PurgeTaskQueueTasks deletes the backlog tasks of a task queue matching a filter, in all its partitions
+func (adh *AdminHandler) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+) (_ *adminservice.PurgeTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.matchingClient.PurgeTaskQueueTasks(ctx, &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:      namespaceID.String(),
+		TaskQueue:        request.GetTaskQueue(),
+		TaskQueueTypes:   request.GetTaskQueueTypes(),
+		WorkflowIdPrefix: request.GetWorkflowIdPrefix(),
+		TypeName:         request.GetTypeName(),
+		MinAge:           request.GetMinAge(),
+		Reason:           request.GetReason(),
+		Identity:         request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PurgeTaskQueueTasksResponse{}, nil
+}
+
+// DescribeTaskQueueBacklog summarizes the tasks persisted in the backlog of a task queue partition
+func (adh *AdminHandler) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+) (_ *adminservice.DescribeTaskQueueBacklogResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueuePartition().GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		MaxTasks:           request.GetMaxTasks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeTaskQueueBacklogResponse{
+		Summary: resp.GetSummary(),
+	}, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +22,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
@@ -33,14 +35,17 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
@@ -51,15 +56,19 @@ import (
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/updatesearchattribute"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type (
@@ -144,6 +153,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		VisibilityMaxPageSize:                 dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		MaxSavedVisibilityQueriesPerNamespace: dynamicconfig.GetIntPropertyFnFilteredByNamespace(1),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -172,6 +183,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetArchiverProvider(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1721,6 +1734,105 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestPauseTaskQueue() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := handler.PauseTaskQueue(ctx, &adminservice.PauseTaskQueueRequest{Namespace: s.namespace.String()})
+	s.Equal(&serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."}, err)
+
+	s.mockMatchingClient.EXPECT().UpdateTaskQueuePauseState(ctx, &matchingservice.UpdateTaskQueuePauseStateRequest{
+		NamespaceId:    s.namespaceID.String(),
+		TaskQueue:      "hello-world",
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		Paused:         true,
+		Reason:         "incident",
+		Identity:       "operator",
+	}).Return(&matchingservice.UpdateTaskQueuePauseStateResponse{}, nil).Times(1)
+	resp, err := handler.PauseTaskQueue(ctx, &adminservice.PauseTaskQueueRequest{
+		Namespace:      s.namespace.String(),
+		TaskQueue:      "hello-world",
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		Reason:         "incident",
+		Identity:       "operator",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	s.mockMatchingClient.EXPECT().UpdateTaskQueuePauseState(ctx, &matchingservice.UpdateTaskQueuePauseStateRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "hello-world",
+		Paused:      false,
+		Identity:    "operator",
+	}).Return(&matchingservice.UpdateTaskQueuePauseStateResponse{}, nil).Times(1)
+	_, err = handler.ResumeTaskQueue(ctx, &adminservice.ResumeTaskQueueRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "hello-world",
+		Identity:  "operator",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestPurgeTaskQueueTasks() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := handler.PurgeTaskQueueTasks(ctx, &adminservice.PurgeTaskQueueTasksRequest{Namespace: s.namespace.String()})
+	s.Equal(&serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."}, err)
+
+	s.mockMatchingClient.EXPECT().PurgeTaskQueueTasks(ctx, &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:      s.namespaceID.String(),
+		TaskQueue:        "hello-world",
+		TaskQueueTypes:   []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		WorkflowIdPrefix: "order-",
+		TypeName:         "ChargeCard",
+		MinAge:           durationpb.New(time.Hour),
+		Reason:           "incident",
+		Identity:         "operator",
+	}).Return(&matchingservice.PurgeTaskQueueTasksResponse{Version: 3}, nil).Times(1)
+	resp, err := handler.PurgeTaskQueueTasks(ctx, &adminservice.PurgeTaskQueueTasksRequest{
+		Namespace:        s.namespace.String(),
+		TaskQueue:        "hello-world",
+		TaskQueueTypes:   []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		WorkflowIdPrefix: "order-",
+		TypeName:         "ChargeCard",
+		MinAge:           durationpb.New(time.Hour),
+		Reason:           "incident",
+		Identity:         "operator",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeTaskQueueBacklog() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	partition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     "hello-world",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	_, err := handler.DescribeTaskQueueBacklog(ctx, &adminservice.DescribeTaskQueueBacklogRequest{Namespace: s.namespace.String()})
+	s.Equal(&serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."}, err)
+
+	summary := &taskqueuespb.TaskQueueBacklogSummary{TaskCount: 2}
+	s.mockMatchingClient.EXPECT().DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:        s.namespaceID.String(),
+		TaskQueuePartition: partition,
+		MaxTasks:           100,
+	}).Return(&matchingservice.DescribeTaskQueueBacklogResponse{Summary: summary}, nil).Times(1)
+	resp, err := handler.DescribeTaskQueueBacklog(ctx, &adminservice.DescribeTaskQueueBacklogRequest{
+		Namespace:          s.namespace.String(),
+		TaskQueuePartition: partition,
+		MaxTasks:           100,
+	})
+	s.NoError(err)
+	s.Equal(summary, resp.GetSummary())
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
@@ -2083,6 +2195,484 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttr
 	}
 }
 
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID).WithRunID(uuid.New())
+	historyArchivalURI := "testScheme://history/URI"
+	nsEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   historyArchivalURI,
+		},
+		false,
+		nil,
+		int64(100),
+	)
+
+	generator := test.InitializeHistoryEventGenerator(tv.NamespaceName(), tv.NamespaceID(), tv.Any().Int64())
+	var archivedBatches []*historypb.History
+	eventCount := int64(0)
+	for generator.HasNextVertex() {
+		history := &historypb.History{}
+		for _, event := range generator.GetNextVertices() {
+			history.Events = append(history.Events, event.GetData().(*historypb.HistoryEvent))
+			eventCount++
+		}
+		archivedBatches = append(archivedBatches, history)
+	}
+	mid := len(archivedBatches) / 2
+
+	mockHistoryArchiver := archiver.NewMockHistoryArchiver(s.controller)
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(
+		archiver.NewArchivalConfig("enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "enabled", historyArchivalURI),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(nsEntry, nil)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("testscheme").Return(mockHistoryArchiver, nil)
+	// the archived history is read twice, once to build the version history and once to import it
+	var getCalls []any
+	for range 2 {
+		getCalls = append(getCalls,
+			mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+					s.Equal(tv.NamespaceID().String(), request.NamespaceID)
+					s.Equal(tv.WorkflowID(), request.WorkflowID)
+					s.Equal(tv.RunID(), request.RunID)
+					s.Nil(request.NextPageToken)
+					return &archiver.GetHistoryResponse{HistoryBatches: archivedBatches[:mid], NextPageToken: []byte("next")}, nil
+				}),
+			mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+					s.Equal([]byte("next"), request.NextPageToken)
+					return &archiver.GetHistoryResponse{HistoryBatches: archivedBatches[mid:]}, nil
+				}),
+		)
+	}
+	gomock.InOrder(getCalls...)
+
+	serializer := serialization.NewSerializer()
+	importedEvents := int64(0)
+	committed := false
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ImportWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+			s.False(committed)
+			s.Equal(tv.NamespaceID().String(), request.NamespaceId)
+			s.Equal(eventCount, request.VersionHistory.Items[len(request.VersionHistory.Items)-1].EventId)
+			if len(request.HistoryBatches) == 0 {
+				committed = true
+				return &historyservice.ImportWorkflowExecutionResponse{}, nil
+			}
+			for _, blob := range request.HistoryBatches {
+				events, err := serializer.DeserializeEvents(blob)
+				s.NoError(err)
+				importedEvents += int64(len(events))
+			}
+			return &historyservice.ImportWorkflowExecutionResponse{Token: []byte("token")}, nil
+		}).MinTimes(2)
+
+	resp, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.NoError(err)
+	s.Equal(eventCount, resp.GetHistoryLength())
+	s.Equal(eventCount, importedEvents)
+	s.True(committed)
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_InvalidRequest() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID).WithRunID(uuid.New())
+
+	_, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+	})
+	s.ErrorIs(err, errRunIDNotSet)
+
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewDisabledArchvialConfig())
+	_, err = s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.ErrorIs(err, errClusterIsNotConfiguredForReadingArchivalHistory)
+}
+
+func (s *adminHandlerSuite) TestAggregateWorkflowExecutions() {
+	aggregations := []*adminservice.AggregateWorkflowExecutionsRequest_Aggregation{
+		{Function: enumsspb.VISIBILITY_AGGREGATION_FUNCTION_MAX, SearchAttribute: searchattribute.CloseTime},
+	}
+	groups := []*adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+		{Count: 10},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	gomock.InOrder(
+		s.mockVisibilityMgr.EXPECT().AggregateWorkflowExecutions(gomock.Any(), &manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID:  s.namespaceID,
+			Namespace:    s.namespace,
+			Query:        "WorkflowType = 'type'",
+			GroupBy:      []string{searchattribute.ExecutionStatus},
+			Aggregations: aggregations,
+			PageSize:     1000,
+		}).Return(&manager.AggregateWorkflowExecutionsResponse{Groups: groups, NextPageToken: []byte("next")}, nil),
+		s.mockVisibilityMgr.EXPECT().AggregateWorkflowExecutions(gomock.Any(), &manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID:   s.namespaceID,
+			Namespace:     s.namespace,
+			Query:         "WorkflowType = 'type'",
+			GroupBy:       []string{searchattribute.ExecutionStatus},
+			Aggregations:  aggregations,
+			PageSize:      10,
+			NextPageToken: []byte("next"),
+		}).Return(&manager.AggregateWorkflowExecutionsResponse{Groups: groups}, nil),
+	)
+
+	resp, err := s.handler.AggregateWorkflowExecutions(context.Background(), &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:    s.namespace.String(),
+		Query:        "WorkflowType = 'type'",
+		GroupBy:      []string{searchattribute.ExecutionStatus},
+		Aggregations: aggregations,
+	})
+	s.NoError(err)
+	s.Equal(groups, resp.GetGroups())
+	s.Equal([]byte("next"), resp.GetNextPageToken())
+
+	resp, err = s.handler.AggregateWorkflowExecutions(context.Background(), &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:     s.namespace.String(),
+		Query:         "WorkflowType = 'type'",
+		GroupBy:       []string{searchattribute.ExecutionStatus},
+		Aggregations:  aggregations,
+		PageSize:      10,
+		NextPageToken: []byte("next"),
+	})
+	s.NoError(err)
+	s.Equal(groups, resp.GetGroups())
+	s.Nil(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) TestAggregateWorkflowExecutions_NilRequest() {
+	_, err := s.handler.AggregateWorkflowExecutions(context.Background(), nil)
+	s.ErrorIs(err, errRequestNotSet)
+}
+
+func (s *adminHandlerSuite) TestCheckVisibilityConsistency() {
+	primaryVisibilityMgr := manager.NewMockVisibilityManager(s.controller)
+	secondaryVisibilityMgr := manager.NewMockVisibilityManager(s.controller)
+	s.handler.visibilityMgr = visibility.NewVisibilityManagerDual(primaryVisibilityMgr, secondaryVisibilityMgr, nil, nil)
+
+	runningExecution := &commonpb.WorkflowExecution{WorkflowId: "running", RunId: uuid.New()}
+	deletedExecution := &commonpb.WorkflowExecution{WorkflowId: "deleted", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	primaryVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    10,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: runningExecution, Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING},
+			{Execution: deletedExecution, Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED},
+		},
+	}, nil)
+	secondaryVisibilityMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found")).Times(2)
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: s.namespaceID.String(),
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: s.namespaceID.String(),
+			Execution:   runningExecution,
+		},
+	}).Return(&historyservice.RefreshWorkflowTasksResponse{}, nil)
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: s.namespaceID.String(),
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: s.namespaceID.String(),
+			Execution:   deletedExecution,
+		},
+	}).Return(nil, serviceerror.NewNotFound("workflow not found"))
+	s.mockHistoryClient.EXPECT().DeleteWorkflowVisibilityRecord(gomock.Any(), &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   deletedExecution,
+	}).Return(&historyservice.DeleteWorkflowVisibilityRecordResponse{}, nil)
+
+	resp, err := s.handler.CheckVisibilityConsistency(context.Background(), &adminservice.CheckVisibilityConsistencyRequest{
+		Namespace: s.namespace.String(),
+		Repair:    true,
+		PageSize:  10,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), resp.GetCheckedCount())
+	s.Len(resp.GetMismatches(), 2)
+	for _, mismatch := range resp.GetMismatches() {
+		s.Equal(enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_SECONDARY, mismatch.GetType())
+		s.True(mismatch.GetRepaired())
+	}
+	// Full scans continue with the records of the secondary store.
+	s.NotNil(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) TestCheckVisibilityConsistency_NoSecondaryVisibility() {
+	_, err := s.handler.CheckVisibilityConsistency(context.Background(), &adminservice.CheckVisibilityConsistencyRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errClusterIsNotConfiguredForSecondaryVisibility)
+
+	_, err = s.handler.CheckVisibilityConsistency(context.Background(), &adminservice.CheckVisibilityConsistencyRequest{
+		Namespace:  s.namespace.String(),
+		SampleRate: 2,
+	})
+	s.ErrorIs(err, errInvalidSampleRate)
+}
+
+func (s *adminHandlerSuite) TestExplainVisibilityQuery() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().ExplainQuery(gomock.Any(), &manager.ExplainQueryRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "CustomKeywordField = 'value'",
+		PageSize:    1000,
+	}).Return(&manager.ExplainQueryResponse{
+		StoreName:              "mysql",
+		TranslatedQuery:        "SELECT * FROM executions_visibility WHERE Keyword01 = ?",
+		QueryArgs:              []string{"value"},
+		SearchAttributeAliases: map[string]string{"CustomKeywordField": "Keyword01"},
+		Plan:                   "id\tselect_type",
+	}, nil)
+
+	resp, err := s.handler.ExplainVisibilityQuery(context.Background(), &adminservice.ExplainVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Query:     "CustomKeywordField = 'value'",
+	})
+	s.NoError(err)
+	s.Equal("mysql", resp.GetStoreName())
+	s.Equal("SELECT * FROM executions_visibility WHERE Keyword01 = ?", resp.GetTranslatedQuery())
+	s.Equal([]string{"value"}, resp.GetQueryArgs())
+	s.Equal(map[string]string{"CustomKeywordField": "Keyword01"}, resp.GetSearchAttributeAliases())
+	s.Equal("id\tselect_type", resp.GetPlan())
+}
+
+func (s *adminHandlerSuite) TestExplainVisibilityQuery_NilRequest() {
+	_, err := s.handler.ExplainVisibilityQuery(context.Background(), nil)
+	s.ErrorIs(err, errRequestNotSet)
+}
+
+func (s *adminHandlerSuite) TestStreamVisibilityChanges() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	shardCursor := &adminservice.VisibilityChangeShardCursor{Incarnation: 10, Sequence: 5, AckTaskId: 100}
+	requestCursor, err := proto.Marshal(&adminservice.VisibilityChangeCursor{
+		Shards: map[int32]*adminservice.VisibilityChangeShardCursor{1: shardCursor},
+	})
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockSaMapper.EXPECT().GetAlias("Keyword01", s.namespace.String()).Return("CustomKeywordField", nil)
+
+	historyStream := historyservicemock.NewMockHistoryService_StreamVisibilityChangesClient(s.controller)
+	s.mockHistoryClient.EXPECT().StreamVisibilityChanges(gomock.Any(), protomock.Eq(&historyservice.StreamVisibilityChangesRequest{
+		NamespaceId: s.namespaceID.String(),
+		ShardId:     1,
+		Cursor:      shardCursor,
+	})).Return(historyStream, nil)
+	nextShardCursor := &adminservice.VisibilityChangeShardCursor{Incarnation: 10, Sequence: 6, AckTaskId: 100}
+	historyStream.EXPECT().Recv().Return(&historyservice.StreamVisibilityChangesResponse{
+		Changes: []*adminservice.VisibilityChange{{
+			Type:    enumsspb.VISIBILITY_CHANGE_TYPE_UPSERTED,
+			ShardId: 1,
+			TaskId:  100,
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string]*commonpb.Payload{"Keyword01": payload.EncodeString("value")},
+			},
+		}},
+		Cursor: nextShardCursor,
+	}, nil)
+	historyStream.EXPECT().Recv().Return(nil, serviceerror.NewUnavailable("shard unloaded"))
+	// The stream of the shard is opened again from the last cursor once the shard is loaded by another host.
+	resumedHistoryStream := historyservicemock.NewMockHistoryService_StreamVisibilityChangesClient(s.controller)
+	s.mockHistoryClient.EXPECT().StreamVisibilityChanges(gomock.Any(), protomock.Eq(&historyservice.StreamVisibilityChangesRequest{
+		NamespaceId: s.namespaceID.String(),
+		ShardId:     1,
+		Cursor:      nextShardCursor,
+	})).Return(resumedHistoryStream, nil)
+	resumedShardCursor := &adminservice.VisibilityChangeShardCursor{Incarnation: 11, Sequence: 1, AckTaskId: 100}
+	resumedHistoryStream.EXPECT().Recv().Return(&historyservice.StreamVisibilityChangesResponse{
+		Changes: []*adminservice.VisibilityChange{{
+			Type:    enumsspb.VISIBILITY_CHANGE_TYPE_DELETED,
+			ShardId: 1,
+			TaskId:  101,
+		}},
+		Cursor: resumedShardCursor,
+	}, nil)
+	resumedHistoryStream.EXPECT().Recv().Return(nil, serviceerror.NewFailedPrecondition("cursor expired"))
+
+	server := adminservicemock.NewMockAdminService_StreamVisibilityChangesServer(s.controller)
+	server.EXPECT().Context().Return(ctx).AnyTimes()
+	var responses []*adminservice.StreamVisibilityChangesResponse
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(response *adminservice.StreamVisibilityChangesResponse) error {
+		responses = append(responses, response)
+		return nil
+	}).AnyTimes()
+
+	err = s.handler.StreamVisibilityChanges(&adminservice.StreamVisibilityChangesRequest{
+		Namespace: s.namespace.String(),
+		Cursor:    requestCursor,
+	}, server)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+
+	s.Len(responses, 2)
+	s.Len(responses[0].GetChanges(), 1)
+	s.Contains(responses[0].GetChanges()[0].GetSearchAttributes().GetIndexedFields(), "CustomKeywordField")
+	responseCursor := &adminservice.VisibilityChangeCursor{}
+	s.NoError(proto.Unmarshal(responses[0].GetCursor(), responseCursor))
+	protorequire.ProtoEqual(s.T(), nextShardCursor, responseCursor.GetShards()[1])
+	s.Len(responses[1].GetChanges(), 1)
+	s.NoError(proto.Unmarshal(responses[1].GetCursor(), responseCursor))
+	protorequire.ProtoEqual(s.T(), resumedShardCursor, responseCursor.GetShards()[1])
+}
+
+func (s *adminHandlerSuite) TestStreamVisibilityChanges_InvalidCursor() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	server := adminservicemock.NewMockAdminService_StreamVisibilityChangesServer(s.controller)
+
+	err := s.handler.StreamVisibilityChanges(&adminservice.StreamVisibilityChangesRequest{
+		Namespace: s.namespace.String(),
+		Cursor:    []byte("invalid"),
+	}, server)
+	s.ErrorIs(err, errInvalidVisibilityChangeCursor)
+}
+
+func (s *adminHandlerSuite) TestUpsertSavedVisibilityQuery() {
+	savedQuery := &persistencespb.SavedVisibilityQuery{
+		Query:          "CustomKeywordField = 'value' ORDER BY StartTime",
+		DefaultColumns: []string{searchattribute.WorkflowType, "CustomKeywordField"},
+	}
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetStoreNames().Return([]string{elasticsearch.PersistenceName}).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockSaMapper.EXPECT().GetFieldName("CustomKeywordField", s.namespace.String()).Return("CustomKeywordField", nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:          &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config:        &persistencespb.NamespaceConfig{},
+			ConfigVersion: 1,
+		},
+	}, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(2), request.Namespace.GetConfigVersion())
+			s.Equal(int64(5), request.NotificationVersion)
+			s.Equal(
+				map[string]*persistencespb.SavedVisibilityQuery{"my-view": savedQuery},
+				request.Namespace.GetConfig().GetSavedVisibilityQueries(),
+			)
+			return nil
+		})
+
+	_, err := s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace:  s.namespace.String(),
+		Name:       "my-view",
+		SavedQuery: savedQuery,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestUpsertSavedVisibilityQuery_Invalid() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetStoreNames().Return([]string{elasticsearch.PersistenceName}).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockSaMapper.EXPECT().GetFieldName("Unknown", s.namespace.String()).Return("", serviceerror.NewInvalidArgument("unknown")).AnyTimes()
+
+	_, err := s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace:  s.namespace.String(),
+		SavedQuery: &persistencespb.SavedVisibilityQuery{Query: "WorkflowType = 'type'"},
+	})
+	s.ErrorIs(err, errSavedVisibilityQueryNameNotSet)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	for _, savedQuery := range []*persistencespb.SavedVisibilityQuery{
+		{Query: "Unknown = 'value'"},
+		{Query: "view:other-view"},
+		{Query: "WorkflowType = 'type'", DefaultColumns: []string{"Unknown"}},
+	} {
+		_, err = s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+			Namespace:  s.namespace.String(),
+			Name:       "my-view",
+			SavedQuery: savedQuery,
+		})
+		s.ErrorAs(err, &invalidArgument)
+	}
+
+	// Maximum number of saved queries is 1 in the test config.
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Config: &persistencespb.NamespaceConfig{
+				SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+					"other-view": {Query: "WorkflowType = 'other'"},
+				},
+			},
+		},
+	}, nil)
+	_, err = s.handler.UpsertSavedVisibilityQuery(context.Background(), &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace:  s.namespace.String(),
+		Name:       "my-view",
+		SavedQuery: &persistencespb.SavedVisibilityQuery{Query: "WorkflowType = 'type'"},
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestDeleteSavedVisibilityQuery() {
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Config: &persistencespb.NamespaceConfig{
+						SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+							"my-view": {Query: "WorkflowType = 'type'"},
+						},
+					},
+				},
+			}, nil
+		}).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Empty(request.Namespace.GetConfig().GetSavedVisibilityQueries())
+			return nil
+		})
+
+	_, err := s.handler.DeleteSavedVisibilityQuery(context.Background(), &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "my-view",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.DeleteSavedVisibilityQuery(context.Background(), &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "other-view",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *adminHandlerSuite) TestListSavedVisibilityQueries() {
+	savedQueries := map[string]*persistencespb.SavedVisibilityQuery{
+		"my-view": {Query: "WorkflowType = 'type'", DefaultColumns: []string{searchattribute.StartTime}},
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Config: &persistencespb.NamespaceConfig{SavedVisibilityQueries: savedQueries},
+		},
+	}, nil)
+
+	resp, err := s.handler.ListSavedVisibilityQueries(context.Background(), &adminservice.ListSavedVisibilityQueriesRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Equal(savedQueries, resp.GetSavedQueries())
+}
+
 func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo,
 	responsePhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo) {
 
@@ -2090,3 +2680,249 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) expectGetNamespaceForSearchAttributeUpdate(config *persistencespb.NamespaceConfig) {
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:          &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config:        config,
+			ConfigVersion: 1,
+		},
+	}, nil)
+}
+
+func (s *adminHandlerSuite) expectVisibilityStoreForSearchAttributeUpdate(storeName string, indexName string) {
+	s.mockVisibilityMgr.EXPECT().GetStoreNames().Return([]string{storeName}).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return(indexName).AnyTimes()
+}
+
+func (s *adminHandlerSuite) TestUpdateSearchAttribute_Rename() {
+	s.expectVisibilityStoreForSearchAttributeUpdate("mysql", "temporal_visibility")
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+	})
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+	})
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(2), request.Namespace.GetConfigVersion())
+			s.Equal(int64(5), request.NotificationVersion)
+			s.Equal(map[string]string{"Keyword01": "MyRenamedAttribute"}, request.Namespace.GetConfig().GetCustomSearchAttributeAliases())
+			return nil
+		})
+
+	resp, err := s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewName:   "MyRenamedAttribute",
+	})
+	s.NoError(err)
+	s.Nil(resp.GetExecution())
+}
+
+func (s *adminHandlerSuite) TestUpdateSearchAttribute_ChangeType() {
+	s.expectVisibilityStoreForSearchAttributeUpdate("mysql", "temporal_visibility")
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+	})
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	workflowID := updatesearchattribute.WorkflowName + "-" + s.namespaceID.String()
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                                primitives.DefaultWorkerTaskQueue,
+			ID:                                       workflowID,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		updatesearchattribute.WorkflowName,
+		updatesearchattribute.WorkflowParams{
+			Namespace:   s.namespace,
+			NamespaceID: s.namespaceID,
+			Name:        "MyAttribute",
+			NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewType:   enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	s.NoError(err)
+	s.Equal(&commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "run-id"}, resp.GetExecution())
+}
+
+func (s *adminHandlerSuite) TestUpdateSearchAttribute_Invalid() {
+	_, err := s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+	})
+	s.ErrorIs(err, errSearchAttributeUpdateNotSet)
+
+	_, err = s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		NewType:   enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	s.ErrorIs(err, errSearchAttributeNameNotSet)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err = s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewName:   searchattribute.WorkflowType,
+	})
+	s.ErrorAs(err, &invalidArgument)
+
+	primaryVisibilityMgr := manager.NewMockVisibilityManager(s.controller)
+	primaryVisibilityMgr.EXPECT().GetStoreNames().Return([]string{"mysql"})
+	primaryVisibilityMgr.EXPECT().GetIndexName().Return("temporal_visibility")
+	secondaryVisibilityMgr := manager.NewMockVisibilityManager(s.controller)
+	secondaryVisibilityMgr.EXPECT().GetStoreNames().Return([]string{elasticsearch.PersistenceName})
+	secondaryVisibilityMgr.EXPECT().GetIndexName().Return("temporal_visibility_v1")
+	s.handler.visibilityMgr = visibility.NewVisibilityManagerDual(primaryVisibilityMgr, secondaryVisibilityMgr, nil, nil)
+	_, err = s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewType:   enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	s.ErrorIs(err, errSearchAttributeUpdateNotSupported)
+	s.handler.visibilityMgr = s.mockVisibilityMgr
+
+	s.expectVisibilityStoreForSearchAttributeUpdate("mysql", "temporal_visibility")
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute", "Int01": "OtherAttribute"},
+	})
+	_, err = s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewName:   "OtherAttribute",
+	})
+	var alreadyExists *serviceerror.AlreadyExists
+	s.ErrorAs(err, &alreadyExists)
+
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+		CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Keyword01": {SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, SourceDropped: true},
+		},
+	})
+	_, err = s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewType:   enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
+func (s *adminHandlerSuite) TestUpdateSearchAttribute_Elasticsearch() {
+	s.expectVisibilityStoreForSearchAttributeUpdate(elasticsearch.PersistenceName, "temporal_visibility_v1")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("temporal_visibility_v1", true).Return(
+		searchattribute.NewNameTypeMapStub(map[string]enumspb.IndexedValueType{
+			"MyAttribute":      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"OtherAttribute":   enumspb.INDEXED_VALUE_TYPE_INT,
+			"RenamedAttribute": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		}),
+		nil,
+	).AnyTimes()
+
+	// A new name is required, the values are migrated to the field with the new name.
+	_, err := s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewType:   enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	s.ErrorIs(err, errSearchAttributeNewNameNotSet)
+
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{})
+	_, err = s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewName:   "OtherAttribute",
+		NewType:   enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+	})
+	var alreadyExists *serviceerror.AlreadyExists
+	s.ErrorAs(err, &alreadyExists)
+
+	// A rename is a migration to the field with the new name, the type defaults to the current one.
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{})
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		updatesearchattribute.WorkflowName,
+		updatesearchattribute.WorkflowParams{
+			Namespace:   s.namespace,
+			NamespaceID: s.namespaceID,
+			Name:        "MyAttribute",
+			NewName:     "RenamedAttribute",
+			NewType:     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	).Return(mockRun, nil)
+	resp, err := s.handler.UpdateSearchAttribute(context.Background(), &adminservice.UpdateSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+		NewName:   "RenamedAttribute",
+	})
+	s.NoError(err)
+	s.Equal("run-id", resp.GetExecution().GetRunId())
+}
+
+func (s *adminHandlerSuite) TestDropSearchAttribute() {
+	s.expectVisibilityStoreForSearchAttributeUpdate("mysql", "temporal_visibility")
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+	})
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).Times(2)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	workflowID := updatesearchattribute.WorkflowName + "-" + s.namespaceID.String()
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		updatesearchattribute.WorkflowName,
+		updatesearchattribute.WorkflowParams{
+			Namespace:   s.namespace,
+			NamespaceID: s.namespaceID,
+			Name:        "MyAttribute",
+			Drop:        true,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.DropSearchAttribute(context.Background(), &adminservice.DropSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+	})
+	s.NoError(err)
+	s.Equal(&commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "run-id"}, resp.GetExecution())
+
+	// Search attributes of a namespace are updated one at a time.
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+	})
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), updatesearchattribute.WorkflowName, gomock.Any()).
+		Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", "", "run-id"))
+	_, err = s.handler.DropSearchAttribute(context.Background(), &adminservice.DropSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+
+	s.expectGetNamespaceForSearchAttributeUpdate(&persistencespb.NamespaceConfig{})
+	_, err = s.handler.DropSearchAttribute(context.Background(), &adminservice.DropSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "MyAttribute",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
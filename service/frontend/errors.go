@@ -14,6 +14,7 @@ var (
 	errActivityIDOrTypeNotSet                             = serviceerror.NewInvalidArgument("Either Activity.Id or Activity.Type should be set on request.")
 	errSignalNameNotSet                                   = serviceerror.NewInvalidArgument("SignalName is not set on request.")
 	errInvalidRunID                                       = serviceerror.NewInvalidArgument("Invalid RunId.")
+	errRunIDNotSet                                        = serviceerror.NewInvalidArgument("RunId is not set on request.")
 	errInvalidNextPageToken                               = serviceerror.NewInvalidArgument("Invalid NextPageToken.")                                 // DEPRECATED
 	errNextPageTokenRunIDMismatch                         = serviceerror.NewInvalidArgument("RunId in the request does not match the NextPageToken.") // DEPRECATED
 	errQueryNotSet                                        = serviceerror.NewInvalidArgument("WorkflowQuery is not set on request.")
@@ -41,8 +42,17 @@ var (
 	errEarliestTimeIsGreaterThanLatestTime                = serviceerror.NewInvalidArgument("EarliestTime in StartTimeFilter should not be larger than LatestTime.")
 	errClusterIsNotConfiguredForVisibilityArchival        = serviceerror.NewInvalidArgument("Cluster is not configured for visibility archival.")
 	errClusterIsNotConfiguredForReadingArchivalVisibility = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived visibility records.")
+	errClusterIsNotConfiguredForReadingArchivalHistory    = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived history.")
 	errNamespaceIsNotConfiguredForVisibilityArchival      = serviceerror.NewInvalidArgument("Namespace is not configured for visibility archival.")
+	errClusterIsNotConfiguredForSecondaryVisibility       = serviceerror.NewInvalidArgument("Cluster is not configured with a secondary visibility store.")
+	errInvalidSampleRate                                  = serviceerror.NewInvalidArgument("SampleRate must be between 0 and 1.")
+	errSavedVisibilityQueryNameNotSet                     = serviceerror.NewInvalidArgument("Saved visibility query name is not set.")
+	errSavedVisibilityQueryNotSet                         = serviceerror.NewInvalidArgument("Saved visibility query is not set.")
 	errSearchAttributesNotSet                             = serviceerror.NewInvalidArgument("SearchAttributes are not set on request.")
+	errSearchAttributeNameNotSet                          = serviceerror.NewInvalidArgument("Search attribute name is not set on request.")
+	errSearchAttributeUpdateNotSet                        = serviceerror.NewInvalidArgument("Neither new name nor new type of search attribute is set on request.")
+	errSearchAttributeUpdateNotSupported                  = serviceerror.NewInvalidArgument("Search attributes can't be updated if only some visibility stores are Elasticsearch compatible.")
+	errSearchAttributeNewNameNotSet                       = serviceerror.NewInvalidArgument("Search attributes of Elasticsearch can only be updated with a new name.")
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")                                 // DEPRECATED
 	errInvalidPaginationToken                             = serviceerror.NewInvalidArgument("Invalid pagination token.")                         // DEPRECATED
 	errInvalidFirstNextEventCombination                   = serviceerror.NewInvalidArgument("Invalid FirstEventId and NextEventId combination.") // DEPRECATED
@@ -55,6 +65,7 @@ var (
 	errIDReusePolicyNotAllowed                            = serviceerror.NewInvalidArgument("Scheduled workflow must not contain WorkflowIDReusePolicy")
 	errBatchJobIDNotSet                                   = serviceerror.NewInvalidArgument("JobId is not set on request.")
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace is not set on request.")
+	errInvalidVisibilityChangeCursor                      = serviceerror.NewInvalidArgument("Invalid visibility change stream cursor.")
 	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
 	errBatchOperationNotSet                               = serviceerror.NewInvalidArgument("Batch operation is not set on request.")
 	errCronAndStartDelaySet                               = serviceerror.NewInvalidArgument("CronSchedule and WorkflowStartDelay may not be used together.")
@@ -92,6 +103,8 @@ var (
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
 	errSearchAttributeDoesntExistMessage              = "Search attribute %s doesn't exist."
+	errSearchAttributeIsBeingUpdatedMessage           = "Search attribute %s is being updated."
+	errSearchAttributeUpdateRunningMessage            = "A search attribute of namespace %s is being updated."
 	errUnknownSearchAttributeTypeMessage              = "Unknown search attribute type: %v."
 	errUnableToGetSearchAttributesMessage             = "Unable to get search attributes: %v."
 	errUnableToRemoveNonCustomSearchAttributesMessage = "Unable to remove non-custom search attributes: %v."
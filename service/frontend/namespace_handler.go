@@ -28,6 +28,7 @@ import (
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -473,6 +474,7 @@ func (d *namespaceHandler) UpdateNamespace(
 			csaAliases, err := d.upsertCustomSearchAttributesAliases(
 				config.CustomSearchAttributeAliases,
 				updatedConfig.CustomSearchAttributeAliases,
+				config.CustomSearchAttributeFieldMigrations,
 			)
 			if err != nil {
 				return nil, err
@@ -836,6 +838,87 @@ func (d *namespaceHandler) ListWorkflowRules(
 	return workflowRules, nil
 }
 
+// UpsertSavedVisibilityQuery adds the saved visibility query to the namespace, or replaces the one with the same name.
+func (d *namespaceHandler) UpsertSavedVisibilityQuery(
+	ctx context.Context,
+	nsName string,
+	name string,
+	savedQuery *persistencespb.SavedVisibilityQuery,
+) error {
+	return d.updateNamespaceConfig(ctx, nsName, func(config *persistencespb.NamespaceConfig) error {
+		maxSavedQueries := d.config.MaxSavedVisibilityQueriesPerNamespace(nsName)
+		if _, ok := config.SavedVisibilityQueries[name]; !ok && len(config.SavedVisibilityQueries) >= maxSavedQueries {
+			return serviceerror.NewInvalidArgumentf("Saved visibility query limit exceeded. Max: %v", maxSavedQueries)
+		}
+		if config.SavedVisibilityQueries == nil {
+			config.SavedVisibilityQueries = make(map[string]*persistencespb.SavedVisibilityQuery)
+		}
+		config.SavedVisibilityQueries[name] = savedQuery
+		return nil
+	})
+}
+
+func (d *namespaceHandler) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	nsName string,
+	name string,
+) error {
+	return d.updateNamespaceConfig(ctx, nsName, func(config *persistencespb.NamespaceConfig) error {
+		if _, ok := config.SavedVisibilityQueries[name]; !ok {
+			return serviceerror.NewNotFoundf("Saved visibility query %q not found.", name)
+		}
+		delete(config.SavedVisibilityQueries, name)
+		return nil
+	})
+}
+
+func (d *namespaceHandler) ListSavedVisibilityQueries(
+	ctx context.Context,
+	nsName string,
+) (map[string]*persistencespb.SavedVisibilityQuery, error) {
+	getNamespaceResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: nsName})
+	if err != nil {
+		return nil, err
+	}
+	return getNamespaceResponse.Namespace.GetConfig().GetSavedVisibilityQueries(), nil
+}
+
+// updateNamespaceConfig applies update to the config of the namespace and persists it.
+func (d *namespaceHandler) updateNamespaceConfig(
+	ctx context.Context,
+	nsName string,
+	update func(config *persistencespb.NamespaceConfig) error,
+) error {
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getNamespaceResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: nsName})
+	if err != nil {
+		return err
+	}
+
+	existingNamespace := getNamespaceResponse.Namespace
+	config := existingNamespace.Config
+	if err := update(config); err != nil {
+		return err
+	}
+
+	updateReq := &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      config,
+			ReplicationConfig:           existingNamespace.ReplicationConfig,
+			ConfigVersion:               existingNamespace.ConfigVersion + 1,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getNamespaceResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}
+	return d.metadataMgr.UpdateNamespace(ctx, updateReq)
+}
+
 func (d *namespaceHandler) createResponse(
 	info *persistencespb.NamespaceInfo,
 	config *persistencespb.NamespaceConfig,
@@ -925,11 +1008,14 @@ func (d *namespaceHandler) mergeNamespaceData(
 func (d *namespaceHandler) upsertCustomSearchAttributesAliases(
 	current map[string]string,
 	upsert map[string]string,
+	fieldMigrations map[string]*persistencespb.CustomSearchAttributeFieldMigration,
 ) (map[string]string, error) {
 	result := util.CloneMapNonNil(current)
 	for key, value := range upsert {
 		if value == "" {
 			delete(result, key)
+		} else if searchattribute.IsMigrationField(key, fieldMigrations) {
+			return nil, errCustomSearchAttributeFieldAlreadyAllocated
 		} else if _, ok := current[key]; !ok {
 			result[key] = value
 		} else {
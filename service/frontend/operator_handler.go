@@ -28,7 +28,6 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
-	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
@@ -206,7 +205,7 @@ func (h *OperatorHandlerImpl) addSearchAttributesInternal(
 			"Cannot add search attributes in standard visibility.",
 			tag.NewStringTag("pluginName", storeName),
 		)
-	} else if storeName == elasticsearch.PersistenceName {
+	} else if visibility.IsElasticsearchCompatibleStore(storeName) {
 		scope := h.metricsHandler.WithTags(metrics.OperationTag(metrics.OperatorAddSearchAttributesScope))
 		err = h.addSearchAttributesElasticsearch(ctx, request, indexName, currentSearchAttributes)
 		if err != nil {
@@ -309,6 +308,12 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 		return serviceerror.NewUnavailablef(errUnableToGetNamespaceInfoMessage, nsName, err)
 	}
 
+	nsEntry, err := h.namespaceRegistry.GetNamespace(namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	fieldMigrations := nsEntry.CustomSearchAttributeFieldMigrations()
+
 	dbCustomSearchAttributes := searchattribute.GetSqlDbIndexSearchAttributes().CustomSearchAttributes
 	cmCustomSearchAttributes := currentSearchAttributes.Custom()
 	upsertFieldToAliasMap := make(map[string]string)
@@ -339,6 +344,8 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 				cntUsed++
 			} else if _, ok := upsertFieldToAliasMap[fieldName]; ok {
 				cntUsed++
+			} else if searchattribute.IsMigrationField(fieldName, fieldMigrations) {
+				cntUsed++
 			} else {
 				targetFieldName = fieldName
 				break
@@ -398,7 +405,7 @@ func (h *OperatorHandlerImpl) RemoveSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if h.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if visibility.HasElasticsearchCompatibleStore(h.visibilityMgr) || indexName == "" {
 		err = h.removeSearchAttributesElasticsearch(ctx, request, indexName, currentSearchAttributes)
 	} else {
 		err = h.removeSearchAttributesSQL(ctx, request, currentSearchAttributes)
@@ -508,7 +515,7 @@ func (h *OperatorHandlerImpl) ListSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if h.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if visibility.HasElasticsearchCompatibleStore(h.visibilityMgr) || indexName == "" {
 		return h.listSearchAttributesElasticsearch(ctx, indexName, searchAttributes)
 	}
 	return h.listSearchAttributesSQL(ctx, request, searchAttributes)
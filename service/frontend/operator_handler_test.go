@@ -20,11 +20,13 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/opensearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
@@ -327,6 +329,10 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributes_DualVisibility() {
 						},
 						nil,
 					)
+				s.mockResource.NamespaceCache.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(
+					namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: testNamespace}, nil, ""),
+					nil,
+				)
 				s.mockResource.FrontendClient.EXPECT().
 					UpdateNamespace(gomock.Any(), gomock.Any()).
 					Return(&workflowservice.UpdateNamespaceResponse{}, tc.addVisManager2Err)
@@ -481,6 +487,10 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesInternal() {
 						},
 						nil,
 					)
+				s.mockResource.NamespaceCache.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(
+					namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: testNamespace}, nil, ""),
+					nil,
+				)
 
 				s.mockResource.FrontendClient.EXPECT().
 					UpdateNamespace(gomock.Any(), gomock.Any()).
@@ -640,6 +650,7 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 		getFrontendClientErr        error
 		describeNamespaceCalled     bool
 		describeNamespaceErr        error
+		fieldMigrations             map[string]*persistencespb.CustomSearchAttributeFieldMigration
 		updateNamespaceCalled       bool
 		updateNamespaceErr          error
 		expectedErrMsg              string
@@ -733,6 +744,29 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 			),
 		},
+		{
+			name: "fail: migration fields are not allocated",
+			request: &operatorservice.AddSearchAttributesRequest{
+				SearchAttributes: map[string]enumspb.IndexedValueType{
+					"CustomAttr1": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					"CustomAttr2": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				},
+				Namespace: testNamespace,
+			},
+			describeNamespaceCalled: true,
+			fieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+				"Int02": {
+					TargetField: "Keyword02",
+					SourceType:  enumspb.INDEXED_VALUE_TYPE_INT,
+					TargetType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				},
+			},
+			expectedErrMsg: fmt.Sprintf(
+				errTooManySearchAttributesMessage,
+				3,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			),
+		},
 		{
 			name: "fail: cannot update namespace",
 			request: &operatorservice.AddSearchAttributesRequest{
@@ -785,6 +819,19 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 						tc.describeNamespaceErr,
 					)
 			}
+			if tc.describeNamespaceCalled && tc.describeNamespaceErr == nil {
+				s.mockResource.NamespaceCache.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(
+					namespace.NewLocalNamespaceForTest(
+						&persistencespb.NamespaceInfo{Name: testNamespace},
+						&persistencespb.NamespaceConfig{
+							CustomSearchAttributeAliases:         searchattribute.TestAliases,
+							CustomSearchAttributeFieldMigrations: tc.fieldMigrations,
+						},
+						"",
+					),
+					nil,
+				)
+			}
 
 			if tc.updateNamespaceCalled {
 				s.mockResource.FrontendClient.EXPECT().
@@ -861,6 +908,7 @@ func (s *operatorHandlerSuite) Test_ListSearchAttributes_SQL() {
 	ctx := context.Background()
 
 	s.mockResource.VisibilityManager.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(false)
+	s.mockResource.VisibilityManager.EXPECT().HasStoreName(opensearch.PersistenceName).Return(false)
 	s.mockResource.VisibilityManager.EXPECT().GetIndexName().Return(testIndexName).AnyTimes()
 	s.mockResource.ClientFactory.EXPECT().
 		NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
@@ -1017,6 +1065,7 @@ func (s *operatorHandlerSuite) Test_RemoveSearchAttributes_SQL() {
 	ctx := context.Background()
 
 	s.mockResource.VisibilityManager.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(false).AnyTimes()
+	s.mockResource.VisibilityManager.EXPECT().HasStoreName(opensearch.PersistenceName).Return(false).AnyTimes()
 	s.mockResource.VisibilityManager.EXPECT().GetIndexName().Return(testIndexName).AnyTimes()
 	s.mockResource.SearchAttributesManager.EXPECT().
 		GetSearchAttributes(testIndexName, true).
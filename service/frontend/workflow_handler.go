@@ -2390,12 +2390,17 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
+	query, err := wh.expandSavedVisibilityQuery(namespaceName, request.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
 	req := &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespaceName,
 		PageSize:      int(request.GetPageSize()),
 		NextPageToken: request.NextPageToken,
-		Query:         request.GetQuery(),
+		Query:         query,
 	}
 	persistenceResp, err := wh.visibilityMgr.ListWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -2503,12 +2508,17 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
+	query, err := wh.expandSavedVisibilityQuery(namespaceName, request.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
 	req := &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespaceName,
 		PageSize:      int(request.GetPageSize()),
 		NextPageToken: request.NextPageToken,
-		Query:         request.GetQuery(),
+		Query:         query,
 	}
 	persistenceResp, err := wh.visibilityMgr.ScanWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -2536,10 +2546,15 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 		return nil, err
 	}
 
+	query, err := wh.expandSavedVisibilityQuery(namespaceName, request.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
 	req := &manager.CountWorkflowExecutionsRequest{
 		NamespaceID: namespaceID,
 		Namespace:   namespaceName,
-		Query:       request.GetQuery(),
+		Query:       query,
 	}
 	persistenceResp, err := wh.visibilityMgr.CountWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -2553,6 +2568,18 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 	return resp, nil
 }
 
+// expandSavedVisibilityQuery replaces a "view:<name>" query by the saved visibility query of the namespace.
+func (wh *WorkflowHandler) expandSavedVisibilityQuery(namespaceName namespace.Name, query string) (string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(query), visibility.SavedQueryPrefix) {
+		return query, nil
+	}
+	namespaceEntry, err := wh.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return "", err
+	}
+	return visibility.ExpandSavedQuery(namespaceEntry, query)
+}
+
 // GetSearchAttributes is a visibility API to get all legal keys that could be used in list APIs
 func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context, _ *workflowservice.GetSearchAttributesRequest) (_ *workflowservice.GetSearchAttributesResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
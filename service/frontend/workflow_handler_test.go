@@ -2124,6 +2124,65 @@ func (s *WorkflowHandlerSuite) TestCountWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *WorkflowHandlerSuite) TestSavedVisibilityQuery() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	savedQuery := "WorkflowType = 'my-type'"
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.testNamespace.String(), Id: s.testNamespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			SavedVisibilityQueries: map[string]*persistencespb.SavedVisibilityQuery{
+				"my-view": {Query: savedQuery},
+			},
+		},
+		"",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(namespaceEntry, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetReadStoreName(s.testNamespace).Return(elasticsearch.PersistenceName).AnyTimes()
+	ctx := context.Background()
+
+	// List, Scan and Count expand the saved query the same way
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*manager.ListWorkflowExecutionsResponse, error) {
+			s.Equal(savedQuery, request.Query)
+			return &manager.ListWorkflowExecutionsResponse{}, nil
+		})
+	_, err := wh.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.testNamespace.String(),
+		Query:     "view:my-view",
+	})
+	s.NoError(err)
+
+	s.mockVisibilityMgr.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*manager.ListWorkflowExecutionsResponse, error) {
+			s.Equal(savedQuery, request.Query)
+			return &manager.ListWorkflowExecutionsResponse{}, nil
+		})
+	_, err = wh.ScanWorkflowExecutions(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
+		Namespace: s.testNamespace.String(),
+		Query:     "view:my-view",
+	})
+	s.NoError(err)
+
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			s.Equal(savedQuery, request.Query)
+			return &manager.CountWorkflowExecutionsResponse{}, nil
+		})
+	_, err = wh.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: s.testNamespace.String(),
+		Query:     "view:my-view",
+	})
+	s.NoError(err)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err = wh.ScanWorkflowExecutions(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
+		Namespace: s.testNamespace.String(),
+		Query:     "view:unknown",
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *WorkflowHandlerSuite) TestVerifyHistoryIsComplete() {
 	events := make([]*historyspb.StrippedHistoryEvent, 50)
 	for i := 0; i < len(events); i++ {
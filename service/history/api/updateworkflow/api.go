@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/effect"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/priorities"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/api"
@@ -50,6 +51,8 @@ type Updater struct {
 	// to avoid data races when used outside the workflow lease.
 	taskQueue              *taskqueuepb.TaskQueue
 	priority               *commonpb.Priority
+	fairness               *taskqueuespb.TaskFairness
+	workflowTypeName       string
 	normalTaskQueueName    string
 	scheduledEventID       int64
 	scheduleToStartTimeout time.Duration
@@ -188,6 +191,13 @@ func (u *Updater) ApplyRequest(
 
 	u.taskQueue = common.CloneProto(newWorkflowTask.TaskQueue)
 	u.priority = common.CloneProto(ms.GetExecutionInfo().Priority)
+	u.fairness = priorities.MakeTaskFairness(
+		ms.GetNamespaceEntry(),
+		ms.GetExecutionInfo().GetSearchAttributes(),
+		u.shardCtx.GetConfig().FairnessKeySearchAttribute(ms.GetNamespaceEntry().Name().String()),
+		u.shardCtx.GetConfig().FairnessWeightSearchAttribute(ms.GetNamespaceEntry().Name().String()),
+	)
+	u.workflowTypeName = ms.GetExecutionInfo().WorkflowTypeName
 	u.normalTaskQueueName = ms.GetExecutionInfo().TaskQueue
 	u.directive = worker_versioning.MakeDirectiveForWorkflowTask(
 		ms.GetInheritedBuildId(),
@@ -276,6 +286,8 @@ func (u *Updater) addWorkflowTaskToMatching(ctx context.Context) error {
 		Clock:                  clock,
 		VersionDirective:       u.directive,
 		Priority:               u.priority,
+		Fairness:               u.fairness,
+		TypeName:               u.workflowTypeName,
 	})
 	if err != nil {
 		return err
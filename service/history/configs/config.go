@@ -194,6 +194,9 @@ type Config struct {
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// search attributes of the fairness key and weight of the tasks sent to matching
+	FairnessKeySearchAttribute    dynamicconfig.StringPropertyFnWithNamespaceFilter
+	FairnessWeightSearchAttribute dynamicconfig.StringPropertyFnWithNamespaceFilter
 	// whether or not using ParentClosePolicy
 	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	// whether or not enable system workers for processing parent close policy task
@@ -310,6 +313,7 @@ type Config struct {
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityProcessorRelocateAttributesMinBlobSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
+	VisibilityChangeStreamBufferSize                      dynamicconfig.IntPropertyFn
 
 	// Disable fetching memo and search attributes from visibility in the event that they were removed
 	// from the mutable state in the close execution visibility task clean up.
@@ -557,6 +561,8 @@ func NewConfig(
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
 		LongPollExpirationInterval:          dynamicconfig.HistoryLongPollExpirationInterval.Get(dc),
 		EventEncodingType:                   dynamicconfig.DefaultEventEncoding.Get(dc),
+		FairnessKeySearchAttribute:          dynamicconfig.FairnessKeySearchAttribute.Get(dc),
+		FairnessWeightSearchAttribute:       dynamicconfig.FairnessWeightSearchAttribute.Get(dc),
 		EnableParentClosePolicy:             dynamicconfig.EnableParentClosePolicy.Get(dc),
 		NumParentClosePolicySystemWorkflows: dynamicconfig.NumParentClosePolicySystemWorkflows.Get(dc),
 		EnableParentClosePolicyWorker:       dynamicconfig.EnableParentClosePolicyWorker.Get(dc),
@@ -634,6 +640,7 @@ func NewConfig(
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup.Get(dc),
 		VisibilityProcessorRelocateAttributesMinBlobSize:      dynamicconfig.VisibilityProcessorRelocateAttributesMinBlobSize.Get(dc),
 		VisibilityQueueMaxReaderCount:                         dynamicconfig.VisibilityQueueMaxReaderCount.Get(dc),
+		VisibilityChangeStreamBufferSize:                      dynamicconfig.VisibilityChangeStreamBufferSize.Get(dc),
 
 		DisableFetchRelocatableAttributesFromVisibility: dynamicconfig.DisableFetchRelocatableAttributesFromVisibility.Get(dc),
 
@@ -0,0 +1,19 @@
+package history
+
+import (
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/priorities"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// makeTaskFairness returns the fairness key and weight of the workflow and activity tasks of the workflow.
+func makeTaskFairness(config *configs.Config, ms historyi.MutableState) *taskqueuespb.TaskFairness {
+	ns := ms.GetNamespaceEntry()
+	return priorities.MakeTaskFairness(
+		ns,
+		ms.GetExecutionInfo().GetSearchAttributes(),
+		config.FairnessKeySearchAttribute(ns.Name().String()),
+		config.FairnessWeightSearchAttribute(ns.Name().String()),
+	)
+}
@@ -67,6 +67,7 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(EventNotifierProvider),
 	fx.Provide(HistoryEngineFactoryProvider),
+	fx.Provide(NewVisibilityChangeFeeds),
 	fx.Provide(HandlerProvider),
 	fx.Provide(ServerProvider),
 	fx.Provide(NewService),
@@ -119,6 +120,7 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		replicationTaskFetcherFactory:    args.ReplicationTaskFetcherFactory,
 		replicationTaskConverterProvider: args.ReplicationTaskConverterFactory,
 		streamReceiverMonitor:            args.StreamReceiverMonitor,
+		visibilityChangeFeeds:            args.VisibilityChangeFeeds,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
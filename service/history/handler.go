@@ -94,6 +94,7 @@ type (
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
 		streamReceiverMonitor            replication.StreamReceiverMonitor
+		visibilityChangeFeeds            *VisibilityChangeFeeds
 	}
 
 	NewHandlerArgs struct {
@@ -126,6 +127,7 @@ type (
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
 		ReplicationTaskConverterFactory replication.SourceTaskConverterProvider
 		StreamReceiverMonitor           replication.StreamReceiverMonitor
+		VisibilityChangeFeeds           *VisibilityChangeFeeds
 	}
 )
 
@@ -2246,6 +2248,36 @@ func (h *Handler) GetWorkflowExecutionRawHistoryV2(
 	return engine.GetWorkflowExecutionRawHistoryV2(ctx, request)
 }
 
+// StreamVisibilityChanges streams the changes made to the visibility records of a namespace by the visibility queue
+// of a shard.
+func (h *Handler) StreamVisibilityChanges(
+	request *historyservice.StreamVisibilityChangesRequest,
+	server historyservice.HistoryService_StreamVisibilityChangesServer,
+) (retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return errShuttingDown
+	}
+	if request.GetNamespaceId() == "" {
+		return errNamespaceNotSet
+	}
+	if request.GetShardId() == 0 {
+		return errShardIDNotSet
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return h.convertError(err)
+	}
+	// Wait for the shard to be loaded, which creates the change feed of its visibility queue.
+	if _, err := shardContext.GetEngine(server.Context()); err != nil {
+		return h.convertError(err)
+	}
+	return h.visibilityChangeFeeds.Stream(shardContext, request, server)
+}
+
 func (h *Handler) ForceDeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ForceDeleteWorkflowExecutionRequest,
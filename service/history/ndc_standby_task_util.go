@@ -133,6 +133,8 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairness                           *taskqueuespb.TaskFairness
+		typeName                           string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -144,6 +146,8 @@ type (
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairness                           *taskqueuespb.TaskFairness
+		typeName                           string
 	}
 )
 
@@ -158,6 +162,7 @@ func newExecutionTimerPostActionInfo(
 func newActivityTaskPostActionInfo(
 	mutableState historyi.MutableState,
 	activityInfo *persistencespb.ActivityInfo,
+	fairness *taskqueuespb.TaskFairness,
 ) (*activityTaskPostActionInfo, error) {
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
@@ -166,6 +171,8 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairness:                           fairness,
+		typeName:                           activityInfo.GetActivityType().GetName(),
 	}, nil
 }
 
@@ -174,6 +181,7 @@ func newActivityRetryTimePostActionInfo(
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
 	activityInfo *persistencespb.ActivityInfo,
+	fairness *taskqueuespb.TaskFairness,
 ) (*activityTaskPostActionInfo, error) {
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
@@ -183,6 +191,8 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairness:                           fairness,
+		typeName:                           activityInfo.GetActivityType().GetName(),
 	}, nil
 }
 
@@ -190,6 +200,7 @@ func newWorkflowTaskPostActionInfo(
 	mutableState historyi.MutableState,
 	workflowTaskScheduleToStartTimeout time.Duration,
 	taskqueue *taskqueuepb.TaskQueue,
+	fairness *taskqueuespb.TaskFairness,
 ) (*workflowTaskPostActionInfo, error) {
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
@@ -199,6 +210,8 @@ func newWorkflowTaskPostActionInfo(
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairness:                           fairness,
+		typeName:                           mutableState.GetExecutionInfo().WorkflowTypeName,
 	}, nil
 }
 
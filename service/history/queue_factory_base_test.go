@@ -152,4 +152,5 @@ type unusedDependencies struct {
 	archival.Archiver
 	workflow.RelocatableAttributesFetcher
 	persistence.HistoryTaskQueueManager
+	*VisibilityChangeFeeds
 }
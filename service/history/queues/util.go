@@ -5,6 +5,20 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
+// ImmediateQueueAckTaskID returns the task ID below which all the tasks of an immediate queue are acked.
+func ImmediateQueueAckTaskID(
+	persistenceQueueState *persistencespb.QueueState,
+) int64 {
+	queueState := FromPersistenceQueueState(persistenceQueueState)
+	ackTaskID := queueState.exclusiveReaderHighWatermark.TaskID
+	for _, scopes := range queueState.readerScopes {
+		if len(scopes) > 0 {
+			ackTaskID = min(ackTaskID, scopes[0].Range.InclusiveMin.TaskID)
+		}
+	}
+	return ackTaskID
+}
+
 func IsTaskAcked(
 	task tasks.Task,
 	persistenceQueueState *persistencespb.QueueState,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/predicates"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
@@ -49,3 +50,26 @@ func TestIsTaskAcked(t *testing.T) {
 	testTask.SetTaskID(testKey.TaskID)
 	assert.True(t, IsTaskAcked(testTask, persistenceQueueState))
 }
+
+func TestImmediateQueueAckTaskID(t *testing.T) {
+	exclusiveReaderHighWatermark := tasks.NewImmediateKey(100)
+	persistenceQueueState := ToPersistenceQueueState(&queueState{
+		readerScopes: map[int64][]Scope{
+			DefaultReaderId: {
+				NewScope(NewRange(tasks.NewImmediateKey(50), tasks.NewImmediateKey(60)), predicates.Universal[tasks.Task]()),
+				NewScope(NewRange(tasks.NewImmediateKey(70), tasks.NewImmediateKey(80)), predicates.Universal[tasks.Task]()),
+			},
+			DefaultReaderId + 1: {
+				NewScope(NewRange(tasks.NewImmediateKey(30), tasks.NewImmediateKey(40)), predicates.Universal[tasks.Task]()),
+			},
+		},
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	})
+	assert.Equal(t, int64(30), ImmediateQueueAckTaskID(persistenceQueueState))
+
+	persistenceQueueState = ToPersistenceQueueState(&queueState{
+		readerScopes:                 map[int64][]Scope{},
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	})
+	assert.Equal(t, int64(100), ImmediateQueueAckTaskID(persistenceQueueState))
+}
@@ -548,6 +548,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	useWfBuildId := activityInfo.GetUseWorkflowBuildIdInfo() != nil
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
+	fairness := makeTaskFairness(t.config, mutableState)
+	activityTypeName := activityInfo.GetActivityType().GetName()
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -565,6 +567,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		Fairness:               fairness,
+		TypeName:               activityTypeName,
 	})
 	if err != nil {
 		return err
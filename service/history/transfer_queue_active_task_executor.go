@@ -195,13 +195,15 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
+	fairness := makeTaskFairness(t.config, mutableState)
+	activityTypeName := ai.GetActivityType().GetName()
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, fairness, activityTypeName, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -244,6 +246,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
+	fairness := makeTaskFairness(t.config, mutableState)
+	workflowTypeName := mutableState.GetExecutionInfo().WorkflowTypeName
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
@@ -257,6 +261,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		scheduleToStartTimeout.AsDuration(),
 		directive,
 		priority,
+		fairness,
+		workflowTypeName,
 		historyi.TransactionPolicyActive,
 	)
 
@@ -280,6 +286,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 			scheduleToStartTimeout.AsDuration(),
 			directive,
 			priority,
+			fairness,
+			workflowTypeName,
 			historyi.TransactionPolicyActive,
 		)
 	}
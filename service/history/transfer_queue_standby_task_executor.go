@@ -132,7 +132,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedEventId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, activityInfo)
+			return newActivityTaskPostActionInfo(mutableState, activityInfo, makeTaskFairness(t.config, mutableState))
 		}
 
 		return nil, nil
@@ -183,6 +183,7 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 				mutableState,
 				scheduleToStartTimeout.AsDuration(),
 				taskQueue,
+				makeTaskFairness(t.config, mutableState),
 			)
 		}
 
@@ -512,6 +513,8 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.fairness,
+		pushActivityInfo.typeName,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -534,6 +537,8 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
 		pushwtInfo.priority,
+		pushwtInfo.fairness,
+		pushwtInfo.typeName,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -94,6 +94,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 	activityScheduleToStartTimeout time.Duration,
 	directive *taskqueuespb.TaskVersionDirective,
 	priority *commonpb.Priority,
+	fairness *taskqueuespb.TaskFairness,
+	typeName string,
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
 	resp, err := t.matchingRawClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -112,6 +114,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		Fairness:               fairness,
+		TypeName:               typeName,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
@@ -147,6 +151,8 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	workflowTaskScheduleToStartTimeout time.Duration,
 	directive *taskqueuespb.TaskVersionDirective,
 	priority *commonpb.Priority,
+	fairness *taskqueuespb.TaskFairness,
+	typeName string,
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
 	var sst *durationpb.Duration
@@ -165,6 +171,8 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), task.TaskID),
 		VersionDirective:       directive,
 		Priority:               priority,
+		Fairness:               fairness,
+		TypeName:               typeName,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
@@ -0,0 +1,300 @@
+package history
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	visibilityChangeStreamHeartbeatInterval = 10 * time.Second
+	visibilityChangeStreamBatchSize         = 100
+)
+
+type (
+	// VisibilityChangeFeeds keeps the changes recently made to the visibility records by the visibility queues of the
+	// shards owned by the host, and streams them to the subscribers of the StreamVisibilityChanges API.
+	//
+	// The position of a subscriber in the changes of a shard is a sequence number, which restarts with each load of the
+	// shard (an incarnation), and the ID of the visibility task below which all the changes were received. The queue of
+	// a newly loaded shard executes again all the tasks from the persisted ack level of the queue, so a subscriber can
+	// resume from the start of a new incarnation when it received the changes of all the tasks below this ack level.
+	VisibilityChangeFeeds struct {
+		bufferSize        dynamicconfig.IntPropertyFn
+		timeSource        clock.TimeSource
+		heartbeatInterval time.Duration
+
+		sync.Mutex
+		feeds map[int32]*visibilityChangeFeed
+	}
+
+	// visibilityChangeFeed holds the changes made by the visibility queue of a shard since it was loaded.
+	visibilityChangeFeed struct {
+		bufferSize  dynamicconfig.IntPropertyFn
+		incarnation int64
+		// The tasks with a greater or equal ID are executed by the queue after the feed is created.
+		resumeTaskID int64
+
+		sync.Mutex
+		changes []visibilityChangeEntry
+		// Sequence number of the first change of the buffer.
+		firstSequence int64
+		// Whether changes were removed from the buffer.
+		evicted  bool
+		notifyCh chan struct{}
+	}
+
+	visibilityChangeEntry struct {
+		namespaceID string
+		change      *adminservice.VisibilityChange
+	}
+)
+
+func NewVisibilityChangeFeeds(
+	config *configs.Config,
+	timeSource clock.TimeSource,
+) *VisibilityChangeFeeds {
+	return &VisibilityChangeFeeds{
+		bufferSize:        config.VisibilityChangeStreamBufferSize,
+		timeSource:        timeSource,
+		heartbeatInterval: visibilityChangeStreamHeartbeatInterval,
+		feeds:             make(map[int32]*visibilityChangeFeed),
+	}
+}
+
+// newFeed replaces the feed of a shard with an empty one. It must be called before the visibility queue of the shard
+// starts executing tasks.
+func (f *VisibilityChangeFeeds) newFeed(shardContext historyi.ShardContext) *visibilityChangeFeed {
+	var resumeTaskID int64
+	if queueState, ok := shardContext.GetQueueState(tasks.CategoryVisibility); ok {
+		resumeTaskID = queues.ImmediateQueueAckTaskID(queueState)
+	}
+	feed := newVisibilityChangeFeed(f.bufferSize, f.timeSource.Now().UnixNano(), resumeTaskID)
+
+	f.Lock()
+	defer f.Unlock()
+	f.feeds[shardContext.GetShardID()] = feed
+	return feed
+}
+
+// removeFeed closes the feed of a shard when its visibility queue is stopped.
+func (f *VisibilityChangeFeeds) removeFeed(shardID int32, feed *visibilityChangeFeed) {
+	f.Lock()
+	defer f.Unlock()
+	if f.feeds[shardID] == feed {
+		delete(f.feeds, shardID)
+	}
+	feed.close()
+}
+
+func (f *VisibilityChangeFeeds) getFeed(shardID int32) *visibilityChangeFeed {
+	f.Lock()
+	defer f.Unlock()
+	return f.feeds[shardID]
+}
+
+// Stream sends the changes made to the visibility records of a namespace by the visibility queue of a shard, starting
+// after the cursor of the request, until the stream is canceled or the shard is unloaded. A response without changes
+// is sent at every heartbeat interval to advance the cursor of the subscriber.
+func (f *VisibilityChangeFeeds) Stream(
+	shardContext historyi.ShardContext,
+	request *historyservice.StreamVisibilityChangesRequest,
+	server historyservice.HistoryService_StreamVisibilityChangesServer,
+) error {
+	if f.bufferSize() <= 0 {
+		return serviceerror.NewFailedPrecondition("Visibility change stream is disabled.")
+	}
+	feed := f.getFeed(shardContext.GetShardID())
+	if feed == nil {
+		return serviceerror.NewUnavailablef("Visibility queue of shard %d is not loaded.", shardContext.GetShardID())
+	}
+	nextSequence, err := feed.start(shardContext.GetShardID(), request.GetCursor())
+	if err != nil {
+		return err
+	}
+
+	heartbeatTicker := time.NewTicker(f.heartbeatInterval)
+	defer heartbeatTicker.Stop()
+	heartbeat := true
+	for {
+		// Read the ack level before the changes: the changes of all the tasks below it are in the buffer.
+		var ackTaskID int64
+		if queueState, ok := shardContext.GetQueueState(tasks.CategoryVisibility); ok {
+			ackTaskID = queues.ImmediateQueueAckTaskID(queueState)
+		}
+		changes, lastSequence, notifyCh, err := feed.read(shardContext.GetShardID(), request.GetNamespaceId(), nextSequence)
+		if err != nil {
+			return err
+		}
+		nextSequence = lastSequence + 1
+
+		if len(changes) > 0 || heartbeat {
+			heartbeat = false
+			err := server.Send(&historyservice.StreamVisibilityChangesResponse{
+				Changes: changes,
+				Cursor: &adminservice.VisibilityChangeShardCursor{
+					Incarnation: feed.incarnation,
+					Sequence:    lastSequence,
+					AckTaskId:   ackTaskID,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(changes) == visibilityChangeStreamBatchSize {
+			continue
+		}
+		if notifyCh == nil {
+			return serviceerror.NewUnavailablef("Shard %d was unloaded.", shardContext.GetShardID())
+		}
+
+		select {
+		case <-server.Context().Done():
+			return nil
+		case <-notifyCh:
+		case <-heartbeatTicker.C:
+			heartbeat = true
+		}
+	}
+}
+
+func newVisibilityChangeFeed(
+	bufferSize dynamicconfig.IntPropertyFn,
+	incarnation int64,
+	resumeTaskID int64,
+) *visibilityChangeFeed {
+	return &visibilityChangeFeed{
+		bufferSize:    bufferSize,
+		incarnation:   incarnation,
+		resumeTaskID:  resumeTaskID,
+		firstSequence: 1,
+		notifyCh:      make(chan struct{}),
+	}
+}
+
+// publish adds the change made by a visibility task to the buffer, and removes the oldest changes above the buffer
+// size. Changes are not kept when the buffer size is 0.
+func (f *visibilityChangeFeed) publish(
+	namespaceID string,
+	change *adminservice.VisibilityChange,
+) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.changes = append(f.changes, visibilityChangeEntry{namespaceID: namespaceID, change: change})
+	if evictCount := len(f.changes) - max(f.bufferSize(), 0); evictCount > 0 {
+		clear(f.changes[:evictCount])
+		f.changes = f.changes[evictCount:]
+		f.firstSequence += int64(evictCount)
+		f.evicted = true
+	}
+	if f.notifyCh == nil {
+		return
+	}
+	close(f.notifyCh)
+	f.notifyCh = make(chan struct{})
+}
+
+// close wakes up the subscribers of the feed, which stop streaming after sending the remaining changes.
+func (f *visibilityChangeFeed) close() {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.notifyCh != nil {
+		close(f.notifyCh)
+		f.notifyCh = nil
+	}
+}
+
+// start returns the sequence number of the first change to send to a subscriber resuming from the cursor.
+func (f *visibilityChangeFeed) start(
+	shardID int32,
+	cursor *adminservice.VisibilityChangeShardCursor,
+) (int64, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	endSequence := f.firstSequence + int64(len(f.changes))
+	switch {
+	case cursor == nil:
+		return endSequence, nil
+	case cursor.GetIncarnation() == f.incarnation:
+		if cursor.GetSequence() >= endSequence {
+			return 0, serviceerror.NewInvalidArgumentf("Invalid visibility change stream cursor for shard %d.", shardID)
+		}
+		if cursor.GetSequence()+1 < f.firstSequence {
+			return 0, errVisibilityChangeCursorExpired(shardID)
+		}
+		return cursor.GetSequence() + 1, nil
+	case !f.evicted && cursor.GetAckTaskId() >= f.resumeTaskID:
+		return f.firstSequence, nil
+	default:
+		return 0, errVisibilityChangeCursorExpired(shardID)
+	}
+}
+
+// read returns the changes of the namespace from the sequence number, the sequence number of the last change it
+// scanned, and a channel closed when the next change is published, or nil when the feed is closed.
+func (f *visibilityChangeFeed) read(
+	shardID int32,
+	namespaceID string,
+	sequence int64,
+) ([]*adminservice.VisibilityChange, int64, <-chan struct{}, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if sequence < f.firstSequence {
+		return nil, 0, nil, errVisibilityChangeCursorExpired(shardID)
+	}
+	var changes []*adminservice.VisibilityChange
+	endSequence := f.firstSequence + int64(len(f.changes))
+	for ; sequence < endSequence && len(changes) < visibilityChangeStreamBatchSize; sequence++ {
+		entry := f.changes[sequence-f.firstSequence]
+		if entry.namespaceID == namespaceID {
+			changes = append(changes, entry.change)
+		}
+	}
+	return changes, sequence - 1, f.notifyCh, nil
+}
+
+func errVisibilityChangeCursorExpired(shardID int32) error {
+	return serviceerror.NewFailedPreconditionf(
+		"Visibility change stream cursor expired for shard %d, the stream must be restarted without cursor.", shardID)
+}
+
+func newVisibilityChange(
+	changeType enumsspb.VisibilityChangeType,
+	request *manager.VisibilityRequestBase,
+	closeTime time.Time,
+) *adminservice.VisibilityChange {
+	change := &adminservice.VisibilityChange{
+		Type:             changeType,
+		ShardId:          request.ShardID,
+		TaskId:           request.TaskID,
+		Execution:        request.Execution,
+		WorkflowType:     request.WorkflowTypeName,
+		Status:           request.Status,
+		StartTime:        timestamppb.New(request.StartTime),
+		ExecutionTime:    timestamppb.New(request.ExecutionTime),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: request.SearchAttributes,
+		Memo:             request.Memo,
+	}
+	if !closeTime.IsZero() {
+		change.CloseTime = timestamppb.New(closeTime)
+	}
+	return change
+}
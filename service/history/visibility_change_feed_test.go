@@ -0,0 +1,173 @@
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestVisibilityChange(taskID int64) *adminservice.VisibilityChange {
+	return &adminservice.VisibilityChange{
+		Type:      enumsspb.VISIBILITY_CHANGE_TYPE_UPSERTED,
+		ShardId:   1,
+		TaskId:    taskID,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+	}
+}
+
+func TestVisibilityChangeFeed_Read(t *testing.T) {
+	feed := newVisibilityChangeFeed(dynamicconfig.GetIntPropertyFn(10), 1, 0)
+	feed.publish("ns-1", newTestVisibilityChange(1))
+	feed.publish("ns-2", newTestVisibilityChange(2))
+	feed.publish("ns-1", newTestVisibilityChange(3))
+
+	changes, lastSequence, notifyCh, err := feed.read(1, "ns-1", 1)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, int64(1), changes[0].GetTaskId())
+	require.Equal(t, int64(3), changes[1].GetTaskId())
+	require.Equal(t, int64(3), lastSequence)
+
+	changes, lastSequence, _, err = feed.read(1, "ns-1", 4)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.Equal(t, int64(3), lastSequence)
+
+	feed.publish("ns-1", newTestVisibilityChange(4))
+	select {
+	case <-notifyCh:
+	default:
+		t.Fatal("publish did not notify the readers")
+	}
+
+	feed.close()
+	_, _, notifyCh, err = feed.read(1, "ns-1", 5)
+	require.NoError(t, err)
+	require.Nil(t, notifyCh)
+}
+
+func TestVisibilityChangeFeed_Eviction(t *testing.T) {
+	feed := newVisibilityChangeFeed(dynamicconfig.GetIntPropertyFn(2), 1, 0)
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		feed.publish("ns-1", newTestVisibilityChange(taskID))
+	}
+
+	_, _, _, err := feed.read(1, "ns-1", 1)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPrecondition)
+
+	changes, _, _, err := feed.read(1, "ns-1", 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, int64(2), changes[0].GetTaskId())
+}
+
+func TestVisibilityChangeFeed_Start(t *testing.T) {
+	var invalidArgument *serviceerror.InvalidArgument
+	var failedPrecondition *serviceerror.FailedPrecondition
+
+	feed := newVisibilityChangeFeed(dynamicconfig.GetIntPropertyFn(2), 10, 100)
+	feed.publish("ns-1", newTestVisibilityChange(100))
+
+	sequence, err := feed.start(1, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), sequence)
+
+	sequence, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 10, Sequence: 0})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), sequence)
+
+	_, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 10, Sequence: 2})
+	require.ErrorAs(t, err, &invalidArgument)
+
+	// A cursor of a previous incarnation resumes from the start of the feed when it received all the changes of the
+	// tasks executed again by the queue.
+	sequence, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 5, Sequence: 7, AckTaskId: 100})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), sequence)
+
+	_, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 5, Sequence: 7, AckTaskId: 99})
+	require.ErrorAs(t, err, &failedPrecondition)
+
+	feed.publish("ns-1", newTestVisibilityChange(101))
+	feed.publish("ns-1", newTestVisibilityChange(102))
+
+	_, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 10, Sequence: 0})
+	require.ErrorAs(t, err, &failedPrecondition)
+
+	sequence, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 10, Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), sequence)
+
+	_, err = feed.start(1, &adminservice.VisibilityChangeShardCursor{Incarnation: 5, Sequence: 7, AckTaskId: 100})
+	require.ErrorAs(t, err, &failedPrecondition)
+}
+
+func TestVisibilityChangeFeeds_Stream(t *testing.T) {
+	controller := gomock.NewController(t)
+	shardContext := historyi.NewMockShardContext(controller)
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetQueueState(tasks.CategoryVisibility).Return(&persistencespb.QueueState{
+		ExclusiveReaderHighWatermark: &persistencespb.TaskKey{TaskId: 100},
+	}, true).AnyTimes()
+
+	feeds := NewVisibilityChangeFeeds(
+		&configs.Config{VisibilityChangeStreamBufferSize: dynamicconfig.GetIntPropertyFn(10)},
+		clock.NewEventTimeSource().Update(time.Unix(0, 10)),
+	)
+	feeds.heartbeatInterval = time.Hour
+	feed := feeds.newFeed(shardContext)
+	feed.publish("ns-1", newTestVisibilityChange(100))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := historyservicemock.NewMockHistoryService_StreamVisibilityChangesServer(controller)
+	server.EXPECT().Context().Return(ctx).AnyTimes()
+	responseCh := make(chan *historyservice.StreamVisibilityChangesResponse, 10)
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(response *historyservice.StreamVisibilityChangesResponse) error {
+		responseCh <- response
+		return nil
+	}).AnyTimes()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- feeds.Stream(shardContext, &historyservice.StreamVisibilityChangesRequest{
+			NamespaceId: "ns-1",
+			ShardId:     1,
+			Cursor:      &adminservice.VisibilityChangeShardCursor{Incarnation: 1, AckTaskId: 100},
+		}, server)
+	}()
+
+	response := <-responseCh
+	require.Len(t, response.GetChanges(), 1)
+	require.Equal(t, int64(100), response.GetChanges()[0].GetTaskId())
+	require.Equal(t, int64(10), response.GetCursor().GetIncarnation())
+	require.Equal(t, int64(1), response.GetCursor().GetSequence())
+	require.Equal(t, int64(100), response.GetCursor().GetAckTaskId())
+
+	feed.publish("ns-2", newTestVisibilityChange(101))
+	feed.publish("ns-1", newTestVisibilityChange(102))
+	response = <-responseCh
+	require.Len(t, response.GetChanges(), 1)
+	require.Equal(t, int64(102), response.GetChanges()[0].GetTaskId())
+	require.Equal(t, int64(3), response.GetCursor().GetSequence())
+
+	feeds.removeFeed(1, feed)
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, <-errCh, &unavailable)
+}
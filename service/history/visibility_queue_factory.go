@@ -23,7 +23,17 @@ type (
 
 		QueueFactoryBaseParams
 
-		VisibilityMgr manager.VisibilityManager
+		VisibilityMgr         manager.VisibilityManager
+		VisibilityChangeFeeds *VisibilityChangeFeeds
+	}
+
+	// visibilityQueue closes the change feed of the shard when the queue is stopped.
+	visibilityQueue struct {
+		queues.Queue
+
+		shardID    int32
+		changeFeed *visibilityChangeFeed
+		feeds      *VisibilityChangeFeeds
 	}
 
 	visibilityQueueFactory struct {
@@ -94,10 +104,12 @@ func (f *visibilityQueueFactory) CreateQueue(
 		metricsHandler,
 	)
 
+	changeFeed := f.VisibilityChangeFeeds.newFeed(shard)
 	executor := newVisibilityQueueTaskExecutor(
 		shard,
 		workflowCache,
 		f.VisibilityMgr,
+		changeFeed,
 		logger,
 		f.MetricsHandler,
 		f.Config.VisibilityProcessorEnsureCloseBeforeDelete,
@@ -125,7 +137,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
 	)
-	return queues.NewImmediateQueue(
+	queue := queues.NewImmediateQueue(
 		shard,
 		tasks.CategoryVisibility,
 		shardScheduler,
@@ -155,4 +167,15 @@ func (f *visibilityQueueFactory) CreateQueue(
 		metricsHandler,
 		factory,
 	)
+	return &visibilityQueue{
+		Queue:      queue,
+		shardID:    shard.GetShardID(),
+		changeFeed: changeFeed,
+		feeds:      f.VisibilityChangeFeeds,
+	}
+}
+
+func (q *visibilityQueue) Stop() {
+	q.Queue.Stop()
+	q.feeds.removeFeed(q.shardID, q.changeFeed)
 }
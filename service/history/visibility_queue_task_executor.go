@@ -7,6 +7,8 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -15,6 +17,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/queues"
@@ -29,6 +32,7 @@ type (
 		logger         log.Logger
 		metricProvider metrics.Handler
 		visibilityMgr  manager.VisibilityManager
+		changeFeed     *visibilityChangeFeed
 
 		ensureCloseBeforeDelete       dynamicconfig.BoolPropertyFn
 		enableCloseWorkflowCleanup    dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -42,6 +46,7 @@ func newVisibilityQueueTaskExecutor(
 	shardContext historyi.ShardContext,
 	workflowCache wcache.Cache,
 	visibilityMgr manager.VisibilityManager,
+	changeFeed *visibilityChangeFeed,
 	logger log.Logger,
 	metricProvider metrics.Handler,
 	ensureCloseBeforeDelete dynamicconfig.BoolPropertyFn,
@@ -54,6 +59,7 @@ func newVisibilityQueueTaskExecutor(
 		logger:         logger,
 		metricProvider: metricProvider,
 		visibilityMgr:  visibilityMgr,
+		changeFeed:     changeFeed,
 
 		ensureCloseBeforeDelete:       ensureCloseBeforeDelete,
 		enableCloseWorkflowCleanup:    enableCloseWorkflowCleanup,
@@ -158,12 +164,17 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.visibilityMgr.RecordWorkflowExecutionStarted(
+	err = t.visibilityMgr.RecordWorkflowExecutionStarted(
 		ctx,
 		&manager.RecordWorkflowExecutionStartedRequest{
 			VisibilityRequestBase: requestBase,
 		},
 	)
+	if err != nil {
+		return err
+	}
+	t.changeFeed.publish(task.GetNamespaceID(), newVisibilityChange(enumsspb.VISIBILITY_CHANGE_TYPE_STARTED, requestBase, time.Time{}))
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) processUpsertExecution(
@@ -200,12 +211,17 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.visibilityMgr.UpsertWorkflowExecution(
+	err = t.visibilityMgr.UpsertWorkflowExecution(
 		ctx,
 		&manager.UpsertWorkflowExecutionRequest{
 			VisibilityRequestBase: requestBase,
 		},
 	)
+	if err != nil {
+		return err
+	}
+	t.changeFeed.publish(task.GetNamespaceID(), newVisibilityChange(enumsspb.VISIBILITY_CHANGE_TYPE_UPSERTED, requestBase, time.Time{}))
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) processCloseExecution(
@@ -277,6 +293,7 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	if err != nil {
 		return err
 	}
+	t.changeFeed.publish(task.GetNamespaceID(), newVisibilityChange(enumsspb.VISIBILITY_CHANGE_TYPE_CLOSED, requestBase, wfCloseTime))
 
 	// Elasticsearch bulk processor doesn't respect context timeout
 	// because under heavy load bulk flush might take longer than taskTimeout.
@@ -332,7 +349,19 @@ func (t *visibilityQueueTaskExecutor) processDeleteExecution(
 			return consts.ErrDependencyTaskNotCompleted
 		}
 	}
-	return t.visibilityMgr.DeleteWorkflowExecution(ctx, request)
+	if err := t.visibilityMgr.DeleteWorkflowExecution(ctx, request); err != nil {
+		return err
+	}
+	t.changeFeed.publish(task.GetNamespaceID(), &adminservice.VisibilityChange{
+		Type:    enumsspb.VISIBILITY_CHANGE_TYPE_DELETED,
+		ShardId: t.shardContext.GetShardID(),
+		TaskId:  task.GetTaskID(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: task.GetWorkflowID(),
+			RunId:      task.GetRunID(),
+		},
+	})
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
@@ -345,7 +374,10 @@ func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
 		startTime        = timestamp.TimeValue(mutableState.GetExecutionState().GetStartTime())
 		executionTime    = timestamp.TimeValue(executionInfo.GetExecutionTime())
 		visibilityMemo   = getWorkflowMemo(copyMapPayload(executionInfo.Memo))
-		searchAttributes = getSearchAttributes(copyMapPayload(executionInfo.SearchAttributes))
+		searchAttributes = getSearchAttributes(searchattribute.MigrateFields(
+			copyMapPayload(executionInfo.SearchAttributes),
+			namespaceEntry.CustomSearchAttributeFieldMigrations(),
+		))
 	)
 
 	var parentExecution *commonpb.WorkflowExecution
@@ -9,22 +9,29 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/telemetry"
@@ -55,6 +62,7 @@ type (
 
 		mockVisibilityMgr *manager.MockVisibilityManager
 		mockExecutionMgr  *persistence.MockExecutionManager
+		changeFeed        *visibilityChangeFeed
 
 		workflowCache               wcache.Cache
 		logger                      log.Logger
@@ -117,6 +125,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
 	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
+	s.changeFeed = newVisibilityChangeFeed(dynamicconfig.GetIntPropertyFn(10), 1, 0)
 
 	mockNamespaceCache := s.mockShard.Resource.NamespaceCache
 	mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
@@ -151,6 +160,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 		s.mockShard,
 		s.workflowCache,
 		s.mockVisibilityMgr,
+		s.changeFeed,
 		s.logger,
 		metrics.NoopMetricsHandler,
 		config.VisibilityProcessorEnsureCloseBeforeDelete,
@@ -387,6 +397,14 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask(
 
 	resp := s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(visibilityTask))
 	s.Nil(resp.ExecutionErr)
+
+	changes, _, _, err := s.changeFeed.read(s.mockShard.GetShardID(), s.namespaceID.String(), 1)
+	s.NoError(err)
+	s.Len(changes, 1)
+	s.Equal(enumsspb.VISIBILITY_CHANGE_TYPE_STARTED, changes[0].GetType())
+	s.Equal(taskID, changes[0].GetTaskId())
+	s.Equal(execution.GetWorkflowId(), changes[0].GetExecution().GetWorkflowId())
+	s.Equal(workflowType, changes[0].GetWorkflowType())
 }
 
 func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes() {
@@ -437,6 +455,155 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttrib
 	s.Nil(resp.ExecutionErr)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes_FieldMigration() {
+	// The namespace migrates the values of Int01 to Keyword02, which are backfilled in a SQL visibility store.
+	namespaceID := namespace.ID(uuid.New())
+	namespaceName := namespace.Name("test-namespace")
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: namespaceID.String(), Name: namespaceName.String()},
+		&persistencespb.NamespaceConfig{
+			Retention: timestamp.DurationFromDays(1),
+			CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+				"Int01": {
+					TargetField: "Keyword02",
+					SourceType:  enumspb.INDEXED_VALUE_TYPE_INT,
+					TargetType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				},
+			},
+		},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		s.version,
+	)
+	mockNamespaceCache := s.mockShard.Resource.NamespaceCache
+	mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(namespaceEntry, nil).AnyTimes()
+	mockNamespaceCache.EXPECT().GetNamespace(namespaceName).Return(namespaceEntry, nil).AnyTimes()
+
+	visibilityMgr, err := visibility.NewManagerFromDataStoreConfig(
+		config.DataStore{
+			SQL: &config.SQL{
+				PluginName:        sqlite.PluginName,
+				DatabaseName:      uuid.New(),
+				ConnectAttributes: map[string]string{"mode": "memory", "cache": "private"},
+			},
+		},
+		resolver.NewNoopResolver(),
+		nil,
+		nil,
+		searchattribute.NewTestProvider(),
+		searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{}),
+		mockNamespaceCache,
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetFloatPropertyFn(1),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		s.logger,
+	)
+	s.NoError(err)
+	defer visibilityMgr.Close()
+	executor := newVisibilityQueueTaskExecutor(
+		s.mockShard,
+		s.workflowCache,
+		visibilityMgr,
+		s.changeFeed,
+		s.logger,
+		metrics.NoopMetricsHandler,
+		s.mockShard.GetConfig().VisibilityProcessorEnsureCloseBeforeDelete,
+		func(_ string) bool { return false },
+		s.mockShard.GetConfig().VisibilityProcessorRelocateAttributesMinBlobSize,
+	)
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	taskQueueName := "some random task queue"
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err = mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: "some random workflow type"},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	intValue, err := searchattribute.EncodeValue(int64(42), enumspb.INDEXED_VALUE_TYPE_INT)
+	s.NoError(err)
+	mutableState.GetExecutionInfo().NamespaceId = namespaceID.String()
+	mutableState.GetExecutionInfo().SearchAttributes = map[string]*commonpb.Payload{"Int01": intValue}
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, wt.ScheduledEventID, wt.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).AnyTimes()
+
+	// The record was written before the migration started.
+	ctx := context.Background()
+	err = visibilityMgr.RecordWorkflowExecutionStarted(ctx, &manager.RecordWorkflowExecutionStartedRequest{
+		VisibilityRequestBase: &manager.VisibilityRequestBase{
+			NamespaceID:      namespaceID,
+			Namespace:        namespaceName,
+			Execution:        execution,
+			WorkflowTypeName: "some random workflow type",
+			StartTime:        s.now,
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			TaskID:           100,
+			ShardID:          s.mockShard.GetShardID(),
+			TaskQueue:        taskQueueName,
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"Int01": intValue}},
+		},
+	})
+	s.NoError(err)
+
+	countMigrated := func() int64 {
+		resp, err := visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+			NamespaceID: namespaceID,
+			Namespace:   namespaceName,
+			Query:       "AliasForKeyword02 = '42'",
+		})
+		s.NoError(err)
+		return resp.Count
+	}
+	upsert := func(taskID int64) {
+		task := &tasks.UpsertExecutionVisibilityTask{
+			WorkflowKey: definition.NewWorkflowKey(namespaceID.String(), execution.GetWorkflowId(), execution.GetRunId()),
+			TaskID:      taskID,
+		}
+		executable := queues.NewExecutable(
+			queues.DefaultReaderId,
+			task,
+			executor,
+			nil,
+			nil,
+			queues.NewNoopPriorityAssigner(),
+			s.mockShard.GetTimeSource(),
+			s.mockShard.GetNamespaceRegistry(),
+			s.mockShard.GetClusterMetadata(),
+			nil,
+			metrics.NoopMetricsHandler,
+			telemetry.NoopTracer,
+		)
+		s.NoError(executor.Execute(ctx, executable).ExecutionErr)
+	}
+
+	// Records written with task IDs lower than the one of the existing record are ignored.
+	upsert(50)
+	s.EqualValues(0, countMigrated())
+
+	// Refreshing the tasks of the workflow execution generates a visibility task with a new task ID.
+	upsert(200)
+	s.EqualValues(1, countMigrated())
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessModifyWorkflowProperties() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
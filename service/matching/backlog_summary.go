@@ -0,0 +1,198 @@
+package matching
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultBacklogSummaryMaxTasks = 10000
+	backlogSummaryPageSize        = 1000
+)
+
+// backlogSummaryAgeBuckets are the maximum ages of the age buckets of a backlog summary. Tasks older than the last
+// one are in an additional bucket.
+var backlogSummaryAgeBuckets = []time.Duration{
+	time.Minute,
+	10 * time.Minute,
+	time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+type backlogSummarizer struct {
+	purgeFilters []*taskqueuespb.TaskQueuePurgeFilter
+	now          time.Time
+
+	summary      *taskqueuespb.TaskQueueBacklogSummary
+	typeNames    map[string]*taskqueuespb.TaskQueueBacklogGroup
+	priorityKeys map[string]*taskqueuespb.TaskQueueBacklogGroup
+	fairnessKeys map[string]*taskqueuespb.TaskQueueBacklogGroup
+}
+
+// summarizeBacklog reads up to maxTasks tasks of the backlog of a physical queue from persistence, from the ack level
+// of each of its subqueues, and summarizes them.
+func summarizeBacklog(
+	ctx context.Context,
+	store persistence.TaskManager,
+	queue *PhysicalTaskQueueKey,
+	purgeFilters []*taskqueuespb.TaskQueuePurgeFilter,
+	maxTasks int,
+	now time.Time,
+) (*taskqueuespb.TaskQueueBacklogSummary, error) {
+	s := newBacklogSummarizer(purgeFilters, now)
+
+	resp, err := store.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: queue.NamespaceId(),
+		TaskQueue:   queue.PersistenceName(),
+		TaskType:    queue.TaskType(),
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return s.finish(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Before tasks are split into subqueues, the ack level of subqueue 0 is the one of the task queue.
+	ackLevels := []int64{resp.TaskQueueInfo.GetAckLevel()}
+	if subqueues := resp.TaskQueueInfo.GetSubqueues(); len(subqueues) > 0 {
+		ackLevels = make([]int64, len(subqueues))
+		for i, subqueue := range subqueues {
+			ackLevels[i] = subqueue.GetAckLevel()
+		}
+	}
+
+	read := 0
+	for subqueue, ackLevel := range ackLevels {
+		var pageToken []byte
+		for {
+			if read >= maxTasks {
+				s.summary.Truncated = true
+				return s.finish(), nil
+			}
+			tasks, err := store.GetTasks(ctx, &persistence.GetTasksRequest{
+				NamespaceID:        queue.NamespaceId(),
+				TaskQueue:          queue.PersistenceName(),
+				TaskType:           queue.TaskType(),
+				InclusiveMinTaskID: ackLevel + 1,
+				ExclusiveMaxTaskID: math.MaxInt64,
+				Subqueue:           subqueue,
+				PageSize:           min(backlogSummaryPageSize, maxTasks-read),
+				NextPageToken:      pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, task := range tasks.Tasks {
+				s.add(task)
+			}
+			read += len(tasks.Tasks)
+			pageToken = tasks.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	return s.finish(), nil
+}
+
+func newBacklogSummarizer(purgeFilters []*taskqueuespb.TaskQueuePurgeFilter, now time.Time) *backlogSummarizer {
+	ageBuckets := make([]*taskqueuespb.TaskQueueBacklogAgeBucket, len(backlogSummaryAgeBuckets)+1)
+	for i := range ageBuckets {
+		ageBuckets[i] = &taskqueuespb.TaskQueueBacklogAgeBucket{}
+		if i < len(backlogSummaryAgeBuckets) {
+			ageBuckets[i].MaxAge = durationpb.New(backlogSummaryAgeBuckets[i])
+		}
+	}
+	return &backlogSummarizer{
+		purgeFilters: purgeFilters,
+		now:          now,
+		summary:      &taskqueuespb.TaskQueueBacklogSummary{AgeBuckets: ageBuckets},
+		typeNames:    make(map[string]*taskqueuespb.TaskQueueBacklogGroup),
+		priorityKeys: make(map[string]*taskqueuespb.TaskQueueBacklogGroup),
+		fairnessKeys: make(map[string]*taskqueuespb.TaskQueueBacklogGroup),
+	}
+}
+
+func (s *backlogSummarizer) add(task *persistencespb.AllocatedTaskInfo) {
+	if IsTaskExpired(task) {
+		s.summary.ExpiredTaskCount++
+		return
+	}
+	if IsTaskPurged(task, s.purgeFilters) {
+		s.summary.PurgedTaskCount++
+		return
+	}
+
+	data := task.GetData()
+	createTime := data.GetCreateTime()
+	s.summary.TaskCount++
+	s.summary.OldestCreateTime = oldestTime(s.summary.OldestCreateTime, createTime)
+
+	priorityKey := ""
+	if pri := data.GetPriority().GetPriorityKey(); pri > 0 {
+		priorityKey = strconv.Itoa(int(pri))
+	}
+	addToBacklogGroup(s.typeNames, data.GetTypeName(), createTime)
+	addToBacklogGroup(s.priorityKeys, priorityKey, createTime)
+	addToBacklogGroup(s.fairnessKeys, data.GetFairness().GetKey(), createTime)
+
+	// tasks without a create time are counted with the oldest ones
+	bucket := len(backlogSummaryAgeBuckets)
+	if createTime != nil {
+		age := s.now.Sub(createTime.AsTime())
+		if i := slices.IndexFunc(backlogSummaryAgeBuckets, func(maxAge time.Duration) bool { return age < maxAge }); i >= 0 {
+			bucket = i
+		}
+	}
+	s.summary.AgeBuckets[bucket].TaskCount++
+}
+
+func (s *backlogSummarizer) finish() *taskqueuespb.TaskQueueBacklogSummary {
+	s.summary.TypeNames = sortedBacklogGroups(s.typeNames)
+	s.summary.PriorityKeys = sortedBacklogGroups(s.priorityKeys)
+	s.summary.FairnessKeys = sortedBacklogGroups(s.fairnessKeys)
+	return s.summary
+}
+
+func addToBacklogGroup(groups map[string]*taskqueuespb.TaskQueueBacklogGroup, key string, createTime *timestamppb.Timestamp) {
+	group, ok := groups[key]
+	if !ok {
+		group = &taskqueuespb.TaskQueueBacklogGroup{Key: key}
+		groups[key] = group
+	}
+	group.TaskCount++
+	group.OldestCreateTime = oldestTime(group.OldestCreateTime, createTime)
+}
+
+// sortedBacklogGroups returns the groups with the most tasks first.
+func sortedBacklogGroups(groups map[string]*taskqueuespb.TaskQueueBacklogGroup) []*taskqueuespb.TaskQueueBacklogGroup {
+	sorted := make([]*taskqueuespb.TaskQueueBacklogGroup, 0, len(groups))
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	slices.SortFunc(sorted, func(a, b *taskqueuespb.TaskQueueBacklogGroup) int {
+		return cmp.Or(cmp.Compare(b.TaskCount, a.TaskCount), cmp.Compare(a.Key, b.Key))
+	})
+	return sorted
+}
+
+func oldestTime(a, b *timestamppb.Timestamp) *timestamppb.Timestamp {
+	if a == nil || (b != nil && b.AsTime().Before(a.AsTime())) {
+		return b
+	}
+	return a
+}
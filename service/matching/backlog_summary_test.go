@@ -0,0 +1,119 @@
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBacklogSummarizer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	task := func(workflowID, typeName string, pri int32, fairnessKey string, age time.Duration) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			Data: &persistencespb.TaskInfo{
+				WorkflowId: workflowID,
+				TypeName:   typeName,
+				CreateTime: timestamppb.New(now.Add(-age)),
+				Priority:   &commonpb.Priority{PriorityKey: pri},
+				Fairness:   &taskqueuespb.TaskFairness{Key: fairnessKey},
+			},
+		}
+	}
+	expired := task("wf-expired", "A", 0, "", time.Hour)
+	expired.Data.ExpiryTime = timestamppb.New(now.Add(-time.Minute))
+
+	s := newBacklogSummarizer([]*taskqueuespb.TaskQueuePurgeFilter{{
+		WorkflowIdPrefix: "purged-",
+		CreatedBefore:    timestamppb.New(now),
+	}}, now)
+	s.add(task("wf-1", "A", 0, "tenant-1", 10*time.Second))
+	s.add(task("wf-2", "A", 2, "tenant-2", 30*time.Minute))
+	s.add(task("wf-3", "B", 0, "tenant-1", 48*time.Hour))
+	s.add(task("wf-4", "A", 0, "tenant-1", 30*24*time.Hour))
+	s.add(task("purged-1", "A", 0, "", time.Minute))
+	s.add(expired)
+	summary := s.finish()
+
+	require.Equal(t, int64(4), summary.GetTaskCount())
+	require.Equal(t, int64(1), summary.GetExpiredTaskCount())
+	require.Equal(t, int64(1), summary.GetPurgedTaskCount())
+	require.False(t, summary.GetTruncated())
+	require.Equal(t, now.Add(-30*24*time.Hour).UnixNano(), summary.GetOldestCreateTime().AsTime().UnixNano())
+
+	require.Len(t, summary.GetTypeNames(), 2)
+	require.Equal(t, "A", summary.GetTypeNames()[0].GetKey())
+	require.Equal(t, int64(3), summary.GetTypeNames()[0].GetTaskCount())
+	require.Equal(t, "B", summary.GetTypeNames()[1].GetKey())
+	require.Equal(t, int64(1), summary.GetTypeNames()[1].GetTaskCount())
+
+	require.Len(t, summary.GetPriorityKeys(), 2)
+	require.Equal(t, "", summary.GetPriorityKeys()[0].GetKey())
+	require.Equal(t, int64(3), summary.GetPriorityKeys()[0].GetTaskCount())
+	require.Equal(t, "2", summary.GetPriorityKeys()[1].GetKey())
+
+	require.Len(t, summary.GetFairnessKeys(), 2)
+	require.Equal(t, "tenant-1", summary.GetFairnessKeys()[0].GetKey())
+	require.Equal(t, int64(3), summary.GetFairnessKeys()[0].GetTaskCount())
+
+	counts := make([]int64, len(summary.GetAgeBuckets()))
+	for i, bucket := range summary.GetAgeBuckets() {
+		counts[i] = bucket.GetTaskCount()
+	}
+	require.Equal(t, []int64{1, 0, 1, 0, 1, 1}, counts)
+	require.Nil(t, summary.GetAgeBuckets()[len(counts)-1].GetMaxAge())
+}
+
+func TestSummarizeBacklog_Truncated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockTaskManager(ctrl)
+
+	f, err := tqid.NewTaskQueueFamily("nsid", "tq")
+	require.NoError(t, err)
+	queue := UnversionedQueueKey(f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).RootPartition())
+
+	store.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskQueueResponse{
+		TaskQueueInfo: &persistencespb.TaskQueueInfo{
+			Subqueues: []*persistencespb.SubqueueInfo{{AckLevel: 10}, {AckLevel: 20}},
+		},
+	}, nil)
+	newTasks := func(n int) []*persistencespb.AllocatedTaskInfo {
+		tasks := make([]*persistencespb.AllocatedTaskInfo, n)
+		for i := range tasks {
+			tasks[i] = &persistencespb.AllocatedTaskInfo{Data: &persistencespb.TaskInfo{CreateTime: timestamppb.Now()}}
+		}
+		return tasks
+	}
+	store.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+			require.Equal(t, 0, req.Subqueue)
+			require.Equal(t, int64(11), req.InclusiveMinTaskID)
+			require.Equal(t, 3, req.PageSize)
+			return &persistence.GetTasksResponse{Tasks: newTasks(2)}, nil
+		})
+	store.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+			require.Equal(t, 1, req.Subqueue)
+			require.Equal(t, int64(21), req.InclusiveMinTaskID)
+			require.Equal(t, 1, req.PageSize)
+			return &persistence.GetTasksResponse{Tasks: newTasks(1), NextPageToken: []byte("more")}, nil
+		})
+
+	summary, err := summarizeBacklog(context.Background(), store, queue, nil, 3, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), summary.GetTaskCount())
+	require.True(t, summary.GetTruncated())
+}
@@ -4,6 +4,7 @@
 package matching
 
 import (
+	"hash/fnv"
 	"time"
 
 	"go.temporal.io/server/common/backoff"
@@ -44,6 +45,14 @@ type (
 		MaxTaskQueueIdleTime                     dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		NumTaskqueueWritePartitions              dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		NumTaskqueueReadPartitions               dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnablePartitionAutoScaling               dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingMinPartitions        dynamicconfig.IntPropertyFnWithNamespaceFilter
+		PartitionAutoScalingMaxPartitions        dynamicconfig.IntPropertyFnWithNamespaceFilter
+		PartitionAutoScalingTargetRate           dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingBacklogThreshold     dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingInterval             dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingCooldown             dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionCountsCacheTTL                  dynamicconfig.DurationPropertyFn
 		BreakdownMetricsByTaskQueue              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByPartition              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByBuildID                dynamicconfig.BoolPropertyFnWithTaskQueueFilter
@@ -57,6 +66,8 @@ type (
 		RedirectRuleLimitPerQueue                dynamicconfig.IntPropertyFnWithNamespaceFilter
 		RedirectRuleMaxUpstreamBuildIDsPerQueue  dynamicconfig.IntPropertyFnWithNamespaceFilter
 		DeletedRuleRetentionTime                 dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		PurgeFilterRetention                     dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		PurgeFilterLimit                         dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PollerHistoryTTL                         dynamicconfig.DurationPropertyFnWithNamespaceFilter
 		ReachabilityBuildIdVisibilityGracePeriod dynamicconfig.DurationPropertyFnWithNamespaceFilter
 		ReachabilityCacheOpenWFsTTL              dynamicconfig.DurationPropertyFn
@@ -71,6 +82,7 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		FairnessBuckets                          dynamicconfig.IntPropertyFnWithTaskQueueFilter
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueFilter
@@ -90,6 +102,13 @@ type (
 		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithTaskQueueFilter
 		AdminNamespaceTaskqueueToPartitionRateSub      dynamicconfig.TypedSubscribableWithTaskQueueFilter[float64]
 
+		FairnessKeyDispatchRateLimits          dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		FairnessKeyDispatchRateLimitsSub       dynamicconfig.TypedSubscribableWithTaskQueueFilter[map[string]float64]
+		FairnessKeyDefaultDispatchRateLimit    dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		FairnessKeyDefaultDispatchRateLimitSub dynamicconfig.TypedSubscribableWithTaskQueueFilter[float64]
+		TypeNameDispatchRateLimits             dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		TypeNameDispatchRateLimitsSub          dynamicconfig.TypedSubscribableWithTaskQueueFilter[map[string]float64]
+
 		VisibilityPersistenceMaxReadQPS         dynamicconfig.IntPropertyFn
 		VisibilityPersistenceMaxWriteQPS        dynamicconfig.IntPropertyFn
 		VisibilityPersistenceSlowQueryThreshold dynamicconfig.DurationPropertyFn
@@ -134,6 +153,7 @@ type (
 		MaxTaskDeleteBatchSize     func() int
 		TaskDeleteInterval         func() time.Duration
 		PriorityLevels             func() int32
+		FairnessBuckets            func() int32
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -146,6 +166,9 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// When true, partition counts managed by partition auto scaling take precedence over
+		// NumWritePartitions and NumReadPartitions.
+		EnablePartitionAutoScaling func() bool
 
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
@@ -154,6 +177,14 @@ type (
 		AdminNamespaceTaskQueueToPartitionDispatchRate func() float64
 		AdminNamespaceTaskQueueToPartitionRateSub      func(func(float64)) (float64, func())
 
+		// Dispatch rate limits of fairness keys and workflow or activity types, for the whole task queue
+		FairnessKeyDispatchRateLimits          func() map[string]float64
+		FairnessKeyDispatchRateLimitsSub       func(func(map[string]float64)) (map[string]float64, func())
+		FairnessKeyDefaultDispatchRateLimit    func() float64
+		FairnessKeyDefaultDispatchRateLimitSub func(func(float64)) (float64, func())
+		TypeNameDispatchRateLimits             func() map[string]float64
+		TypeNameDispatchRateLimitsSub          func(func(map[string]float64)) (map[string]float64, func())
+
 		// Retry policy for fetching user data from root partition. Should retry forever.
 		GetUserDataRetryPolicy backoff.RetryPolicy
 
@@ -238,6 +269,14 @@ func NewConfig(
 		ThrottledLogRPS:                          dynamicconfig.MatchingThrottledLogRPS.Get(dc),
 		NumTaskqueueWritePartitions:              dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
 		NumTaskqueueReadPartitions:               dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
+		EnablePartitionAutoScaling:               dynamicconfig.MatchingEnablePartitionAutoScaling.Get(dc),
+		PartitionAutoScalingMinPartitions:        dynamicconfig.MatchingPartitionAutoScalingMinPartitions.Get(dc),
+		PartitionAutoScalingMaxPartitions:        dynamicconfig.MatchingPartitionAutoScalingMaxPartitions.Get(dc),
+		PartitionAutoScalingTargetRate:           dynamicconfig.MatchingPartitionAutoScalingTargetRate.Get(dc),
+		PartitionAutoScalingBacklogThreshold:     dynamicconfig.MatchingPartitionAutoScalingBacklogThreshold.Get(dc),
+		PartitionAutoScalingInterval:             dynamicconfig.MatchingPartitionAutoScalingInterval.Get(dc),
+		PartitionAutoScalingCooldown:             dynamicconfig.MatchingPartitionAutoScalingCooldown.Get(dc),
+		PartitionCountsCacheTTL:                  dynamicconfig.MatchingPartitionCountsCacheTTL.Get(dc),
 		BreakdownMetricsByTaskQueue:              dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 		BreakdownMetricsByPartition:              dynamicconfig.MetricsBreakdownByPartition.Get(dc),
 		BreakdownMetricsByBuildID:                dynamicconfig.MetricsBreakdownByBuildID.Get(dc),
@@ -253,6 +292,8 @@ func NewConfig(
 		RedirectRuleLimitPerQueue:                dynamicconfig.RedirectRuleLimitPerQueue.Get(dc),
 		RedirectRuleMaxUpstreamBuildIDsPerQueue:  dynamicconfig.RedirectRuleMaxUpstreamBuildIDsPerQueue.Get(dc),
 		DeletedRuleRetentionTime:                 dynamicconfig.MatchingDeletedRuleRetentionTime.Get(dc),
+		PurgeFilterRetention:                     dynamicconfig.MatchingTaskQueuePurgeFilterRetention.Get(dc),
+		PurgeFilterLimit:                         dynamicconfig.MatchingTaskQueuePurgeFilterLimit.Get(dc),
 		PollerHistoryTTL:                         dynamicconfig.PollerHistoryTTL.Get(dc),
 		ReachabilityBuildIdVisibilityGracePeriod: dynamicconfig.ReachabilityBuildIdVisibilityGracePeriod.Get(dc),
 		ReachabilityCacheOpenWFsTTL:              dynamicconfig.ReachabilityCacheOpenWFsTTL.Get(dc),
@@ -267,6 +308,7 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		FairnessBuckets:                          dynamicconfig.MatchingFairnessBuckets.Get(dc),
 		MatchingDropNonRetryableTasks:            dynamicconfig.MatchingDropNonRetryableTasks.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
@@ -275,6 +317,13 @@ func NewConfig(
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate.Get(dc),
 		AdminNamespaceTaskqueueToPartitionRateSub:      dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate.Subscribe(dc),
 
+		FairnessKeyDispatchRateLimits:          dynamicconfig.MatchingFairnessKeyDispatchRateLimits.Get(dc),
+		FairnessKeyDispatchRateLimitsSub:       dynamicconfig.MatchingFairnessKeyDispatchRateLimits.Subscribe(dc),
+		FairnessKeyDefaultDispatchRateLimit:    dynamicconfig.MatchingFairnessKeyDefaultDispatchRateLimit.Get(dc),
+		FairnessKeyDefaultDispatchRateLimitSub: dynamicconfig.MatchingFairnessKeyDefaultDispatchRateLimit.Subscribe(dc),
+		TypeNameDispatchRateLimits:             dynamicconfig.MatchingTypeNameDispatchRateLimits.Get(dc),
+		TypeNameDispatchRateLimitsSub:          dynamicconfig.MatchingTypeNameDispatchRateLimits.Subscribe(dc),
+
 		VisibilityPersistenceMaxReadQPS:         dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
 		VisibilityPersistenceMaxWriteQPS:        dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
 		VisibilityPersistenceSlowQueryThreshold: dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
@@ -343,6 +392,9 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		PriorityLevels: func() int32 {
 			return int32(config.PriorityLevels(ns.String(), taskQueueName, taskType))
 		},
+		FairnessBuckets: func() int32 {
+			return int32(config.FairnessBuckets(ns.String(), taskQueueName, taskType))
+		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
 		GetUserDataReturnBudget:    returnEmptyTaskTimeBudget,
@@ -360,6 +412,9 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(ns.String(), taskQueueName, taskType))
 		},
+		EnablePartitionAutoScaling: func() bool {
+			return config.EnablePartitionAutoScaling(ns.String(), taskQueueName, taskType)
+		},
 		BreakdownMetricsByTaskQueue: func() bool {
 			return config.BreakdownMetricsByTaskQueue(ns.String(), taskQueueName, taskType)
 		},
@@ -381,6 +436,24 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		AdminNamespaceTaskQueueToPartitionRateSub: func(cb func(float64)) (float64, func()) {
 			return config.AdminNamespaceTaskqueueToPartitionRateSub(ns.String(), taskQueueName, taskType, cb)
 		},
+		FairnessKeyDispatchRateLimits: func() map[string]float64 {
+			return config.FairnessKeyDispatchRateLimits(ns.String(), taskQueueName, taskType)
+		},
+		FairnessKeyDispatchRateLimitsSub: func(cb func(map[string]float64)) (map[string]float64, func()) {
+			return config.FairnessKeyDispatchRateLimitsSub(ns.String(), taskQueueName, taskType, cb)
+		},
+		FairnessKeyDefaultDispatchRateLimit: func() float64 {
+			return config.FairnessKeyDefaultDispatchRateLimit(ns.String(), taskQueueName, taskType)
+		},
+		FairnessKeyDefaultDispatchRateLimitSub: func(cb func(float64)) (float64, func()) {
+			return config.FairnessKeyDefaultDispatchRateLimitSub(ns.String(), taskQueueName, taskType, cb)
+		},
+		TypeNameDispatchRateLimits: func() map[string]float64 {
+			return config.TypeNameDispatchRateLimits(ns.String(), taskQueueName, taskType)
+		},
+		TypeNameDispatchRateLimitsSub: func(cb func(map[string]float64)) (map[string]float64, func()) {
+			return config.TypeNameDispatchRateLimitsSub(ns.String(), taskQueueName, taskType, cb)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(ns.String(), taskQueueName, taskType)
@@ -417,3 +490,14 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 func defaultPriorityLevel(priorityLevels int32) int32 {
 	return (priorityLevels + 1) / 2
 }
+
+// fairnessBucket returns the backlog subqueue bucket of a fairness key. Tasks without a fairness key always use
+// bucket 0, which is also the bucket of all tasks when fairness buckets are disabled.
+func fairnessBucket(fairnessKey string, fairnessBuckets int32) int32 {
+	if fairnessKey == "" || fairnessBuckets <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(fairnessKey))
+	return int32(h.Sum32() % uint32(fairnessBuckets))
+}
@@ -45,6 +45,9 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules":               1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData":                 1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueuePauseState":              1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/PurgeTaskQueueTasks":                    1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueueBacklog":               1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
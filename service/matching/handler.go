@@ -166,7 +166,11 @@ func (h *Handler) AddActivityTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}, err
+	resp := &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}
+	if err == nil && request.GetForwardInfo() == nil {
+		resp.PartitionCounts = h.engine.GetPartitionCounts(ctx, request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	}
+	return resp, err
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -191,7 +195,11 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}, err
+	resp := &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}
+	if err == nil && request.GetForwardInfo() == nil {
+		resp.PartitionCounts = h.engine.GetPartitionCounts(ctx, request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	}
+	return resp, err
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -219,7 +227,18 @@ func (h *Handler) PollActivityTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	if err != nil || request.GetForwardedSource() != "" {
+		return resp, err
+	}
+	if counts := h.engine.GetPartitionCounts(ctx, request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY); counts != nil {
+		if resp == emptyPollActivityTaskQueueResponse {
+			// don't mutate the shared empty response
+			resp = &matchingservice.PollActivityTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -247,7 +266,18 @@ func (h *Handler) PollWorkflowTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	if err != nil || request.GetForwardedSource() != "" {
+		return resp, err
+	}
+	if counts := h.engine.GetPartitionCounts(ctx, request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW); counts != nil {
+		if resp == emptyPollWorkflowTaskQueueResponse {
+			// don't mutate the shared empty response
+			resp = &matchingservice.PollWorkflowTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -396,6 +426,30 @@ func (h *Handler) SyncDeploymentUserData(
 	return h.engine.SyncDeploymentUserData(ctx, request)
 }
 
+func (h *Handler) UpdateTaskQueuePauseState(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePauseStateRequest,
+) (_ *matchingservice.UpdateTaskQueuePauseStateResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.UpdateTaskQueuePauseState(ctx, request)
+}
+
+func (h *Handler) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+) (_ *matchingservice.PurgeTaskQueueTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.PurgeTaskQueueTasks(ctx, request)
+}
+
+func (h *Handler) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+) (_ *matchingservice.DescribeTaskQueueBacklogResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.DescribeTaskQueueBacklog(ctx, request)
+}
+
 func (h *Handler) ApplyTaskQueueUserDataReplicationEvent(
 	ctx context.Context,
 	request *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest,
@@ -49,6 +49,8 @@ type TaskMatcher struct {
 	backlogTasksCreateTime map[int64]int   // task creation time (unix nanos) -> number of tasks with that time
 	backlogTasksLock       sync.Mutex
 	lastPoller             atomic.Int64 // unix nanos of most recent poll start time
+	pauseLock              sync.Mutex
+	resumeC                chan struct{} // closed when dispatch is resumed, nil when not paused
 }
 
 const (
@@ -138,6 +140,10 @@ func (tm *TaskMatcher) recycleToken(*internalTask) {
 //   - context deadline is exceeded
 //   - task is matched and consumer returns error in response channel
 func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, error) {
+	if tm.resumeChan() != nil {
+		// Dispatch is paused, the task goes to the backlog.
+		return false, nil
+	}
 	if !tm.isBacklogNegligible() {
 		// To ensure better dispatch ordering, we block sync match when a significant backlog is present.
 		// Note that this check does not make a noticeable difference for history tasks, as they do not wait for a
@@ -300,6 +306,17 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask, interr
 	tm.registerBacklogTask(task)
 	defer tm.unregisterBacklogTask(task)
 
+	// hold the task, without matching or forwarding it, while dispatch is paused
+	if resumeC := tm.resumeChan(); resumeC != nil {
+		select {
+		case <-resumeC:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-interruptCh:
+			return errInterrupted
+		}
+	}
+
 	if err := tm.rateLimiter.Wait(ctx); err != nil {
 		return err
 	}
@@ -462,6 +479,26 @@ func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
 }
 
+// SetPaused pauses or resumes the dispatch of workflow and activity tasks. Queries and nexus tasks,
+// which are never backlogged, are still dispatched while paused.
+func (tm *TaskMatcher) SetPaused(paused bool) {
+	tm.pauseLock.Lock()
+	defer tm.pauseLock.Unlock()
+	if paused && tm.resumeC == nil {
+		tm.resumeC = make(chan struct{})
+	} else if !paused && tm.resumeC != nil {
+		close(tm.resumeC)
+		tm.resumeC = nil
+	}
+}
+
+// resumeChan returns a channel closed when dispatch is resumed, or nil if dispatch is not paused.
+func (tm *TaskMatcher) resumeChan() <-chan struct{} {
+	tm.pauseLock.Lock()
+	defer tm.pauseLock.Unlock()
+	return tm.resumeC
+}
+
 func (tm *TaskMatcher) poll(
 	ctx context.Context, pollMetadata *pollMetadata, queryOnly bool,
 ) (task *internalTask, forwardedPoll bool, err error) {
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/util"
 )
@@ -77,14 +79,57 @@ type taskPQ struct {
 
 	// rate limiter for overall queue
 	wholeQueueLimiter simpleLimiter
+
+	// rate limiters for fairness keys and workflow/activity types
+	taskRateLimits      taskRateLimits
+	fairnessKeyLimiters keyedLimiters
+	typeNameLimiters    keyedLimiters
+
+	// current time for sorting tasks by ready time, set by findAndWakeMatches
+	now int64
+
+	// fair queuing state of each priority level
+	fairness map[int32]*fairQueue
 }
 
 func (t *taskPQ) Add(task *internalTask) {
+	if !task.isPollForwarder {
+		task.fairPass = t.fairQueueForTask(task).charge(task.getFairness())
+	}
 	heap.Push(t, task)
 }
 
+// Remove removes a task that was not dispatched, e.g. because its context was canceled.
 func (t *taskPQ) Remove(task *internalTask) {
 	heap.Remove(t, task.matchHeapIndex)
+	t.refund(task)
+}
+
+// RemoveMatched removes a task that is being dispatched.
+func (t *taskPQ) RemoveMatched(task *internalTask) {
+	heap.Remove(t, task.matchHeapIndex)
+	if !task.isPollForwarder {
+		t.fairQueueForTask(task).advance(task.fairPass)
+	}
+}
+
+func (t *taskPQ) refund(task *internalTask) {
+	if !task.isPollForwarder {
+		t.fairQueueForTask(task).refund(task.getFairness(), task.fairPass)
+	}
+}
+
+func (t *taskPQ) fairQueueForTask(task *internalTask) *fairQueue {
+	priorityKey := task.getPriority().GetPriorityKey()
+	q, ok := t.fairness[priorityKey]
+	if !ok {
+		q = newFairQueue()
+		if t.fairness == nil {
+			t.fairness = make(map[int32]*fairQueue)
+		}
+		t.fairness[priorityKey] = q
+	}
+	return q
 }
 
 func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
@@ -95,7 +140,21 @@ func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
 	// }
 	return max(
 		t.wholeQueueLimiter.ready,
-		// TODO(pri): add more times here, e.g. fairness key limit, per-task backoff
+		t.taskReadyTime(task),
+		// TODO(pri): add more times here, e.g. per-task backoff
+	)
+}
+
+// taskReadyTime returns the ready time of a task from the rate limits of its fairness key and
+// workflow/activity type.
+func (t *taskPQ) taskReadyTime(task *internalTask) int64 {
+	if task.isForwarded() || !t.taskRateLimits.enabled() {
+		// don't count any rate limit for forwarded tasks, it was counted on the child
+		return 0
+	}
+	return max(
+		t.fairnessKeyLimiters.ready(task.getFairness().GetKey()),
+		t.typeNameLimiters.ready(task.getTypeName()),
 	)
 }
 
@@ -106,6 +165,32 @@ func (t *taskPQ) consumeTokens(now int64, task *internalTask, tokens int64) {
 	}
 
 	t.wholeQueueLimiter.consume(now, tokens)
+
+	if !t.taskRateLimits.enabled() {
+		return
+	}
+	burst := t.taskRateLimits.burst
+	key := task.getFairness().GetKey()
+	t.fairnessKeyLimiters.consume(key, t.taskRateLimits.fairnessKeyRate(key), burst, now, tokens)
+	typeName := task.getTypeName()
+	t.typeNameLimiters.consume(typeName, t.taskRateLimits.typeNameRate(typeName), burst, now, tokens)
+}
+
+// fixReadyTime re-sorts a task whose ready time may have increased since the heap was sorted
+// and returns true if it moved.
+func (t *taskPQ) fixReadyTime(task *internalTask) bool {
+	if !t.taskRateLimits.enabled() {
+		return false
+	}
+	i := task.matchHeapIndex
+	heap.Fix(t, i)
+	return task.matchHeapIndex != i
+}
+
+func (t *taskPQ) setTaskRateLimits(limits taskRateLimits) {
+	t.taskRateLimits = limits
+	t.fairnessKeyLimiters.update(limits.fairnessKeyRate, limits.burst)
+	t.typeNameLimiters.update(limits.typeNameRate, limits.burst)
 }
 
 // implements heap.Interface
@@ -119,21 +204,22 @@ func (t *taskPQ) Less(i int, j int) bool {
 	// - ready time: to sort all ready tasks ahead of others, or else find the earliest ready task
 	// - isPollForwarder: forwarding polls should happen only if there are no other tasks
 	// - priority key: to sort tasks by priority
-	// - fairness key pass: to arrange tasks fairly by key
+	// - fairness key pass: to arrange tasks fairly by key, weighted by the key's weight
 	// - ordering key: to sort tasks by ordering key
 	// - task id: last resort comparison
 
 	a, b := t.heap[i], t.heap[j]
 
-	// TODO(pri): ready time is not task-specific yet, we only have whole-queue, so we don't
-	// need to consider this here yet.
-	// // ready time
-	// aready, bready := max(t.now, t.readyTimeForTask(a)), max(t.now, t.readyTimeForTask(b))
-	// if aready < bready {
-	// 	return true
-	// } else if aready > bready {
-	// 	return false
-	// }
+	// ready time: the whole-queue limit is the same for all tasks, so only the task-specific
+	// limits need to be considered here.
+	if t.taskRateLimits.enabled() {
+		aready, bready := max(t.now, t.taskReadyTime(a)), max(t.now, t.taskReadyTime(b))
+		if aready < bready {
+			return true
+		} else if aready > bready {
+			return false
+		}
+	}
 
 	// poll forwarder is always last
 	if !a.isPollForwarder && b.isPollForwarder {
@@ -151,6 +237,13 @@ func (t *taskPQ) Less(i int, j int) bool {
 		return false
 	}
 
+	// try fairness key pass
+	if a.fairPass < b.fairPass {
+		return true
+	} else if a.fairPass > b.fairPass {
+		return false
+	}
+
 	// Note: sync match tasks have a fixed negative id.
 	// Query tasks will get 0 here.
 	var aid, bid int64
@@ -204,6 +297,7 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 			return false
 		}
 		task.matchHeapIndex = invalidHeapIndex - 1 // maintain heap/index invariant
+		t.refund(task)
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 			t.ages.record(task.event.Data.CreateTime, -1)
 		}
@@ -234,6 +328,9 @@ type matcherData struct {
 	tasks   taskPQ
 
 	lastPoller time.Time // most recent poll start time
+
+	// dispatch of workflow and activity tasks is paused
+	paused bool
 }
 
 func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock.TimeSource, canForward bool) matcherData {
@@ -255,6 +352,24 @@ func (d *matcherData) UpdateRateLimit(rate float64, burstDuration time.Duration)
 	d.tasks.wholeQueueLimiter.set(rate, burstDuration)
 }
 
+func (d *matcherData) UpdateTaskRateLimits(limits taskRateLimits) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.tasks.setTaskRateLimits(limits)
+}
+
+func (d *matcherData) SetPaused(paused bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.paused == paused {
+		return
+	}
+	d.paused = paused
+	d.findAndWakeMatches()
+}
+
 func (d *matcherData) EnqueueTaskNoWait(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -347,6 +462,10 @@ func (d *matcherData) MatchTaskImmediately(task *internalTask) (canSyncMatch, go
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
+	if d.isPaused(task) {
+		// the task goes to the backlog, and is dispatched on resume
+		return false, false
+	}
 	if !d.isBacklogNegligible() {
 		// To ensure better dispatch ordering, we block sync match when a significant backlog is present.
 		// Note that this check does not make a noticeable difference for history tasks, as they do not wait for a
@@ -394,6 +513,8 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 	for _, task := range d.tasks.heap {
 		if !allowForwarding && task.isPollForwarder {
 			continue
+		} else if d.isPaused(task) {
+			continue
 		}
 
 		for _, poller := range d.pollers.heap {
@@ -414,6 +535,13 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 	return nil, nil
 }
 
+// isPaused returns true if the task can't be matched, locally or by forwarding it, because
+// dispatch is paused. Queries, nexus tasks and forwarded polls are matched while paused.
+// call with lock held
+func (d *matcherData) isPaused(task *internalTask) bool {
+	return d.paused && !(task.isQuery() || task.isNexus() || task.isPollForwarder)
+}
+
 // call with lock held
 func (d *matcherData) allowForwarding() (allowForwarding bool) {
 	// If there is a non-negligible backlog, we pause forwarding to make sure
@@ -452,7 +580,13 @@ func (d *matcherData) findAndWakeMatches() {
 	allowForwarding := d.canForward && d.allowForwarding()
 
 	now := d.timeSource.Now().UnixNano()
-	// TODO(pri): for task-specific ready time, we need to do a full/partial re-heapify here
+
+	if d.tasks.taskRateLimits.enabled() {
+		// The ready time of tasks with their own rate limits changes with the current time, so
+		// re-sort them once here. Dispatches below only delay tasks, those are fixed up lazily.
+		d.tasks.now = now
+		heap.Init(&d.tasks)
+	}
 
 	for {
 		// search for highest priority match
@@ -465,13 +599,17 @@ func (d *matcherData) findAndWakeMatches() {
 
 		// check ready time
 		delay := d.tasks.readyTimeForTask(task) - now
+		if delay > 0 && d.tasks.fixReadyTime(task) {
+			// a dispatch of the same fairness key or type delayed this task, look again
+			continue
+		}
 		d.rateLimitTimer.set(d.timeSource, d.rematchAfterTimer, time.Duration(delay))
 		if delay > 0 {
 			return // not ready yet, timer will call match later
 		}
 
 		// ready to signal match
-		d.tasks.Remove(task)
+		d.tasks.RemoveMatched(task)
 		d.pollers.Remove(poller)
 
 		// TODO(pri): maybe we can allow tasks to have costs other than 1
@@ -586,6 +724,160 @@ func (rt *resettableTimer) unset() {
 	}
 }
 
+// fair queuing
+
+// fairQueue implements "start-time fair queuing" across the fairness keys of the tasks of one
+// priority level. Each task gets a pass when it's added: the later of the virtual time and the
+// pass after the previous task of its key, and each task advances its key's pass by
+// 1/weight. Tasks are dispatched in pass order, so keys get dispatches in proportion to their
+// weights no matter how many tasks each key has queued. The virtual time is the pass of the
+// last dispatched task, so a key that was idle doesn't get credit for the time it was idle.
+//
+// Tasks without a fairness key are not charged: they get the virtual time as pass, so they
+// keep their task id order when no task has a fairness key.
+type fairQueue struct {
+	vtime   float64
+	finish  map[string]float64 // pass after the last added task of each key
+	pruneAt int
+}
+
+const fairQueueMinPruneSize = 1000
+
+func newFairQueue() *fairQueue {
+	return &fairQueue{
+		finish:  make(map[string]float64),
+		pruneAt: fairQueueMinPruneSize,
+	}
+}
+
+// charge returns the pass of a new task with the given fairness.
+func (q *fairQueue) charge(fairness *taskqueuespb.TaskFairness) float64 {
+	key := fairness.GetKey()
+	if key == "" {
+		return q.vtime
+	}
+	pass := max(q.vtime, q.finish[key])
+	q.finish[key] = pass + 1/priorities.FairnessWeight(fairness)
+	return pass
+}
+
+// refund undoes charge for a task that was removed without being dispatched, if it was the
+// last task charged for its key.
+func (q *fairQueue) refund(fairness *taskqueuespb.TaskFairness, pass float64) {
+	key := fairness.GetKey()
+	if key == "" {
+		return
+	}
+	if finish, ok := q.finish[key]; ok && finish == pass+1/priorities.FairnessWeight(fairness) {
+		q.finish[key] = pass
+	}
+}
+
+// advance moves the virtual time to the pass of a dispatched task.
+func (q *fairQueue) advance(pass float64) {
+	q.vtime = max(q.vtime, pass)
+	if len(q.finish) < q.pruneAt {
+		return
+	}
+	// keys with a pass behind the virtual time get the virtual time anyway
+	for key, finish := range q.finish {
+		if finish <= q.vtime {
+			delete(q.finish, key)
+		}
+	}
+	q.pruneAt = max(fairQueueMinPruneSize, 2*len(q.finish))
+}
+
+// task rate limits
+
+// taskRateLimits holds the per-partition dispatch rates of fairness keys and workflow/activity
+// types. Rates <= 0 mean unlimited.
+type taskRateLimits struct {
+	fairnessKeyRates       map[string]float64
+	fairnessKeyDefaultRate float64
+	typeNameRates          map[string]float64
+	burst                  time.Duration
+}
+
+func (l *taskRateLimits) enabled() bool {
+	return len(l.fairnessKeyRates) > 0 || l.fairnessKeyDefaultRate > 0 || len(l.typeNameRates) > 0
+}
+
+func (l *taskRateLimits) fairnessKeyRate(key string) float64 {
+	if key == "" {
+		// tasks without a fairness key are only limited by the whole-queue limit
+		return 0
+	}
+	if rate, ok := l.fairnessKeyRates[key]; ok {
+		return rate
+	}
+	return l.fairnessKeyDefaultRate
+}
+
+func (l *taskRateLimits) typeNameRate(typeName string) float64 {
+	if typeName == "" {
+		return 0
+	}
+	return l.typeNameRates[typeName]
+}
+
+// keyedLimiters holds a simpleLimiter for each key with a rate limit. A limiter is created on
+// the first dispatch of its key.
+type keyedLimiters struct {
+	limiters map[string]*simpleLimiter
+	pruneAt  int
+}
+
+const keyedLimitersMinPruneSize = 1000
+
+func (k *keyedLimiters) ready(key string) int64 {
+	if l, ok := k.limiters[key]; ok {
+		return l.ready
+	}
+	return 0
+}
+
+func (k *keyedLimiters) consume(key string, rate float64, burst time.Duration, now int64, tokens int64) {
+	if rate <= 0 {
+		return
+	}
+	l, ok := k.limiters[key]
+	if !ok {
+		if k.limiters == nil {
+			k.limiters = make(map[string]*simpleLimiter)
+			k.pruneAt = keyedLimitersMinPruneSize
+		}
+		if len(k.limiters) >= k.pruneAt {
+			k.prune(now)
+		}
+		l = &simpleLimiter{}
+		l.set(rate, burst)
+		k.limiters[key] = l
+	}
+	l.consume(now, tokens)
+}
+
+// prune removes limiters that are back to full burst, which behave the same as a new limiter.
+func (k *keyedLimiters) prune(now int64) {
+	for key, l := range k.limiters {
+		if l.ready+l.burst.Nanoseconds() <= now {
+			delete(k.limiters, key)
+		}
+	}
+	k.pruneAt = max(keyedLimitersMinPruneSize, 2*len(k.limiters))
+}
+
+// update sets new rates for the existing limiters and removes the ones without a rate.
+func (k *keyedLimiters) update(rate func(string) float64, burst time.Duration) {
+	for key, l := range k.limiters {
+		if r := rate(key); r > 0 {
+			l.set(r, burst)
+		} else {
+			delete(k.limiters, key)
+		}
+	}
+}
+
 // simple limiter
 
 // simpleLimiter implements a "GCRA" limiter. The owner should read the `ready` field directly
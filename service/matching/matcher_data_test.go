@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math/rand"
 	"runtime"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -110,6 +111,12 @@ func (s *MatcherDataSuite) newBacklogTaskWithPriority(id int64, age time.Duratio
 	return newInternalTaskFromBacklog(t, f)
 }
 
+func (s *MatcherDataSuite) newBacklogTaskWithFairness(id int64, key string, weight float32) *internalTask {
+	t := s.newBacklogTask(id, 0, nil)
+	t.event.Data.Fairness = &taskqueuespb.TaskFairness{Key: key, Weight: weight}
+	return t
+}
+
 func (s *MatcherDataSuite) waitForPollers(n int) {
 	s.Eventually(func() bool {
 		s.md.lock.Lock()
@@ -365,6 +372,135 @@ func (s *MatcherDataSuite) TestOrder() {
 	// poll forwarder is last to match, but it does a half-match so we won't see it here
 }
 
+func (s *MatcherDataSuite) TestFairnessOrder() {
+	a1 := s.newBacklogTaskWithFairness(1, "a", 2)
+	a2 := s.newBacklogTaskWithFairness(2, "a", 2)
+	a3 := s.newBacklogTaskWithFairness(3, "a", 2)
+	a4 := s.newBacklogTaskWithFairness(4, "a", 2)
+	b1 := s.newBacklogTaskWithFairness(5, "b", 1)
+	b2 := s.newBacklogTaskWithFairness(6, "b", 1)
+
+	for _, t := range []*internalTask{a1, a2, a3, a4, b1, b2} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	// "a" has twice the weight of "b", so it gets two dispatches for each dispatch of "b"
+	for _, t := range []*internalTask{a1, b1, a2, a3, b2, a4} {
+		s.Equal(t, s.pollFakeTime(time.Second).task)
+	}
+
+	// a new key starts at the current virtual time, so it doesn't get ahead of the other keys for all its tasks
+	a5 := s.newBacklogTaskWithFairness(7, "a", 2)
+	b3 := s.newBacklogTaskWithFairness(8, "b", 1)
+	c1 := s.newBacklogTaskWithFairness(9, "c", 1)
+	c2 := s.newBacklogTaskWithFairness(10, "c", 1)
+	for _, t := range []*internalTask{c1, c2, a5, b3} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+	for _, t := range []*internalTask{c1, a5, b3, c2} {
+		s.Equal(t, s.pollFakeTime(time.Second).task)
+	}
+}
+
+func (s *MatcherDataSuite) TestFairnessKeyRateLimit() {
+	s.md.UpdateTaskRateLimits(taskRateLimits{fairnessKeyRates: map[string]float64{"a": 1}})
+
+	a1 := s.newBacklogTaskWithFairness(1, "a", 1)
+	a2 := s.newBacklogTaskWithFairness(2, "a", 1)
+	b1 := s.newBacklogTaskWithFairness(3, "b", 1)
+	for _, t := range []*internalTask{a1, a2, b1} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	// "a" is over its limit after a1, so b1 goes ahead of a2
+	s.Equal(a1, s.pollFakeTime(time.Second).task)
+	s.Equal(b1, s.pollFakeTime(time.Second).task)
+
+	// a2 waits until "a" is under its limit again
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- s.pollFakeTime(time.Minute) }()
+	s.waitForPollers(1)
+	s.ts.Advance(500 * time.Millisecond)
+	s.Empty(resC)
+	s.ts.Advance(500 * time.Millisecond)
+	s.Equal(a2, (<-resC).task)
+}
+
+func (s *MatcherDataSuite) TestTypeNameRateLimit() {
+	s.md.UpdateTaskRateLimits(taskRateLimits{typeNameRates: map[string]float64{"slow": 1}})
+
+	slow1 := s.newBacklogTask(1, 0, nil)
+	slow1.event.Data.TypeName = "slow"
+	slow2 := s.newBacklogTask(2, 0, nil)
+	slow2.event.Data.TypeName = "slow"
+	fast := s.newBacklogTask(3, 0, nil)
+	fast.event.Data.TypeName = "fast"
+	for _, t := range []*internalTask{slow1, slow2, fast} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	s.Equal(slow1, s.pollFakeTime(time.Second).task)
+	s.Equal(fast, s.pollFakeTime(time.Second).task)
+	s.ts.Advance(time.Second)
+	s.Equal(slow2, s.pollFakeTime(time.Second).task)
+}
+
+func (s *MatcherDataSuite) TestTypeNameRateLimitMultipleDispatches() {
+	s.md.UpdateTaskRateLimits(taskRateLimits{typeNameRates: map[string]float64{"slow": 1}})
+	s.md.SetPaused(true)
+
+	slow1 := s.newBacklogTask(1, 0, nil)
+	slow1.event.Data.TypeName = "slow"
+	slow2 := s.newBacklogTask(2, 0, nil)
+	slow2.event.Data.TypeName = "slow"
+	fast := s.newBacklogTask(3, 0, nil)
+	fast.event.Data.TypeName = "fast"
+	for _, t := range []*internalTask{slow1, slow2, fast} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	resC := make(chan *matchResult, 3)
+	for range 3 {
+		go func() { resC <- s.pollFakeTime(time.Minute) }()
+	}
+	s.waitForPollers(3)
+
+	// unpausing matches all pollers in one pass: slow2 is delayed by the dispatch of slow1
+	// and must not block fast
+	s.md.SetPaused(false)
+	got := []*internalTask{(<-resC).task, (<-resC).task}
+	s.ElementsMatch([]*internalTask{slow1, fast}, got)
+	s.Empty(resC)
+
+	s.ts.Advance(time.Second)
+	s.Equal(slow2, (<-resC).task)
+}
+
+func (s *MatcherDataSuite) TestPause() {
+	s.md.SetPaused(true)
+
+	// sync match is rejected so that the task goes to the backlog
+	canSyncMatch, gotSyncMatch := s.md.MatchTaskImmediately(s.newSyncTask(nil))
+	s.False(canSyncMatch)
+	s.False(gotSyncMatch)
+
+	// backlog task is not dispatched, but queries are
+	t1 := s.newBacklogTask(1, 0, nil)
+	s.md.EnqueueTaskNoWait(t1)
+	respC := make(chan taskResponse, 1)
+	go s.queryFakeTime(time.Second, respC)
+	pres := s.pollFakeTime(time.Second)
+	s.NotNil(pres.task)
+	s.True(pres.task.isQuery())
+	pres.task.finish(nil, true)
+	<-respC
+
+	s.Error(s.pollRealTime(10 * time.Millisecond).ctxErr)
+
+	s.md.SetPaused(false)
+	s.Equal(t1, s.pollFakeTime(time.Second).task)
+}
+
 func (s *MatcherDataSuite) TestPollForwardSuccess() {
 	t1 := s.newBacklogTask(1, 0, nil)
 	t2 := s.newBacklogTask(2, 0, nil)
@@ -474,6 +610,47 @@ func (s *MatcherDataSuite) TestReprocessTasks() {
 	s.Error(res.ctxErr)
 }
 
+// fair queue tests
+
+func TestFairQueueRefund(t *testing.T) {
+	q := newFairQueue()
+	fairness := &taskqueuespb.TaskFairness{Key: "a", Weight: 4}
+
+	require.Zero(t, q.charge(fairness))
+	pass := q.charge(fairness)
+	require.InDelta(t, 0.25, pass, 1e-9)
+
+	// the last task of the key gets its pass back, so it's not charged twice when it's re-added
+	q.refund(fairness, pass)
+	require.InDelta(t, 0.25, q.charge(fairness), 1e-9)
+
+	// but an earlier one doesn't
+	q.refund(fairness, 0)
+	require.InDelta(t, 0.5, q.charge(fairness), 1e-9)
+
+	// tasks without a fairness key are not charged
+	require.Zero(t, q.charge(nil))
+	q.advance(0.5)
+	require.InDelta(t, 0.5, q.charge(nil), 1e-9)
+}
+
+func TestKeyedLimitersPrune(t *testing.T) {
+	var k keyedLimiters
+	now := time.Now().UnixNano()
+
+	for i := range keyedLimitersMinPruneSize {
+		k.consume(strconv.Itoa(i), 1, time.Second, now, 1)
+	}
+	require.Len(t, k.limiters, keyedLimitersMinPruneSize)
+	require.Positive(t, k.ready("0"))
+
+	// limiters that are back to full burst are removed when the next key is added
+	now += int64(2 * time.Second)
+	k.consume("new", 1, time.Second, now, 1)
+	require.Len(t, k.limiters, 1)
+	require.Zero(t, k.ready("0"))
+}
+
 // simple limiter tests
 
 func TestSimpleLimiter(t *testing.T) {
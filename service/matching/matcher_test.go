@@ -697,6 +697,37 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestPause() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	t.childMatcher.SetPaused(true)
+
+	// sync match is rejected so that the task goes to the backlog
+	matched, err := t.childMatcher.Offer(context.Background(), newInternalTaskForSyncMatch(randomTaskInfo().Data, nil))
+	t.NoError(err)
+	t.False(matched)
+
+	// backlog task is held until dispatch is resumed
+	errC := make(chan error, 1)
+	go func() {
+		errC <- t.childMatcher.MustOffer(context.Background(), newInternalTaskForSyncMatch(randomTaskInfo().Data, nil), nil)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	_, err = t.childMatcher.Poll(ctx, &pollMetadata{})
+	cancel()
+	t.ErrorIs(err, errNoTasks)
+
+	t.childMatcher.SetPaused(false)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	task, err := t.childMatcher.Poll(ctx, &pollMetadata{})
+	cancel()
+	t.NoError(err)
+	task.finish(nil, true)
+	t.NoError(<-errC)
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -529,6 +530,8 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
 		Priority:         addRequest.Priority,
+		Fairness:         addRequest.Fairness,
+		TypeName:         addRequest.TypeName,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -537,6 +540,25 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	})
 }
 
+// GetPartitionCounts returns the partition counts chosen by partition auto scaling for the task queue type of the
+// given partition, or nil if the partition is not loaded or its counts are not managed by partition auto scaling.
+func (e *matchingEngineImpl) GetPartitionCounts(
+	ctx context.Context,
+	namespaceID string,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskType enumspb.TaskQueueType,
+) *taskqueuespb.TaskQueuePartitionCounts {
+	partition, err := tqid.PartitionFromProto(taskQueue, namespaceID, taskType)
+	if err != nil || partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, false, loadCauseOtherRead)
+	if err != nil || pm == nil {
+		return nil
+	}
+	return pm.PartitionCounts()
+}
+
 // AddActivityTask either delivers task directly to waiting poller or save it into task queue persistence.
 func (e *matchingEngineImpl) AddActivityTask(
 	ctx context.Context,
@@ -568,6 +590,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		Fairness:         addRequest.Fairness,
+		TypeName:         addRequest.TypeName,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -1141,9 +1165,13 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		physicalInfoByBuildId := make(map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo)
 		if timeSinceLastFanOut > lastFanOutTTL {
 			// collect internal info
-			numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
-
 			for _, taskQueueType := range req.TaskQueueTypes {
+				numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
+				if e.config.EnablePartitionAutoScaling(req.GetNamespace(), req.GetTaskQueue().GetName(), taskQueueType) {
+					if counts := userData.GetPerType()[int32(taskQueueType)].GetPartitionCounts(); counts != nil {
+						numPartitions = int(max(counts.GetWritePartitions(), counts.GetReadPartitions()))
+					}
+				}
 				for i := 0; i < numPartitions; i++ {
 					partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
 						NamespaceId: request.GetNamespaceId(),
@@ -1231,10 +1259,24 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 				TaskReachability: reachability,
 			}
 		}
+		// rate limits and pauses apply per task queue type, not per partition or version
+		dispatchRateLimits := make(map[int32]*taskqueuespb.TaskDispatchRateLimits)
+		pauseInfo := make(map[int32]*taskqueuespb.TaskQueuePauseInfo)
+		for _, taskQueueType := range req.TaskQueueTypes {
+			typeConfig := newTaskQueueConfig(rootPartition.TaskQueue().Family().TaskQueue(taskQueueType), e.config, namespace.Name(req.Namespace))
+			if limits := taskDispatchRateLimits(typeConfig); limits != nil {
+				dispatchRateLimits[int32(taskQueueType)] = limits
+			}
+			if info := userData.GetPerType()[int32(taskQueueType)].GetPauseInfo(); info != nil {
+				pauseInfo[int32(taskQueueType)] = info
+			}
+		}
 		return &matchingservice.DescribeTaskQueueResponse{
 			DescResponse: &workflowservice.DescribeTaskQueueResponse{
 				VersionsInfo: versionsInfo,
 			},
+			DispatchRateLimits: dispatchRateLimits,
+			PauseInfo:          pauseInfo,
 		}, nil
 	}
 	// Otherwise, do legacy DescribeTaskQueue
@@ -1792,6 +1834,201 @@ func (e *matchingEngineImpl) SyncDeploymentUserData(
 	return &matchingservice.SyncDeploymentUserDataResponse{Version: version}, nil
 }
 
+// UpdateTaskQueuePauseState pauses or resumes the dispatch of tasks of a task queue. The pause state is stored in
+// the user data of the task queue, so that all partitions pick it up.
+func (e *matchingEngineImpl) UpdateTaskQueuePauseState(
+	ctx context.Context,
+	req *matchingservice.UpdateTaskQueuePauseStateRequest,
+) (*matchingservice.UpdateTaskQueuePauseStateResponse, error) {
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(req.NamespaceId, req.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	taskQueueTypes, err := backlogTaskQueueTypes(req.GetTaskQueueTypes(), "paused")
+	if err != nil {
+		return nil, err
+	}
+
+	tqMgr, _, err := e.getTaskQueuePartitionManager(ctx, taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(), true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOptions := UserDataUpdateOptions{Source: "UpdateTaskQueuePauseState"}
+
+	version, err := tqMgr.GetUserDataManager().UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(e.clusterMeta.GetClusterID())
+		}
+		now := hlc.Next(clk, e.timeSource)
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data == nil {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+
+		changed := false
+		for _, t := range taskQueueTypes {
+			perType := data.PerType[int32(t)]
+			if perType == nil {
+				perType = &persistencespb.TaskQueueTypeUserData{}
+				data.PerType[int32(t)] = perType
+			}
+			if req.GetPaused() {
+				// keep the original pause time when paused again, only the reason and identity are updated
+				pauseTime := perType.GetPauseInfo().GetPauseTime()
+				if pauseTime == nil {
+					pauseTime = timestamppb.New(e.timeSource.Now())
+				}
+				perType.PauseInfo = &taskqueuespb.TaskQueuePauseInfo{
+					PauseTime: pauseTime,
+					Reason:    req.GetReason(),
+					Identity:  req.GetIdentity(),
+				}
+				changed = true
+			} else if perType.GetPauseInfo() != nil {
+				perType.PauseInfo = nil
+				changed = true
+			}
+		}
+		if !changed {
+			return nil, false, errUserDataUnmodified
+		}
+
+		data.Clock = now
+		return data, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateTaskQueuePauseStateResponse{Version: version}, nil
+}
+
+// PurgeTaskQueueTasks adds a purge filter to the user data of the task queue types. Partitions drop the backlog
+// tasks matching it when they read them, and the task queue scavenger deletes them from unloaded partitions.
+func (e *matchingEngineImpl) PurgeTaskQueueTasks(
+	ctx context.Context,
+	req *matchingservice.PurgeTaskQueueTasksRequest,
+) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(req.NamespaceId, req.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	taskQueueTypes, err := backlogTaskQueueTypes(req.GetTaskQueueTypes(), "purged")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetWorkflowIdPrefix() == "" && req.GetTypeName() == "" && req.GetMinAge().AsDuration() <= 0 {
+		return nil, serviceerror.NewInvalidArgument("purge requires a workflow ID prefix, a type name or a minimum age")
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+
+	tqMgr, _, err := e.getTaskQueuePartitionManager(ctx, taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(), true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOptions := UserDataUpdateOptions{Source: "PurgeTaskQueueTasks"}
+
+	version, err := tqMgr.GetUserDataManager().UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(e.clusterMeta.GetClusterID())
+		}
+		now := hlc.Next(clk, e.timeSource)
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data == nil {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+
+		purgeTime := e.timeSource.Now()
+		filter := &taskqueuespb.TaskQueuePurgeFilter{
+			WorkflowIdPrefix: req.GetWorkflowIdPrefix(),
+			TypeName:         req.GetTypeName(),
+			CreatedBefore:    timestamppb.New(purgeTime.Add(-max(req.GetMinAge().AsDuration(), 0))),
+			PurgeTime:        timestamppb.New(purgeTime),
+			Reason:           req.GetReason(),
+			Identity:         req.GetIdentity(),
+		}
+		retention := e.config.PurgeFilterRetention(nsName.String())
+		limit := e.config.PurgeFilterLimit(nsName.String())
+		for _, t := range taskQueueTypes {
+			perType := data.PerType[int32(t)]
+			if perType == nil {
+				perType = &persistencespb.TaskQueueTypeUserData{}
+				data.PerType[int32(t)] = perType
+			}
+			perType.PurgeFilters = slices.DeleteFunc(perType.PurgeFilters, func(f *taskqueuespb.TaskQueuePurgeFilter) bool {
+				return purgeTime.Sub(f.GetPurgeTime().AsTime()) > retention
+			})
+			if len(perType.PurgeFilters) >= limit {
+				return nil, false, serviceerror.NewFailedPreconditionf(
+					"task queue type %v was purged %d times in the last %v, the limit is %d", t, len(perType.PurgeFilters), retention, limit)
+			}
+			perType.PurgeFilters = append(perType.PurgeFilters, filter)
+		}
+
+		data.Clock = now
+		return data, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.PurgeTaskQueueTasksResponse{Version: version}, nil
+}
+
+// DescribeTaskQueueBacklog summarizes the tasks persisted in the backlog of the unversioned queue of a partition.
+func (e *matchingEngineImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	req *matchingservice.DescribeTaskQueueBacklogRequest,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), req.GetNamespaceId())
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseDescribe)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := pm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	purgeFilters := userData.GetData().GetPerType()[int32(partition.TaskType())].GetPurgeFilters()
+	maxTasks := int(req.GetMaxTasks())
+	if maxTasks <= 0 {
+		maxTasks = defaultBacklogSummaryMaxTasks
+	}
+
+	summary, err := summarizeBacklog(ctx, e.taskManager, UnversionedQueueKey(partition), purgeFilters, maxTasks, e.timeSource.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.DescribeTaskQueueBacklogResponse{Summary: summary}, nil
+}
+
+// backlogTaskQueueTypes returns the task queue types of a request operating on the backlog of a task queue, which
+// default to workflow and activity, the only types with a backlog.
+func backlogTaskQueueTypes(taskQueueTypes []enumspb.TaskQueueType, operation string) ([]enumspb.TaskQueueType, error) {
+	if len(taskQueueTypes) == 0 {
+		return []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}, nil
+	}
+	for _, t := range taskQueueTypes {
+		if t != enumspb.TASK_QUEUE_TYPE_WORKFLOW && t != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+			return nil, serviceerror.NewInvalidArgumentf("task queue type %v can't be %s", t, operation)
+		}
+	}
+	return taskQueueTypes, nil
+}
+
 func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 	ctx context.Context,
 	req *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest,
@@ -1865,6 +2102,9 @@ func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 			}
 		}
 
+		// Partition counts follow the load of each cluster, the local ones are kept.
+		mergedUserData.PerType = keepLocalPartitionCounts(mergedUserData.GetPerType(), current.GetPerType())
+
 		// No need to keep the tombstones around after replication.
 		mergedUserData.VersioningData = ClearTombstones(mergedData)
 		return mergedUserData, len(buildIdsToRevive) > 0, nil
@@ -2503,6 +2503,126 @@ func (s *matchingEngineSuite) TestUpdateUserData_FailsOnKnownVersionMismatch() {
 	s.ErrorAs(err, &failedPreconditionError)
 }
 
+func (s *matchingEngineSuite) TestUpdateTaskQueuePauseState() {
+	namespaceID := namespace.ID(uuid.New())
+	tq := "tupac"
+
+	getPerType := func() map[int32]*persistencespb.TaskQueueTypeUserData {
+		res, err := s.matchingEngine.GetTaskQueueUserData(context.Background(), &matchingservice.GetTaskQueueUserDataRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     tq,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		})
+		s.NoError(err)
+		return res.GetUserData().GetData().GetPerType()
+	}
+
+	_, err := s.matchingEngine.UpdateTaskQueuePauseState(context.Background(), &matchingservice.UpdateTaskQueuePauseStateRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      tq,
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_NEXUS},
+		Paused:         true,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.matchingEngine.UpdateTaskQueuePauseState(context.Background(), &matchingservice.UpdateTaskQueuePauseStateRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   tq,
+		Paused:      true,
+		Reason:      "incident",
+		Identity:    "operator",
+	})
+	s.NoError(err)
+	perType := getPerType()
+	for _, t := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		s.Equal("incident", perType[int32(t)].GetPauseInfo().GetReason())
+		s.Equal("operator", perType[int32(t)].GetPauseInfo().GetIdentity())
+		s.NotNil(perType[int32(t)].GetPauseInfo().GetPauseTime())
+	}
+
+	_, err = s.matchingEngine.UpdateTaskQueuePauseState(context.Background(), &matchingservice.UpdateTaskQueuePauseStateRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      tq,
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		Paused:         false,
+	})
+	s.NoError(err)
+	perType = getPerType()
+	s.NotNil(perType[int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW)].GetPauseInfo())
+	s.Nil(perType[int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)].GetPauseInfo())
+}
+
+func (s *matchingEngineSuite) TestPurgeTaskQueueTasks() {
+	namespaceID := namespace.ID(uuid.New())
+	tq := "tupac"
+	s.matchingEngine.config.PurgeFilterLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+
+	getPerType := func() map[int32]*persistencespb.TaskQueueTypeUserData {
+		res, err := s.matchingEngine.GetTaskQueueUserData(context.Background(), &matchingservice.GetTaskQueueUserDataRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     tq,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		})
+		s.NoError(err)
+		return res.GetUserData().GetData().GetPerType()
+	}
+
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err := s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   tq,
+	})
+	s.ErrorAs(err, &invalidArgument)
+	_, err = s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:      namespaceID.String(),
+		TaskQueue:        tq,
+		TaskQueueTypes:   []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_NEXUS},
+		WorkflowIdPrefix: "order-",
+	})
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:      namespaceID.String(),
+		TaskQueue:        tq,
+		WorkflowIdPrefix: "order-",
+		MinAge:           durationpb.New(time.Hour),
+		Reason:           "incident",
+		Identity:         "operator",
+	})
+	s.NoError(err)
+	perType := getPerType()
+	for _, t := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		filters := perType[int32(t)].GetPurgeFilters()
+		s.Len(filters, 1)
+		s.Equal("order-", filters[0].GetWorkflowIdPrefix())
+		s.Equal("incident", filters[0].GetReason())
+		s.Equal("operator", filters[0].GetIdentity())
+		s.Equal(time.Hour, filters[0].GetPurgeTime().AsTime().Sub(filters[0].GetCreatedBefore().AsTime()))
+	}
+
+	_, err = s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      tq,
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		TypeName:       "ChargeCard",
+	})
+	s.NoError(err)
+	perType = getPerType()
+	s.Len(perType[int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW)].GetPurgeFilters(), 1)
+	s.Len(perType[int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)].GetPurgeFilters(), 2)
+
+	s.logger.Expect(testlogger.Error, "user data update function failed")
+	_, err = s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      tq,
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		TypeName:       "Refund",
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
 func (s *matchingEngineSuite) TestUnknownBuildId_Match() {
 	namespaceId := uuid.New()
 	tq := "makeToast"
@@ -3605,6 +3725,23 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	return persistence.UnknownNumRowsAffected, nil
 }
 
+func (m *testTaskManager) CompleteTasksInRange(
+	_ context.Context,
+	request *persistence.CompleteTasksInRangeRequest,
+) (int, error) {
+	tlm := m.getQueueManager(request.TaskQueueName, request.NamespaceID, request.TaskType)
+	tlm.Lock()
+	defer tlm.Unlock()
+	keys := tlm.tasks.Keys()
+	for _, key := range keys {
+		id := key.(int64)
+		if id >= request.InclusiveMinTaskID && id < request.ExclusiveMaxTaskID {
+			tlm.tasks.Remove(id)
+		}
+	}
+	return persistence.UnknownNumRowsAffected, nil
+}
+
 func (m *testTaskManager) ListTaskQueue(
 	_ context.Context,
 	_ *persistence.ListTaskQueueRequest,
@@ -0,0 +1,317 @@
+package matching
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// partitionScaler periodically adjusts the partition counts of the workflow and activity types of a task queue
+	// to their add and dispatch rates and backlog. It runs in the root partition of the workflow type, which owns the
+	// user data of the task queue where the counts are stored.
+	//
+	// Scaling up raises the read and write partition counts together. Scaling down only lowers the write partition
+	// count at first. The read partitions that are not written to anymore are removed once clients can't be using
+	// the old write partition count anymore and their backlog is drained.
+	partitionScaler struct {
+		pm        *taskQueuePartitionManagerImpl
+		config    *Config
+		logger    log.Logger
+		goroGroup goro.Group
+	}
+
+	partitionLoad struct {
+		addRate      float64
+		dispatchRate float64
+		backlog      int64
+		// the partition couldn't be described, its load is unknown
+		unknown bool
+	}
+
+	partitionScalingParams struct {
+		minPartitions int
+		maxPartitions int
+		// target add or dispatch rate of a single partition
+		targetRate float64
+		// average backlog per write partition above which a partition is added
+		backlogThreshold int64
+		// minimum time between changes of the write partition count
+		cooldown time.Duration
+		// minimum time between lowering the write partition count and removing read partitions
+		drainDelay time.Duration
+	}
+)
+
+var scaledTaskQueueTypes = []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+
+func newPartitionScaler(pm *taskQueuePartitionManagerImpl) *partitionScaler {
+	return &partitionScaler{
+		pm:     pm,
+		config: pm.engine.config,
+		logger: log.With(pm.engine.logger,
+			tag.WorkflowTaskQueueName(pm.partition.TaskQueue().Name()),
+			tag.WorkflowNamespace(pm.ns.Name().String())),
+	}
+}
+
+func (s *partitionScaler) Start() {
+	s.goroGroup.Go(s.run)
+}
+
+func (s *partitionScaler) Stop() {
+	s.goroGroup.Cancel()
+}
+
+func (s *partitionScaler) run(ctx context.Context) error {
+	ctx = s.pm.callerInfoContext(ctx)
+	nsName := s.pm.ns.Name().String()
+	taskQueueName := s.pm.partition.TaskQueue().Name()
+	for {
+		timer := time.NewTimer(s.config.PartitionAutoScalingInterval(nsName, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		for _, taskType := range scaledTaskQueueTypes {
+			if !s.config.EnablePartitionAutoScaling(nsName, taskQueueName, taskType) {
+				continue
+			}
+			if err := s.scale(ctx, taskType); err != nil && !common.IsContextCanceledErr(err) {
+				s.logger.Warn("failed to scale task queue partitions", tag.WorkflowTaskQueueType(taskType), tag.Error(err))
+			}
+		}
+	}
+}
+
+func (s *partitionScaler) scale(ctx context.Context, taskType enumspb.TaskQueueType) error {
+	nsName := s.pm.ns.Name().String()
+	taskQueueName := s.pm.partition.TaskQueue().Name()
+
+	userData, _, err := s.pm.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	current := userData.GetData().GetPerType()[int32(taskType)].GetPartitionCounts()
+	initial := current == nil
+	if initial {
+		// start from the statically configured counts
+		write := max(1, s.config.NumTaskqueueWritePartitions(nsName, taskQueueName, taskType))
+		read := max(write, s.config.NumTaskqueueReadPartitions(nsName, taskQueueName, taskType))
+		current = &taskqueuespb.TaskQueuePartitionCounts{
+			ReadPartitions:  int32(read),
+			WritePartitions: int32(write),
+		}
+	}
+
+	load, err := s.getLoad(ctx, taskType, int(current.GetReadPartitions()))
+	if err != nil {
+		return err
+	}
+	params := partitionScalingParams{
+		minPartitions:    s.config.PartitionAutoScalingMinPartitions(nsName),
+		maxPartitions:    s.config.PartitionAutoScalingMaxPartitions(nsName),
+		targetRate:       s.config.PartitionAutoScalingTargetRate(nsName, taskQueueName, taskType),
+		backlogThreshold: int64(s.config.PartitionAutoScalingBacklogThreshold(nsName, taskQueueName, taskType)),
+		cooldown:         s.config.PartitionAutoScalingCooldown(nsName, taskQueueName, taskType),
+		drainDelay:       s.config.PartitionCountsCacheTTL(),
+	}
+	counts := computePartitionCounts(current, load, params, s.pm.engine.timeSource.Now())
+	if counts == nil {
+		if !initial {
+			return nil
+		}
+		// Persist the counts scaling starts from, clients only use the partitions past the root once they
+		// learn the counts.
+		counts = current
+	}
+
+	// Fail the update if the user data changed since the counts were read, they are computed again next time.
+	updateOptions := UserDataUpdateOptions{KnownVersion: userData.GetVersion(), Source: "PartitionScaler"}
+	_, err = s.pm.userDataManager.UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data == nil {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		perType := data.PerType[int32(taskType)]
+		if perType == nil {
+			perType = &persistencespb.TaskQueueTypeUserData{}
+			data.PerType[int32(taskType)] = perType
+		}
+		perType.PartitionCounts = counts
+		// Partition counts follow the load of this cluster, they are not replicated. The clock is left
+		// unchanged, advancing it could make the user data replicated from other clusters look older.
+		return data, false, nil
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.Info("scaled task queue partitions",
+		tag.WorkflowTaskQueueType(taskType),
+		tag.NewInt32("read-partitions", counts.GetReadPartitions()),
+		tag.NewInt32("write-partitions", counts.GetWritePartitions()))
+	return nil
+}
+
+// getLoad returns the load of each of the first numPartitions partitions of the given task queue type. The load of
+// the partitions that can't be described is marked unknown, an error is returned only if none can be described.
+func (s *partitionScaler) getLoad(ctx context.Context, taskType enumspb.TaskQueueType, numPartitions int) ([]partitionLoad, error) {
+	load := make([]partitionLoad, numPartitions)
+	var lastErr error
+	for i := range load {
+		callCtx, cancel := context.WithTimeout(ctx, ioTimeout)
+		resp, err := s.pm.matchingClient.DescribeTaskQueuePartition(callCtx, &matchingservice.DescribeTaskQueuePartitionRequest{
+			NamespaceId: s.pm.partition.NamespaceId(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     s.pm.partition.TaskQueue().Name(),
+				TaskQueueType: taskType,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+			Versions:    &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+			ReportStats: true,
+		})
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			s.logger.Warn("failed to describe task queue partition for scaling",
+				tag.WorkflowTaskQueueType(taskType), tag.NewInt("partition", i), tag.Error(err))
+			load[i].unknown = true
+			lastErr = err
+			continue
+		}
+		for _, vii := range resp.GetVersionsInfoInternal() {
+			stats := vii.GetPhysicalTaskQueueInfo().GetTaskQueueStats()
+			load[i].addRate += float64(stats.GetTasksAddRate())
+			load[i].dispatchRate += float64(stats.GetTasksDispatchRate())
+			load[i].backlog += stats.GetApproximateBacklogCount()
+		}
+	}
+	if !slices.ContainsFunc(load, func(l partitionLoad) bool { return !l.unknown }) {
+		return nil, lastErr
+	}
+	return load, nil
+}
+
+// computePartitionCounts returns the partition counts for the given load of the read partitions, or nil if the
+// current counts should be kept.
+func computePartitionCounts(
+	current *taskqueuespb.TaskQueuePartitionCounts,
+	load []partitionLoad,
+	params partitionScalingParams,
+	now time.Time,
+) *taskqueuespb.TaskQueuePartitionCounts {
+	read, write := int(current.GetReadPartitions()), int(current.GetWritePartitions())
+	sinceUpdate := now.Sub(current.GetUpdateTime().AsTime())
+
+	var addRate, dispatchRate float64
+	var backlog int64
+	var partial bool
+	for _, l := range load {
+		addRate += l.addRate
+		dispatchRate += l.dispatchRate
+		backlog += l.backlog
+		partial = partial || l.unknown
+	}
+
+	minPartitions := max(1, params.minPartitions)
+	maxPartitions := max(minPartitions, params.maxPartitions)
+	desired := minPartitions
+	if params.targetRate > 0 {
+		desired = int(math.Ceil(max(addRate, dispatchRate) / params.targetRate))
+	}
+	// A growing backlog is only helped by more partitions when it's being dispatched.
+	if dispatchRate > 0 && backlog > params.backlogThreshold*int64(write) {
+		desired = max(desired, write+1)
+	}
+	desired = min(max(desired, minPartitions), maxPartitions)
+	if partial {
+		// the load of some partitions is missing from the totals, don't scale down because of it
+		desired = max(desired, min(write, maxPartitions))
+	}
+
+	if desired != write && sinceUpdate >= params.cooldown {
+		return &taskqueuespb.TaskQueuePartitionCounts{
+			ReadPartitions:  int32(max(read, desired)),
+			WritePartitions: int32(desired),
+			UpdateTime:      timestamppb.New(now),
+		}
+	}
+
+	if read > write && sinceUpdate >= params.drainDelay {
+		// Remove the read partitions past the write partitions, up to the last one with a backlog.
+		newRead := write
+		for i := write; i < min(read, len(load)); i++ {
+			if load[i].backlog > 0 || load[i].unknown {
+				newRead = i + 1
+			}
+		}
+		if newRead < read {
+			return &taskqueuespb.TaskQueuePartitionCounts{
+				ReadPartitions:  int32(newRead),
+				WritePartitions: int32(write),
+				UpdateTime:      timestamppb.New(now),
+			}
+		}
+	}
+	return nil
+}
+
+// keepLocalPartitionCounts returns perType with the partition counts of localPerType. perType and its entries are
+// copied before they are changed.
+func keepLocalPartitionCounts(
+	perType map[int32]*persistencespb.TaskQueueTypeUserData,
+	localPerType map[int32]*persistencespb.TaskQueueTypeUserData,
+) map[int32]*persistencespb.TaskQueueTypeUserData {
+	types := make(map[int32]struct{}, len(perType)+len(localPerType))
+	for t := range perType {
+		types[t] = struct{}{}
+	}
+	for t := range localPerType {
+		types[t] = struct{}{}
+	}
+
+	result := perType
+	cloned := false
+	for t := range types {
+		counts := localPerType[t].GetPartitionCounts()
+		if proto.Equal(perType[t].GetPartitionCounts(), counts) {
+			continue
+		}
+		if !cloned {
+			result = maps.Clone(perType)
+			if result == nil {
+				result = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+			}
+			cloned = true
+		}
+		typeData := common.CloneProto(perType[t])
+		if typeData == nil {
+			typeData = &persistencespb.TaskQueueTypeUserData{}
+		}
+		typeData.PartitionCounts = counts
+		result[t] = typeData
+	}
+	return result
+}
@@ -0,0 +1,194 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestComputePartitionCounts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	params := partitionScalingParams{
+		minPartitions:    1,
+		maxPartitions:    8,
+		targetRate:       100,
+		backlogThreshold: 1000,
+		cooldown:         5 * time.Minute,
+		drainDelay:       time.Minute,
+	}
+	counts := func(read, write int32, updated time.Duration) *taskqueuespb.TaskQueuePartitionCounts {
+		return &taskqueuespb.TaskQueuePartitionCounts{
+			ReadPartitions:  read,
+			WritePartitions: write,
+			UpdateTime:      timestamppb.New(now.Add(-updated)),
+		}
+	}
+	uniformLoad := func(partitions int, rate float64, backlog int64) []partitionLoad {
+		load := make([]partitionLoad, partitions)
+		for i := range load {
+			load[i] = partitionLoad{addRate: rate, dispatchRate: rate, backlog: backlog}
+		}
+		return load
+	}
+
+	testCases := []struct {
+		name     string
+		current  *taskqueuespb.TaskQueuePartitionCounts
+		load     []partitionLoad
+		expected *taskqueuespb.TaskQueuePartitionCounts
+	}{
+		{
+			name:     "steady",
+			current:  counts(4, 4, time.Hour),
+			load:     uniformLoad(4, 90, 0),
+			expected: nil,
+		},
+		{
+			name:     "scale up by rate",
+			current:  counts(2, 2, time.Hour),
+			load:     uniformLoad(2, 250, 0),
+			expected: counts(5, 5, 0),
+		},
+		{
+			name:     "scale up from static counts",
+			current:  &taskqueuespb.TaskQueuePartitionCounts{ReadPartitions: 4, WritePartitions: 4},
+			load:     uniformLoad(4, 150, 0),
+			expected: counts(6, 6, 0),
+		},
+		{
+			name:     "scale up bounded",
+			current:  counts(4, 4, time.Hour),
+			load:     uniformLoad(4, 1000, 0),
+			expected: counts(8, 8, 0),
+		},
+		{
+			name:     "scale up by backlog",
+			current:  counts(2, 2, time.Hour),
+			load:     uniformLoad(2, 50, 1500),
+			expected: counts(3, 3, 0),
+		},
+		{
+			name:     "backlog not dispatched",
+			current:  counts(2, 2, time.Hour),
+			load:     []partitionLoad{{addRate: 100, backlog: 5000}, {addRate: 100, backlog: 5000}},
+			expected: nil,
+		},
+		{
+			name:     "scale up in cooldown",
+			current:  counts(2, 2, time.Minute),
+			load:     uniformLoad(2, 250, 0),
+			expected: nil,
+		},
+		{
+			name:     "scale down write first",
+			current:  counts(4, 4, time.Hour),
+			load:     uniformLoad(4, 10, 0),
+			expected: counts(4, 1, 0),
+		},
+		{
+			name:     "scale down to min partitions",
+			current:  counts(4, 4, time.Hour),
+			load:     uniformLoad(4, 0, 0),
+			expected: counts(4, 1, 0),
+		},
+		{
+			name:     "drain before drain delay",
+			current:  counts(4, 1, 30*time.Second),
+			load:     uniformLoad(4, 0, 0),
+			expected: nil,
+		},
+		{
+			name:     "drain read partitions",
+			current:  counts(4, 1, 2*time.Minute),
+			load:     uniformLoad(4, 0, 0),
+			expected: counts(1, 1, 0),
+		},
+		{
+			name:    "drain up to partition with backlog",
+			current: counts(4, 1, 2*time.Minute),
+			load: []partitionLoad{
+				{addRate: 50, dispatchRate: 50},
+				{},
+				{dispatchRate: 1, backlog: 10},
+				{},
+			},
+			expected: counts(3, 1, 0),
+		},
+		{
+			name:     "no scale down with unknown load",
+			current:  counts(4, 4, time.Hour),
+			load:     []partitionLoad{{addRate: 10, dispatchRate: 10}, {unknown: true}, {}, {}},
+			expected: nil,
+		},
+		{
+			name:     "scale up with unknown load",
+			current:  counts(2, 2, time.Hour),
+			load:     []partitionLoad{{addRate: 350, dispatchRate: 350}, {unknown: true}},
+			expected: counts(4, 4, 0),
+		},
+		{
+			name:     "drain keeps partition with unknown load",
+			current:  counts(4, 1, 2*time.Minute),
+			load:     []partitionLoad{{addRate: 50, dispatchRate: 50}, {unknown: true}, {}, {}},
+			expected: counts(2, 1, 0),
+		},
+		{
+			name:     "scale up while draining",
+			current:  counts(4, 1, time.Hour),
+			load:     uniformLoad(4, 50, 0),
+			expected: counts(4, 2, 0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := computePartitionCounts(tc.current, tc.load, params, now)
+			if tc.expected == nil {
+				require.Nil(t, actual)
+				return
+			}
+			require.NotNil(t, actual)
+			require.Equal(t, tc.expected.GetReadPartitions(), actual.GetReadPartitions())
+			require.Equal(t, tc.expected.GetWritePartitions(), actual.GetWritePartitions())
+			require.Equal(t, now.UnixNano(), actual.GetUpdateTime().AsTime().UnixNano())
+		})
+	}
+}
+
+func TestKeepLocalPartitionCounts(t *testing.T) {
+	t.Parallel()
+
+	local := &taskqueuespb.TaskQueuePartitionCounts{ReadPartitions: 8, WritePartitions: 4}
+	remote := &taskqueuespb.TaskQueuePartitionCounts{ReadPartitions: 2, WritePartitions: 2}
+	pauseInfo := &taskqueuespb.TaskQueuePauseInfo{Reason: "remote"}
+
+	perType := map[int32]*persistencespb.TaskQueueTypeUserData{
+		1: {PauseInfo: pauseInfo, PartitionCounts: remote},
+		2: {PartitionCounts: remote},
+	}
+	localPerType := map[int32]*persistencespb.TaskQueueTypeUserData{
+		1: {PartitionCounts: local},
+		3: {PartitionCounts: local},
+	}
+
+	merged := keepLocalPartitionCounts(perType, localPerType)
+	require.Len(t, merged, 3)
+	require.Equal(t, local, merged[1].GetPartitionCounts())
+	require.Equal(t, pauseInfo.GetReason(), merged[1].GetPauseInfo().GetReason())
+	require.Nil(t, merged[2].GetPartitionCounts())
+	require.Equal(t, local, merged[3].GetPartitionCounts())
+
+	// the given map is not mutated
+	require.Equal(t, remote, perType[1].GetPartitionCounts())
+	require.Equal(t, remote, perType[2].GetPartitionCounts())
+	require.NotContains(t, perType, int32(3))
+
+	unchanged := map[int32]*persistencespb.TaskQueueTypeUserData{1: {PartitionCounts: local}}
+	merged = keepLocalPartitionCounts(unchanged, map[int32]*persistencespb.TaskQueueTypeUserData{1: {PartitionCounts: local}})
+	require.Same(t, unchanged[1], merged[1])
+}
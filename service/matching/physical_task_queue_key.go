@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"strconv"
+	"strings"
 
 	deploymentpb "go.temporal.io/api/deployment/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -141,6 +142,19 @@ func (q *PhysicalTaskQueueKey) PersistenceName() string {
 	}
 }
 
+// TaskQueueFamilyNameFromPersistenceName returns the name of the task queue family of a physical queue from its
+// persistence name. For sticky queues, it returns the sticky name.
+func TaskQueueFamilyNameFromPersistenceName(persistenceName string) string {
+	name, found := strings.CutPrefix(persistenceName, nonRootPartitionPrefix)
+	if !found {
+		return persistenceName
+	}
+	if i := strings.LastIndex(name, partitionDelimiter); i >= 0 {
+		return name[:i]
+	}
+	return persistenceName
+}
+
 func (q *PhysicalTaskQueueKey) IsVersioned() bool {
 	return q.version.IsVersioned()
 }
@@ -64,3 +64,23 @@ func TestUnversionedQueueKey(t *testing.T) {
 	a.Equal("", key.Version().BuildId())
 	a.Nil(key.Version().Deployment())
 }
+
+func TestTaskQueueFamilyNameFromPersistenceName(t *testing.T) {
+	a := assert.New(t)
+
+	f, err := tqid.NewTaskQueueFamily("", "my/tq")
+	assert.NoError(t, err)
+	root := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition()
+	p := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).NormalPartition(2)
+	for _, key := range []*PhysicalTaskQueueKey{
+		UnversionedQueueKey(root),
+		UnversionedQueueKey(p),
+		VersionSetQueueKey(p, "abc3"),
+		BuildIdQueueKey(p, "abc3"),
+	} {
+		a.Equal("my/tq", TaskQueueFamilyNameFromPersistenceName(key.PersistenceName()))
+	}
+
+	sticky := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).StickyPartition("sticky")
+	a.Equal("sticky", TaskQueueFamilyNameFromPersistenceName(UnversionedQueueKey(sticky).PersistenceName()))
+}
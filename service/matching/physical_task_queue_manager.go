@@ -102,6 +102,7 @@ type (
 		OfferQuery(ctx context.Context, task *internalTask) (*matchingservice.QueryWorkflowResponse, error)
 		OfferNexusTask(ctx context.Context, task *internalTask) (*matchingservice.DispatchNexusTaskResponse, error)
 		ReprocessAllTasks()
+		SetPaused(paused bool)
 	}
 )
 
@@ -238,6 +239,7 @@ func (c *physicalTaskQueueManagerImpl) Start() {
 		return
 	}
 	c.liveness.Start()
+	c.updatePaused()
 	c.backlogMgr.Start()
 	c.matcher.Start()
 	c.logger.Info("Started physicalTaskQueueManager", tag.LifeCycleStarted, tag.Cause(c.config.loadCause.String()))
@@ -330,6 +332,12 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 			task.finish(nil, false)
 			continue
 		}
+		// Same for a task read before a purge of the task queue that matches it.
+		if task.event != nil && c.IsTaskPurged(task.event.AllocatedTaskInfo) {
+			c.metricsHandler.Counter(metrics.PurgedTasksPerTaskQueueCounter.Name()).Record(1)
+			task.finish(nil, false)
+			continue
+		}
 
 		task.namespace = c.partitionMgr.ns.Name()
 		task.backlogCountHint = c.backlogCountHint
@@ -384,10 +392,29 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTas
 	c.priMatcher.AddTask(task)
 }
 
+func (c *physicalTaskQueueManagerImpl) IsTaskPurged(task *persistencespb.AllocatedTaskInfo) bool {
+	perTypeUserData, _, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		return false
+	}
+	return IsTaskPurged(task, perTypeUserData.GetPurgeFilters())
+}
+
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
+	c.updatePaused()
 	c.matcher.ReprocessAllTasks()
 }
 
+// updatePaused pauses or resumes dispatch according to the pause state of the task queue type in user data.
+func (c *physicalTaskQueueManagerImpl) updatePaused() {
+	perTypeUserData, _, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		// user data is not loaded yet, this is called again when it is
+		return
+	}
+	c.matcher.SetPaused(perTypeUserData.GetPauseInfo() != nil)
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
@@ -35,6 +35,8 @@ type (
 		DispatchSpooledTask(ctx context.Context, task *internalTask, userDataChanged <-chan struct{}) error
 		AddSpooledTask(task *internalTask) error
 		AddSpooledTaskToMatcher(task *internalTask)
+		// IsTaskPurged returns true if a backlog task matches a purge of the task queue type, and should be dropped.
+		IsTaskPurged(task *persistencespb.AllocatedTaskInfo) bool
 		UserDataChanged()
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
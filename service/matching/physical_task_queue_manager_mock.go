@@ -171,6 +171,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) HasPollerAfter(accessTime an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPollerAfter", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).HasPollerAfter), accessTime)
 }
 
+// IsTaskPurged mocks base method.
+func (m *MockphysicalTaskQueueManager) IsTaskPurged(task *persistence.AllocatedTaskInfo) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTaskPurged", task)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTaskPurged indicates an expected call of IsTaskPurged.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) IsTaskPurged(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTaskPurged", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).IsTaskPurged), task)
+}
+
 // LegacyDescribeTaskQueue mocks base method.
 func (m *MockphysicalTaskQueueManager) LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
 	m.ctrl.T.Helper()
@@ -49,9 +49,9 @@ type (
 		db         *taskQueueDB
 		taskWriter *priTaskWriter
 
-		subqueueLock        sync.Mutex
-		subqueues           []*priTaskReader
-		subqueuesByPriority map[int32]int
+		subqueueLock   sync.Mutex
+		subqueues      []*priTaskReader
+		subqueuesByKey map[subqueueKey]int
 
 		logger           log.Logger
 		throttledLogger  log.ThrottledLogger
@@ -62,6 +62,12 @@ type (
 		// update before unloading
 		skipFinalUpdate atomic.Bool
 	}
+
+	// subqueueKey is the comparable form of persistencespb.SubqueueKey.
+	subqueueKey struct {
+		priority       int32
+		fairnessBucket int32
+	}
 )
 
 var _ backlogManager = (*priBacklogManagerImpl)(nil)
@@ -77,15 +83,15 @@ func newPriBacklogManager(
 	metricsHandler metrics.Handler,
 ) *priBacklogManagerImpl {
 	bmg := &priBacklogManagerImpl{
-		pqMgr:               pqMgr,
-		config:              config,
-		tqCtx:               tqCtx,
-		subqueuesByPriority: make(map[int32]int),
-		matchingClient:      matchingClient,
-		metricsHandler:      metricsHandler,
-		logger:              logger,
-		throttledLogger:     throttledLogger,
-		initializedError:    future.NewFuture[struct{}](),
+		pqMgr:            pqMgr,
+		config:           config,
+		tqCtx:            tqCtx,
+		subqueuesByKey:   make(map[subqueueKey]int),
+		matchingClient:   matchingClient,
+		metricsHandler:   metricsHandler,
+		logger:           logger,
+		throttledLogger:  throttledLogger,
+		initializedError: future.NewFuture[struct{}](),
 	}
 	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler)
 	bmg.taskWriter = newPriTaskWriter(bmg)
@@ -158,11 +164,14 @@ func (c *priBacklogManagerImpl) loadSubqueuesLocked(subqueues []persistencespb.S
 			r.Start()
 			c.subqueues = append(c.subqueues, r)
 		}
-		c.subqueuesByPriority[subqueues[i].Key.Priority] = i
+		c.subqueuesByKey[subqueueKey{
+			priority:       subqueues[i].Key.GetPriority(),
+			fairnessBucket: subqueues[i].Key.GetFairnessBucket(),
+		}] = i
 	}
 }
 
-func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
+func (c *priBacklogManagerImpl) getSubqueue(priority int32, fairnessKey string) int {
 	levels := c.config.PriorityLevels()
 	if priority == 0 {
 		priority = defaultPriorityLevel(levels)
@@ -173,11 +182,15 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 	} else if priority > int32(levels) {
 		priority = int32(levels)
 	}
+	key := subqueueKey{
+		priority:       priority,
+		fairnessBucket: fairnessBucket(fairnessKey, c.config.FairnessBuckets()),
+	}
 
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
 
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
@@ -185,7 +198,8 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 	// but we want to serialize these updates.
 	// TODO(pri): maybe we can improve that
 	subqueues, err := c.db.AllocateSubqueue(c.tqCtx, &persistencespb.SubqueueKey{
-		Priority: priority,
+		Priority:       key.priority,
+		FairnessBucket: key.fairnessBucket,
 	})
 	if err != nil {
 		c.signalIfFatal(err)
@@ -196,9 +210,9 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 
 	c.loadSubqueuesLocked(subqueues)
 
-	// After AllocateSubqueue added a subqueue for this priority, and we merged the result into
+	// After AllocateSubqueue added a subqueue for this key, and we merged the result into
 	// our state with loadSubqueuesLocked, this lookup should now find a subqueue.
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
@@ -221,7 +235,7 @@ func (c *priBacklogManagerImpl) periodicSync() {
 }
 
 func (c *priBacklogManagerImpl) SpoolTask(taskInfo *persistencespb.TaskInfo) error {
-	subqueue := c.getSubqueueForPriority(taskInfo.Priority.GetPriorityKey())
+	subqueue := c.getSubqueue(taskInfo.GetPriority().GetPriorityKey(), taskInfo.GetFairness().GetKey())
 	err := c.taskWriter.appendTask(subqueue, taskInfo)
 	c.signalIfFatal(err)
 	return err
@@ -89,6 +89,8 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				ForwardInfo:            f.getForwardInfo(task),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				Fairness:               task.event.Data.GetFairness(),
+				TypeName:               task.event.Data.GetTypeName(),
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
@@ -107,6 +109,8 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				Fairness:               task.event.Data.GetFairness(),
+				TypeName:               task.event.Data.GetTypeName(),
 			},
 		)
 	default:
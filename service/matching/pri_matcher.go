@@ -45,7 +45,13 @@ type priTaskMatcher struct {
 	adminTqRate float64
 	dynamicRate float64
 
-	cancel1, cancel2 func()
+	// dispatch rate limits of fairness keys and workflow/activity types, for the whole task queue
+	fairnessKeyRates       map[string]float64
+	fairnessKeyDefaultRate float64
+	typeNameRates          map[string]float64
+	taskLimitPartitions    int // number of partitions the task limits were last divided by
+
+	cancel1, cancel2, cancel3, cancel4, cancel5 func()
 }
 
 type waitingPoller struct {
@@ -113,7 +119,11 @@ func newPriTaskMatcher(
 
 	tm.adminNsRate, tm.cancel1 = config.AdminNamespaceToPartitionRateSub(tm.setAdminNsRate)
 	tm.adminTqRate, tm.cancel2 = config.AdminNamespaceTaskQueueToPartitionRateSub(tm.setAdminTqRate)
+	tm.fairnessKeyRates, tm.cancel3 = config.FairnessKeyDispatchRateLimitsSub(tm.setFairnessKeyRates)
+	tm.fairnessKeyDefaultRate, tm.cancel4 = config.FairnessKeyDefaultDispatchRateLimitSub(tm.setFairnessKeyDefaultRate)
+	tm.typeNameRates, tm.cancel5 = config.TypeNameDispatchRateLimitsSub(tm.setTypeNameRates)
 	tm.setLimitLocked()
+	tm.setTaskLimitsLocked()
 
 	return tm
 }
@@ -143,6 +153,9 @@ func (tm *priTaskMatcher) Start() {
 func (tm *priTaskMatcher) Stop() {
 	tm.cancel1()
 	tm.cancel2()
+	tm.cancel3()
+	tm.cancel4()
+	tm.cancel5()
 }
 
 func (tm *priTaskMatcher) forwardTasks(lim quotas.RateLimiter, retrier backoff.Retrier) {
@@ -466,6 +479,13 @@ func (tm *priTaskMatcher) PollForQuery(ctx context.Context, pollMetadata *pollMe
 	return tm.poll(ctx, pollMetadata, true)
 }
 
+// SetPaused pauses or resumes the dispatch of workflow and activity tasks, including their
+// forwarding to the parent partition. Queries and nexus tasks, which are never backlogged, are
+// still dispatched while paused.
+func (tm *priTaskMatcher) SetPaused(paused bool) {
+	tm.data.SetPaused(paused)
+}
+
 func (tm *priTaskMatcher) ReprocessAllTasks() {
 	tasks := tm.data.ReprocessTasks(func(task *internalTask) (shouldRemove bool) {
 		// TODO(pri): do we have to reprocess _all_ backlog tasks or can we determine
@@ -517,6 +537,60 @@ func (tm *priTaskMatcher) setLimitLocked() {
 	)
 
 	tm.data.UpdateRateLimit(rate, burstDuration)
+
+	if tm.numPartitions() != tm.taskLimitPartitions {
+		tm.setTaskLimitsLocked()
+	}
+}
+
+func (tm *priTaskMatcher) setFairnessKeyRates(rates map[string]float64) {
+	tm.limiterLock.Lock()
+	defer tm.limiterLock.Unlock()
+	tm.fairnessKeyRates = rates
+	tm.setTaskLimitsLocked()
+}
+
+func (tm *priTaskMatcher) setFairnessKeyDefaultRate(rps float64) {
+	tm.limiterLock.Lock()
+	defer tm.limiterLock.Unlock()
+	tm.fairnessKeyDefaultRate = rps
+	tm.setTaskLimitsLocked()
+}
+
+func (tm *priTaskMatcher) setTypeNameRates(rates map[string]float64) {
+	tm.limiterLock.Lock()
+	defer tm.limiterLock.Unlock()
+	tm.typeNameRates = rates
+	tm.setTaskLimitsLocked()
+}
+
+func (tm *priTaskMatcher) setTaskLimitsLocked() {
+	tm.taskLimitPartitions = tm.numPartitions()
+
+	// divide the rates equally across all partitions
+	perPartition := func(rate float64) float64 {
+		if tm.taskLimitPartitions > 0 {
+			return rate / float64(tm.taskLimitPartitions)
+		}
+		return rate
+	}
+	perPartitionRates := func(rates map[string]float64) map[string]float64 {
+		if len(rates) == 0 {
+			return nil
+		}
+		out := make(map[string]float64, len(rates))
+		for key, rate := range rates {
+			out[key] = perPartition(rate)
+		}
+		return out
+	}
+
+	tm.data.UpdateTaskRateLimits(taskRateLimits{
+		fairnessKeyRates:       perPartitionRates(tm.fairnessKeyRates),
+		fairnessKeyDefaultRate: perPartition(tm.fairnessKeyDefaultRate),
+		typeNameRates:          perPartitionRates(tm.typeNameRates),
+		burst:                  burstDuration,
+	})
 }
 
 // Rate returns the current dynamic rate setting
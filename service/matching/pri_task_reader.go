@@ -251,6 +251,10 @@ func (tr *priTaskReader) processTaskBatch(tasks []*persistencespb.AllocatedTaskI
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
 			return true
 		}
+		if tr.backlogMgr.pqMgr.IsTaskPurged(t) {
+			metrics.PurgedTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
+			return true
+		}
 
 		// We may race to read tasks with signalNewTasks. If it wins, we may end up seeing
 		// tasks twice. In that case, we should just ignore them. If we win (based on
@@ -66,6 +66,7 @@ type (
 		waitableMatchResult
 		forwardCtx      context.Context // non-nil for sync match task only
 		isPollForwarder bool
+		fairPass        float64 // virtual start time of the task among the tasks of its fairness key
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -256,6 +257,22 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+func (task *internalTask) getFairness() *taskqueuespb.TaskFairness {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetFairness()
+	}
+	// query and nexus tasks are always dispatched ahead of the backlog, so they don't need fairness
+	return nil
+}
+
+// getTypeName returns the workflow type of a workflow task or the activity type of an activity task.
+func (task *internalTask) getTypeName() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetTypeName()
+	}
+	return ""
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
@@ -56,6 +56,8 @@ type (
 		cachedPhysicalInfoByBuildId     map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo // non-nil for root-partition
 		cachedPhysicalInfoByBuildIdLock sync.RWMutex                                                             // locks mutation of cachedPhysicalInfoByBuildId
 		lastFanOut                      int64                                                                    // serves as a TTL for cachedPhysicalInfoByBuildId
+		// adjusts the partition counts of the task queue, only set for the root partition of the workflow type
+		partitionScaler *partitionScaler
 	}
 )
 
@@ -85,6 +87,24 @@ func newTaskQueuePartitionManager(
 		cachedPhysicalInfoByBuildId: nil,
 	}
 
+	// Partition counts chosen by partition auto scaling take precedence over the configured ones.
+	numWritePartitions, numReadPartitions := tqConfig.NumWritePartitions, tqConfig.NumReadPartitions
+	tqConfig.NumWritePartitions = func() int {
+		if counts := pm.PartitionCounts(); counts != nil {
+			return max(1, int(counts.GetWritePartitions()))
+		}
+		return numWritePartitions()
+	}
+	tqConfig.NumReadPartitions = func() int {
+		if counts := pm.PartitionCounts(); counts != nil {
+			return max(1, int(counts.GetReadPartitions()))
+		}
+		return numReadPartitions()
+	}
+	if partition.IsRoot() && partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL && partition.TaskType() == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		pm.partitionScaler = newPartitionScaler(pm)
+	}
+
 	defaultQ, err := newPhysicalTaskQueueManager(pm, UnversionedQueueKey(partition))
 	if err != nil {
 		return nil, err
@@ -97,6 +117,9 @@ func (pm *taskQueuePartitionManagerImpl) Start() {
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, 1)
 	pm.userDataManager.Start()
 	pm.defaultQueue.Start()
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.Start()
+	}
 }
 
 // Stop does not unload the partition from matching engine. It is intended to be called by matching engine when
@@ -107,6 +130,9 @@ func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
 	for _, vq := range pm.versionedQueues {
 		vq.Stop(unloadCause)
 	}
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.Stop()
+	}
 	pm.defaultQueue.Stop(unloadCause)
 	pm.userDataManager.Stop()
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, -1)
@@ -543,11 +569,11 @@ func (pm *taskQueuePartitionManagerImpl) LegacyDescribeTaskQueue(includeTaskQueu
 	if includeTaskQueueStatus {
 		resp.DescResponse.TaskQueueStatus = pm.defaultQueue.LegacyDescribeTaskQueue(true).DescResponse.TaskQueueStatus
 	}
+	perTypeUserData, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return nil, err
+	}
 	if pm.partition.Kind() != enumspb.TASK_QUEUE_KIND_STICKY {
-		perTypeUserData, _, err := pm.getPerTypeUserData()
-		if err != nil {
-			return nil, err
-		}
 		current, ramping := worker_versioning.CalculateTaskQueueVersioningInfo(perTypeUserData.GetDeploymentData())
 		info := &taskqueuepb.TaskQueueVersioningInfo{
 			// [cleanup-wv-3.1]
@@ -567,6 +593,16 @@ func (pm *taskQueuePartitionManagerImpl) LegacyDescribeTaskQueue(includeTaskQueu
 		}
 		resp.DescResponse.VersioningInfo = info
 	}
+	if limits := pm.dispatchRateLimits(); limits != nil {
+		resp.DispatchRateLimits = map[int32]*taskqueuespb.TaskDispatchRateLimits{
+			int32(pm.partition.TaskType()): limits,
+		}
+	}
+	if pauseInfo := perTypeUserData.GetPauseInfo(); pauseInfo != nil {
+		resp.PauseInfo = map[int32]*taskqueuespb.TaskQueuePauseInfo{
+			int32(pm.partition.TaskType()): pauseInfo,
+		}
+	}
 	return resp, nil
 }
 
@@ -611,6 +647,15 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 
 	pm.versionedQueuesLock.RUnlock()
 
+	var pauseInfo *taskqueuespb.TaskQueuePauseInfo
+	if internalTaskQueueStatus {
+		perTypeUserData, _, err := pm.getPerTypeUserData()
+		if err != nil {
+			return nil, err
+		}
+		pauseInfo = perTypeUserData.GetPauseInfo()
+	}
+
 	versionsInfo := make(map[string]*taskqueuespb.TaskQueueVersionInfoInternal, 0)
 	for v := range versions {
 		vInfo := &taskqueuespb.TaskQueueVersionInfoInternal{
@@ -636,6 +681,8 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 		}
 		if internalTaskQueueStatus {
 			vInfo.PhysicalTaskQueueInfo.InternalTaskQueueStatus = physicalQueue.GetInternalTaskQueueStatus()
+			vInfo.PhysicalTaskQueueInfo.DispatchRateLimits = pm.dispatchRateLimits()
+			vInfo.PhysicalTaskQueueInfo.PauseInfo = pauseInfo
 		}
 
 		// The following assigns buildID to either a v2 based buildID or a versionID representing a worker-deployment version.
@@ -654,6 +701,37 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 	}, nil
 }
 
+// dispatchRateLimits returns the dispatch rate limits of fairness keys and workflow/activity types of the task
+// queue, or nil if there are none.
+func (pm *taskQueuePartitionManagerImpl) dispatchRateLimits() *taskqueuespb.TaskDispatchRateLimits {
+	return taskDispatchRateLimits(pm.config)
+}
+
+func taskDispatchRateLimits(config *taskQueueConfig) *taskqueuespb.TaskDispatchRateLimits {
+	limits := &taskqueuespb.TaskDispatchRateLimits{
+		FairnessKeyRates:       config.FairnessKeyDispatchRateLimits(),
+		FairnessKeyDefaultRate: config.FairnessKeyDefaultDispatchRateLimit(),
+		TypeNameRates:          config.TypeNameDispatchRateLimits(),
+	}
+	if len(limits.FairnessKeyRates) == 0 && limits.FairnessKeyDefaultRate <= 0 && len(limits.TypeNameRates) == 0 {
+		return nil
+	}
+	return limits
+}
+
+// PartitionCounts returns the partition counts of the task queue type chosen by partition auto scaling, or nil if
+// they are not managed by it.
+func (pm *taskQueuePartitionManagerImpl) PartitionCounts() *taskqueuespb.TaskQueuePartitionCounts {
+	if !pm.config.EnablePartitionAutoScaling() {
+		return nil
+	}
+	perTypeUserData, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return nil
+	}
+	return perTypeUserData.GetPartitionCounts()
+}
+
 func (pm *taskQueuePartitionManagerImpl) Partition() tqid.Partition {
 	return pm.partition
 }
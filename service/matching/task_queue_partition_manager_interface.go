@@ -49,6 +49,9 @@ type (
 		// error is returned, if dispatched to local poller then nil and nil is returned.
 		DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error)
 		GetUserDataManager() userDataManager
+		// PartitionCounts returns the partition counts of the task queue type chosen by partition auto scaling, or nil
+		// if they are not managed by it.
+		PartitionCounts() *taskqueuespb.TaskQueuePartitionCounts
 		// MarkAlive updates the liveness timer to keep this partition manager alive.
 		MarkAlive()
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
@@ -261,6 +261,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) Partition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Partition", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Partition))
 }
 
+// PartitionCounts mocks base method.
+func (m *MocktaskQueuePartitionManager) PartitionCounts() *taskqueue0.TaskQueuePartitionCounts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartitionCounts")
+	ret0, _ := ret[0].(*taskqueue0.TaskQueuePartitionCounts)
+	return ret0
+}
+
+// PartitionCounts indicates an expected call of PartitionCounts.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) PartitionCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartitionCounts", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).PartitionCounts))
+}
+
 // PollTask mocks base method.
 func (m *MocktaskQueuePartitionManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, bool, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +476,62 @@ func (s *PartitionManagerTestSuite) TestLegacyDescribeTaskQueue() {
 	}
 }
 
+func (s *PartitionManagerTestSuite) TestLegacyDescribeTaskQueue_DispatchRateLimits() {
+	resp, err := s.partitionMgr.LegacyDescribeTaskQueue(false)
+	s.NoError(err)
+	s.Empty(resp.GetDispatchRateLimits())
+
+	s.partitionMgr.config.TypeNameDispatchRateLimits = func() map[string]float64 { return map[string]float64{"slow": 1} }
+	resp, err = s.partitionMgr.LegacyDescribeTaskQueue(false)
+	s.NoError(err)
+	s.Equal(map[string]float64{"slow": 1}, resp.GetDispatchRateLimits()[int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW)].GetTypeNameRates())
+}
+
+func (s *PartitionManagerTestSuite) TestLegacyDescribeTaskQueue_PauseInfo() {
+	resp, err := s.partitionMgr.LegacyDescribeTaskQueue(false)
+	s.NoError(err)
+	s.Empty(resp.GetPauseInfo())
+
+	s.userDataMgr.Lock()
+	s.userDataMgr.data = &persistencespb.VersionedTaskQueueUserData{Data: &persistencespb.TaskQueueUserData{
+		PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+			int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW): {
+				PauseInfo: &taskqueuespb.TaskQueuePauseInfo{Reason: "maintenance", Identity: "operator"},
+			},
+		},
+	}}
+	s.userDataMgr.Unlock()
+
+	resp, err = s.partitionMgr.LegacyDescribeTaskQueue(false)
+	s.NoError(err)
+	s.Equal("maintenance", resp.GetPauseInfo()[int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW)].GetReason())
+}
+
+func (s *PartitionManagerTestSuite) TestPartitionCounts() {
+	config := s.partitionMgr.config
+	staticRead, staticWrite := config.NumReadPartitions(), config.NumWritePartitions()
+
+	s.userDataMgr.Lock()
+	s.userDataMgr.data = &persistencespb.VersionedTaskQueueUserData{Data: &persistencespb.TaskQueueUserData{
+		PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+			int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW): {
+				PartitionCounts: &taskqueuespb.TaskQueuePartitionCounts{ReadPartitions: 7, WritePartitions: 5},
+			},
+		},
+	}}
+	s.userDataMgr.Unlock()
+
+	// partition counts in user data are ignored when partition auto scaling is disabled
+	s.Nil(s.partitionMgr.PartitionCounts())
+	s.Equal(staticRead, config.NumReadPartitions())
+	s.Equal(staticWrite, config.NumWritePartitions())
+
+	config.EnablePartitionAutoScaling = func() bool { return true }
+	s.Equal(int32(7), s.partitionMgr.PartitionCounts().GetReadPartitions())
+	s.Equal(7, config.NumReadPartitions())
+	s.Equal(5, config.NumWritePartitions())
+}
+
 func (s *PartitionManagerTestSuite) validateAddTask(expectedBuildId string, expectedSyncMatch bool, versioningData *persistencespb.VersioningData, directive *taskqueuespb.TaskVersionDirective) {
 	timeout := 1000000 * time.Millisecond
 	if expectedSyncMatch {
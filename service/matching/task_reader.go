@@ -260,6 +260,11 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
 			continue
 		}
+		if tr.backlogMgr.pqMgr.IsTaskPurged(t) {
+			metrics.PurgedTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			continue
+		}
 		if err := tr.addSingleTaskToBuffer(ctx, t); err != nil {
 			return err
 		}
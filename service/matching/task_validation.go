@@ -2,6 +2,7 @@ package matching
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -9,6 +10,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -215,3 +217,25 @@ func IsTaskExpired(t *persistencespb.AllocatedTaskInfo) bool {
 	expiry := timestamp.TimeValue(t.GetData().GetExpiryTime())
 	return expiry.Unix() > 0 && expiry.Before(time.Now())
 }
+
+// IsTaskPurged returns true if the task matches any of the purge filters of its task queue type, in which case it
+// should be deleted like an expired task.
+func IsTaskPurged(t *persistencespb.AllocatedTaskInfo, filters []*taskqueuespb.TaskQueuePurgeFilter) bool {
+	for _, filter := range filters {
+		if taskMatchesPurgeFilter(t.GetData(), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+func taskMatchesPurgeFilter(t *persistencespb.TaskInfo, filter *taskqueuespb.TaskQueuePurgeFilter) bool {
+	if !strings.HasPrefix(t.GetWorkflowId(), filter.GetWorkflowIdPrefix()) {
+		return false
+	}
+	if filter.GetTypeName() != "" && t.GetTypeName() != filter.GetTypeName() {
+		return false
+	}
+	// tasks without a create time can't be told apart from tasks added after the purge, keep them
+	return t.GetCreateTime() != nil && t.GetCreateTime().AsTime().Before(filter.GetCreatedBefore().AsTime())
+}
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -288,3 +289,24 @@ func (s *taskValidatorSuite) TestIsTaskValid_WorkflowTask_Error() {
 	_, err := s.taskValidator.isTaskValid(s.task, taskType)
 	s.Error(err)
 }
+
+func (s *taskValidatorSuite) TestIsTaskPurged() {
+	s.task.Data.TypeName = "ChargeCard"
+	createTime := s.task.Data.CreateTime.AsTime()
+	after := timestamppb.New(createTime.Add(time.Second))
+	before := timestamppb.New(createTime.Add(-time.Second))
+
+	s.False(IsTaskPurged(s.task, nil))
+	s.True(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{{CreatedBefore: after}}))
+	s.False(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{{CreatedBefore: before}}))
+	s.True(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{{WorkflowIdPrefix: s.workflowID[:4], TypeName: "ChargeCard", CreatedBefore: after}}))
+	s.False(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{{WorkflowIdPrefix: "other", CreatedBefore: after}}))
+	s.False(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{{TypeName: "Refund", CreatedBefore: after}}))
+	s.True(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{
+		{TypeName: "Refund", CreatedBefore: after},
+		{WorkflowIdPrefix: s.workflowID, CreatedBefore: after},
+	}))
+
+	s.task.Data.CreateTime = nil
+	s.False(IsTaskPurged(s.task, []*taskqueuespb.TaskQueuePurgeFilter{{CreatedBefore: after}}))
+}
@@ -30,20 +30,15 @@ import (
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/updatesearchattribute"
+	"go.temporal.io/server/service/worker/visibilityreindex"
 	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Options(
-	migration.Module,
-	addsearchattributes.Module,
+	workerComponentModules,
 	resource.Module,
-	deletenamespace.Module,
-	scheduler.Module,
-	batcher.Module,
-	deployment.Module, // [cleanup-wv-pre-release]
-	workerdeployment.Module,
-	dlq.Module,
 	dynamicconfig.Module,
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
@@ -91,6 +86,21 @@ var Module = fx.Options(
 	fx.Invoke(ServiceLifetimeHooks),
 )
 
+// workerComponentModules provide the worker components whose workflows and activities are registered by the worker
+// manager.
+var workerComponentModules = fx.Options(
+	migration.Module,
+	addsearchattributes.Module,
+	deletenamespace.Module,
+	scheduler.Module,
+	batcher.Module,
+	deployment.Module, // [cleanup-wv-pre-release]
+	workerdeployment.Module,
+	dlq.Module,
+	visibilityreindex.Module,
+	updatesearchattribute.Module,
+)
+
 func ThrottledLoggerRpsFnProvider(serviceConfig *Config) resource.ThrottledLoggerRpsFn {
 	return func() float64 { return float64(serviceConfig.ThrottledLogRPS()) }
 }
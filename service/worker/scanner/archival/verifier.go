@@ -0,0 +1,425 @@
+package archival
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// Report summarizes the result of verifying archived executions
+	Report struct {
+		VerifiedCount  int
+		MissingCount   int
+		CorruptedCount int
+		ErrorCount     int
+		// Failures lists the missing and corrupted archives, capped at maxReportedFailures entries.
+		Failures []Failure
+		// NextPageTokens are the archived visibility page tokens the next run starts from, keyed by namespace ID.
+		// Namespaces whose archived executions were all verified start over from the first page.
+		NextPageTokens map[string][]byte
+	}
+
+	// Failure describes one archived execution which failed verification
+	Failure struct {
+		NamespaceID string
+		Namespace   string
+		WorkflowID  string
+		RunID       string
+		Reason      string
+	}
+
+	// VerifierHeartbeatDetails is the heartbeat detail for ArchivalVerifierActivity. Report only covers the
+	// namespaces before NamespaceIdx, so a retried activity verifies the namespace it stopped in from the start.
+	VerifierHeartbeatDetails struct {
+		NamespaceIdx           int
+		NamespaceNextPageToken []byte
+		Report                 Report
+	}
+
+	// Verifier is the type that holds the state for the archival integrity verifier
+	Verifier struct {
+		metadataManager  persistence.MetadataManager
+		archivalMetadata archiver.ArchivalMetadata
+		archiverProvider provider.ArchiverProvider
+		verifyCount      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		query            dynamicconfig.StringPropertyFnWithNamespaceFilter
+		pageTokens       map[string][]byte
+		rateLimiter      quotas.RateLimiter
+		metricsHandler   metrics.Handler
+		logger           log.Logger
+		isInTest         bool
+
+		hbd VerifierHeartbeatDetails
+	}
+
+	executionResult int
+)
+
+const (
+	executionVerified executionResult = iota
+	executionMissing
+	executionCorrupted
+
+	namespacePageSize   = 100
+	historyPageSize     = 100
+	maxReportedFailures = 1000
+)
+
+var errArchivedHistoryCorrupted = errors.New("archived history is corrupted")
+
+// NewVerifier returns an instance of the archival verifier.
+// Calling the Run() method results in one iteration over all namespaces which have history and
+// visibility archival URIs configured. For each namespace, the next page of archived visibility
+// records matching the query is read back and the archived history of every execution is verified
+//   - the history can be read through HistoryArchiver.Get
+//   - event IDs are continuous starting from the first event
+//   - the last event closes the workflow and carries the highest version of the history,
+//     and the history can be read with that version as the CloseFailoverVersion
+//
+// The page of a namespace is read with the page token the previous run reported, so consecutive runs
+// verify all the archived executions in turn instead of the same first page.
+func NewVerifier(
+	metadataManager persistence.MetadataManager,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
+	verifyCount dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	query dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	rps dynamicconfig.IntPropertyFn,
+	pageTokens map[string][]byte,
+	hbd VerifierHeartbeatDetails,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Verifier {
+	return &Verifier{
+		metadataManager:  metadataManager,
+		archivalMetadata: archivalMetadata,
+		archiverProvider: archiverProvider,
+		verifyCount:      verifyCount,
+		query:            query,
+		pageTokens:       pageTokens,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(rps()) },
+		),
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.ArchivalVerifierScope)),
+		logger:         logger,
+
+		hbd: hbd,
+	}
+}
+
+// Run runs the verifier and returns the report of the run
+func (v *Verifier) Run(ctx context.Context) (Report, error) {
+	if !v.archivalMetadata.GetHistoryConfig().ReadEnabled() || !v.archivalMetadata.GetVisibilityConfig().ReadEnabled() {
+		v.logger.Info("Archival verifier skipped because reading archived history or visibility is disabled")
+		return v.hbd.Report, nil
+	}
+
+	for {
+		resp, err := v.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       namespacePageSize,
+			NextPageToken:  v.hbd.NamespaceNextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return v.hbd.Report, err
+		}
+		for v.hbd.NamespaceIdx < len(resp.Namespaces) {
+			nsReport, err := v.verifyNamespace(ctx, resp.Namespaces[v.hbd.NamespaceIdx].Namespace)
+			if err != nil {
+				return v.hbd.Report, err
+			}
+			// the counts of a namespace are only added once it's done, together with advancing the index
+			v.hbd.Report.merge(nsReport)
+			v.hbd.NamespaceIdx++
+			v.heartbeat(ctx)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		v.hbd.NamespaceIdx = 0
+		v.hbd.NamespaceNextPageToken = resp.NextPageToken
+		v.heartbeat(ctx)
+	}
+
+	report := v.hbd.Report
+	v.logger.Info("Archival verifier finished",
+		tag.NewInt("verified", report.VerifiedCount),
+		tag.NewInt("missing", report.MissingCount),
+		tag.NewInt("corrupted", report.CorruptedCount),
+		tag.NewInt("errors", report.ErrorCount),
+	)
+	return report, nil
+}
+
+func (v *Verifier) verifyNamespace(
+	ctx context.Context,
+	detail *persistencespb.NamespaceDetail,
+) (Report, error) {
+	var report Report
+	nsID := detail.GetInfo().GetId()
+	nsName := detail.GetInfo().GetName()
+	historyURIString := detail.GetConfig().GetHistoryArchivalUri()
+	visibilityURIString := detail.GetConfig().GetVisibilityArchivalUri()
+	if historyURIString == "" || visibilityURIString == "" {
+		// archived executions can only be found from namespaces archiving both history and visibility
+		return report, nil
+	}
+	// the next run reads the same page again unless this one reads it
+	pageToken := v.pageTokens[nsID]
+	report.setNextPageToken(nsID, pageToken)
+	logger := log.With(v.logger, tag.WorkflowNamespace(nsName))
+	metricsHandler := v.metricsHandler.WithTags(metrics.NamespaceTag(nsName))
+
+	historyURI, err := archiver.NewURI(historyURIString)
+	if err != nil {
+		logger.Error("Failed to parse history archival URI", tag.ArchivalURI(historyURIString), tag.Error(err))
+		recordError(&report, metricsHandler)
+		return report, nil
+	}
+	visibilityURI, err := archiver.NewURI(visibilityURIString)
+	if err != nil {
+		logger.Error("Failed to parse visibility archival URI", tag.ArchivalURI(visibilityURIString), tag.Error(err))
+		recordError(&report, metricsHandler)
+		return report, nil
+	}
+	historyArchiver, err := v.archiverProvider.GetHistoryArchiver(historyURI.Scheme())
+	if err != nil {
+		logger.Error("Failed to get history archiver", tag.ArchivalURI(historyURIString), tag.Error(err))
+		recordError(&report, metricsHandler)
+		return report, nil
+	}
+	visibilityArchiver, err := v.archiverProvider.GetVisibilityArchiver(visibilityURI.Scheme())
+	if err != nil {
+		logger.Error("Failed to get visibility archiver", tag.ArchivalURI(visibilityURIString), tag.Error(err))
+		recordError(&report, metricsHandler)
+		return report, nil
+	}
+
+	resp, err := v.queryVisibility(ctx, visibilityArchiver, visibilityURI, nsID, nsName, pageToken)
+	if err != nil && len(pageToken) != 0 && ctx.Err() == nil {
+		// the page token of the previous run is invalid if the query changed since
+		logger.Warn("Failed to continue querying archived visibility records, starting over from the first page", tag.Error(err))
+		pageToken = nil
+		resp, err = v.queryVisibility(ctx, visibilityArchiver, visibilityURI, nsID, nsName, pageToken)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		logger.Error("Failed to query archived visibility records", tag.ArchivalURI(visibilityURIString), tag.Error(err))
+		recordError(&report, metricsHandler)
+		return report, nil
+	}
+	report.setNextPageToken(nsID, resp.NextPageToken)
+
+	for _, execution := range resp.Executions {
+		if err := v.rateLimiter.Wait(ctx); err != nil {
+			return report, err
+		}
+		v.heartbeat(ctx)
+
+		result, err := v.verifyExecution(ctx, historyArchiver, historyURI, nsID, execution)
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		executionLogger := log.With(logger,
+			tag.WorkflowID(execution.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetExecution().GetRunId()),
+			tag.ArchivalURI(historyURIString),
+		)
+		switch {
+		case err != nil && !errors.Is(err, errArchivedHistoryCorrupted):
+			executionLogger.Error("Failed to verify archived history", tag.Error(err))
+			recordError(&report, metricsHandler)
+		case result == executionMissing:
+			executionLogger.Warn("Archived history is missing")
+			metrics.ArchivalVerifierMissingCount.With(metricsHandler).Record(1)
+			report.MissingCount++
+			report.addFailure(nsID, nsName, execution, "archived history not found")
+		case result == executionCorrupted:
+			executionLogger.Warn("Archived history is corrupted", tag.Error(err))
+			metrics.ArchivalVerifierCorruptedCount.With(metricsHandler).Record(1)
+			report.CorruptedCount++
+			report.addFailure(nsID, nsName, execution, err.Error())
+		default:
+			metrics.ArchivalVerifierVerifiedCount.With(metricsHandler).Record(1)
+			report.VerifiedCount++
+		}
+	}
+	return report, nil
+}
+
+func (v *Verifier) queryVisibility(
+	ctx context.Context,
+	visibilityArchiver archiver.VisibilityArchiver,
+	URI archiver.URI,
+	namespaceID string,
+	namespaceName string,
+	pageToken []byte,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      v.verifyCount(namespaceName),
+		NextPageToken: pageToken,
+		Query:         v.query(namespaceName),
+	}, searchattribute.NameTypeMap{})
+}
+
+func (v *Verifier) verifyExecution(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	namespaceID string,
+	execution *workflowpb.WorkflowExecutionInfo,
+) (executionResult, error) {
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetExecution().GetWorkflowId(),
+		RunID:       execution.GetExecution().GetRunId(),
+		PageSize:    historyPageSize,
+	}
+
+	nextEventID := int64(1)
+	maxVersion := int64(0)
+	var lastEvent *historypb.HistoryEvent
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return executionMissing, nil
+			}
+			return executionVerified, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			for _, event := range batch.Events {
+				if event.GetEventId() != nextEventID {
+					return executionCorrupted, fmt.Errorf("%w: expected event ID %d, got %d", errArchivedHistoryCorrupted, nextEventID, event.GetEventId())
+				}
+				nextEventID++
+				maxVersion = max(maxVersion, event.GetVersion())
+				lastEvent = event
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if lastEvent == nil {
+		return executionCorrupted, fmt.Errorf("%w: archived history is empty", errArchivedHistoryCorrupted)
+	}
+	if !isWorkflowCloseEvent(lastEvent.GetEventType()) {
+		return executionCorrupted, fmt.Errorf("%w: last event %d is %v instead of a workflow close event", errArchivedHistoryCorrupted, lastEvent.GetEventId(), lastEvent.GetEventType())
+	}
+	if lastEvent.GetVersion() != maxVersion {
+		return executionCorrupted, fmt.Errorf("%w: close event version %d is lower than event version %d", errArchivedHistoryCorrupted, lastEvent.GetVersion(), maxVersion)
+	}
+	if historyLength := execution.GetHistoryLength(); historyLength != 0 && historyLength != lastEvent.GetEventId() {
+		return executionCorrupted, fmt.Errorf("%w: archived history has %d events, visibility record has %d", errArchivedHistoryCorrupted, lastEvent.GetEventId(), historyLength)
+	}
+
+	// the archived history must be addressable by its close failover version
+	closeFailoverVersion := lastEvent.GetVersion()
+	_, err := historyArchiver.Get(ctx, URI, &archiver.GetHistoryRequest{
+		NamespaceID:          namespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: &closeFailoverVersion,
+		PageSize:             1,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return executionCorrupted, fmt.Errorf("%w: archived history not found for close failover version %d", errArchivedHistoryCorrupted, closeFailoverVersion)
+		}
+		return executionVerified, err
+	}
+	return executionVerified, nil
+}
+
+func recordError(report *Report, metricsHandler metrics.Handler) {
+	metrics.ArchivalVerifierErrorCount.With(metricsHandler).Record(1)
+	report.ErrorCount++
+}
+
+func (r *Report) merge(other Report) {
+	r.VerifiedCount += other.VerifiedCount
+	r.MissingCount += other.MissingCount
+	r.CorruptedCount += other.CorruptedCount
+	r.ErrorCount += other.ErrorCount
+	r.Failures = append(r.Failures, other.Failures[:min(len(other.Failures), maxReportedFailures-len(r.Failures))]...)
+	for namespaceID, token := range other.NextPageTokens {
+		r.setNextPageToken(namespaceID, token)
+	}
+}
+
+func (r *Report) setNextPageToken(namespaceID string, token []byte) {
+	if len(token) == 0 {
+		delete(r.NextPageTokens, namespaceID)
+		return
+	}
+	if r.NextPageTokens == nil {
+		r.NextPageTokens = make(map[string][]byte)
+	}
+	r.NextPageTokens[namespaceID] = token
+}
+
+func (r *Report) addFailure(
+	namespaceID string,
+	namespaceName string,
+	execution *workflowpb.WorkflowExecutionInfo,
+	reason string,
+) {
+	if len(r.Failures) >= maxReportedFailures {
+		return
+	}
+	r.Failures = append(r.Failures, Failure{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		WorkflowID:  execution.GetExecution().GetWorkflowId(),
+		RunID:       execution.GetExecution().GetRunId(),
+		Reason:      reason,
+	})
+}
+
+func (v *Verifier) heartbeat(ctx context.Context) {
+	if !v.isInTest {
+		activity.RecordHeartbeat(ctx, v.hbd)
+	}
+}
+
+func isWorkflowCloseEvent(eventType enumspb.EventType) bool {
+	switch eventType {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,290 @@
+package archival
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testNamespaceID   = "test-namespace-id"
+	testNamespace     = "test-namespace"
+	testHistoryURI    = "test:///history/archival"
+	testVisibilityURI = "test:///visibility/archival"
+	testScheme        = "test"
+)
+
+type (
+	verifierSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockMetadataManager    *persistence.MockMetadataManager
+		mockArchivalMetadata   archiver.MetadataMock
+		mockArchiverProvider   *provider.MockArchiverProvider
+		mockHistoryArchiver    *archiver.MockHistoryArchiver
+		mockVisibilityArchiver *archiver.MockVisibilityArchiver
+
+		verifier *Verifier
+	}
+)
+
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, new(verifierSuite))
+}
+
+func (s *verifierSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataManager = persistence.NewMockMetadataManager(s.controller)
+	s.mockArchivalMetadata = archiver.NewMetadataMock(s.controller)
+	s.mockArchiverProvider = provider.NewMockArchiverProvider(s.controller)
+	s.mockHistoryArchiver = archiver.NewMockHistoryArchiver(s.controller)
+	s.mockVisibilityArchiver = archiver.NewMockVisibilityArchiver(s.controller)
+
+	s.verifier = NewVerifier(
+		s.mockMetadataManager,
+		s.mockArchivalMetadata,
+		s.mockArchiverProvider,
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
+		dynamicconfig.GetStringPropertyFnFilteredByNamespace(""),
+		dynamicconfig.GetIntPropertyFn(1000),
+		nil,
+		VerifierHeartbeatDetails{},
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+	s.verifier.isInTest = true
+}
+
+func (s *verifierSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *verifierSuite) TestRun_ArchivalReadDisabled() {
+	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewDisabledArchvialConfig())
+
+	report, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(Report{}, report)
+}
+
+func (s *verifierSuite) TestRun() {
+	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(s.enabledArchivalConfig())
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(s.enabledArchivalConfig())
+	s.mockMetadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: s.namespaceDetail("no-archival-id", "no-archival", "", "")},
+			{Namespace: s.namespaceDetail(testNamespaceID, testNamespace, testHistoryURI, testVisibilityURI)},
+		},
+	}, nil)
+	s.mockArchiverProvider.EXPECT().GetHistoryArchiver(testScheme).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.EXPECT().GetVisibilityArchiver(testScheme).Return(s.mockVisibilityArchiver, nil)
+
+	s.mockVisibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.QueryVisibilityRequest, _ any) (*archiver.QueryVisibilityResponse, error) {
+			s.Equal(testNamespaceID, request.NamespaceID)
+			s.Equal(10, request.PageSize)
+			return &archiver.QueryVisibilityResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{
+					s.executionInfo("valid", 4),
+					s.executionInfo("missing", 0),
+					s.executionInfo("gap", 0),
+					s.executionInfo("not-closed", 0),
+					s.executionInfo("length-mismatch", 10),
+					s.executionInfo("error", 0),
+				},
+			}, nil
+		})
+
+	valid := s.history(1, 4, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED)
+	gap := s.history(1, 4, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED)
+	gap[1].Events[0].EventId = 5
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+			switch request.WorkflowID {
+			case "valid":
+				if request.CloseFailoverVersion != nil {
+					s.Equal(int64(1), *request.CloseFailoverVersion)
+					return &archiver.GetHistoryResponse{HistoryBatches: valid[:1]}, nil
+				}
+				// two pages
+				if request.NextPageToken == nil {
+					return &archiver.GetHistoryResponse{HistoryBatches: valid[:1], NextPageToken: []byte("next")}, nil
+				}
+				return &archiver.GetHistoryResponse{HistoryBatches: valid[1:]}, nil
+			case "missing":
+				return nil, serviceerror.NewNotFound("archived history not found")
+			case "gap":
+				return &archiver.GetHistoryResponse{HistoryBatches: gap}, nil
+			case "not-closed":
+				return &archiver.GetHistoryResponse{HistoryBatches: s.history(1, 3, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED)}, nil
+			case "length-mismatch":
+				return &archiver.GetHistoryResponse{HistoryBatches: s.history(1, 4, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED)}, nil
+			default:
+				return nil, serviceerror.NewUnavailable("archive unavailable")
+			}
+		}).AnyTimes()
+
+	report, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, report.VerifiedCount)
+	s.Equal(1, report.MissingCount)
+	s.Equal(3, report.CorruptedCount)
+	s.Equal(1, report.ErrorCount)
+	s.Len(report.Failures, 4)
+	var failedWorkflowIDs []string
+	for _, failure := range report.Failures {
+		s.Equal(testNamespaceID, failure.NamespaceID)
+		s.Equal(testNamespace, failure.Namespace)
+		s.NotEmpty(failure.Reason)
+		failedWorkflowIDs = append(failedWorkflowIDs, failure.WorkflowID)
+	}
+	s.Equal([]string{"missing", "gap", "not-closed", "length-mismatch"}, failedWorkflowIDs)
+}
+
+func (s *verifierSuite) TestRun_ContinuesFromPageToken() {
+	s.verifier.pageTokens = map[string][]byte{testNamespaceID: []byte("page-2"), "other-namespace-id": []byte("page-5")}
+	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(s.enabledArchivalConfig()).Times(2)
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(s.enabledArchivalConfig()).Times(2)
+	s.mockMetadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: s.namespaceDetail(testNamespaceID, testNamespace, testHistoryURI, testVisibilityURI)},
+		},
+	}, nil).Times(2)
+	s.mockArchiverProvider.EXPECT().GetHistoryArchiver(testScheme).Return(s.mockHistoryArchiver, nil).Times(2)
+	s.mockArchiverProvider.EXPECT().GetVisibilityArchiver(testScheme).Return(s.mockVisibilityArchiver, nil).Times(2)
+
+	s.mockVisibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.QueryVisibilityRequest, _ any) (*archiver.QueryVisibilityResponse, error) {
+			s.Equal([]byte("page-2"), request.NextPageToken)
+			return &archiver.QueryVisibilityResponse{NextPageToken: []byte("page-3")}, nil
+		})
+	report, err := s.verifier.Run(context.Background())
+	s.NoError(err)
+	// the page tokens of deleted namespaces or namespaces without archival are dropped
+	s.Equal(map[string][]byte{testNamespaceID: []byte("page-3")}, report.NextPageTokens)
+
+	// an invalid page token starts over from the first page
+	s.verifier.pageTokens = map[string][]byte{testNamespaceID: []byte("invalid")}
+	s.verifier.hbd = VerifierHeartbeatDetails{}
+	s.mockVisibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewInvalidArgument("invalid next page token"))
+	s.mockVisibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.QueryVisibilityRequest, _ any) (*archiver.QueryVisibilityResponse, error) {
+			s.Nil(request.NextPageToken)
+			return &archiver.QueryVisibilityResponse{}, nil
+		})
+	report, err = s.verifier.Run(context.Background())
+	s.NoError(err)
+	s.Equal(0, report.ErrorCount)
+	s.Empty(report.NextPageTokens)
+}
+
+func (s *verifierSuite) TestRun_InterruptedNamespaceNotCounted() {
+	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(s.enabledArchivalConfig())
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(s.enabledArchivalConfig())
+	s.mockMetadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: s.namespaceDetail(testNamespaceID, testNamespace, testHistoryURI, testVisibilityURI)},
+		},
+	}, nil)
+	s.mockArchiverProvider.EXPECT().GetHistoryArchiver(testScheme).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.EXPECT().GetVisibilityArchiver(testScheme).Return(s.mockVisibilityArchiver, nil)
+	s.mockVisibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			s.executionInfo("missing", 0),
+			s.executionInfo("interrupted", 0),
+		},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("archived history not found"))
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, archiver.URI, *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+			cancel()
+			return nil, context.Canceled
+		})
+
+	// the namespace is verified again from the start on retry, so none of its counts are reported yet
+	report, err := s.verifier.Run(ctx)
+	s.ErrorIs(err, context.Canceled)
+	s.Equal(Report{}, report)
+	s.Equal(0, s.verifier.hbd.NamespaceIdx)
+	s.Equal(Report{}, s.verifier.hbd.Report)
+}
+
+func (s *verifierSuite) TestVerifyExecution_CloseVersion() {
+	historyURI, err := archiver.NewURI(testHistoryURI)
+	s.NoError(err)
+
+	batches := s.history(1, 4, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED)
+	batches[0].Events[1].Version = 2
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&archiver.GetHistoryResponse{HistoryBatches: batches}, nil)
+	result, err := s.verifier.verifyExecution(context.Background(), s.mockHistoryArchiver, historyURI, testNamespaceID, s.executionInfo("wid", 0))
+	s.ErrorIs(err, errArchivedHistoryCorrupted)
+	s.Equal(executionCorrupted, result)
+
+	batches = s.history(1, 4, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED)
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&archiver.GetHistoryResponse{HistoryBatches: batches}, nil)
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	result, err = s.verifier.verifyExecution(context.Background(), s.mockHistoryArchiver, historyURI, testNamespaceID, s.executionInfo("wid", 0))
+	s.ErrorIs(err, errArchivedHistoryCorrupted)
+	s.Equal(executionCorrupted, result)
+}
+
+func (s *verifierSuite) enabledArchivalConfig() archiver.ArchivalConfig {
+	return archiver.NewArchivalConfig(
+		"enabled",
+		dynamicconfig.GetStringPropertyFn("enabled"),
+		dynamicconfig.GetBoolPropertyFn(true),
+		"enabled",
+		"",
+	)
+}
+
+func (s *verifierSuite) namespaceDetail(id string, name string, historyURI string, visibilityURI string) *persistencespb.NamespaceDetail {
+	return &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Id: id, Name: name},
+		Config: &persistencespb.NamespaceConfig{
+			HistoryArchivalUri:    historyURI,
+			VisibilityArchivalUri: visibilityURI,
+		},
+	}
+}
+
+func (s *verifierSuite) executionInfo(workflowID string, historyLength int64) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution:     &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+		HistoryLength: historyLength,
+	}
+}
+
+// history returns two batches with events from firstEventID to lastEventID, the last event is of the given type.
+func (s *verifierSuite) history(firstEventID int64, lastEventID int64, lastEventType enumspb.EventType) []*historypb.History {
+	var events []*historypb.HistoryEvent
+	for eventID := firstEventID; eventID <= lastEventID; eventID++ {
+		events = append(events, &historypb.HistoryEvent{
+			EventId:   eventID,
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		})
+	}
+	events[len(events)-1].EventType = lastEventType
+	mid := len(events) / 2
+	return []*historypb.History{{Events: events[:mid]}, {Events: events[mid:]}}
+}
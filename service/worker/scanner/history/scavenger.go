@@ -34,6 +34,18 @@ type (
 		CurrentPage  int
 
 		NextPageToken []byte
+
+		// OrphanBranches is the report of the orphan history branches found so far, by namespace ID
+		OrphanBranches map[string]OrphanBranchReport
+	}
+
+	// OrphanBranchReport is the number and size of the orphan history branches of a namespace.
+	// A history branch is orphan if the mutable state of its workflow execution exists, but none of
+	// its version histories references the branch.
+	OrphanBranchReport struct {
+		Count        int
+		SizeBytes    int64
+		DeletedCount int
 	}
 
 	// Scavenger is the type that holds the state for history scavenger daemon
@@ -52,6 +64,7 @@ type (
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		deleteOrphanBranches        dynamicconfig.BoolPropertyFn
 
 		sync.WaitGroup
 		sync.Mutex
@@ -63,6 +76,7 @@ type (
 		namespaceID string
 		workflowID  string
 		runID       string
+		branchInfo  *persistencespb.HistoryBranch
 		branchToken []byte
 	}
 )
@@ -79,6 +93,8 @@ const (
 // each branch, the scavenger will attempt
 //   - describe the corresponding workflow execution
 //   - deletion of history itself, if there are no workflow execution
+//   - report, and optionally deletion, of the branch if the workflow execution
+//     exists but doesn't reference the branch anymore
 func NewScavenger(
 	numShards int32,
 	db persistence.ExecutionManager,
@@ -90,6 +106,7 @@ func NewScavenger(
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	deleteOrphanBranches dynamicconfig.BoolPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Scavenger {
@@ -106,6 +123,7 @@ func NewScavenger(
 		historyDataMinAge:           historyDataMinAge,
 		executionDataDurationBuffer: executionDataDurationBuffer,
 		enableRetentionVerification: enableRetentionVerification,
+		deleteOrphanBranches:        deleteOrphanBranches,
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryScavengerScope)),
 		logger:                      logger,
 
@@ -127,6 +145,14 @@ func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error)
 
 	s.Lock()
 	defer s.Unlock()
+	for namespaceID, report := range s.hbd.OrphanBranches {
+		s.logger.Info("found orphan history branches",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.Counter(report.Count),
+			tag.NewInt64("size-bytes", report.SizeBytes),
+			tag.NewInt("deleted-count", report.DeletedCount),
+		)
+	}
 	return s.hbd, nil
 }
 
@@ -242,6 +268,7 @@ func (s *Scavenger) filterTask(
 		namespaceID: namespaceID,
 		workflowID:  workflowID,
 		runID:       runID,
+		branchInfo:  branch.BranchInfo,
 		branchToken: branchToken.Data,
 	}
 }
@@ -261,6 +288,9 @@ func (s *Scavenger) handleTask(
 	})
 	switch err.(type) {
 	case nil:
+		if err := s.handleOrphanBranch(ctx, task, ms.GetDatabaseMutableState()); err != nil {
+			return err
+		}
 		if s.enableRetentionVerification() {
 			return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
 		}
@@ -285,6 +315,120 @@ func (s *Scavenger) handleTask(
 	return err
 }
 
+// handleOrphanBranch reports the branch if it's an orphan of the mutable state, and deletes it if enabled.
+func (s *Scavenger) handleOrphanBranch(
+	ctx context.Context,
+	task taskDetail,
+	mutableState *persistencespb.WorkflowMutableState,
+) error {
+	orphan, err := s.isOrphanBranch(task.branchInfo, mutableState)
+	if err != nil {
+		s.logger.Error("unable to parse the version histories of the mutable state", getTaskLoggingTags(err, task)...)
+		return err
+	}
+	if !orphan {
+		return nil
+	}
+
+	size, err := s.branchSize(ctx, task)
+	if err != nil {
+		s.logger.Error("encountered error when reading orphan history branch", getTaskLoggingTags(err, task)...)
+		return err
+	}
+	handler := s.metricsHandler.WithTags(metrics.NamespaceIDTag(task.namespaceID))
+	metrics.HistoryScavengerOrphanBranchCount.With(handler).Record(1)
+	metrics.HistoryScavengerOrphanBranchSize.With(handler).Record(size)
+	s.logger.Info("found orphan history branch", append(getTaskLoggingTags(nil, task), tag.NewInt64("size-bytes", size))...)
+
+	deleted := false
+	if s.deleteOrphanBranches() {
+		err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     task.shardID,
+			BranchToken: task.branchToken,
+		})
+		if err != nil {
+			s.logger.Error("encountered error when deleting orphan history branch", getTaskLoggingTags(err, task)...)
+		} else {
+			metrics.HistoryScavengerOrphanBranchDeleteCount.With(handler).Record(1)
+			s.logger.Info("deleted orphan history branch", getTaskLoggingTags(nil, task)...)
+			deleted = true
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.hbd.OrphanBranches == nil {
+		s.hbd.OrphanBranches = make(map[string]OrphanBranchReport)
+	}
+	report := s.hbd.OrphanBranches[task.namespaceID]
+	report.Count++
+	report.SizeBytes += size
+	if deleted {
+		report.DeletedCount++
+	}
+	s.hbd.OrphanBranches[task.namespaceID] = report
+	return err
+}
+
+// isOrphanBranch returns true if none of the version histories of the mutable state is on the branch or
+// forked from it. A mutable state without version histories doesn't make any branch orphan.
+func (s *Scavenger) isOrphanBranch(
+	branch *persistencespb.HistoryBranch,
+	mutableState *persistencespb.WorkflowMutableState,
+) (bool, error) {
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories().GetHistories()
+	if len(versionHistories) == 0 {
+		return false, nil
+	}
+	for _, versionHistory := range versionHistories {
+		referenced, err := s.db.GetHistoryBranchUtil().ParseHistoryBranchInfo(versionHistory.GetBranchToken())
+		if err != nil {
+			return false, err
+		}
+		if referenced.GetBranchId() == branch.GetBranchId() {
+			return false, nil
+		}
+		for _, ancestor := range referenced.GetAncestors() {
+			if ancestor.GetBranchId() == branch.GetBranchId() {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// branchSize returns the size of the history nodes of the branch itself, excluding the nodes of its ancestors.
+func (s *Scavenger) branchSize(
+	ctx context.Context,
+	task taskDetail,
+) (int64, error) {
+	var size int64
+	var pageToken []byte
+	for {
+		resp, err := s.db.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       task.shardID,
+			BranchToken:   task.branchToken,
+			MinEventID:    persistence.GetBeginNodeID(task.branchInfo),
+			MaxEventID:    common.EndEventID,
+			PageSize:      pageSize,
+			NextPageToken: pageToken,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			// the branch has no nodes of its own
+			return size, nil
+		default:
+			return 0, err
+		}
+		size += int64(resp.Size)
+		if len(resp.NextPageToken) == 0 {
+			return size, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
 func (s *Scavenger) handleErr(
 	err error,
 ) {
@@ -13,6 +13,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -100,6 +101,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 		dataAge,
 		executionDataAge,
 		enableRetentionVerification,
+		dynamicconfig.GetBoolPropertyFn(false),
 		s.metricHandler,
 		s.logger,
 	)
@@ -722,3 +724,88 @@ func (s *ScavengerTestSuite) TestDeleteWorkflowAfterRetention() {
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
+
+func (s *ScavengerTestSuite) TestOrphanBranches() {
+	s.runOrphanBranchesTest(false)
+}
+
+func (s *ScavengerTestSuite) TestDeletingOrphanBranches() {
+	s.scavenger.deleteOrphanBranches = dynamicconfig.GetBoolPropertyFn(true)
+	s.runOrphanBranchesTest(true)
+}
+
+func (s *ScavengerTestSuite) runOrphanBranchesTest(deleteOrphans bool) {
+	forkTime := timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2)
+	info := persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1")
+	// branch1 is the root, branch2 is forked from it and is the current branch, branch3 is forked from branch1 but
+	// isn't referenced by the mutable state
+	ancestors := []*persistencespb.HistoryBranchRange{{BranchId: branchID1, BeginNodeId: 1, EndNodeId: 5}}
+	branch1 := &persistencespb.HistoryBranch{TreeId: treeID1, BranchId: branchID1}
+	branch2 := &persistencespb.HistoryBranch{TreeId: treeID1, BranchId: branchID2, Ancestors: ancestors}
+	branch3 := &persistencespb.HistoryBranch{TreeId: treeID1, BranchId: branchID3, Ancestors: ancestors}
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), protomock.Eq(&persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	})).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{BranchInfo: branch1, ForkTime: forkTime, Info: info},
+			{BranchInfo: branch2, ForkTime: forkTime, Info: info},
+			{BranchInfo: branch3, ForkTime: forkTime, Info: info},
+		},
+	}, nil)
+
+	currentBranchToken, err := s.historyBranchUtil.NewHistoryBranch(uuid.New(), uuid.New(), uuid.New(), treeID1, &branchID2, ancestors, 0, 0, 0)
+	s.Nil(err)
+	ms := &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				LastUpdateTime: timestamppb.New(time.Now()),
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{BranchToken: currentBranchToken}},
+				},
+			},
+		},
+	}
+	s.mockRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(namespace.NewNamespaceForTest(
+		nil,
+		&persistencespb.NamespaceConfig{Retention: durationpb.New(time.Hour)},
+		false,
+		nil,
+		0,
+	), nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(ms, nil).Times(3)
+	s.mockExecutionManager.EXPECT().GetHistoryBranchUtil().Return(&s.historyBranchUtil).AnyTimes()
+
+	branchToken3, err := s.historyBranchUtil.NewHistoryBranch(uuid.New(), uuid.New(), uuid.New(), treeID1, &branchID3, ancestors, 0, 0, 0)
+	s.Nil(err)
+	shardID := common.WorkflowIDToHistoryShard("namespaceID1", "workflowID1", s.numShards)
+	s.mockExecutionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), protomock.Eq(&persistence.ReadHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: branchToken3,
+		MinEventID:  5,
+		MaxEventID:  common.EndEventID,
+		PageSize:    pageSize,
+	})).Return(&persistence.ReadRawHistoryBranchResponse{Size: 100, NextPageToken: []byte("page1")}, nil)
+	s.mockExecutionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), protomock.Eq(&persistence.ReadHistoryBranchRequest{
+		ShardID:       shardID,
+		BranchToken:   branchToken3,
+		MinEventID:    5,
+		MaxEventID:    common.EndEventID,
+		PageSize:      pageSize,
+		NextPageToken: []byte("page1"),
+	})).Return(&persistence.ReadRawHistoryBranchResponse{Size: 20}, nil)
+	expectedReport := OrphanBranchReport{Count: 1, SizeBytes: 120}
+	if deleteOrphans {
+		s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), protomock.Eq(&persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardID,
+			BranchToken: branchToken3,
+		})).Return(nil)
+		expectedReport.DeletedCount = 1
+	}
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(0, hbd.SkipCount)
+	s.Equal(3, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(map[string]OrphanBranchReport{"namespaceID1": expectedReport}, hbd.OrphanBranches)
+}
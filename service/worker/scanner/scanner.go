@@ -14,6 +14,8 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -54,6 +56,8 @@ type (
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
 		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		// HistoryScannerDeleteOrphanBranches indicates if the history scavenger deletes orphan history branches
+		HistoryScannerDeleteOrphanBranches dynamicconfig.BoolPropertyFn
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -70,6 +74,15 @@ type (
 		RemovableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn
 		// BuildIdScavengerVisibilityRPS is the rate limit for visibility calls from the build ID scavenger
 		BuildIdScavengerVisibilityRPS dynamicconfig.FloatPropertyFn
+
+		// ArchivalScannerEnabled indicates if archival scanner should be started as part of scanner
+		ArchivalScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalScannerVerifyCount is the number of archived executions verified per namespace, from the next page of ArchivalScannerQuery
+		ArchivalScannerVerifyCount dynamicconfig.IntPropertyFnWithNamespaceFilter
+		// ArchivalScannerQuery is the archived visibility query used to select the archived executions to verify
+		ArchivalScannerQuery dynamicconfig.StringPropertyFnWithNamespaceFilter
+		// ArchivalScannerRPS the max rate of archive reads from archival scanner
+		ArchivalScannerRPS dynamicconfig.IntPropertyFn
 	}
 
 	// scannerContext is the context object that gets
@@ -87,6 +100,8 @@ type (
 		matchingClient     matchingservice.MatchingServiceClient
 		adminClient        adminservice.AdminServiceClient
 		namespaceRegistry  namespace.Registry
+		archivalMetadata   archiver.ArchivalMetadata
+		archiverProvider   provider.ArchiverProvider
 		currentClusterName string
 		hostInfo           membership.HostInfo
 	}
@@ -119,6 +134,8 @@ func New(
 	adminClient adminservice.AdminServiceClient,
 	matchingClient matchingservice.MatchingServiceClient,
 	registry namespace.Registry,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 	currentClusterName string,
 	hostInfo membership.HostInfo,
 ) *Scanner {
@@ -136,6 +153,8 @@ func New(
 			matchingClient:     matchingClient,
 			adminClient:        adminClient,
 			namespaceRegistry:  registry,
+			archivalMetadata:   archivalMetadata,
+			archiverProvider:   archiverProvider,
 			currentClusterName: currentClusterName,
 			hostInfo:           hostInfo,
 		},
@@ -177,6 +196,12 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.ArchivalScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, archivalScannerWFStartOptions, archivalScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, archivalScannerTaskQueueName)
+	}
+
 	if s.context.cfg.BuildIdScavengerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, build_ids.BuildIdScavengerWFStartOptions, build_ids.BuildIdScavangerWorkflowName)
@@ -210,9 +235,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(ArchivalScannerWorkflow, workflow.RegisterOptions{Name: archivalScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(ArchivalVerifierActivity, activity.RegisterOptions{Name: archivalVerifierActivityName})
 
 		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
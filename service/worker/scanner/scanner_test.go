@@ -52,6 +52,10 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
 	}
+	archivalScanner := expectedScanner{
+		WFTypeName:    archivalScannerWFTypeName,
+		TaskQueueName: archivalScannerTaskQueueName,
+	}
 
 	type testCase struct {
 		Name                     string
@@ -59,6 +63,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
 		BuildIdScavengerEnabled  bool
+		ArchivalScannerEnabled   bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -154,14 +159,25 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                     "ArchivalScanner",
+			ExecutionsScannerEnabled: false,
+			TaskQueueScannerEnabled:  false,
+			HistoryScannerEnabled:    false,
+			BuildIdScavengerEnabled:  false,
+			ArchivalScannerEnabled:   true,
+			DefaultStore:             config.StoreTypeNoSQL,
+			ExpectedScanners:         []expectedScanner{archivalScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
 			TaskQueueScannerEnabled:  true,
 			HistoryScannerEnabled:    true,
 			BuildIdScavengerEnabled:  true,
+			ArchivalScannerEnabled:   true,
 			DefaultStore:             config.StoreTypeSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, buildIdScavenger},
+			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, buildIdScavenger, archivalScanner},
 		},
 	} {
 		s.Run(c.Name, func() {
@@ -181,6 +197,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					ArchivalScannerEnabled:                 dynamicconfig.GetBoolPropertyFn(c.ArchivalScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -202,6 +219,8 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				mockAdminClient,
 				nil,
 				mockNamespaceRegistry,
+				nil,
+				nil,
 				"active-cluster",
 				membership.NewHostInfoFromAddress("localhost"),
 			)
@@ -260,6 +279,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			ArchivalScannerEnabled:                 dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -278,6 +298,8 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		mockAdminClient,
 		nil,
 		mockNamespaceRegistry,
+		nil,
+		nil,
 		"active-cluster",
 		membership.NewHostInfoFromAddress("localhost"),
 	)
@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/backoff"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/matching"
 )
 
 var (
@@ -37,9 +39,28 @@ func (s *Scavenger) completeTasks(
 	return n, err
 }
 
+func (s *Scavenger) completeTasksInRange(
+	ctx context.Context,
+	key *p.TaskQueueKey,
+	inclusiveMinTaskID int64,
+	exclusiveMaxTaskID int64,
+) error {
+	return s.retryForever(func() error {
+		_, err := s.db.CompleteTasksInRange(ctx, &p.CompleteTasksInRangeRequest{
+			NamespaceID:        key.NamespaceID,
+			TaskQueueName:      key.TaskQueueName,
+			TaskType:           key.TaskQueueType,
+			InclusiveMinTaskID: inclusiveMinTaskID,
+			ExclusiveMaxTaskID: exclusiveMaxTaskID,
+		})
+		return err
+	})
+}
+
 func (s *Scavenger) getTasks(
 	ctx context.Context,
 	key *p.TaskQueueKey,
+	minTaskID int64,
 	batchSize int,
 ) (*p.GetTasksResponse, error) {
 	var err error
@@ -49,7 +70,7 @@ func (s *Scavenger) getTasks(
 			NamespaceID:        key.NamespaceID,
 			TaskQueue:          key.TaskQueueName,
 			TaskType:           key.TaskQueueType,
-			InclusiveMinTaskID: 0, // get the first N tasks sorted by taskID
+			InclusiveMinTaskID: minTaskID, // get the first N tasks from minTaskID sorted by taskID
 			ExclusiveMaxTaskID: math.MaxInt64,
 			PageSize:           batchSize,
 		})
@@ -58,6 +79,30 @@ func (s *Scavenger) getTasks(
 	return resp, err
 }
 
+// getPurgeFilters returns the purge filters of the task queue type from the user data of its task queue family.
+func (s *Scavenger) getPurgeFilters(
+	ctx context.Context,
+	key *p.TaskQueueKey,
+) ([]*taskqueuespb.TaskQueuePurgeFilter, error) {
+	var err error
+	var resp *p.GetTaskQueueUserDataResponse
+	err = s.retryForever(func() error {
+		resp, err = s.db.GetTaskQueueUserData(ctx, &p.GetTaskQueueUserDataRequest{
+			NamespaceID: key.NamespaceID,
+			TaskQueue:   matching.TaskQueueFamilyNameFromPersistenceName(key.TaskQueueName),
+		})
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// task queues without user data were never purged
+			resp, err = nil, nil
+		}
+		return err
+	})
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.UserData.GetData().GetPerType()[int32(key.TaskQueueType)].GetPurgeFilters(), nil
+}
+
 func (s *Scavenger) listTaskQueue(
 	ctx context.Context,
 	pageSize int,
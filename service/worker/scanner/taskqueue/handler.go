@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/log/tag"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/matching"
@@ -30,20 +31,24 @@ const scannerTaskQueuePrefix = "temporal-sys-tl-scanner"
 // Each loop of the handler proceeds as follows
 //   - Retrieve the next batch of tasks sorted by task_id for this task queue from persistence
 //   - If there are 0 tasks for this task queue, try deleting the task queue if its idle
-//   - If any of the tasks in the batch isn't expired, we are done. Since tasks are retrieved
+//   - If any of the tasks in the batch isn't expired or purged, we are done. Since tasks are retrieved
 //     in sorted order, if one of the tasks isn't expired, chances are, none of the tasks above
-//     it are expired as well - so, we give up and wait for the next run
+//     it are expired as well - so, we give up and wait for the next run. If the task queue was purged
+//     though, the purged tasks above it are deleted by deletePurgedTasks
 //   - Delete the entire batch of tasks
 //   - If the number of tasks retrieved is less than batchSize, there are no more tasks in the task queue
 //     Try deleting the task queue if its idle
 func (s *Scavenger) deleteHandler(key *p.TaskQueueKey, state *taskQueueState) handlerStatus {
 	var err error
 	var nProcessed, nDeleted int
+	// purge filters are loaded only when a task isn't expired, most task queues are never purged
+	var purgeFilters []*taskqueuespb.TaskQueuePurgeFilter
+	var purgeFiltersLoaded bool
 
 	defer func() { s.deleteHandlerLog(key, state, nProcessed, nDeleted, err) }()
 
 	for nProcessed < maxTasksPerJob {
-		resp, err1 := s.getTasks(s.lifecycleCtx, key, taskBatchSize)
+		resp, err1 := s.getTasks(s.lifecycleCtx, key, 0, taskBatchSize)
 		if err1 != nil {
 			err = err1
 			return handlerStatusErr
@@ -57,8 +62,24 @@ func (s *Scavenger) deleteHandler(key *p.TaskQueueKey, state *taskQueueState) ha
 
 		for _, task := range resp.Tasks {
 			nProcessed++
-			if !matching.IsTaskExpired(task) {
-				return handlerStatusDone
+			if matching.IsTaskExpired(task) {
+				continue
+			}
+			if !purgeFiltersLoaded {
+				if purgeFilters, err = s.getPurgeFilters(s.lifecycleCtx, key); err != nil {
+					return handlerStatusErr
+				}
+				purgeFiltersLoaded = true
+			}
+			if !matching.IsTaskPurged(task, purgeFilters) {
+				if len(purgeFilters) == 0 {
+					return handlerStatusDone
+				}
+				status, nScanned, nPurged, err1 := s.deletePurgedTasks(key, state, task.GetTaskId(), purgeFilters, maxTasksPerJob-nProcessed)
+				nProcessed += nScanned
+				nDeleted += nPurged
+				err = err1
+				return status
 			}
 		}
 
@@ -77,6 +98,72 @@ func (s *Scavenger) deleteHandler(key *p.TaskQueueKey, state *taskQueueState) ha
 	return handlerStatusDefer
 }
 
+// deletePurgedTasks deletes the purged tasks after a task that can't be deleted yet, which CompleteTasksLessThan
+// can't reach. Otherwise, they would be dispatched once the purge filters matching them expire. Runs of purged or
+// expired tasks with consecutive task IDs are deleted together. A gap in the task IDs ends the run: matching may
+// still be writing the task queue, and a task ID that was allocated but not written yet when the page was read must
+// not be deleted. At most limit tasks are scanned, the scan continues from where it stopped when the job is deferred.
+func (s *Scavenger) deletePurgedTasks(
+	key *p.TaskQueueKey,
+	state *taskQueueState,
+	minTaskID int64,
+	purgeFilters []*taskqueuespb.TaskQueuePurgeFilter,
+	limit int,
+) (status handlerStatus, nProcessed int, nDeleted int, err error) {
+	state.purgeScanLevel = max(state.purgeScanLevel, minTaskID)
+
+	for nProcessed < limit {
+		resp, err1 := s.getTasks(s.lifecycleCtx, key, state.purgeScanLevel, taskBatchSize)
+		if err1 != nil {
+			return handlerStatusErr, nProcessed, nDeleted, err1
+		}
+
+		var runStart, runEnd int64
+		var runLength int
+		deleteRun := func() error {
+			if runLength == 0 {
+				return nil
+			}
+			if err := s.completeTasksInRange(s.lifecycleCtx, key, runStart, runEnd); err != nil {
+				return err
+			}
+			nDeleted += runLength
+			runLength = 0
+			return nil
+		}
+		for _, task := range resp.Tasks {
+			nProcessed++
+			if matching.IsTaskExpired(task) || matching.IsTaskPurged(task, purgeFilters) {
+				if runLength > 0 && task.GetTaskId() != runEnd {
+					if err := deleteRun(); err != nil {
+						return handlerStatusErr, nProcessed, nDeleted, err
+					}
+				}
+				if runLength == 0 {
+					runStart = task.GetTaskId()
+				}
+				runEnd = task.GetTaskId() + 1
+				runLength++
+				continue
+			}
+			if err := deleteRun(); err != nil {
+				return handlerStatusErr, nProcessed, nDeleted, err
+			}
+		}
+		if err := deleteRun(); err != nil {
+			return handlerStatusErr, nProcessed, nDeleted, err
+		}
+
+		if len(resp.Tasks) < taskBatchSize {
+			state.purgeScanLevel = 0
+			return handlerStatusDone, nProcessed, nDeleted, nil
+		}
+		state.purgeScanLevel = resp.Tasks[len(resp.Tasks)-1].GetTaskId() + 1
+	}
+
+	return handlerStatusDefer, nProcessed, nDeleted, nil
+}
+
 func (s *Scavenger) tryDeleteTaskQueue(key *p.TaskQueueKey, state *taskQueueState) {
 	if strings.HasPrefix(key.TaskQueueName, scannerTaskQueuePrefix) {
 		return // avoid deleting our own task queue
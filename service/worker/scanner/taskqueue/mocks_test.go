@@ -114,10 +114,30 @@ func (tbl *mockTaskTable) generate(count int, expired bool) {
 }
 
 func (tbl *mockTaskTable) get(count int) []*persistencespb.AllocatedTaskInfo {
-	if len(tbl.tasks) >= count {
-		return tbl.tasks[:count]
+	return tbl.getFrom(0, count)
+}
+
+func (tbl *mockTaskTable) getFrom(minID int64, count int) []*persistencespb.AllocatedTaskInfo {
+	tasks := tbl.tasks
+	for len(tasks) > 0 && tasks[0].GetTaskId() < minID {
+		tasks = tasks[1:]
+	}
+	if len(tasks) >= count {
+		return tasks[:count]
 	}
-	return tbl.tasks[:]
+	return tasks
+}
+
+func (tbl *mockTaskTable) deleteInRange(minID int64, maxID int64) int {
+	var kept []*persistencespb.AllocatedTaskInfo
+	for _, t := range tbl.tasks {
+		if t.GetTaskId() < minID || t.GetTaskId() >= maxID {
+			kept = append(kept, t)
+		}
+	}
+	count := len(tbl.tasks) - len(kept)
+	tbl.tasks = kept
+	return count
 }
 
 func (tbl *mockTaskTable) deleteLessThan(id int64, limit int) int {
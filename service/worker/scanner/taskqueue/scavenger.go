@@ -34,6 +34,8 @@ type (
 	taskQueueState struct {
 		rangeID     int64
 		lastUpdated time.Time
+		// task id from which the scan for purged tasks continues when a job is deferred
+		purgeScanLevel int64
 	}
 
 	stats struct {
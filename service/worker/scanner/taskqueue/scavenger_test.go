@@ -7,11 +7,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -23,6 +29,7 @@ type (
 
 		taskQueueTable *mockTaskQueueTable
 		taskTables     map[string]*mockTaskTable
+		userData       map[string]*persistencespb.TaskQueueUserData
 		scvgr          *Scavenger
 	}
 )
@@ -38,6 +45,7 @@ func (s *ScavengerTestSuite) SetupTest() {
 	s.taskMgr = p.NewMockTaskManager(s.controller)
 	s.taskQueueTable = &mockTaskQueueTable{}
 	s.taskTables = make(map[string]*mockTaskTable)
+	s.userData = make(map[string]*persistencespb.TaskQueueUserData)
 	logger := log.NewTestLogger()
 	s.scvgr = NewScavenger(s.taskMgr, metrics.NoopMetricsHandler, logger)
 	maxTasksPerJob = 4
@@ -127,6 +135,124 @@ func (s *ScavengerTestSuite) TestAliveTasksFollowedByExpired() {
 	}
 }
 
+func (s *ScavengerTestSuite) TestPurgedTasksFollowedByAlive() {
+	nTasks := 32
+	nTaskQueues := 3
+	for i := 0; i < nTaskQueues; i++ {
+		name := fmt.Sprintf("test-purged-tq-%v", i)
+		s.taskQueueTable.generate(name, true)
+		tt := newMockTaskTable()
+		tt.generate(nTasks/2, false)
+		purgedWorkflowID := tt.workflowID
+		tt.workflowID = uuid.New()
+		tt.generate(nTasks/2, false)
+		s.taskTables[name] = tt
+		s.userData[name] = &persistencespb.TaskQueueUserData{
+			PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+				int32(enumspb.TASK_QUEUE_TYPE_UNSPECIFIED): {
+					PurgeFilters: []*taskqueuespb.TaskQueuePurgeFilter{{
+						WorkflowIdPrefix: purgedWorkflowID,
+						CreatedBefore:    timestamppb.New(time.Now().Add(time.Second)),
+						PurgeTime:        timestamppb.New(time.Now().Add(time.Second)),
+					}},
+				},
+			},
+		}
+	}
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	for tl, tbl := range s.taskTables {
+		tasks := tbl.get(100)
+		s.Equal(nTasks/2, len(tasks), "scavenger deleted non-purged tasks")
+		s.Equal(int64(nTasks/2), tasks[0].GetTaskId(), "scavenger deleted wrong set of tasks")
+		s.NotNil(s.taskQueueTable.get(tl), "scavenger deleted a non-expired executorTask queue")
+	}
+}
+
+func (s *ScavengerTestSuite) TestPurgedTasksBetweenAlive() {
+	nTasks := 32
+	nTaskQueues := 3
+	for i := 0; i < nTaskQueues; i++ {
+		name := fmt.Sprintf("test-purged-between-tq-%v", i)
+		s.taskQueueTable.generate(name, true)
+		tt := newMockTaskTable()
+		tt.generate(nTasks/4, false)
+		aliveWorkflowID := tt.workflowID
+		tt.workflowID = uuid.New()
+		purgedWorkflowID := tt.workflowID
+		tt.generate(nTasks/2, false)
+		tt.workflowID = aliveWorkflowID
+		tt.generate(nTasks/4, false)
+		s.taskTables[name] = tt
+		s.userData[name] = &persistencespb.TaskQueueUserData{
+			PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+				int32(enumspb.TASK_QUEUE_TYPE_UNSPECIFIED): {
+					PurgeFilters: []*taskqueuespb.TaskQueuePurgeFilter{{
+						WorkflowIdPrefix: purgedWorkflowID,
+						CreatedBefore:    timestamppb.New(time.Now().Add(time.Second)),
+						PurgeTime:        timestamppb.New(time.Now().Add(time.Second)),
+					}},
+				},
+			},
+		}
+	}
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	for tl, tbl := range s.taskTables {
+		tasks := tbl.get(100)
+		s.Equal(nTasks/2, len(tasks), "scavenger didn't delete the purged tasks after the alive tasks")
+		for _, task := range tasks {
+			s.True(task.GetTaskId() < int64(nTasks/4) || task.GetTaskId() >= int64(3*nTasks/4), "scavenger deleted wrong set of tasks")
+		}
+		s.NotNil(s.taskQueueTable.get(tl), "scavenger deleted a non-expired executorTask queue")
+	}
+}
+
+func (s *ScavengerTestSuite) TestPurgedTasksWithTaskIDGaps() {
+	nTasks := 32
+	name := "test-purged-gaps-tq"
+	s.taskQueueTable.generate(name, true)
+	tt := newMockTaskTable()
+	tt.generate(nTasks/4, false)
+	aliveWorkflowID := tt.workflowID
+	tt.workflowID = uuid.New()
+	purgedWorkflowID := tt.workflowID
+	for i := 0; i < nTasks/2; i++ {
+		tt.generate(1, false)
+		// task IDs allocated by matching but not written yet
+		tt.nextTaskID += int64(i % 2)
+	}
+	tt.workflowID = aliveWorkflowID
+	tt.generate(nTasks/4, false)
+	s.taskTables[name] = tt
+	seenTaskIDs := make(map[int64]struct{})
+	for _, task := range tt.get(100) {
+		seenTaskIDs[task.GetTaskId()] = struct{}{}
+	}
+	s.userData[name] = &persistencespb.TaskQueueUserData{
+		PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+			int32(enumspb.TASK_QUEUE_TYPE_UNSPECIFIED): {
+				PurgeFilters: []*taskqueuespb.TaskQueuePurgeFilter{{
+					WorkflowIdPrefix: purgedWorkflowID,
+					CreatedBefore:    timestamppb.New(time.Now().Add(time.Second)),
+					PurgeTime:        timestamppb.New(time.Now().Add(time.Second)),
+				}},
+			},
+		},
+	}
+	s.taskMgr.EXPECT().CompleteTasksInRange(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *p.CompleteTasksInRangeRequest) (int, error) {
+			for taskID := req.InclusiveMinTaskID; taskID < req.ExclusiveMaxTaskID; taskID++ {
+				_, ok := seenTaskIDs[taskID]
+				s.True(ok, "scavenger deleted a task ID it didn't see")
+			}
+			return s.taskTables[req.TaskQueueName].deleteInRange(req.InclusiveMinTaskID, req.ExclusiveMaxTaskID), nil
+		}).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	s.Equal(nTasks/2, len(tt.get(100)), "scavenger didn't delete the purged tasks after the alive tasks")
+}
+
 func (s *ScavengerTestSuite) TestAllExpiredTasksWithErrors() {
 	nTasks := 32
 	nTaskQueues := 3
@@ -172,13 +298,25 @@ func (s *ScavengerTestSuite) setupTaskMgrMocks() {
 		}).AnyTimes()
 	s.taskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *p.GetTasksRequest) (*p.GetTasksResponse, error) {
-			result := s.taskTables[req.TaskQueue].get(req.PageSize)
+			result := s.taskTables[req.TaskQueue].getFrom(req.InclusiveMinTaskID, req.PageSize)
 			return &p.GetTasksResponse{Tasks: result}, nil
 		}).AnyTimes()
 	s.taskMgr.EXPECT().CompleteTasksLessThan(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *p.CompleteTasksLessThanRequest) (int, error) {
 			return s.taskTables[req.TaskQueueName].deleteLessThan(req.ExclusiveMaxTaskID, req.Limit), nil
 		}).AnyTimes()
+	s.taskMgr.EXPECT().CompleteTasksInRange(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *p.CompleteTasksInRangeRequest) (int, error) {
+			return s.taskTables[req.TaskQueueName].deleteInRange(req.InclusiveMinTaskID, req.ExclusiveMaxTaskID), nil
+		}).AnyTimes()
+	s.taskMgr.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *p.GetTaskQueueUserDataRequest) (*p.GetTaskQueueUserDataResponse, error) {
+			data, ok := s.userData[req.TaskQueue]
+			if !ok {
+				return nil, serviceerror.NewNotFound("task queue user data not found")
+			}
+			return &p.GetTaskQueueUserDataResponse{UserData: &persistencespb.VersionedTaskQueueUserData{Data: data}}, nil
+		}).AnyTimes()
 }
 
 func (s *ScavengerTestSuite) setupTaskMgrMocksWithErrors() {
@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/worker/scanner/archival"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
@@ -32,6 +33,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	archivalScannerWFID          = "temporal-sys-archival-scanner"
+	archivalScannerWFTypeName    = "temporal-sys-archival-scanner-workflow"
+	archivalScannerTaskQueueName = "temporal-sys-archival-scanner-taskqueue-0"
+	archivalVerifierActivityName = "temporal-sys-archival-scanner-verify-activity"
 )
 
 type (
@@ -73,6 +79,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	archivalScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    archivalScannerWFID,
+		TaskQueue:             archivalScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 0 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -104,6 +116,25 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// ArchivalScannerWorkflow is the workflow that runs the archival scanner background daemon.
+// The result of the workflow is the report of the verified archives. Each run continues from the
+// archived visibility page tokens reported by the previous run.
+func ArchivalScannerWorkflow(
+	ctx workflow.Context,
+) (archival.Report, error) {
+	var lastReport archival.Report
+	if workflow.HasLastCompletionResult(ctx) {
+		if err := workflow.GetLastCompletionResult(ctx, &lastReport); err != nil {
+			workflow.GetLogger(ctx).Warn("Failed to get the report of the previous run, verifying from the first pages", tag.Error(err))
+		}
+	}
+
+	var report archival.Report
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), archivalVerifierActivityName, lastReport.NextPageTokens)
+	err := future.Get(ctx, &report)
+	return report, err
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -131,6 +162,7 @@ func HistoryScavengerActivity(
 		ctx.cfg.HistoryScannerDataMinAge,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.HistoryScannerVerifyRetention,
+		ctx.cfg.HistoryScannerDeleteOrphanBranches,
 		ctx.metricsHandler,
 		ctx.logger,
 	)
@@ -191,3 +223,32 @@ func ExecutionsScavengerActivity(
 	}
 	return nil
 }
+
+// ArchivalVerifierActivity is the activity that runs archival verifier
+func ArchivalVerifierActivity(
+	activityCtx context.Context,
+	pageTokens map[string][]byte,
+) (archival.Report, error) {
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	hbd := archival.VerifierHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	verifier := archival.NewVerifier(
+		ctx.metadataManager,
+		ctx.archivalMetadata,
+		ctx.archiverProvider,
+		ctx.cfg.ArchivalScannerVerifyCount,
+		ctx.cfg.ArchivalScannerQuery,
+		ctx.cfg.ArchivalScannerRPS,
+		pageTokens,
+		hbd,
+		ctx.metricsHandler,
+		ctx.logger,
+	)
+	return verifier.Run(activityCtx)
+}
@@ -7,6 +7,8 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -45,6 +47,8 @@ type (
 		namespaceRegistry      namespace.Registry
 		workerServiceResolver  membership.ServiceResolver
 		visibilityManager      manager.VisibilityManager
+		archivalMetadata       archiver.ArchivalMetadata
+		archiverProvider       provider.ArchiverProvider
 
 		namespaceReplicationQueue persistence.NamespaceReplicationQueue
 
@@ -114,6 +118,8 @@ func NewService(
 	visibilityManager manager.VisibilityManager,
 	matchingClient resource.MatchingClient,
 	namespaceReplicationTaskExecutor nsreplication.TaskExecutor,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(primitives.WorkerService)
 	if err != nil {
@@ -139,6 +145,8 @@ func NewService(
 		taskManager:               taskManager,
 		historyClient:             historyClient,
 		visibilityManager:         visibilityManager,
+		archivalMetadata:          archivalMetadata,
+		archiverProvider:          archiverProvider,
 
 		workerManager:                    workerManager,
 		perNamespaceWorkerManager:        perNamespaceWorkerManager,
@@ -178,6 +186,7 @@ func NewConfig(
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
+			HistoryScannerDeleteOrphanBranches:      dynamicconfig.HistoryScannerDeleteOrphanBranches.Get(dc),
 			ExecutionScannerPerHostQPS:              dynamicconfig.ExecutionScannerPerHostQPS.Get(dc),
 			ExecutionScannerPerShardQPS:             dynamicconfig.ExecutionScannerPerShardQPS.Get(dc),
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
@@ -185,6 +194,10 @@ func NewConfig(
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
+			ArchivalScannerEnabled:                  dynamicconfig.ArchivalScannerEnabled.Get(dc),
+			ArchivalScannerVerifyCount:              dynamicconfig.ArchivalScannerVerifyCount.Get(dc),
+			ArchivalScannerQuery:                    dynamicconfig.ArchivalScannerQuery.Get(dc),
+			ArchivalScannerRPS:                      dynamicconfig.ArchivalScannerRPS.Get(dc),
 		},
 		BatcherRPS:                           dynamicconfig.BatcherRPS.Get(dc),
 		BatcherConcurrency:                   dynamicconfig.BatcherConcurrency.Get(dc),
@@ -305,6 +318,8 @@ func (s *Service) initScanner() error {
 		adminClient,
 		s.matchingClient,
 		s.namespaceRegistry,
+		s.archivalMetadata,
+		s.archiverProvider,
 		currentCluster,
 		s.hostInfo,
 	)
@@ -0,0 +1,321 @@
+package updatesearchattribute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+)
+
+type (
+	localActivities struct {
+		persistenceConfig             *config.Persistence
+		metadataManager               persistence.MetadataManager
+		saManager                     searchattribute.Manager
+		namespaceCacheRefreshInterval dynamicconfig.DurationPropertyFn
+		logger                        log.Logger
+	}
+
+	allocateFieldRequest struct {
+		NamespaceID      namespace.ID
+		Name             string
+		NewName          string
+		NewType          enumspb.IndexedValueType
+		Drop             bool
+		VisibilityStores []VisibilityStore
+	}
+
+	allocateFieldResult struct {
+		SourceField string
+		// TargetField is empty if the search attribute is dropped.
+		TargetField string
+	}
+
+	swapAliasRequest struct {
+		NamespaceID namespace.ID
+		SourceField string
+		TargetField string
+		NewName     string
+		// Elasticsearch search attributes are not aliases, only the source field is dropped.
+		Elasticsearch bool
+	}
+)
+
+func newInvalidArgumentError(format string, args ...any) error {
+	return temporal.NewNonRetryableApplicationError(
+		"InvalidArgument: "+fmt.Sprintf(format, args...), errTypeInvalidArgument, nil)
+}
+
+func (a *localActivities) GetNamespaceCacheRefreshInterval(_ context.Context) (time.Duration, error) {
+	return a.namespaceCacheRefreshInterval(), nil
+}
+
+// GetVisibilityStores returns the configured visibility stores, the primary one first. The secondary index of an
+// Elasticsearch store is returned as another store with the same name. Custom search attributes can only be updated
+// if all the visibility stores are SQL stores, or if all of them are Elasticsearch compatible.
+func (a *localActivities) GetVisibilityStores(_ context.Context) ([]VisibilityStore, error) {
+	secondaryStoreName := a.persistenceConfig.SecondaryVisibilityStore
+	secondaryStore := a.persistenceConfig.GetSecondaryVisibilityStoreConfig()
+	if secondaryStoreName == "" && secondaryStore.Elasticsearch != nil {
+		secondaryStoreName = a.persistenceConfig.VisibilityStore
+	}
+
+	var stores []VisibilityStore
+	for _, store := range []struct {
+		name string
+		ds   config.DataStore
+	}{
+		{name: a.persistenceConfig.VisibilityStore, ds: a.persistenceConfig.GetVisibilityStoreConfig()},
+		{name: secondaryStoreName, ds: secondaryStore},
+	} {
+		if store.name == "" {
+			continue
+		}
+		elasticsearch := store.ds.Elasticsearch != nil || store.ds.OpenSearch != nil
+		if store.ds.SQL == nil && !elasticsearch {
+			return nil, newInvalidArgumentError("visibility store %s is neither a SQL nor an Elasticsearch store", store.name)
+		}
+		if len(stores) > 0 && stores[0].Elasticsearch != elasticsearch {
+			return nil, newInvalidArgumentError("visibility stores %s and %s are of different kinds", stores[0].Name, store.name)
+		}
+		stores = append(stores, VisibilityStore{
+			Name:          store.name,
+			IndexName:     store.ds.GetIndexName(),
+			Elasticsearch: elasticsearch,
+		})
+	}
+	return stores, nil
+}
+
+// AllocateField records the migration of the field the search attribute is an alias of to a free field of the new
+// type. Search attributes of Elasticsearch are fields themselves, they are migrated to the field with the new name,
+// which must be registered with the new type already. It returns the fields of the migration already recorded for the
+// search attribute, if any, so it can be retried.
+func (a *localActivities) AllocateField(ctx context.Context, request *allocateFieldRequest) (*allocateFieldResult, error) {
+	elasticsearch := isElasticsearch(request.VisibilityStores)
+	var result *allocateFieldResult
+	err := a.updateNamespaceConfig(ctx, request.NamespaceID, func(config *persistencespb.NamespaceConfig) error {
+		sourceField, ok := util.InverseMap(config.GetCustomSearchAttributeAliases())[request.Name]
+		if elasticsearch {
+			sourceField, ok = request.Name, true
+		}
+		if !ok {
+			return newInvalidArgumentError("search attribute %s doesn't exist", request.Name)
+		}
+		if migration, ok := config.GetCustomSearchAttributeFieldMigrations()[sourceField]; ok {
+			if migration.GetTargetField() == "" && request.Drop ||
+				migration.GetTargetField() != "" && !request.Drop && migration.GetTargetType() == request.NewType &&
+					(!elasticsearch || migration.GetTargetField() == request.NewName) {
+				result = &allocateFieldResult{SourceField: sourceField, TargetField: migration.GetTargetField()}
+				return errNoUpdate
+			}
+			return newInvalidArgumentError("search attribute %s is already migrated", request.Name)
+		}
+
+		sourceType, err := a.getFieldType(request.VisibilityStores, sourceField)
+		if err != nil {
+			return err
+		}
+		migration := &persistencespb.CustomSearchAttributeFieldMigration{
+			SourceType: sourceType,
+		}
+		switch {
+		case request.Drop:
+			migration.SourceDropped = true
+		case elasticsearch:
+			if request.NewName == request.Name {
+				return newInvalidArgumentError("search attribute %s can only be updated with a new name", request.Name)
+			}
+			if searchattribute.IsMigrationField(request.NewName, config.GetCustomSearchAttributeFieldMigrations()) {
+				return newInvalidArgumentError("search attribute %s is already migrated", request.NewName)
+			}
+			targetType, err := a.getFieldType(request.VisibilityStores, request.NewName)
+			if err != nil {
+				return err
+			}
+			if targetType != request.NewType {
+				return newInvalidArgumentError("search attribute %s already exists with type %v", request.NewName, targetType)
+			}
+			migration.TargetField = request.NewName
+			migration.TargetType = request.NewType
+		default:
+			if request.NewType == sourceType {
+				return newInvalidArgumentError("search attribute %s is already of type %v", request.Name, sourceType)
+			}
+			targetField, err := a.findFreeField(config, request.VisibilityStores, request.NewType)
+			if err != nil {
+				return err
+			}
+			migration.TargetField = targetField
+			migration.TargetType = request.NewType
+		}
+
+		if config.CustomSearchAttributeFieldMigrations == nil {
+			config.CustomSearchAttributeFieldMigrations = make(map[string]*persistencespb.CustomSearchAttributeFieldMigration)
+		}
+		config.CustomSearchAttributeFieldMigrations[sourceField] = migration
+		result = &allocateFieldResult{SourceField: sourceField, TargetField: migration.TargetField}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.logger.Info("Search attribute field migration recorded.",
+		tag.WorkflowNamespaceID(request.NamespaceID.String()),
+		tag.NewStringTag("search-attribute", request.Name),
+		tag.NewStringTag("source-field", result.SourceField),
+		tag.NewStringTag("target-field", result.TargetField))
+	return result, nil
+}
+
+// SwapAlias makes the search attribute an alias of the target field, and drops the source field. The search attribute
+// is removed if there is no target field.
+func (a *localActivities) SwapAlias(ctx context.Context, request *swapAliasRequest) error {
+	return a.updateNamespaceConfig(ctx, request.NamespaceID, func(config *persistencespb.NamespaceConfig) error {
+		migration, ok := config.GetCustomSearchAttributeFieldMigrations()[request.SourceField]
+		if !ok || migration.GetTargetField() != request.TargetField {
+			return newInvalidArgumentError("migration of field %s was removed", request.SourceField)
+		}
+		if request.Elasticsearch {
+			if migration.GetSourceDropped() {
+				return errNoUpdate
+			}
+			migration.SourceDropped = true
+			return nil
+		}
+		aliases := config.GetCustomSearchAttributeAliases()
+		if request.TargetField != "" && aliases[request.TargetField] == request.NewName {
+			// Already swapped.
+			return errNoUpdate
+		}
+		if request.TargetField == "" && aliases[request.SourceField] == "" {
+			// Already removed.
+			return errNoUpdate
+		}
+		if field, ok := util.InverseMap(aliases)[request.NewName]; ok && field != request.SourceField {
+			return newInvalidArgumentError("search attribute %s already exists", request.NewName)
+		}
+
+		delete(aliases, request.SourceField)
+		if request.TargetField != "" {
+			aliases[request.TargetField] = request.NewName
+		}
+		migration.SourceDropped = true
+		return nil
+	})
+}
+
+func isElasticsearch(stores []VisibilityStore) bool {
+	return len(stores) > 0 && stores[0].Elasticsearch
+}
+
+// errNoUpdate is returned by the update function of updateNamespaceConfig to skip the update.
+var errNoUpdate = errors.New("no update")
+
+func (a *localActivities) updateNamespaceConfig(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	update func(config *persistencespb.NamespaceConfig) error,
+) error {
+	metadata, err := a.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: namespaceID.String()})
+	if err != nil {
+		var notFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &notFound) {
+			return newInvalidArgumentError("namespace %s not found", namespaceID)
+		}
+		return err
+	}
+
+	existingNamespace := resp.Namespace
+	config := existingNamespace.Config
+	if err := update(config); err != nil {
+		if errors.Is(err, errNoUpdate) {
+			return nil
+		}
+		return err
+	}
+
+	return a.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      config,
+			ReplicationConfig:           existingNamespace.ReplicationConfig,
+			ConfigVersion:               existingNamespace.ConfigVersion + 1,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
+
+// getFieldType returns the type the field is registered with in the cluster metadata of the visibility stores.
+func (a *localActivities) getFieldType(stores []VisibilityStore, field string) (enumspb.IndexedValueType, error) {
+	for _, store := range stores {
+		searchAttributes, err := a.saManager.GetSearchAttributes(store.IndexName, true)
+		if err != nil {
+			return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, err
+		}
+		if fieldType, ok := searchAttributes.Custom()[field]; ok {
+			return fieldType, nil
+		}
+	}
+	return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, newInvalidArgumentError("field %s is not registered in cluster metadata", field)
+}
+
+// findFreeField returns the first pre-allocated field of the given type which is registered in the cluster metadata
+// of all the visibility stores, and which is neither an alias nor a migration field of the namespace.
+func (a *localActivities) findFreeField(
+	config *persistencespb.NamespaceConfig,
+	stores []VisibilityStore,
+	fieldType enumspb.IndexedValueType,
+) (string, error) {
+	customSearchAttributes := make([]map[string]enumspb.IndexedValueType, 0, len(stores))
+	for _, store := range stores {
+		searchAttributes, err := a.saManager.GetSearchAttributes(store.IndexName, true)
+		if err != nil {
+			return "", err
+		}
+		customSearchAttributes = append(customSearchAttributes, searchAttributes.Custom())
+	}
+
+	dbCustomSearchAttributes := searchattribute.GetSqlDbIndexSearchAttributes().CustomSearchAttributes
+FieldLoop:
+	for _, field := range slices.Sorted(maps.Keys(dbCustomSearchAttributes)) {
+		if dbCustomSearchAttributes[field] != fieldType {
+			continue
+		}
+		if _, ok := config.GetCustomSearchAttributeAliases()[field]; ok {
+			continue
+		}
+		if searchattribute.IsMigrationField(field, config.GetCustomSearchAttributeFieldMigrations()) {
+			continue
+		}
+		for _, custom := range customSearchAttributes {
+			if custom[field] != fieldType {
+				continue FieldLoop
+			}
+		}
+		return field, nil
+	}
+	return "", newInvalidArgumentError("no field of type %v is available", fieldType)
+}
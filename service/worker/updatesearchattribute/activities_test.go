@@ -0,0 +1,354 @@
+package updatesearchattribute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+type activitiesSuite struct {
+	suite.Suite
+
+	controller          *gomock.Controller
+	mockMetadataManager *persistence.MockMetadataManager
+	mockSAManager       *searchattribute.MockManager
+
+	la *localActivities
+}
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataManager = persistence.NewMockMetadataManager(s.controller)
+	s.mockSAManager = searchattribute.NewMockManager(s.controller)
+
+	s.mockSAManager.EXPECT().GetSearchAttributes(gomock.Any(), true).Return(
+		searchattribute.NewNameTypeMapStub(map[string]enumspb.IndexedValueType{
+			"Keyword01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"Int01":     enumspb.INDEXED_VALUE_TYPE_INT,
+			"Int02":     enumspb.INDEXED_VALUE_TYPE_INT,
+			"Int03":     enumspb.INDEXED_VALUE_TYPE_INT,
+		}),
+		nil,
+	).AnyTimes()
+
+	s.la = &localActivities{
+		metadataManager:               s.mockMetadataManager,
+		saManager:                     s.mockSAManager,
+		namespaceCacheRefreshInterval: dynamicconfig.GetDurationPropertyFn(0),
+		logger:                        log.NewTestLogger(),
+	}
+}
+
+func (s *activitiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activitiesSuite) expectGetNamespace(config *persistencespb.NamespaceConfig) {
+	s.mockMetadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockMetadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: "namespace-id"}).Return(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info:          &persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"},
+				Config:        config,
+				ConfigVersion: 3,
+			},
+		},
+		nil,
+	)
+}
+
+func (s *activitiesSuite) expectUpdateNamespace() *persistencespb.NamespaceConfig {
+	var config persistencespb.NamespaceConfig
+	s.mockMetadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.EqualValues(4, request.Namespace.ConfigVersion)
+			s.EqualValues(7, request.NotificationVersion)
+			config.CustomSearchAttributeAliases = request.Namespace.Config.CustomSearchAttributeAliases
+			config.CustomSearchAttributeFieldMigrations = request.Namespace.Config.CustomSearchAttributeFieldMigrations
+			return nil
+		})
+	return &config
+}
+
+func (s *activitiesSuite) TestAllocateField() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{
+			"Keyword01": "MyAttribute",
+			"Int01":     "OtherAttribute",
+		},
+		CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Int02": {SourceType: enumspb.INDEXED_VALUE_TYPE_INT, SourceDropped: true},
+		},
+	})
+	updated := s.expectUpdateNamespace()
+
+	result, err := s.la.AllocateField(context.Background(), &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "MyAttribute",
+		NewType:          enumspb.INDEXED_VALUE_TYPE_INT,
+		VisibilityStores: []VisibilityStore{{Name: "sql-visibility", IndexName: "temporal_visibility"}},
+	})
+	s.NoError(err)
+	s.Equal(&allocateFieldResult{SourceField: "Keyword01", TargetField: "Int03"}, result)
+	s.Equal(&persistencespb.CustomSearchAttributeFieldMigration{
+		TargetField: "Int03",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+	}, updated.CustomSearchAttributeFieldMigrations["Keyword01"])
+	s.Equal("MyAttribute", updated.CustomSearchAttributeAliases["Keyword01"])
+}
+
+func (s *activitiesSuite) TestAllocateField_Retried() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+		CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Keyword01": {
+				TargetField: "Int01",
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+			},
+		},
+	})
+
+	result, err := s.la.AllocateField(context.Background(), &allocateFieldRequest{
+		NamespaceID: "namespace-id",
+		Name:        "MyAttribute",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	s.NoError(err)
+	s.Equal(&allocateFieldResult{SourceField: "Keyword01", TargetField: "Int01"}, result)
+}
+
+func (s *activitiesSuite) TestAllocateField_Drop() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+	})
+	updated := s.expectUpdateNamespace()
+
+	result, err := s.la.AllocateField(context.Background(), &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "MyAttribute",
+		Drop:             true,
+		VisibilityStores: []VisibilityStore{{Name: "sql-visibility", IndexName: "temporal_visibility"}},
+	})
+	s.NoError(err)
+	s.Equal(&allocateFieldResult{SourceField: "Keyword01"}, result)
+	s.Equal(&persistencespb.CustomSearchAttributeFieldMigration{
+		SourceType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		SourceDropped: true,
+	}, updated.CustomSearchAttributeFieldMigrations["Keyword01"])
+}
+
+func (s *activitiesSuite) TestAllocateField_Elasticsearch() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{})
+	updated := s.expectUpdateNamespace()
+
+	result, err := s.la.AllocateField(context.Background(), &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "Keyword01",
+		NewName:          "Int01",
+		NewType:          enumspb.INDEXED_VALUE_TYPE_INT,
+		VisibilityStores: []VisibilityStore{{Name: "es-visibility", IndexName: "temporal_visibility_v1", Elasticsearch: true}},
+	})
+	s.NoError(err)
+	s.Equal(&allocateFieldResult{SourceField: "Keyword01", TargetField: "Int01"}, result)
+	s.Equal(&persistencespb.CustomSearchAttributeFieldMigration{
+		TargetField: "Int01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+	}, updated.CustomSearchAttributeFieldMigrations["Keyword01"])
+	s.Empty(updated.CustomSearchAttributeAliases)
+
+	// The field with the new name must be registered with the new type.
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{})
+	_, err = s.la.AllocateField(context.Background(), &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "Keyword01",
+		NewName:          "Int01",
+		NewType:          enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		VisibilityStores: []VisibilityStore{{Name: "es-visibility", IndexName: "temporal_visibility_v1", Elasticsearch: true}},
+	})
+	var appErr *temporal.ApplicationError
+	s.ErrorAs(err, &appErr)
+	s.Equal(errTypeInvalidArgument, appErr.Type())
+}
+
+func (s *activitiesSuite) TestAllocateField_InvalidArgument() {
+	testCases := []struct {
+		name    string
+		config  *persistencespb.NamespaceConfig
+		newType enumspb.IndexedValueType
+	}{
+		{
+			name:    "search attribute not found",
+			config:  &persistencespb.NamespaceConfig{},
+			newType: enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+		{
+			name: "same type",
+			config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+			},
+			newType: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+		{
+			name: "no free field",
+			config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+			},
+			newType: enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.expectGetNamespace(tc.config)
+			_, err := s.la.AllocateField(context.Background(), &allocateFieldRequest{
+				NamespaceID:      "namespace-id",
+				Name:             "MyAttribute",
+				NewType:          tc.newType,
+				VisibilityStores: []VisibilityStore{{Name: "sql-visibility", IndexName: "temporal_visibility"}},
+			})
+			var appErr *temporal.ApplicationError
+			s.ErrorAs(err, &appErr)
+			s.Equal(errTypeInvalidArgument, appErr.Type())
+		})
+	}
+}
+
+func (s *activitiesSuite) TestSwapAlias() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{"Keyword01": "MyAttribute"},
+		CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Keyword01": {
+				TargetField: "Int01",
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+			},
+		},
+	})
+	updated := s.expectUpdateNamespace()
+
+	err := s.la.SwapAlias(context.Background(), &swapAliasRequest{
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		TargetField: "Int01",
+		NewName:     "MyIntAttribute",
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"Int01": "MyIntAttribute"}, updated.CustomSearchAttributeAliases)
+	s.True(updated.CustomSearchAttributeFieldMigrations["Keyword01"].SourceDropped)
+
+	// Retry doesn't update the namespace again.
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases:         updated.CustomSearchAttributeAliases,
+		CustomSearchAttributeFieldMigrations: updated.CustomSearchAttributeFieldMigrations,
+	})
+	err = s.la.SwapAlias(context.Background(), &swapAliasRequest{
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		TargetField: "Int01",
+		NewName:     "MyIntAttribute",
+	})
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) TestSwapAlias_Drop() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeAliases: map[string]string{
+			"Keyword01": "MyAttribute",
+			"Int01":     "OtherAttribute",
+		},
+		CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Keyword01": {SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, SourceDropped: true},
+		},
+	})
+	updated := s.expectUpdateNamespace()
+
+	err := s.la.SwapAlias(context.Background(), &swapAliasRequest{
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		NewName:     "MyAttribute",
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"Int01": "OtherAttribute"}, updated.CustomSearchAttributeAliases)
+}
+
+func (s *activitiesSuite) TestSwapAlias_Elasticsearch() {
+	s.expectGetNamespace(&persistencespb.NamespaceConfig{
+		CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+			"Keyword01": {
+				TargetField: "Int01",
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+			},
+		},
+	})
+	updated := s.expectUpdateNamespace()
+
+	err := s.la.SwapAlias(context.Background(), &swapAliasRequest{
+		NamespaceID:   "namespace-id",
+		SourceField:   "Keyword01",
+		TargetField:   "Int01",
+		NewName:       "Int01",
+		Elasticsearch: true,
+	})
+	s.NoError(err)
+	s.Empty(updated.CustomSearchAttributeAliases)
+	s.True(updated.CustomSearchAttributeFieldMigrations["Keyword01"].SourceDropped)
+}
+
+func (s *activitiesSuite) TestGetVisibilityStores() {
+	s.la.persistenceConfig = &config.Persistence{
+		VisibilityStore:          "sql-visibility",
+		SecondaryVisibilityStore: "es-visibility",
+		DataStores: map[string]config.DataStore{
+			"sql-visibility": {SQL: &config.SQL{DatabaseName: "temporal_visibility"}},
+			"es-visibility":  {Elasticsearch: &esclient.Config{}},
+		},
+	}
+	_, err := s.la.GetVisibilityStores(context.Background())
+	var appErr *temporal.ApplicationError
+	s.ErrorAs(err, &appErr)
+	s.Equal(errTypeInvalidArgument, appErr.Type())
+
+	s.la.persistenceConfig.SecondaryVisibilityStore = ""
+	stores, err := s.la.GetVisibilityStores(context.Background())
+	s.NoError(err)
+	s.Equal([]VisibilityStore{{Name: "sql-visibility", IndexName: "temporal_visibility"}}, stores)
+}
+
+func (s *activitiesSuite) TestGetVisibilityStores_Elasticsearch() {
+	s.la.persistenceConfig = &config.Persistence{
+		VisibilityStore: "es-visibility",
+		DataStores: map[string]config.DataStore{
+			"es-visibility": {Elasticsearch: &esclient.Config{
+				Indices: map[string]string{
+					esclient.VisibilityAppName:          "temporal_visibility_v1",
+					esclient.SecondaryVisibilityAppName: "temporal_visibility_v1_secondary",
+				},
+			}},
+		},
+	}
+	stores, err := s.la.GetVisibilityStores(context.Background())
+	s.NoError(err)
+	s.Equal([]VisibilityStore{
+		{Name: "es-visibility", IndexName: "temporal_visibility_v1", Elasticsearch: true},
+		{Name: "es-visibility", IndexName: "temporal_visibility_v1_secondary", Elasticsearch: true},
+	}, stores)
+}
@@ -0,0 +1,69 @@
+package updatesearchattribute
+
+import (
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// updateSearchAttributeComponent represent background work needed for changing the type of search attributes.
+	updateSearchAttributeComponent struct {
+		componentParams
+	}
+
+	componentParams struct {
+		fx.In
+		DynamicCollection *dynamicconfig.Collection
+		PersistenceConfig *config.Persistence
+		MetadataManager   persistence.MetadataManager
+		Manager           searchattribute.Manager
+		Logger            log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &updateSearchAttributeComponent{componentParams: params}
+}
+
+func (wc *updateSearchAttributeComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(UpdateSearchAttributeWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	la := wc.localActivities()
+	registry.RegisterActivityWithOptions(la.GetVisibilityStores, activity.RegisterOptions{Name: getVisibilityStoresActivityName})
+	registry.RegisterActivityWithOptions(la.AllocateField, activity.RegisterOptions{Name: allocateFieldActivityName})
+	registry.RegisterActivityWithOptions(la.SwapAlias, activity.RegisterOptions{Name: swapAliasActivityName})
+	registry.RegisterActivityWithOptions(la.GetNamespaceCacheRefreshInterval, activity.RegisterOptions{Name: getNamespaceCacheRefreshIntervalActivityName})
+}
+
+func (wc *updateSearchAttributeComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *updateSearchAttributeComponent) RegisterActivities(_ sdkworker.Registry) {
+	// Backfills are run by the visibility reindex workflow, there are only local activities.
+}
+
+func (wc *updateSearchAttributeComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *updateSearchAttributeComponent) localActivities() *localActivities {
+	return &localActivities{
+		persistenceConfig:             wc.PersistenceConfig,
+		metadataManager:               wc.MetadataManager,
+		saManager:                     wc.Manager,
+		namespaceCacheRefreshInterval: dynamicconfig.NamespaceCacheRefreshInterval.Get(wc.DynamicCollection),
+		logger:                        wc.Logger,
+	}
+}
@@ -0,0 +1,204 @@
+package updatesearchattribute
+
+import (
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/visibilityreindex"
+)
+
+const (
+	// WorkflowName is the name of the system workflow changing the type of a custom search attribute or dropping it.
+	WorkflowName = "temporal-sys-update-search-attribute-workflow"
+
+	errTypeInvalidArgument = "InvalidArgument"
+
+	// The activities are registered on the default worker along with the ones of the other system
+	// workflows, so their names have to be unique.
+	getVisibilityStoresActivityName              = "update-search-attribute-get-visibility-stores-activity"
+	allocateFieldActivityName                    = "update-search-attribute-allocate-field-activity"
+	swapAliasActivityName                        = "update-search-attribute-swap-alias-activity"
+	getNamespaceCacheRefreshIntervalActivityName = "update-search-attribute-get-namespace-cache-refresh-interval-activity"
+)
+
+type (
+	// WorkflowParams is the parameters for update search attribute workflow.
+	WorkflowParams struct {
+		Namespace   namespace.Name
+		NamespaceID namespace.ID
+		// Name is the current name of the custom search attribute.
+		Name string
+		// NewName is the name of the custom search attribute once its type is changed. Defaults to Name. Required if
+		// the visibility stores are Elasticsearch stores.
+		NewName string
+		// NewType is the type of the custom search attribute. Ignored if the search attribute is dropped.
+		NewType enumspb.IndexedValueType
+		// Drop removes the custom search attribute and its values instead of changing its type.
+		Drop bool
+		// RPS limits the number of workflow executions backfilled per second.
+		RPS float64
+	}
+
+	// VisibilityStore is a visibility store whose records are backfilled.
+	VisibilityStore struct {
+		// Name is the name of the data store in the persistence config.
+		Name string
+		// IndexName is the name the custom search attributes of the store are registered with in the
+		// cluster metadata.
+		IndexName string
+		// Elasticsearch is true if the store is an Elasticsearch compatible store.
+		Elasticsearch bool
+	}
+)
+
+var (
+	localActivityOptions = workflow.LocalActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			MaximumInterval:        10 * time.Second,
+			NonRetryableErrorTypes: []string{errTypeInvalidArgument},
+		},
+		StartToCloseTimeout:    10 * time.Second,
+		ScheduleToCloseTimeout: time.Minute,
+	}
+
+	childWorkflowOptions = workflow.ChildWorkflowOptions{
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+)
+
+// UpdateSearchAttributeWorkflow changes the type of a custom search attribute of a namespace, or drops it.
+//
+// The type of a search attribute is the one of the visibility store field it is an alias of. To change it:
+//  1. A free field of the new type is allocated. The migration from the old field to the new one is recorded in the
+//     namespace config, so the values of the old field are copied to the new one whenever visibility records are written.
+//  2. The visibility tasks of the workflow executions whose records have a value for the search attribute are
+//     regenerated. The history service rewrites the records from the mutable states, which backfills the new field.
+//  3. The alias is swapped to the new field, and the old field is dropped: its values are not written anymore.
+//  4. The records are rewritten once more to clear the values of the old field.
+//
+// The migration is kept in the namespace config, because running workflow executions still have values of the old
+// field in their mutable states. As a consequence, the old field is never allocated to another search attribute.
+// Dropping a search attribute records a migration without new field, rewrites the records and removes the alias.
+//
+// Search attributes of Elasticsearch stores are fields of the index mappings, not aliases. The new field is the new
+// name of the search attribute, it is added to the mappings of all the indices before being allocated, and the old
+// field is dropped instead of swapping an alias.
+func UpdateSearchAttributeWorkflow(ctx workflow.Context, params WorkflowParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+
+	if params.NewName == "" {
+		params.NewName = params.Name
+	}
+
+	ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+
+	// Values are backfilled in both primary and secondary visibility stores.
+	var stores []VisibilityStore
+	if err := workflow.ExecuteLocalActivity(ctx1, getVisibilityStoresActivityName).Get(ctx, &stores); err != nil {
+		return err
+	}
+
+	elasticsearch := isElasticsearch(stores)
+	if elasticsearch && !params.Drop {
+		if err := addField(ctx, params, stores); err != nil {
+			return err
+		}
+	}
+
+	// Step 1. Allocate the new field.
+	var fields allocateFieldResult
+	err := workflow.ExecuteLocalActivity(ctx1, allocateFieldActivityName, &allocateFieldRequest{
+		NamespaceID:      params.NamespaceID,
+		Name:             params.Name,
+		NewName:          params.NewName,
+		NewType:          params.NewType,
+		Drop:             params.Drop,
+		VisibilityStores: stores,
+	}).Get(ctx, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Step 2. Backfill the new field. The query is run while the alias still refers to the old field.
+	if err := backfill(ctx, params, stores, params.Name); err != nil {
+		return err
+	}
+
+	// Step 3. Swap the alias to the new field.
+	err = workflow.ExecuteLocalActivity(ctx1, swapAliasActivityName, &swapAliasRequest{
+		NamespaceID:   params.NamespaceID,
+		SourceField:   fields.SourceField,
+		TargetField:   fields.TargetField,
+		NewName:       params.NewName,
+		Elasticsearch: elasticsearch,
+	}).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if params.Drop {
+		logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName))
+		return nil
+	}
+
+	// Step 4. Clear the old field of the records which have a value for the new one.
+	if err := backfill(ctx, params, stores, params.NewName); err != nil {
+		return err
+	}
+
+	logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName))
+	return nil
+}
+
+// addField adds the field with the new name and type of the search attribute to the mappings of the Elasticsearch
+// indices, and registers it in their cluster metadata.
+func addField(ctx workflow.Context, params WorkflowParams, stores []VisibilityStore) error {
+	for _, store := range stores {
+		childOptions := childWorkflowOptions
+		childOptions.WorkflowID = fmt.Sprintf("%s-%s-%s", addsearchattributes.WorkflowName, params.NamespaceID, store.IndexName)
+		ctx1 := workflow.WithChildOptions(ctx, childOptions)
+		err := workflow.ExecuteChildWorkflow(ctx1, addsearchattributes.WorkflowName, addsearchattributes.WorkflowParams{
+			IndexName:             store.IndexName,
+			CustomAttributesToAdd: map[string]enumspb.IndexedValueType{params.NewName: params.NewType},
+		}).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfill rewrites the visibility records which have a value for the search attribute with the given name, once
+// the namespace caches are refreshed with the latest namespace config. The records are rewritten by the history
+// service, with task IDs higher than the ones of the existing records: records written by the workflow itself with
+// the task IDs of the mutable states would be ignored by the visibility stores. The regenerated visibility tasks
+// write all the visibility stores, so the records to rewrite are only queried from the primary one.
+func backfill(ctx workflow.Context, params WorkflowParams, stores []VisibilityStore, name string) error {
+	ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+	var namespaceCacheRefreshInterval time.Duration
+	if err := workflow.ExecuteLocalActivity(ctx1, getNamespaceCacheRefreshIntervalActivityName).Get(ctx, &namespaceCacheRefreshInterval); err != nil {
+		return err
+	}
+	// 2 seconds are a random buffer.
+	if err := workflow.Sleep(ctx, namespaceCacheRefreshInterval+2*time.Second); err != nil {
+		return err
+	}
+
+	childOptions := childWorkflowOptions
+	childOptions.WorkflowID = fmt.Sprintf("%s-%s", visibilityreindex.WorkflowName, params.NamespaceID)
+	ctx2 := workflow.WithChildOptions(ctx, childOptions)
+	return workflow.ExecuteChildWorkflow(ctx2, visibilityreindex.WorkflowName, visibilityreindex.WorkflowParams{
+		TargetVisibilityStore: stores[0].Name,
+		NamespaceID:           params.NamespaceID.String(),
+		Query:                 fmt.Sprintf("%s IS NOT NULL", name),
+		RefreshTasks:          true,
+		RPS:                   params.RPS,
+	}).Get(ctx, nil)
+}
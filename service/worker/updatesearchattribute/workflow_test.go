@@ -0,0 +1,181 @@
+package updatesearchattribute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/visibilityreindex"
+)
+
+var testVisibilityStores = []VisibilityStore{
+	{Name: "sql-visibility", IndexName: "temporal_visibility"},
+	{Name: "sql-visibility-secondary", IndexName: "temporal_visibility_secondary"},
+}
+
+var testElasticsearchVisibilityStores = []VisibilityStore{
+	{Name: "es-visibility", IndexName: "temporal_visibility_v1", Elasticsearch: true},
+	{Name: "es-visibility", IndexName: "temporal_visibility_v1_secondary", Elasticsearch: true},
+}
+
+func newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(UpdateSearchAttributeWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	env.RegisterWorkflowWithOptions(visibilityreindex.VisibilityReindexWorkflow, workflow.RegisterOptions{Name: visibilityreindex.WorkflowName})
+	env.RegisterWorkflowWithOptions(addsearchattributes.AddSearchAttributesWorkflow, workflow.RegisterOptions{Name: addsearchattributes.WorkflowName})
+	var la *localActivities
+	env.RegisterActivityWithOptions(la.GetVisibilityStores, activity.RegisterOptions{Name: getVisibilityStoresActivityName})
+	env.RegisterActivityWithOptions(la.AllocateField, activity.RegisterOptions{Name: allocateFieldActivityName})
+	env.RegisterActivityWithOptions(la.SwapAlias, activity.RegisterOptions{Name: swapAliasActivityName})
+	env.RegisterActivityWithOptions(la.GetNamespaceCacheRefreshInterval, activity.RegisterOptions{Name: getNamespaceCacheRefreshIntervalActivityName})
+	return env
+}
+
+func expectBackfill(env *testsuite.TestWorkflowEnvironment, storeName string, query string) {
+	env.OnWorkflow(visibilityreindex.WorkflowName, mock.Anything, visibilityreindex.WorkflowParams{
+		TargetVisibilityStore: storeName,
+		NamespaceID:           "namespace-id",
+		Query:                 query,
+		RefreshTasks:          true,
+		RPS:                   10,
+	}).Return(nil).Once()
+}
+
+func Test_UpdateSearchAttributeWorkflow_ChangeType(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getVisibilityStoresActivityName, mock.Anything).Return(testVisibilityStores, nil).Once()
+	env.OnActivity(allocateFieldActivityName, mock.Anything, &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "MyAttribute",
+		NewName:          "MyIntAttribute",
+		NewType:          enumspb.INDEXED_VALUE_TYPE_INT,
+		VisibilityStores: testVisibilityStores,
+	}).Return(&allocateFieldResult{SourceField: "Keyword01", TargetField: "Int01"}, nil).Once()
+	env.OnActivity(getNamespaceCacheRefreshIntervalActivityName, mock.Anything).Return(10*time.Second, nil).Twice()
+	expectBackfill(env, "sql-visibility", "MyAttribute IS NOT NULL")
+	env.OnActivity(swapAliasActivityName, mock.Anything, &swapAliasRequest{
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		TargetField: "Int01",
+		NewName:     "MyIntAttribute",
+	}).Return(nil).Once()
+	expectBackfill(env, "sql-visibility", "MyIntAttribute IS NOT NULL")
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Name:        "MyAttribute",
+		NewName:     "MyIntAttribute",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+		RPS:         10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func Test_UpdateSearchAttributeWorkflow_Elasticsearch(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getVisibilityStoresActivityName, mock.Anything).Return(testElasticsearchVisibilityStores, nil).Once()
+	for _, store := range testElasticsearchVisibilityStores {
+		env.OnWorkflow(addsearchattributes.WorkflowName, mock.Anything, addsearchattributes.WorkflowParams{
+			IndexName:             store.IndexName,
+			CustomAttributesToAdd: map[string]enumspb.IndexedValueType{"MyIntAttribute": enumspb.INDEXED_VALUE_TYPE_INT},
+		}).Return(nil).Once()
+	}
+	env.OnActivity(allocateFieldActivityName, mock.Anything, &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "MyAttribute",
+		NewName:          "MyIntAttribute",
+		NewType:          enumspb.INDEXED_VALUE_TYPE_INT,
+		VisibilityStores: testElasticsearchVisibilityStores,
+	}).Return(&allocateFieldResult{SourceField: "MyAttribute", TargetField: "MyIntAttribute"}, nil).Once()
+	env.OnActivity(getNamespaceCacheRefreshIntervalActivityName, mock.Anything).Return(10*time.Second, nil).Twice()
+	expectBackfill(env, "es-visibility", "MyAttribute IS NOT NULL")
+	env.OnActivity(swapAliasActivityName, mock.Anything, &swapAliasRequest{
+		NamespaceID:   "namespace-id",
+		SourceField:   "MyAttribute",
+		TargetField:   "MyIntAttribute",
+		NewName:       "MyIntAttribute",
+		Elasticsearch: true,
+	}).Return(nil).Once()
+	expectBackfill(env, "es-visibility", "MyIntAttribute IS NOT NULL")
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Name:        "MyAttribute",
+		NewName:     "MyIntAttribute",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+		RPS:         10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func Test_UpdateSearchAttributeWorkflow_Drop(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getVisibilityStoresActivityName, mock.Anything).Return(testVisibilityStores, nil).Once()
+	env.OnActivity(allocateFieldActivityName, mock.Anything, &allocateFieldRequest{
+		NamespaceID:      "namespace-id",
+		Name:             "MyAttribute",
+		NewName:          "MyAttribute",
+		Drop:             true,
+		VisibilityStores: testVisibilityStores,
+	}).Return(&allocateFieldResult{SourceField: "Keyword01"}, nil).Once()
+	env.OnActivity(getNamespaceCacheRefreshIntervalActivityName, mock.Anything).Return(10*time.Second, nil).Once()
+	expectBackfill(env, "sql-visibility", "MyAttribute IS NOT NULL")
+	env.OnActivity(swapAliasActivityName, mock.Anything, &swapAliasRequest{
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		NewName:     "MyAttribute",
+	}).Return(nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Name:        "MyAttribute",
+		Drop:        true,
+		RPS:         10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func Test_UpdateSearchAttributeWorkflow_NoFreeField(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getVisibilityStoresActivityName, mock.Anything).Return(testVisibilityStores, nil).Once()
+	env.OnActivity(allocateFieldActivityName, mock.Anything, mock.Anything).
+		Return(nil, newInvalidArgumentError("no field of type %v is available", enumspb.INDEXED_VALUE_TYPE_INT)).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		NamespaceID: "namespace-id",
+		Name:        "MyAttribute",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, env.GetWorkflowError(), &appErr)
+	require.Equal(t, errTypeInvalidArgument, appErr.Type())
+	env.AssertExpectations(t)
+}
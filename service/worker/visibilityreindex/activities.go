@@ -0,0 +1,524 @@
+package visibilityreindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	historyPageSize = 100
+)
+
+type (
+	localActivities struct {
+		persistenceConfig *config.Persistence
+	}
+
+	activities struct {
+		executionManager     persistence.ExecutionManager
+		historyClient        historyservice.HistoryServiceClient
+		namespaceRegistry    namespace.Registry
+		newVisibilityManager func(storeName string) (manager.VisibilityManager, error)
+		metricsHandler       metrics.Handler
+		logger               log.Logger
+	}
+
+	metadataRequest struct {
+		TargetVisibilityStore string
+	}
+
+	metadataResponse struct {
+		TargetVisibilityStore string
+		NumHistoryShards      int32
+	}
+
+	reindexShardRequest struct {
+		ShardID               int32
+		TargetVisibilityStore string
+		NamespaceID           string
+		RefreshTasks          bool
+		RPS                   float64
+		PageSize              int
+	}
+
+	reindexQueryRequest struct {
+		TargetVisibilityStore string
+		NamespaceID           string
+		Query                 string
+		NumHistoryShards      int32
+		RefreshTasks          bool
+		RPS                   float64
+		PageSize              int
+	}
+
+	reindexShardResponse struct {
+		ReindexedCount int64
+		SkippedCount   int64
+	}
+
+	// reindexShardHeartbeatDetails allows a retried activity to resume from the last page of
+	// workflow executions which was completely reindexed. It is used by ReindexQuery too.
+	reindexShardHeartbeatDetails struct {
+		PageToken      []byte
+		ReindexedCount int64
+		SkippedCount   int64
+	}
+)
+
+var (
+	errExecutionNotFound = errors.New("workflow execution not found")
+)
+
+func (a *localActivities) GetMetadata(_ context.Context, request *metadataRequest) (*metadataResponse, error) {
+	storeName := request.TargetVisibilityStore
+	if storeName == "" {
+		storeName = a.persistenceConfig.VisibilityStore
+	}
+	ds, ok := a.persistenceConfig.DataStores[storeName]
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("InvalidArgument: data store %q is not configured", storeName), errTypeInvalidArgument, nil)
+	}
+	if ds.SQL == nil && ds.Elasticsearch == nil && ds.OpenSearch == nil && ds.CustomDataStoreConfig == nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("InvalidArgument: data store %q is not a visibility store", storeName), errTypeInvalidArgument, nil)
+	}
+	return &metadataResponse{
+		TargetVisibilityStore: storeName,
+		NumHistoryShards:      a.persistenceConfig.NumHistoryShards,
+	}, nil
+}
+
+// ReindexShard writes the visibility records of all the workflow executions of the shard to the
+// target visibility store.
+func (a *activities) ReindexShard(ctx context.Context, request *reindexShardRequest) (*reindexShardResponse, error) {
+	logger := log.With(a.logger, tag.ShardID(request.ShardID))
+
+	visibilityManager, err := a.newVisibilityManager(request.TargetVisibilityStore)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("InvalidArgument: unable to create visibility manager for data store %q: %v", request.TargetVisibilityStore, err),
+			errTypeInvalidArgument,
+			nil)
+	}
+	// Closing the manager flushes the pending Elasticsearch bulk requests.
+	defer visibilityManager.Close()
+
+	var details reindexShardHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			logger.Warn("Unable to get heartbeat details, reindexing the shard from the beginning.", tag.Error(err))
+			details = reindexShardHeartbeatDetails{}
+		}
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	for {
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   request.ShardID,
+			PageSize:  request.PageSize,
+			PageToken: details.PageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, state := range resp.States {
+			namespaceID := state.GetExecutionInfo().GetNamespaceId()
+			if request.NamespaceID != "" && namespaceID != request.NamespaceID {
+				details.SkippedCount++
+				metrics.VisibilityReindexSkipsCount.With(a.metricsHandler).Record(1)
+				continue
+			}
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			execution := &commonpb.WorkflowExecution{
+				WorkflowId: state.GetExecutionInfo().GetWorkflowId(),
+				RunId:      state.GetExecutionState().GetRunId(),
+			}
+			if err := a.reindexExecutionAndCount(ctx, logger, visibilityManager, request.ShardID, namespaceID, execution, request.RefreshTasks, &details); err != nil {
+				return nil, err
+			}
+		}
+
+		details.PageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.PageToken) == 0 {
+			break
+		}
+	}
+
+	return &reindexShardResponse{
+		ReindexedCount: details.ReindexedCount,
+		SkippedCount:   details.SkippedCount,
+	}, nil
+}
+
+// ReindexQuery writes the visibility records of the workflow executions of the namespace whose
+// records in the target visibility store match the query.
+func (a *activities) ReindexQuery(ctx context.Context, request *reindexQueryRequest) (*reindexShardResponse, error) {
+	logger := log.With(a.logger, tag.WorkflowNamespaceID(request.NamespaceID))
+
+	namespaceEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(request.NamespaceID))
+	if err != nil {
+		var nsNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &nsNotFound) {
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("InvalidArgument: %v", err), errTypeInvalidArgument, nil)
+		}
+		return nil, err
+	}
+
+	visibilityManager, err := a.newVisibilityManager(request.TargetVisibilityStore)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("InvalidArgument: unable to create visibility manager for data store %q: %v", request.TargetVisibilityStore, err),
+			errTypeInvalidArgument,
+			nil)
+	}
+	// Closing the manager flushes the pending Elasticsearch bulk requests.
+	defer visibilityManager.Close()
+
+	var details reindexShardHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			logger.Warn("Unable to get heartbeat details, reindexing from the beginning.", tag.Error(err))
+			details = reindexShardHeartbeatDetails{}
+		}
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	for {
+		resp, err := visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespaceEntry.ID(),
+			Namespace:     namespaceEntry.Name(),
+			PageSize:      request.PageSize,
+			NextPageToken: details.PageToken,
+			Query:         request.Query,
+		})
+		if err != nil {
+			var invalidArgument *serviceerror.InvalidArgument
+			if errors.As(err, &invalidArgument) {
+				return nil, temporal.NewNonRetryableApplicationError(
+					fmt.Sprintf("InvalidArgument: %v", err), errTypeInvalidArgument, nil)
+			}
+			return nil, err
+		}
+
+		for _, executionInfo := range resp.Executions {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			execution := executionInfo.GetExecution()
+			shardID := common.WorkflowIDToHistoryShard(request.NamespaceID, execution.GetWorkflowId(), request.NumHistoryShards)
+			if err := a.reindexExecutionAndCount(ctx, logger, visibilityManager, shardID, request.NamespaceID, execution, request.RefreshTasks, &details); err != nil {
+				return nil, err
+			}
+		}
+
+		details.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.PageToken) == 0 {
+			break
+		}
+	}
+
+	return &reindexShardResponse{
+		ReindexedCount: details.ReindexedCount,
+		SkippedCount:   details.SkippedCount,
+	}, nil
+}
+
+func (a *activities) reindexExecutionAndCount(
+	ctx context.Context,
+	logger log.Logger,
+	visibilityManager manager.VisibilityManager,
+	shardID int32,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	refreshTasks bool,
+	details *reindexShardHeartbeatDetails,
+) error {
+	var reindexed bool
+	var err error
+	if refreshTasks {
+		reindexed, err = a.refreshExecutionTasks(ctx, namespaceID, execution)
+	} else {
+		reindexed, err = a.reindexExecution(ctx, visibilityManager, shardID, namespaceID, execution)
+	}
+	if err != nil {
+		logger.Error("Unable to reindex workflow execution.",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err))
+		return err
+	}
+	if reindexed {
+		details.ReindexedCount++
+		metrics.VisibilityReindexExecutionsCount.With(a.metricsHandler).Record(1)
+	} else {
+		details.SkippedCount++
+		metrics.VisibilityReindexSkipsCount.With(a.metricsHandler).Record(1)
+	}
+	return nil
+}
+
+// refreshExecutionTasks regenerates the tasks of the workflow execution in the history service, which
+// writes its visibility record with a new task ID. It returns false if the workflow execution doesn't exist.
+func (a *activities) refreshExecutionTasks(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	_, err := a.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: namespaceID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: namespaceID,
+			Execution:   execution,
+		},
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		var nsNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &notFound) || errors.As(err, &nsNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// reindexExecution writes the visibility record of the workflow execution. It returns false if the
+// workflow execution doesn't need a visibility record.
+func (a *activities) reindexExecution(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	shardID int32,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	namespaceEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(namespaceID))
+	if err != nil {
+		var nsNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &nsNotFound) {
+			// Namespace is deleted, its workflow executions are deleted too.
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Mutable state is read again through the history service, which has its latest version.
+	resp, err := a.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	state := resp.GetDatabaseMutableState()
+
+	switch state.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		// Zombie workflow executions are not visible.
+		return false, nil
+	}
+
+	requestBase, closeTime, err := a.getVisibilityRequest(ctx, shardID, namespaceEntry, state)
+	if errors.Is(err, errExecutionNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if state.GetExecutionState().GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return true, visibilityManager.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: requestBase,
+		})
+	}
+
+	executionInfo := state.GetExecutionInfo()
+	var executionDuration time.Duration
+	if executionInfo.GetExecutionTime() != nil {
+		executionDuration = closeTime.Sub(executionInfo.GetExecutionTime().AsTime())
+	}
+	return true, visibilityManager.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: requestBase,
+		CloseTime:             closeTime,
+		ExecutionDuration:     executionDuration,
+		HistoryLength:         state.GetNextEventId() - 1,
+		HistorySizeBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+		StateTransitionCount:  executionInfo.GetStateTransitionCount(),
+	})
+}
+
+// getVisibilityRequest builds the visibility request the same way the history service visibility
+// queue does, and returns the close time of closed workflow executions.
+func (a *activities) getVisibilityRequest(
+	ctx context.Context,
+	shardID int32,
+	namespaceEntry *namespace.Namespace,
+	state *persistencespb.WorkflowMutableState,
+) (*manager.VisibilityRequestBase, time.Time, error) {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	memo := executionInfo.GetMemo()
+	searchAttributes := executionInfo.GetSearchAttributes()
+	closeTime := executionInfo.GetCloseTime().AsTime()
+	isClosed := executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	if executionInfo.GetRelocatableAttributesRemoved() || (isClosed && executionInfo.GetCloseTime() == nil) {
+		// Memo and search attributes were removed from the mutable state once they were written to the
+		// visibility store, which is the one being rebuilt. They are replayed from history instead.
+		// Workflow executions closed before v1.16 don't have a close time in the mutable state either.
+		replayed, err := a.replayHistory(ctx, shardID, state)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if executionInfo.GetRelocatableAttributesRemoved() {
+			memo, searchAttributes = replayed.memo, replayed.searchAttributes
+		}
+		if executionInfo.GetCloseTime() == nil {
+			closeTime = replayed.lastEventTime
+		}
+	}
+	if !isClosed {
+		closeTime = time.Time{}
+	}
+
+	var parentExecution *commonpb.WorkflowExecution
+	if executionInfo.GetParentWorkflowId() != "" && executionInfo.GetParentRunId() != "" {
+		parentExecution = &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetParentWorkflowId(),
+			RunId:      executionInfo.GetParentRunId(),
+		}
+	}
+	var visibilityMemo *commonpb.Memo
+	if memo != nil {
+		visibilityMemo = &commonpb.Memo{Fields: memo}
+	}
+	// Search attributes of the mutable state are keyed by field name, like the ones written by the
+	// history service, which migrates them the same way.
+	searchAttributes = searchattribute.MigrateFields(searchAttributes, namespaceEntry.CustomSearchAttributeFieldMigrations())
+	var visibilitySearchAttributes *commonpb.SearchAttributes
+	if searchAttributes != nil {
+		visibilitySearchAttributes = &commonpb.SearchAttributes{IndexedFields: searchAttributes}
+	}
+
+	return &manager.VisibilityRequestBase{
+		NamespaceID: namespaceEntry.ID(),
+		Namespace:   namespaceEntry.Name(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		WorkflowTypeName: executionInfo.GetWorkflowTypeName(),
+		StartTime:        executionState.GetStartTime().AsTime(),
+		Status:           executionState.GetStatus(),
+		ExecutionTime:    executionInfo.GetExecutionTime().AsTime(),
+		// Transaction ID of the last events batch is a task ID of the shard, which is lower than the
+		// ones of the visibility tasks generated after it. Elasticsearch uses it as document version.
+		TaskID:           executionInfo.GetLastFirstEventTxnId(),
+		ShardID:          shardID,
+		Memo:             visibilityMemo,
+		TaskQueue:        executionInfo.GetTaskQueue(),
+		SearchAttributes: visibilitySearchAttributes,
+		ParentExecution:  parentExecution,
+		RootExecution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetRootWorkflowId(),
+			RunId:      executionInfo.GetRootRunId(),
+		},
+	}, closeTime, nil
+}
+
+type replayedHistory struct {
+	memo             map[string]*commonpb.Payload
+	searchAttributes map[string]*commonpb.Payload
+	lastEventTime    time.Time
+}
+
+// replayHistory applies the memo and search attributes of the history events like the mutable state does.
+func (a *activities) replayHistory(
+	ctx context.Context,
+	shardID int32,
+	state *persistencespb.WorkflowMutableState,
+) (*replayedHistory, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(state.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &replayedHistory{}
+	req := &persistence.ReadHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: currentVersionHistory.GetBranchToken(),
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  state.GetNextEventId(),
+		PageSize:    historyPageSize,
+	}
+	for {
+		events, _, nextPageToken, err := persistence.ReadFullPageEvents(ctx, a.executionManager, req)
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return nil, errExecutionNotFound
+			}
+			return nil, err
+		}
+		for _, event := range events {
+			switch event.GetEventType() {
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+				attr := event.GetWorkflowExecutionStartedEventAttributes()
+				result.memo = payload.MergeMapOfPayload(result.memo, attr.GetMemo().GetFields())
+				result.searchAttributes = payload.MergeMapOfPayload(result.searchAttributes, attr.GetSearchAttributes().GetIndexedFields())
+			case enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+				attr := event.GetUpsertWorkflowSearchAttributesEventAttributes()
+				result.searchAttributes = payload.MergeMapOfPayload(result.searchAttributes, attr.GetSearchAttributes().GetIndexedFields())
+			case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED:
+				attr := event.GetWorkflowPropertiesModifiedEventAttributes()
+				result.memo = payload.MergeMapOfPayload(result.memo, attr.GetUpsertedMemo().GetFields())
+			}
+			result.lastEventTime = event.GetEventTime().AsTime()
+		}
+		if len(nextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = nextPageToken
+	}
+
+	return result, nil
+}
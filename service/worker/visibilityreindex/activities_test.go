@@ -0,0 +1,462 @@
+package visibilityreindex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type activitiesSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller            *gomock.Controller
+	mockExecutionManager  *persistence.MockExecutionManager
+	mockHistoryClient     *historyservicemock.MockHistoryServiceClient
+	mockNamespaceRegistry *namespace.MockRegistry
+	mockVisibilityManager *manager.MockVisibilityManager
+
+	a *activities
+}
+
+const (
+	testNamespace   = "test-namespace"
+	testNamespaceID = "test-namespace-id"
+	testShardID     = int32(7)
+)
+
+var (
+	startTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closeTime = time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
+
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace}, nil, ""),
+		nil,
+	).AnyTimes()
+
+	s.a = &activities{
+		executionManager:  s.mockExecutionManager,
+		historyClient:     s.mockHistoryClient,
+		namespaceRegistry: s.mockNamespaceRegistry,
+		newVisibilityManager: func(storeName string) (manager.VisibilityManager, error) {
+			s.Equal("es-visibility", storeName)
+			return s.mockVisibilityManager, nil
+		},
+		metricsHandler: metrics.NoopMetricsHandler,
+		logger:         log.NewTestLogger(),
+	}
+}
+
+func (s *activitiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activitiesSuite) TestGetMetadata() {
+	la := &localActivities{
+		persistenceConfig: &config.Persistence{
+			DefaultStore:     "default",
+			VisibilityStore:  "es-visibility",
+			NumHistoryShards: 4,
+			DataStores: map[string]config.DataStore{
+				"default":        {Cassandra: &config.Cassandra{}},
+				"es-visibility":  {Elasticsearch: &esclient.Config{}},
+				"sql-visibility": {SQL: &config.SQL{}},
+			},
+		},
+	}
+
+	resp, err := la.GetMetadata(context.Background(), &metadataRequest{})
+	s.NoError(err)
+	s.Equal(&metadataResponse{TargetVisibilityStore: "es-visibility", NumHistoryShards: 4}, resp)
+
+	resp, err = la.GetMetadata(context.Background(), &metadataRequest{TargetVisibilityStore: "sql-visibility"})
+	s.NoError(err)
+	s.Equal(&metadataResponse{TargetVisibilityStore: "sql-visibility", NumHistoryShards: 4}, resp)
+
+	for _, storeName := range []string{"default", "unknown"} {
+		_, err = la.GetMetadata(context.Background(), &metadataRequest{TargetVisibilityStore: storeName})
+		var appErr *temporal.ApplicationError
+		s.ErrorAs(err, &appErr)
+		s.Equal(errTypeInvalidArgument, appErr.Type())
+		s.True(appErr.NonRetryable())
+	}
+}
+
+func (s *activitiesSuite) TestReindexShard() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	memo := map[string]*commonpb.Payload{"memo-key": payload.EncodeString("memo-value")}
+	searchAttributes := map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("keyword")}
+
+	running := newMutableState("wid-running", "rid-running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	running.ExecutionInfo.Memo = memo
+	running.ExecutionInfo.SearchAttributes = searchAttributes
+	running.ExecutionInfo.LastFirstEventTxnId = 1001
+	running.NextEventId = 6
+
+	// Memo and search attributes of the closed workflow execution were removed from its mutable state.
+	closed := newMutableState("wid-closed", "rid-closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	closed.ExecutionInfo.RelocatableAttributesRemoved = true
+	closed.ExecutionInfo.CloseTime = timestamppb.New(closeTime)
+	closed.ExecutionInfo.LastFirstEventTxnId = 1002
+	closed.ExecutionInfo.StateTransitionCount = 5
+	closed.ExecutionInfo.ExecutionStats = &persistencespb.ExecutionStats{HistorySize: 2048}
+	closed.NextEventId = 4
+
+	deleted := newMutableState("wid-deleted", "rid-deleted", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	zombie := newMutableState("wid-zombie", "rid-zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  testShardID,
+		PageSize: 2,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States:    []*persistencespb.WorkflowMutableState{running, closed},
+		PageToken: []byte("page-2"),
+	}, nil)
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   testShardID,
+		PageSize:  2,
+		PageToken: []byte("page-2"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{deleted, zombie},
+	}, nil)
+
+	s.expectDescribeMutableState(running, nil)
+	s.expectDescribeMutableState(closed, nil)
+	s.expectDescribeMutableState(deleted, serviceerror.NewNotFound("workflow execution not found"))
+	s.expectDescribeMutableState(zombie, nil)
+
+	s.mockExecutionManager.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     testShardID,
+		BranchToken: []byte("rid-closed"),
+		MinEventID:  1,
+		MaxEventID:  4,
+		PageSize:    historyPageSize,
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{
+			{
+				EventId:   1,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+						Memo:             &commonpb.Memo{Fields: memo},
+						SearchAttributes: &commonpb.SearchAttributes{IndexedFields: searchAttributes},
+					},
+				},
+			},
+			{
+				EventId:   2,
+				EventType: enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES,
+				Attributes: &historypb.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
+					UpsertWorkflowSearchAttributesEventAttributes: &historypb.UpsertWorkflowSearchAttributesEventAttributes{
+						SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+							"CustomKeywordField": payload.EncodeString("upserted"),
+							"CustomIntField":     payload.EncodeString("1"),
+						}},
+					},
+				},
+			},
+			{
+				EventId:   3,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED,
+				Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedEventAttributes{
+					WorkflowPropertiesModifiedEventAttributes: &historypb.WorkflowPropertiesModifiedEventAttributes{
+						UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+							"memo-key": payload.EncodeString("modified"),
+						}},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	s.mockVisibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			s.Equal("wid-running", request.Execution.GetWorkflowId())
+			s.Equal(namespace.Name(testNamespace), request.Namespace)
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, request.Status)
+			s.Equal(startTime, request.StartTime)
+			s.EqualValues(1001, request.TaskID)
+			s.Equal(testShardID, request.ShardID)
+			s.Equal(memo, request.Memo.GetFields())
+			s.Equal(searchAttributes, request.SearchAttributes.GetIndexedFields())
+			return nil
+		})
+	s.mockVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			s.Equal("wid-closed", request.Execution.GetWorkflowId())
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, request.Status)
+			s.EqualValues(1002, request.TaskID)
+			s.Equal(closeTime, request.CloseTime)
+			s.Equal(24*time.Hour, request.ExecutionDuration)
+			s.EqualValues(3, request.HistoryLength)
+			s.EqualValues(2048, request.HistorySizeBytes)
+			s.EqualValues(5, request.StateTransitionCount)
+			s.Equal("modified", decodeString(s.T(), request.Memo.GetFields()["memo-key"]))
+			s.Len(request.SearchAttributes.GetIndexedFields(), 2)
+			s.Equal("upserted", decodeString(s.T(), request.SearchAttributes.GetIndexedFields()["CustomKeywordField"]))
+			return nil
+		})
+	s.mockVisibilityManager.EXPECT().Close()
+
+	result, err := env.ExecuteActivity(s.a.ReindexShard, &reindexShardRequest{
+		ShardID:               testShardID,
+		TargetVisibilityStore: "es-visibility",
+		RPS:                   1000,
+		PageSize:              2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{ReindexedCount: 2, SkippedCount: 2}, resp)
+}
+
+func (s *activitiesSuite) TestReindexShard_ResumeFromHeartbeat() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+	env.SetHeartbeatDetails(&reindexShardHeartbeatDetails{
+		PageToken:      []byte("page-2"),
+		ReindexedCount: 10,
+		SkippedCount:   1,
+	})
+
+	other := newMutableState("wid", "rid", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	other.ExecutionInfo.NamespaceId = "other-namespace-id"
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   testShardID,
+		PageSize:  2,
+		PageToken: []byte("page-2"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{other},
+	}, nil)
+	s.mockVisibilityManager.EXPECT().Close()
+
+	result, err := env.ExecuteActivity(s.a.ReindexShard, &reindexShardRequest{
+		ShardID:               testShardID,
+		TargetVisibilityStore: "es-visibility",
+		NamespaceID:           testNamespaceID,
+		RPS:                   1000,
+		PageSize:              2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{ReindexedCount: 10, SkippedCount: 2}, resp)
+}
+
+func (s *activitiesSuite) TestReindexQuery() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	migratedNamespaceID := "migrated-namespace-id"
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID(migratedNamespaceID)).Return(
+		namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: migratedNamespaceID, Name: testNamespace},
+			&persistencespb.NamespaceConfig{
+				CustomSearchAttributeFieldMigrations: map[string]*persistencespb.CustomSearchAttributeFieldMigration{
+					"Keyword01": {
+						TargetField:   "Int01",
+						SourceType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						TargetType:    enumspb.INDEXED_VALUE_TYPE_INT,
+						SourceDropped: true,
+					},
+				},
+			},
+			"",
+		),
+		nil,
+	).AnyTimes()
+
+	running := newMutableState("wid-running", "rid-running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	running.ExecutionInfo.NamespaceId = migratedNamespaceID
+	running.ExecutionInfo.SearchAttributes = map[string]*commonpb.Payload{"Keyword01": payload.EncodeString("42")}
+	deleted := newMutableState("wid-deleted", "rid-deleted", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	deleted.ExecutionInfo.NamespaceId = migratedNamespaceID
+
+	s.mockVisibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: namespace.ID(migratedNamespaceID),
+		Namespace:   testNamespace,
+		PageSize:    2,
+		Query:       "MyAttribute IS NOT NULL",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wid-running", RunId: "rid-running"}},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wid-deleted", RunId: "rid-deleted"}},
+		},
+	}, nil)
+	for _, state := range []*persistencespb.WorkflowMutableState{running, deleted} {
+		var resp *historyservice.DescribeMutableStateResponse
+		var err error
+		if state == running {
+			resp = &historyservice.DescribeMutableStateResponse{DatabaseMutableState: state}
+		} else {
+			err = serviceerror.NewNotFound("workflow execution not found")
+		}
+		s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+			NamespaceId: migratedNamespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: state.ExecutionInfo.WorkflowId,
+				RunId:      state.ExecutionState.RunId,
+			},
+		})).Return(resp, err)
+	}
+	s.mockVisibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			s.Equal("wid-running", request.Execution.GetWorkflowId())
+			s.Equal(common.WorkflowIDToHistoryShard(migratedNamespaceID, "wid-running", 16), request.ShardID)
+			s.Len(request.SearchAttributes.GetIndexedFields(), 1)
+			var value int64
+			s.NoError(payload.Decode(request.SearchAttributes.GetIndexedFields()["Int01"], &value))
+			s.EqualValues(42, value)
+			return nil
+		})
+	s.mockVisibilityManager.EXPECT().Close()
+
+	result, err := env.ExecuteActivity(s.a.ReindexQuery, &reindexQueryRequest{
+		TargetVisibilityStore: "es-visibility",
+		NamespaceID:           migratedNamespaceID,
+		Query:                 "MyAttribute IS NOT NULL",
+		NumHistoryShards:      16,
+		RPS:                   1000,
+		PageSize:              2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{ReindexedCount: 1, SkippedCount: 1}, resp)
+}
+
+func (s *activitiesSuite) TestReindexQuery_RefreshTasks() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	executions := []*commonpb.WorkflowExecution{
+		{WorkflowId: "wid-running", RunId: "rid-running"},
+		{WorkflowId: "wid-deleted", RunId: "rid-deleted"},
+	}
+	s.mockVisibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    2,
+		Query:       "MyAttribute IS NOT NULL",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: executions[0]},
+			{Execution: executions[1]},
+		},
+	}, nil)
+	// Records are rewritten by the history service, not by the activity.
+	for i, execution := range executions {
+		var err error
+		if i == 1 {
+			err = serviceerror.NewNotFound("workflow execution not found")
+		}
+		s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), protomock.Eq(&historyservice.RefreshWorkflowTasksRequest{
+			NamespaceId: testNamespaceID,
+			Request: &adminservice.RefreshWorkflowTasksRequest{
+				NamespaceId: testNamespaceID,
+				Execution:   execution,
+			},
+		})).Return(&historyservice.RefreshWorkflowTasksResponse{}, err)
+	}
+	s.mockVisibilityManager.EXPECT().Close()
+
+	result, err := env.ExecuteActivity(s.a.ReindexQuery, &reindexQueryRequest{
+		TargetVisibilityStore: "es-visibility",
+		NamespaceID:           testNamespaceID,
+		Query:                 "MyAttribute IS NOT NULL",
+		NumHistoryShards:      16,
+		RefreshTasks:          true,
+		RPS:                   1000,
+		PageSize:              2,
+	})
+	s.NoError(err)
+	var resp reindexShardResponse
+	s.NoError(result.Get(&resp))
+	s.Equal(reindexShardResponse{ReindexedCount: 1, SkippedCount: 1}, resp)
+}
+
+func (s *activitiesSuite) expectDescribeMutableState(state *persistencespb.WorkflowMutableState, err error) {
+	var resp *historyservice.DescribeMutableStateResponse
+	if err == nil {
+		resp = &historyservice.DescribeMutableStateResponse{DatabaseMutableState: state}
+	}
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: testNamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: state.ExecutionInfo.WorkflowId,
+			RunId:      state.ExecutionState.RunId,
+		},
+	})).Return(resp, err)
+}
+
+func newMutableState(
+	workflowID string,
+	runID string,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:      testNamespaceID,
+			WorkflowId:       workflowID,
+			WorkflowTypeName: "workflow-type",
+			ExecutionTime:    timestamppb.New(startTime),
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory([]byte(runID), nil)),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     runID,
+			State:     state,
+			Status:    status,
+			StartTime: timestamppb.New(startTime),
+		},
+	}
+}
+
+func decodeString(t *testing.T, p *commonpb.Payload) string {
+	var value string
+	if err := payload.Decode(p, &value); err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
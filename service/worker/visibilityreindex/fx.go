@@ -0,0 +1,128 @@
+package visibilityreindex
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// visibilityReindexComponent represent background work needed for rebuilding visibility records.
+	visibilityReindexComponent struct {
+		componentParams
+	}
+
+	componentParams struct {
+		fx.In
+		DynamicCollection              *dynamicconfig.Collection
+		PersistenceConfig              *config.Persistence
+		PersistenceServiceResolver     resolver.ServiceResolver
+		CustomVisibilityStoreFactory   visibility.VisibilityStoreFactory
+		SearchAttributesProvider       searchattribute.Provider
+		SearchAttributesMapperProvider searchattribute.MapperProvider
+		NamespaceRegistry              namespace.Registry
+		ExecutionManager               persistence.ExecutionManager
+		HistoryClient                  resource.HistoryClient
+		MetricsHandler                 metrics.Handler
+		Logger                         log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &visibilityReindexComponent{componentParams: params}
+}
+
+func (wc *visibilityReindexComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(VisibilityReindexWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	la := wc.localActivities()
+	registry.RegisterActivityWithOptions(la.GetMetadata, activity.RegisterOptions{Name: getMetadataActivityName})
+}
+
+func (wc *visibilityReindexComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *visibilityReindexComponent) RegisterActivities(registry sdkworker.Registry) {
+	a := wc.activities()
+	registry.RegisterActivityWithOptions(a.ReindexShard, activity.RegisterOptions{Name: reindexShardActivityName})
+	registry.RegisterActivityWithOptions(a.ReindexQuery, activity.RegisterOptions{Name: reindexQueryActivityName})
+}
+
+func (wc *visibilityReindexComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.VisibilityReindexActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *visibilityReindexComponent) localActivities() *localActivities {
+	return &localActivities{
+		persistenceConfig: wc.PersistenceConfig,
+	}
+}
+
+func (wc *visibilityReindexComponent) activities() *activities {
+	return &activities{
+		executionManager:     wc.ExecutionManager,
+		historyClient:        wc.HistoryClient,
+		namespaceRegistry:    wc.NamespaceRegistry,
+		newVisibilityManager: wc.newVisibilityManager,
+		metricsHandler:       wc.MetricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityReindexWorkflowScope)),
+		logger:               wc.Logger,
+	}
+}
+
+// newVisibilityManager creates a visibility manager writing to the data store. Unlike the worker
+// visibility manager, it has an Elasticsearch bulk processor, configured like the history service one.
+func (wc *visibilityReindexComponent) newVisibilityManager(storeName string) (manager.VisibilityManager, error) {
+	dc := wc.DynamicCollection
+	esProcessorConfig := &elasticsearch.ProcessorConfig{
+		IndexerConcurrency:       dynamicconfig.WorkerIndexerConcurrency.Get(dc),
+		ESProcessorNumOfWorkers:  dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
+		ESProcessorBulkActions:   dynamicconfig.WorkerESProcessorBulkActions.Get(dc),
+		ESProcessorBulkSize:      dynamicconfig.WorkerESProcessorBulkSize.Get(dc),
+		ESProcessorFlushInterval: dynamicconfig.WorkerESProcessorFlushInterval.Get(dc),
+		ESProcessorAckTimeout:    dynamicconfig.WorkerESProcessorAckTimeout.Get(dc),
+	}
+	return visibility.NewManagerFromDataStoreConfig(
+		wc.PersistenceConfig.DataStores[storeName],
+		wc.PersistenceServiceResolver,
+		wc.CustomVisibilityStoreFactory,
+		esProcessorConfig,
+		wc.SearchAttributesProvider,
+		wc.SearchAttributesMapperProvider,
+		wc.NamespaceRegistry,
+		dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
+		dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
+		dynamicconfig.OperatorRPSRatio.Get(dc),
+		dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
+		dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
+		dynamicconfig.VisibilityEnableManualPagination.Get(dc),
+		wc.MetricsHandler,
+		log.With(wc.Logger, tag.NewStringTag("visibility-store", storeName)),
+	)
+}
@@ -0,0 +1,220 @@
+package visibilityreindex
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	// WorkflowName is the name of the system workflow rebuilding visibility records from primary persistence.
+	WorkflowName = "temporal-sys-visibility-reindex-workflow"
+	// ProgressQueryType is the query type returning the Progress of the workflow.
+	ProgressQueryType = "progress"
+
+	defaultRPS                     = 100
+	defaultConcurrentActivityCount = 4
+	defaultPageSize                = 100
+	defaultShardCountPerExecution  = 64
+
+	// The activities are registered on the default worker along with the ones of the other system
+	// workflows, so their names have to be unique.
+	getMetadataActivityName  = "visibility-reindex-get-metadata-activity"
+	reindexShardActivityName = "visibility-reindex-reindex-shard-activity"
+	reindexQueryActivityName = "visibility-reindex-reindex-query-activity"
+
+	errTypeInvalidArgument = "InvalidArgument"
+)
+
+type (
+	// WorkflowParams is the parameters for visibility reindex workflow.
+	WorkflowParams struct {
+		// TargetVisibilityStore is the name of the data store visibility records are written to.
+		// Defaults to the configured visibility store.
+		TargetVisibilityStore string
+		// NamespaceID restricts the reindex to the workflow executions of a single namespace. Optional.
+		NamespaceID string
+		// Query restricts the reindex to the workflow executions of the namespace whose records in the
+		// target visibility store match the visibility query. These are listed from the visibility store
+		// instead of scanning the shards. Requires NamespaceID. Optional.
+		Query string
+		// RefreshTasks regenerates the visibility tasks of the workflow executions in the history service
+		// instead of writing their records to the target visibility store. The history service writes the
+		// records to all the visibility stores with new task IDs, so they replace the existing records even
+		// if the mutable states didn't change since. Records are written once the tasks are processed.
+		RefreshTasks bool
+		// RPS limits the number of workflow executions reindexed per second across all shards.
+		RPS float64
+		// ConcurrentActivityCount is the number of shards reindexed concurrently.
+		ConcurrentActivityCount int
+		// PageSize is the number of workflow executions read from persistence at once.
+		PageSize int
+		// ShardCountPerExecution is the number of shards reindexed before continue-as-new.
+		ShardCountPerExecution int
+
+		// Progress is carried over continue-as-new. A previous reindex can be resumed by setting
+		// Progress.NextShardID to the one reported by its progress query.
+		Progress Progress
+	}
+
+	// Progress is the progress of the visibility reindex workflow.
+	Progress struct {
+		NumHistoryShards int32
+		// NextShardID is the first shard which is not reindexed yet. Shards are reindexed in order.
+		NextShardID int32
+		// ReindexedCount is the number of workflow executions written to the target visibility store.
+		ReindexedCount int64
+		// SkippedCount is the number of workflow executions which don't need a visibility record,
+		// e.g. because they were deleted while being reindexed.
+		SkippedCount        int64
+		ContinuedAsNewCount int
+	}
+)
+
+var (
+	localActivityOptions = workflow.LocalActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+		StartToCloseTimeout:    10 * time.Second,
+		ScheduleToCloseTimeout: time.Minute,
+	}
+
+	reindexShardActivityOptions = workflow.ActivityOptions{
+		TaskQueue: primitives.VisibilityReindexActivityTQ,
+		// Shards are large, rely on heartbeats for liveness detection. Retries resume from the last
+		// heartbeat, so there is no limit on the number of attempts.
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			MaximumInterval:        5 * time.Minute,
+			NonRetryableErrorTypes: []string{errTypeInvalidArgument},
+		},
+	}
+)
+
+// VisibilityReindexWorkflow rebuilds the visibility records of all the workflow executions, shard by
+// shard, from their mutable state. Records are written to the target visibility store with the
+// versions of the mutable states, so newer records written by the history service are never
+// overwritten, and neither are the existing records of unchanged mutable states: RefreshTasks has to
+// be set to rewrite them. With a query, only the workflow executions matching it are reindexed.
+func VisibilityReindexWorkflow(ctx workflow.Context, params WorkflowParams) error {
+	logger := workflow.GetLogger(ctx)
+
+	if err := workflow.SetQueryHandler(ctx, ProgressQueryType, func() (Progress, error) {
+		return params.Progress, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := validateAndSetParams(ctx, &params); err != nil {
+		return err
+	}
+
+	if params.Query != "" {
+		ctx1 := workflow.WithActivityOptions(ctx, reindexShardActivityOptions)
+		var result reindexShardResponse
+		err := workflow.ExecuteActivity(ctx1, reindexQueryActivityName, &reindexQueryRequest{
+			TargetVisibilityStore: params.TargetVisibilityStore,
+			NamespaceID:           params.NamespaceID,
+			Query:                 params.Query,
+			NumHistoryShards:      params.Progress.NumHistoryShards,
+			RefreshTasks:          params.RefreshTasks,
+			RPS:                   params.RPS,
+			PageSize:              params.PageSize,
+		}).Get(ctx, &result)
+		if err != nil {
+			return err
+		}
+		params.Progress.ReindexedCount += result.ReindexedCount
+		params.Progress.SkippedCount += result.SkippedCount
+		logger.Info("Visibility reindex completed.",
+			"reindexed", params.Progress.ReindexedCount,
+			"skipped", params.Progress.SkippedCount)
+		return nil
+	}
+
+	lastShardID := min(
+		params.Progress.NextShardID+int32(params.ShardCountPerExecution)-1,
+		params.Progress.NumHistoryShards,
+	)
+	for params.Progress.NextShardID <= lastShardID {
+		batchSize := min(int32(params.ConcurrentActivityCount), lastShardID-params.Progress.NextShardID+1)
+		ctx1 := workflow.WithActivityOptions(ctx, reindexShardActivityOptions)
+		futures := make([]workflow.Future, 0, batchSize)
+		for shardID := params.Progress.NextShardID; shardID < params.Progress.NextShardID+batchSize; shardID++ {
+			futures = append(futures, workflow.ExecuteActivity(ctx1, reindexShardActivityName, &reindexShardRequest{
+				ShardID:               shardID,
+				TargetVisibilityStore: params.TargetVisibilityStore,
+				NamespaceID:           params.NamespaceID,
+				RefreshTasks:          params.RefreshTasks,
+				RPS:                   params.RPS / float64(params.ConcurrentActivityCount),
+				PageSize:              params.PageSize,
+			}))
+		}
+		for _, future := range futures {
+			var result reindexShardResponse
+			if err := future.Get(ctx, &result); err != nil {
+				return err
+			}
+			params.Progress.ReindexedCount += result.ReindexedCount
+			params.Progress.SkippedCount += result.SkippedCount
+		}
+		params.Progress.NextShardID += batchSize
+	}
+
+	if params.Progress.NextShardID > params.Progress.NumHistoryShards {
+		logger.Info("Visibility reindex completed.",
+			"reindexed", params.Progress.ReindexedCount,
+			"skipped", params.Progress.SkippedCount)
+		return nil
+	}
+
+	// There are still more shards to reindex. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit.
+	params.Progress.ContinuedAsNewCount++
+	return workflow.NewContinueAsNewError(ctx, VisibilityReindexWorkflow, params)
+}
+
+func validateAndSetParams(ctx workflow.Context, params *WorkflowParams) error {
+	if params.RPS <= 0 {
+		params.RPS = defaultRPS
+	}
+	if params.ConcurrentActivityCount <= 0 {
+		params.ConcurrentActivityCount = defaultConcurrentActivityCount
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.ShardCountPerExecution <= 0 {
+		params.ShardCountPerExecution = defaultShardCountPerExecution
+	}
+	if params.Progress.NextShardID <= 0 {
+		params.Progress.NextShardID = 1
+	}
+	if params.Query != "" && params.NamespaceID == "" {
+		return temporal.NewNonRetryableApplicationError(
+			"InvalidArgument: NamespaceID is required to reindex the workflow executions matching a query", errTypeInvalidArgument, nil)
+	}
+
+	if params.Progress.NumHistoryShards > 0 && params.TargetVisibilityStore != "" {
+		// Already validated before continue-as-new.
+		return nil
+	}
+
+	ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+	var metadata metadataResponse
+	err := workflow.ExecuteLocalActivity(ctx1, getMetadataActivityName, &metadataRequest{
+		TargetVisibilityStore: params.TargetVisibilityStore,
+	}).Get(ctx, &metadata)
+	if err != nil {
+		return err
+	}
+	params.TargetVisibilityStore = metadata.TargetVisibilityStore
+	params.Progress.NumHistoryShards = metadata.NumHistoryShards
+	return nil
+}
@@ -0,0 +1,166 @@
+package visibilityreindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+)
+
+func newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(VisibilityReindexWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	var la *localActivities
+	var a *activities
+	env.RegisterActivityWithOptions(la.GetMetadata, activity.RegisterOptions{Name: getMetadataActivityName})
+	env.RegisterActivityWithOptions(a.ReindexShard, activity.RegisterOptions{Name: reindexShardActivityName})
+	env.RegisterActivityWithOptions(a.ReindexQuery, activity.RegisterOptions{Name: reindexQueryActivityName})
+	return env
+}
+
+func Test_VisibilityReindexWorkflow(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getMetadataActivityName, mock.Anything, &metadataRequest{}).
+		Return(&metadataResponse{TargetVisibilityStore: "es-visibility", NumHistoryShards: 3}, nil).Once()
+	for shardID := int32(1); shardID <= 3; shardID++ {
+		env.OnActivity(reindexShardActivityName, mock.Anything, &reindexShardRequest{
+			ShardID:               shardID,
+			TargetVisibilityStore: "es-visibility",
+			RPS:                   50,
+			PageSize:              defaultPageSize,
+		}).Return(&reindexShardResponse{ReindexedCount: 10, SkippedCount: 1}, nil).Once()
+	}
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		RPS:                     100,
+		ConcurrentActivityCount: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	result, err := env.QueryWorkflow(ProgressQueryType)
+	require.NoError(t, err)
+	var progress Progress
+	require.NoError(t, result.Get(&progress))
+	require.Equal(t, Progress{
+		NumHistoryShards: 3,
+		NextShardID:      4,
+		ReindexedCount:   30,
+		SkippedCount:     3,
+	}, progress)
+}
+
+func Test_VisibilityReindexWorkflow_ContinueAsNew(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	for _, shardID := range []int32{5, 6} {
+		env.OnActivity(reindexShardActivityName, mock.Anything, &reindexShardRequest{
+			ShardID:               shardID,
+			TargetVisibilityStore: "es-visibility",
+			NamespaceID:           "namespace-id",
+			RPS:                   defaultRPS,
+			PageSize:              10,
+		}).Return(&reindexShardResponse{ReindexedCount: 10}, nil).Once()
+	}
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		TargetVisibilityStore:   "es-visibility",
+		NamespaceID:             "namespace-id",
+		ConcurrentActivityCount: 1,
+		PageSize:                10,
+		ShardCountPerExecution:  2,
+		// Resume a previous reindex, metadata is not requested again.
+		Progress: Progress{
+			NumHistoryShards: 8,
+			NextShardID:      5,
+			ReindexedCount:   100,
+		},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.ErrorAs(t, env.GetWorkflowError(), &continueAsNewErr)
+	env.AssertExpectations(t)
+
+	result, err := env.QueryWorkflow(ProgressQueryType)
+	require.NoError(t, err)
+	var progress Progress
+	require.NoError(t, result.Get(&progress))
+	require.Equal(t, Progress{
+		NumHistoryShards:    8,
+		NextShardID:         7,
+		ReindexedCount:      120,
+		ContinuedAsNewCount: 1,
+	}, progress)
+}
+
+func Test_VisibilityReindexWorkflow_InvalidTargetVisibilityStore(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getMetadataActivityName, mock.Anything, &metadataRequest{TargetVisibilityStore: "unknown"}).
+		Return(nil, temporal.NewNonRetryableApplicationError("InvalidArgument: data store \"unknown\" is not configured", errTypeInvalidArgument, nil)).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		TargetVisibilityStore: "unknown",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, env.GetWorkflowError(), &appErr)
+	require.Equal(t, errTypeInvalidArgument, appErr.Type())
+	env.AssertExpectations(t)
+}
+
+func Test_VisibilityReindexWorkflow_Query(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.OnActivity(getMetadataActivityName, mock.Anything, &metadataRequest{}).
+		Return(&metadataResponse{TargetVisibilityStore: "sql-visibility", NumHistoryShards: 4}, nil).Once()
+	env.OnActivity(reindexQueryActivityName, mock.Anything, &reindexQueryRequest{
+		TargetVisibilityStore: "sql-visibility",
+		NamespaceID:           "namespace-id",
+		Query:                 "CustomKeywordField IS NOT NULL",
+		NumHistoryShards:      4,
+		RPS:                   defaultRPS,
+		PageSize:              defaultPageSize,
+	}).Return(&reindexShardResponse{ReindexedCount: 10, SkippedCount: 1}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		NamespaceID: "namespace-id",
+		Query:       "CustomKeywordField IS NOT NULL",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	result, err := env.QueryWorkflow(ProgressQueryType)
+	require.NoError(t, err)
+	var progress Progress
+	require.NoError(t, result.Get(&progress))
+	require.EqualValues(t, 10, progress.ReindexedCount)
+	require.EqualValues(t, 1, progress.SkippedCount)
+}
+
+func Test_VisibilityReindexWorkflow_QueryWithoutNamespace(t *testing.T) {
+	env := newTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Query: "CustomKeywordField IS NOT NULL",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, env.GetWorkflowError(), &appErr)
+	require.Equal(t, errTypeInvalidArgument, appErr.Type())
+}
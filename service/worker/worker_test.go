@@ -0,0 +1,111 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.temporal.io/server/service/worker/dlq"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/mock/gomock"
+)
+
+// notStartedWorker registers workflows and activities like a worker but doesn't poll.
+type notStartedWorker struct {
+	sdkworker.Worker
+}
+
+func (w notStartedWorker) Start() error { return nil }
+
+func (w notStartedWorker) Stop() {}
+
+// TestWorkerManager_RegistersAllComponents starts the worker manager with all the worker components, their workflows
+// and activities must have unique names on the workers they share.
+func TestWorkerManager_RegistersAllComponents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var components []workercommon.WorkerComponent
+	fxtest.New(
+		t,
+		workerComponentModules,
+		workerComponentTestDeps(),
+		fx.Invoke(fx.Annotate(func(c []workercommon.WorkerComponent) {
+			components = c
+		}, fx.ParamTags(workercommon.WorkerComponentTag))),
+	).RequireStart().RequireStop()
+	require.NotEmpty(t, components)
+
+	sdkClient, err := sdkclient.NewLazyClient(sdkclient.Options{})
+	require.NoError(t, err)
+	clientFactory := sdk.NewMockClientFactory(ctrl)
+	clientFactory.EXPECT().GetSystemClient().Return(sdkClient)
+	clientFactory.EXPECT().NewWorker(sdkClient, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(c sdkclient.Client, taskQueue string, options sdkworker.Options) sdkworker.Worker {
+			return notStartedWorker{Worker: sdkworker.New(c, taskQueue, options)}
+		}).AnyTimes()
+
+	wm := NewWorkerManager(components, log.NewTestLogger(), clientFactory, membership.NewHostInfoFromAddress("self"))
+	require.NotPanics(t, wm.Start)
+	wm.Stop()
+}
+
+// workerComponentTestDeps provides the dependencies of the worker components. They are not used to register workflows
+// and activities, so they are left empty.
+func workerComponentTestDeps() fx.Option {
+	return fx.Options(
+		fx.Supply(
+			&config.Persistence{},
+			dynamicconfig.NewNoopCollection(),
+			fx.Annotate(log.NewTestLogger(), fx.As(new(log.Logger))),
+			fx.Annotate(metrics.NoopMetricsHandler, fx.As(new(metrics.Handler))),
+			dlq.CurrentClusterName("active"),
+		),
+		provideEmpty[persistence.ExecutionManager](),
+		provideEmpty[persistence.TaskManager](),
+		provideEmpty[persistence.MetadataManager](),
+		provideEmpty[persistence.NamespaceReplicationQueue](),
+		provideEmpty[persistence.NexusEndpointManager](),
+		provideEmpty[manager.VisibilityManager](),
+		provideEmpty[visibility.VisibilityStoreFactory](),
+		provideEmpty[esclient.Client](),
+		provideEmpty[namespace.Registry](),
+		provideEmpty[cluster.Metadata](),
+		provideEmpty[searchattribute.Manager](),
+		provideEmpty[searchattribute.Provider](),
+		provideEmpty[searchattribute.MapperProvider](),
+		provideEmpty[resolver.ServiceResolver](),
+		provideEmpty[resource.HistoryClient](),
+		provideEmpty[workflowservice.WorkflowServiceClient](),
+		provideEmpty[client.Factory](),
+		provideEmpty[client.Bean](),
+		provideEmpty[dlq.HistoryClient](),
+		provideEmpty[dlq.TaskClientDialer](),
+	)
+}
+
+func provideEmpty[T any]() fx.Option {
+	return fx.Provide(func() T {
+		var empty T
+		return empty
+	})
+}
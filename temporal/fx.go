@@ -21,6 +21,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -37,6 +38,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/visibility"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	osclient "go.temporal.io/server/common/persistence/visibility/store/opensearch/client"
 	"go.temporal.io/server/common/pprof"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -101,6 +103,7 @@ type (
 		ServiceResolver        resolver.ServiceResolver
 		CustomDataStoreFactory persistenceClient.AbstractDataStoreFactory
 		CustomVisibilityStore  visibility.VisibilityStoreFactory
+		ArchiverFactories      provider.ArchiverFactories
 
 		SearchAttributesMapper     searchattribute.Mapper
 		CustomFrontendInterceptors []grpc.UnaryServerInterceptor
@@ -221,14 +224,25 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 
 	// EsConfig / EsClient
 	var esConfig *esclient.Config
+	var osConfig *osclient.Config
 	var esClient esclient.Client
 
 	if persistenceConfig.VisibilityConfigExist() &&
 		persistenceConfig.DataStores[persistenceConfig.VisibilityStore].Elasticsearch != nil {
 		esConfig = persistenceConfig.DataStores[persistenceConfig.VisibilityStore].Elasticsearch
+	} else if persistenceConfig.VisibilityConfigExist() &&
+		persistenceConfig.DataStores[persistenceConfig.VisibilityStore].OpenSearch != nil {
+		osConfig = persistenceConfig.DataStores[persistenceConfig.VisibilityStore].OpenSearch
 	} else if persistenceConfig.SecondaryVisibilityConfigExist() &&
 		persistenceConfig.DataStores[persistenceConfig.SecondaryVisibilityStore].Elasticsearch != nil {
 		esConfig = persistenceConfig.DataStores[persistenceConfig.SecondaryVisibilityStore].Elasticsearch
+	} else if persistenceConfig.SecondaryVisibilityConfigExist() &&
+		persistenceConfig.DataStores[persistenceConfig.SecondaryVisibilityStore].OpenSearch != nil {
+		osConfig = persistenceConfig.DataStores[persistenceConfig.SecondaryVisibilityStore].OpenSearch
+	}
+	if osConfig != nil {
+		// OpenSearch connection settings are Elasticsearch ones.
+		esConfig = &osConfig.Config
 	}
 
 	if esConfig != nil {
@@ -242,10 +256,18 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 			}
 		}
 
-		esClient, err = esclient.NewClient(esConfig, esHttpClient, logger)
-		if err != nil {
-			return serverOptionsProvider{}, fmt.Errorf("unable to create Elasticsearch client (URL = %v, username = %q): %w",
-				esConfig.URL.Redacted(), esConfig.Username, err)
+		if osConfig != nil {
+			esClient, err = osclient.NewClient(osConfig, esHttpClient, logger)
+			if err != nil {
+				return serverOptionsProvider{}, fmt.Errorf("unable to create OpenSearch client (URL = %v, username = %q): %w",
+					osConfig.URL.Redacted(), osConfig.Username, err)
+			}
+		} else {
+			esClient, err = esclient.NewClient(esConfig, esHttpClient, logger)
+			if err != nil {
+				return serverOptionsProvider{}, fmt.Errorf("unable to create Elasticsearch client (URL = %v, username = %q): %w",
+					esConfig.URL.Redacted(), esConfig.Username, err)
+			}
 		}
 	}
 
@@ -275,6 +297,7 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		ServiceResolver:        so.persistenceServiceResolver,
 		CustomDataStoreFactory: so.customDataStoreFactory,
 		CustomVisibilityStore:  so.customVisibilityStoreFactory,
+		ArchiverFactories:      so.archiverFactories,
 
 		SearchAttributesMapper:     so.searchAttributesMapper,
 		CustomFrontendInterceptors: so.customFrontendInterceptors,
@@ -349,6 +372,7 @@ type (
 		ClaimMapper                authorization.ClaimMapper
 		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
 		VisibilityStoreFactory     visibility.VisibilityStoreFactory
+		ArchiverFactories          provider.ArchiverFactories
 		SpanExporters              []otelsdktrace.SpanExporter
 		InstanceID                 resource.InstanceID                     `optional:"true"`
 		StaticServiceHosts         map[primitives.ServiceName]static.Hosts `optional:"true"`
@@ -387,6 +411,9 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() visibility.VisibilityStoreFactory {
 				return params.VisibilityStoreFactory
 			},
+			func() provider.ArchiverFactories {
+				return params.ArchiverFactories
+			},
 			func() client.FactoryProvider {
 				return params.ClientFactoryProvider
 			},
@@ -585,6 +612,7 @@ func ApplyClusterMetadataConfigProvider(
 		persistenceServiceResolver,
 		&svc.Persistence,
 		customDataStoreFactory,
+		nil,
 		logger,
 		metricsHandler,
 		telemetry.NoopTracerProvider,
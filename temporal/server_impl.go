@@ -156,6 +156,7 @@ func initSystemNamespaces(
 		persistenceServiceResolver,
 		cfg,
 		customDataStoreFactory,
+		nil,
 		logger,
 		metricsHandler,
 		telemetry.NoopTracerProvider,
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -147,6 +148,19 @@ func WithCustomVisibilityStoreFactory(customFactory visibility.VisibilityStoreFa
 	})
 }
 
+// WithArchiverFactory registers the factory of the history and visibility archivers of a custom URI scheme.
+// The config block of the archivers is read from the custom section of the archival provider config, keyed
+// by scheme. A factory registered for the scheme of a built-in archiver replaces the built-in archiver.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithArchiverFactory(scheme string, factory provider.ArchiverFactory) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		if s.archiverFactories == nil {
+			s.archiverFactories = make(provider.ArchiverFactories)
+		}
+		s.archiverFactories[scheme] = factory
+	})
+}
+
 // WithClientFactoryProvider sets a custom ClientFactoryProvider
 // NOTE: this option is experimental and may be changed or removed in future release.
 func WithClientFactoryProvider(clientFactoryProvider client.FactoryProvider) ServerOption {
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -49,6 +50,7 @@ type (
 		dynamicConfigClient          dynamicconfig.Client
 		customDataStoreFactory       persistenceClient.AbstractDataStoreFactory
 		customVisibilityStoreFactory visibility.VisibilityStoreFactory
+		archiverFactories            provider.ArchiverFactories
 		clientFactoryProvider        client.FactoryProvider
 		searchAttributesMapper       searchattribute.Mapper
 		customFrontendInterceptors   []grpc.UnaryServerInterceptor
@@ -75,6 +77,12 @@ func (so *serverOptions) loadAndValidate() error {
 		}
 	}
 
+	for scheme, factory := range so.archiverFactories {
+		if scheme == "" || factory == nil {
+			return fmt.Errorf("invalid archiver factory %v for scheme %q", factory, scheme)
+		}
+	}
+
 	if so.config == nil {
 		err := so.loadConfig()
 		if err != nil {
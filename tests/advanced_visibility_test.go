@@ -509,6 +509,26 @@ func (s *AdvancedVisibilitySuite) TestListWorkflow_KeywordQuery() {
 	resp, err = s.FrontendClient().ListWorkflowExecutions(testcore.NewContext(), listRequest)
 	s.NoError(err)
 	s.Len(resp.GetExecutions(), 0)
+
+	// Substring, suffix and case-insensitive pattern search
+	for query, expectedCount := range map[string]int{
+		`CONTAINS(CustomKeywordField, "ice for")`:  1,
+		`CONTAINS(CustomKeywordField, "Ice for")`:  0,
+		`ENDS_WITH(CustomKeywordField, "for all")`: 1,
+		`ENDS_WITH(CustomKeywordField, "justice")`: 0,
+		`ILIKE(CustomKeywordField, "JUST_CE%ALL")`: 1,
+		`ILIKE(CustomKeywordField, "%for")`:        0,
+		`NOT CONTAINS(CustomKeywordField, "ice")`:  0,
+	} {
+		listRequest = &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace: s.Namespace().String(),
+			PageSize:  testcore.DefaultPageSize,
+			Query:     fmt.Sprintf(`WorkflowId = %q AND %s`, id, query),
+		}
+		resp, err = s.FrontendClient().ListWorkflowExecutions(testcore.NewContext(), listRequest)
+		s.NoError(err)
+		s.Len(resp.GetExecutions(), expectedCount, query)
+	}
 }
 
 func (s *AdvancedVisibilitySuite) TestListWorkflow_StringQuery() {
@@ -572,6 +592,22 @@ func (s *AdvancedVisibilitySuite) TestListWorkflow_StringQuery() {
 	resp, err = s.FrontendClient().ListWorkflowExecutions(testcore.NewContext(), listRequest)
 	s.NoError(err)
 	s.Len(resp.GetExecutions(), 1)
+
+	// Full-text match of all the words
+	for query, expectedCount := range map[string]int{
+		`MATCH(CustomTextField) AGAINST ("matters nothing")`:     1,
+		`MATCH(CustomTextField) AGAINST ("nothing really")`:      0,
+		`NOT MATCH(CustomTextField) AGAINST ("nothing matters")`: 0,
+	} {
+		listRequest = &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace: s.Namespace().String(),
+			PageSize:  testcore.DefaultPageSize,
+			Query:     fmt.Sprintf(`WorkflowId = %q AND %s`, id, query),
+		}
+		resp, err = s.FrontendClient().ListWorkflowExecutions(testcore.NewContext(), listRequest)
+		s.NoError(err)
+		s.Len(resp.GetExecutions(), expectedCount, query)
+	}
 }
 
 // To test last page search trigger max window size error
@@ -487,7 +487,7 @@ func newArchiverBase(enabled bool, executionManager persistence.ExecutionManager
 	if !enabled {
 		return &ArchiverBase{
 			metadata: archiver.NewArchivalMetadata(dcCollection, "", false, "", false, &config.ArchivalNamespaceDefaults{}),
-			provider: provider.NewArchiverProvider(nil, nil, nil, logger, metrics.NoopMetricsHandler),
+			provider: provider.NewArchiverProvider(nil, nil, nil, nil, logger, metrics.NoopMetricsHandler),
 		}
 	}
 
@@ -510,6 +510,7 @@ func newArchiverBase(enabled bool, executionManager persistence.ExecutionManager
 		&config.VisibilityArchiverProvider{
 			Filestore: cfg,
 		},
+		nil,
 		executionManager,
 		logger,
 		metrics.NoopMetricsHandler,
@@ -103,6 +103,10 @@ const (
 	CLIOptQuiet = "quiet"
 	// CLIOptForce is the cli option for force mode
 	CLIOptForce = "force"
+	// CLIOptOutput is the cli option for the output file or directory
+	CLIOptOutput = "output"
+	// CLIOptInput is the cli option for the input file
+	CLIOptInput = "input"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
@@ -150,6 +154,10 @@ const (
 	CLIFlagQuiet = CLIOptQuiet + ", q"
 	// CLIFlagForce is the cli flag for force mode
 	CLIFlagForce = CLIOptForce + ", f"
+	// CLIFlagOutput is the cli flag for the output file or directory
+	CLIFlagOutput = CLIOptOutput + ", o"
+	// CLIFlagInput is the cli flag for the input file
+	CLIFlagInput = CLIOptInput + ", i"
 	// CLIFlagDisableInitialHostLookup is the cli flag for only using supplied hosts to connect to the database
 	CLIFlagDisableInitialHostLookup = "disable-initial-host-lookup"
 
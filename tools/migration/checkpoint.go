@@ -0,0 +1,94 @@
+package migration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+)
+
+type (
+	// checkpoint records the progress of a migration, so an interrupted migration can be resumed where it stopped.
+	// Phases are resumed from the page they were copying, items which were already copied are skipped.
+	checkpoint struct {
+		path string
+
+		// Completed are the phases which were copied entirely.
+		Completed []Phase `json:"completed,omitempty"`
+		// Cursor is the position of the phase in progress, if any.
+		Cursor *cursor `json:"cursor,omitempty"`
+	}
+
+	// cursor is the position of a phase: the page token of the page it's copying, and for phases which are copied
+	// shard by shard, category by category or namespace by namespace, the shard, category and namespace of the page.
+	cursor struct {
+		Phase       Phase  `json:"phase"`
+		ShardID     int32  `json:"shardId,omitempty"`
+		CategoryID  int    `json:"categoryId,omitempty"`
+		NamespaceID string `json:"namespaceId,omitempty"`
+		PageToken   []byte `json:"pageToken,omitempty"`
+	}
+)
+
+// loadCheckpoint reads the checkpoint at path. A missing file is an empty checkpoint, an empty path is a checkpoint
+// which is kept in memory only.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	cp := &checkpoint{path: path}
+	if path == "" {
+		return cp, nil
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read checkpoint: %w", err)
+	}
+	if err := json.Unmarshal(content, cp); err != nil {
+		return nil, fmt.Errorf("unable to parse checkpoint %v: %w", path, err)
+	}
+	return cp, nil
+}
+
+func (c *checkpoint) isCompleted(phase Phase) bool {
+	return slices.Contains(c.Completed, phase)
+}
+
+// cursorOf returns the cursor of the phase to resume from, or nil if the phase wasn't started.
+func (c *checkpoint) cursorOf(phase Phase) *cursor {
+	if c.Cursor == nil || c.Cursor.Phase != phase {
+		return nil
+	}
+	return c.Cursor
+}
+
+func (c *checkpoint) advance(position cursor) error {
+	c.Cursor = &position
+	return c.save()
+}
+
+func (c *checkpoint) complete(phase Phase) error {
+	c.Completed = append(c.Completed, phase)
+	c.Cursor = nil
+	return c.save()
+}
+
+// save writes the checkpoint to a temporary file first, so the checkpoint file is never partially written.
+func (c *checkpoint) save() error {
+	if c.path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("unable to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return fmt.Errorf("unable to write checkpoint: %w", err)
+	}
+	return nil
+}
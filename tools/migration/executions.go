@@ -0,0 +1,403 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// historyTaskCategories are the categories of the history tasks which are persisted.
+var historyTaskCategories = []tasks.Category{
+	tasks.CategoryTransfer,
+	tasks.CategoryTimer,
+	tasks.CategoryReplication,
+	tasks.CategoryVisibility,
+	tasks.CategoryArchival,
+	tasks.CategoryOutbound,
+}
+
+func (m *Migrator) migrateExecutions(ctx context.Context, cp *checkpoint) error {
+	for shardID := resumedShardID(cp, PhaseExecutions); shardID <= m.config.NumHistoryShards; shardID++ {
+		err := paginate(cp, cursor{Phase: PhaseExecutions, ShardID: shardID}, func(pageToken []byte) ([]byte, error) {
+			resp, err := m.source.execution.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+				ShardID:   shardID,
+				PageSize:  m.config.PageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, state := range resp.States {
+				if err := m.migrateExecution(ctx, shardID, state); err != nil {
+					return nil, err
+				}
+			}
+			return resp.NextPageToken, nil
+		})
+		if err != nil {
+			return fmt.Errorf("shard %d: %w", shardID, err)
+		}
+	}
+	return nil
+}
+
+// migrateExecution creates the execution in the target, as the current execution of its workflow if it's the current
+// execution in the source. Buffered events can't be part of a snapshot, they are appended by an update each after the
+// execution is created, the execution is created with a DB record version accounting for these updates.
+func (m *Migrator) migrateExecution(
+	ctx context.Context,
+	shardID int32,
+	state *persistence.InternalWorkflowMutableState,
+) error {
+	snapshot, err := m.newWorkflowSnapshot(state)
+	if err != nil {
+		return err
+	}
+	rangeID, err := m.targetRangeID(ctx, shardID)
+	if err != nil {
+		return err
+	}
+
+	numBufferedEvents := int64(len(state.BufferedEvents))
+	if snapshot.DBRecordVersion != 0 && snapshot.DBRecordVersion < numBufferedEvents {
+		return fmt.Errorf("execution %v/%v/%v has %d buffered events but DB record version %d",
+			snapshot.NamespaceID, snapshot.WorkflowID, snapshot.RunID, numBufferedEvents, snapshot.DBRecordVersion)
+	}
+	dbRecordVersion := func(appendedBufferedEvents int64) int64 {
+		if snapshot.DBRecordVersion == 0 {
+			return 0
+		}
+		return snapshot.DBRecordVersion - numBufferedEvents + appendedBufferedEvents
+	}
+
+	var appended int64
+	existing, err := m.target.execution.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: snapshot.NamespaceID,
+		WorkflowID:  snapshot.WorkflowID,
+		RunID:       snapshot.RunID,
+	})
+	switch {
+	case err == nil:
+		// created before the migration was interrupted, possibly without all of its buffered events
+		appended = int64(len(existing.State.BufferedEvents))
+	case isNotFound(err):
+		mode, err := m.createWorkflowMode(ctx, shardID, snapshot)
+		if err != nil {
+			return err
+		}
+		createSnapshot := *snapshot
+		createSnapshot.DBRecordVersion = dbRecordVersion(0)
+		_, err = m.target.execution.CreateWorkflowExecution(ctx, &persistence.InternalCreateWorkflowExecutionRequest{
+			ShardID:             shardID,
+			RangeID:             rangeID,
+			Mode:                mode,
+			NewWorkflowSnapshot: createSnapshot,
+		})
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	for ; appended < numBufferedEvents; appended++ {
+		err := m.target.execution.UpdateWorkflowExecution(ctx, &persistence.InternalUpdateWorkflowExecutionRequest{
+			ShardID: shardID,
+			RangeID: rangeID,
+			Mode:    persistence.UpdateWorkflowModeIgnoreCurrent,
+			UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+				NamespaceID:        snapshot.NamespaceID,
+				WorkflowID:         snapshot.WorkflowID,
+				RunID:              snapshot.RunID,
+				ExecutionInfo:      snapshot.ExecutionInfo,
+				ExecutionInfoBlob:  snapshot.ExecutionInfoBlob,
+				ExecutionState:     snapshot.ExecutionState,
+				ExecutionStateBlob: snapshot.ExecutionStateBlob,
+				NextEventID:        snapshot.NextEventID,
+				LastWriteVersion:   snapshot.LastWriteVersion,
+				DBRecordVersion:    dbRecordVersion(appended + 1),
+				NewBufferedEvents:  state.BufferedEvents[appended],
+				Condition:          snapshot.NextEventID,
+				Checksum:           snapshot.Checksum,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createWorkflowMode returns the mode to create the execution with, depending on whether it's the current execution
+// of its workflow in the source.
+func (m *Migrator) createWorkflowMode(
+	ctx context.Context,
+	shardID int32,
+	snapshot *persistence.InternalWorkflowSnapshot,
+) (persistence.CreateWorkflowMode, error) {
+	current, err := m.source.execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: snapshot.NamespaceID,
+		WorkflowID:  snapshot.WorkflowID,
+	})
+	if err != nil && !isNotFound(err) {
+		return 0, err
+	}
+	if err == nil && current.RunID == snapshot.RunID {
+		return persistence.CreateWorkflowModeBrandNew, nil
+	}
+	return persistence.CreateWorkflowModeBypassCurrent, nil
+}
+
+// newWorkflowSnapshot returns a snapshot of the execution with the blobs of the source. The execution info is decoded
+// for the keys of the execution and its last write version.
+func (m *Migrator) newWorkflowSnapshot(state *persistence.InternalWorkflowMutableState) (*persistence.InternalWorkflowSnapshot, error) {
+	executionInfoBlob, err := serialization.DecryptBlob(state.ExecutionInfo, m.config.KeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	executionInfo, err := m.serializer.WorkflowExecutionInfoFromBlob(executionInfoBlob)
+	if err != nil {
+		return nil, err
+	}
+	executionState, err := m.serializer.WorkflowExecutionStateFromBlob(state.ExecutionState)
+	if err != nil {
+		return nil, err
+	}
+	lastWriteVersion := common.EmptyVersion
+	if executionInfo.GetVersionHistories() != nil {
+		currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+		if err != nil {
+			return nil, err
+		}
+		lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+		if err != nil {
+			return nil, err
+		}
+		lastWriteVersion = lastItem.GetVersion()
+	}
+	chasmNodes, err := m.convertChasmNodes(state.ChasmNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIDs))
+	for _, id := range state.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	return &persistence.InternalWorkflowSnapshot{
+		NamespaceID:         executionInfo.GetNamespaceId(),
+		WorkflowID:          executionInfo.GetWorkflowId(),
+		RunID:               executionState.GetRunId(),
+		ExecutionInfo:       executionInfo,
+		ExecutionInfoBlob:   state.ExecutionInfo,
+		ExecutionState:      executionState,
+		ExecutionStateBlob:  state.ExecutionState,
+		LastWriteVersion:    lastWriteVersion,
+		NextEventID:         state.NextEventID,
+		DBRecordVersion:     state.DBRecordVersion,
+		ActivityInfos:       state.ActivityInfos,
+		TimerInfos:          state.TimerInfos,
+		ChildExecutionInfos: state.ChildExecutionInfos,
+		RequestCancelInfos:  state.RequestCancelInfos,
+		SignalInfos:         state.SignalInfos,
+		ChasmNodes:          chasmNodes,
+		SignalRequestedIDs:  signalRequestedIDs,
+		Condition:           state.NextEventID,
+		Checksum:            state.Checksum,
+	}, nil
+}
+
+// convertChasmNodes converts the CHASM nodes between the single blob of Cassandra stores and the metadata and data
+// blobs of SQL stores, if the source and the target are of different kinds. The node data, which may be encrypted, is
+// encrypted again after the conversion.
+func (m *Migrator) convertChasmNodes(nodes map[string]persistence.InternalChasmNode) (map[string]persistence.InternalChasmNode, error) {
+	if m.source.isCassandra() == m.target.isCassandra() {
+		return nodes, nil
+	}
+	converted := make(map[string]persistence.InternalChasmNode, len(nodes))
+	for path, node := range nodes {
+		decoded, encrypted, err := m.decodeChasmNode(node)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode CHASM node %v: %w", path, err)
+		}
+		var convertedNode persistence.InternalChasmNode
+		if m.target.isCassandra() {
+			convertedNode.CassandraBlob, err = m.serializer.ChasmNodeToBlob(decoded, enumspb.ENCODING_TYPE_PROTO3)
+			if err == nil && encrypted {
+				convertedNode.CassandraBlob, err = serialization.EncryptBlob(convertedNode.CassandraBlob, m.config.KeyProvider)
+			}
+		} else {
+			convertedNode.Metadata, convertedNode.Data, err = m.serializer.ChasmNodeToBlobs(decoded, enumspb.ENCODING_TYPE_PROTO3)
+			if err == nil && encrypted {
+				convertedNode.Data, err = serialization.EncryptBlob(convertedNode.Data, m.config.KeyProvider)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert CHASM node %v: %w", path, err)
+		}
+		converted[path] = convertedNode
+	}
+	return converted, nil
+}
+
+// decodeChasmNode decodes the node, and returns whether it was encrypted.
+func (m *Migrator) decodeChasmNode(node persistence.InternalChasmNode) (*persistencespb.ChasmNode, bool, error) {
+	if node.CassandraBlob != nil {
+		encrypted := serialization.IsEncryptedBlob(node.CassandraBlob)
+		blob, err := serialization.DecryptBlob(node.CassandraBlob, m.config.KeyProvider)
+		if err != nil {
+			return nil, false, err
+		}
+		decoded, err := m.serializer.ChasmNodeFromBlob(blob)
+		return decoded, encrypted, err
+	}
+	encrypted := serialization.IsEncryptedBlob(node.Data)
+	data, err := serialization.DecryptBlob(node.Data, m.config.KeyProvider)
+	if err != nil {
+		return nil, false, err
+	}
+	decoded, err := m.serializer.ChasmNodeFromBlobs(node.Metadata, data)
+	return decoded, encrypted, err
+}
+
+func (m *Migrator) migrateHistoryTasks(ctx context.Context, cp *checkpoint) error {
+	for shardID := resumedShardID(cp, PhaseHistoryTasks); shardID <= m.config.NumHistoryShards; shardID++ {
+		categories := historyTaskCategories
+		if resumed := cp.cursorOf(PhaseHistoryTasks); resumed != nil && resumed.ShardID == shardID {
+			for len(categories) > 0 && categories[0].ID() != resumed.CategoryID {
+				categories = categories[1:]
+			}
+		}
+		for _, category := range categories {
+			position := cursor{Phase: PhaseHistoryTasks, ShardID: shardID, CategoryID: category.ID()}
+			err := paginate(cp, position, func(pageToken []byte) ([]byte, error) {
+				return m.migrateHistoryTaskPage(ctx, shardID, category, pageToken)
+			})
+			if err != nil {
+				return fmt.Errorf("shard %d, category %v: %w", shardID, category.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// migrateHistoryTaskPage copies a page of history tasks, except the ones which were copied before the migration was
+// interrupted, which stores may not allow to write twice.
+func (m *Migrator) migrateHistoryTaskPage(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	pageToken []byte,
+) ([]byte, error) {
+	resp, err := m.source.execution.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+		ShardID:             shardID,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: tasks.MinimumKey,
+		ExclusiveMaxTaskKey: tasks.MaximumKey,
+		BatchSize:           m.config.PageSize,
+		NextPageToken:       pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Tasks) == 0 {
+		return resp.NextPageToken, nil
+	}
+
+	// scheduled tasks are read up to an exclusive fire time, which stores compare at their own precision, so the range
+	// is widened to include the fire time of the last task; tasks outside of the page are read but ignored
+	exclusiveMaxKey := resp.Tasks[len(resp.Tasks)-1].Key.Next()
+	if category.Type() == tasks.CategoryTypeScheduled {
+		exclusiveMaxKey = tasks.NewKey(exclusiveMaxKey.FireTime.Add(time.Second), 0)
+	}
+	existing := make(map[tasks.Key]struct{})
+	err = readHistoryTasks(ctx, m.target, shardID, category, resp.Tasks[0].Key, exclusiveMaxKey, m.config.PageSize,
+		func(task persistence.InternalHistoryTask) error {
+			existing[task.Key] = struct{}{}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	byWorkflow := make(map[definition.WorkflowKey][]persistence.InternalHistoryTask)
+	for _, task := range resp.Tasks {
+		if _, ok := existing[task.Key]; ok {
+			continue
+		}
+		decoded, err := m.serializer.DeserializeTask(category, task.Blob)
+		if err != nil {
+			return nil, err
+		}
+		workflowKey := definition.NewWorkflowKey(decoded.GetNamespaceID(), decoded.GetWorkflowID(), "")
+		byWorkflow[workflowKey] = append(byWorkflow[workflowKey], task)
+	}
+	if len(byWorkflow) == 0 {
+		return resp.NextPageToken, nil
+	}
+
+	rangeID, err := m.targetRangeID(ctx, shardID)
+	if err != nil {
+		return nil, err
+	}
+	for workflowKey, workflowTasks := range byWorkflow {
+		err := m.target.execution.AddHistoryTasks(ctx, &persistence.InternalAddHistoryTasksRequest{
+			ShardID:     shardID,
+			RangeID:     rangeID,
+			NamespaceID: workflowKey.NamespaceID,
+			WorkflowID:  workflowKey.WorkflowID,
+			Tasks:       map[tasks.Category][]persistence.InternalHistoryTask{category: workflowTasks},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.NextPageToken, nil
+}
+
+// readHistoryTasks calls fn with the history tasks of the category in the key range.
+func readHistoryTasks(
+	ctx context.Context,
+	s *stores,
+	shardID int32,
+	category tasks.Category,
+	inclusiveMinKey tasks.Key,
+	exclusiveMaxKey tasks.Key,
+	pageSize int,
+	fn func(task persistence.InternalHistoryTask) error,
+) error {
+	var pageToken []byte
+	for {
+		resp, err := s.execution.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: inclusiveMinKey,
+			ExclusiveMaxTaskKey: exclusiveMaxKey,
+			BatchSize:           pageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, task := range resp.Tasks {
+			if err := fn(task); err != nil {
+				return err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
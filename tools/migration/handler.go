@@ -0,0 +1,154 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/resolver"
+)
+
+var errVerificationFailed = errors.New("source and target don't match")
+
+// migrate copies the source store into the target store, then compares them unless skipped.
+func migrate(c *cli.Context, logger log.Logger) error {
+	migrator, closeStores, err := newMigratorFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	if err := migrator.Migrate(context.Background()); err != nil {
+		return err
+	}
+	if c.Bool(flagSkipVerify) {
+		return nil
+	}
+	return runVerification(migrator, logger)
+}
+
+// verify compares the source store and the target store.
+func verify(c *cli.Context, logger log.Logger) error {
+	migrator, closeStores, err := newMigratorFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	return runVerification(migrator, logger)
+}
+
+func runVerification(migrator *Migrator, logger log.Logger) error {
+	results, err := migrator.Verify(context.Background())
+	if err != nil {
+		return err
+	}
+	var mismatch bool
+	for _, result := range results {
+		fmt.Println(result)
+		mismatch = mismatch || !result.Matches()
+	}
+	if mismatch {
+		return errVerificationFailed
+	}
+	logger.Info("Source and target match.")
+	return nil
+}
+
+// newMigratorFromCLI returns a Migrator between the default stores of the source and target configs, and a function
+// closing the stores.
+func newMigratorFromCLI(c *cli.Context, logger log.Logger) (*Migrator, func(), error) {
+	if c.GlobalString(flagTargetConfigDir) == "" {
+		return nil, nil, fmt.Errorf("--%v is required", flagTargetConfigDir)
+	}
+	sourceCfg, err := config.LoadConfig(
+		c.GlobalString(flagSourceEnv),
+		c.GlobalString(flagSourceConfigDir),
+		c.GlobalString(flagSourceZone),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load source config: %w", err)
+	}
+	if err := sourceCfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid source config: %w", err)
+	}
+	targetCfg, err := config.LoadConfig(
+		c.GlobalString(flagTargetEnv),
+		c.GlobalString(flagTargetConfigDir),
+		c.GlobalString(flagTargetZone),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load target config: %w", err)
+	}
+	if err := targetCfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid target config: %w", err)
+	}
+	if sourceCfg.Persistence.NumHistoryShards != targetCfg.Persistence.NumHistoryShards {
+		return nil, nil, fmt.Errorf("source has %d history shards but target has %d, the number of history shards can't be changed",
+			sourceCfg.Persistence.NumHistoryShards, targetCfg.Persistence.NumHistoryShards)
+	}
+
+	var keyProvider serialization.KeyProvider
+	if encryption := sourceCfg.Persistence.Encryption; encryption != nil {
+		keyProvider, err = serialization.NewFileKeyRing(encryption.KeyRingFile, encryption.KeyRingRefreshInterval, logger)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to load source key ring: %w", err)
+		}
+	}
+
+	source, err := newDataStoreFactory(sourceCfg, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("source: %w", err)
+	}
+	target, err := newDataStoreFactory(targetCfg, logger)
+	if err != nil {
+		source.Close()
+		return nil, nil, fmt.Errorf("target: %w", err)
+	}
+	closeStores := func() {
+		source.Close()
+		target.Close()
+	}
+
+	migrator, err := NewMigrator(source, target, Config{
+		NumHistoryShards: sourceCfg.Persistence.NumHistoryShards,
+		PageSize:         c.GlobalInt(flagPageSize),
+		CheckpointFile:   c.String(flagCheckpointFile),
+		KeyProvider:      keyProvider,
+	}, logger)
+	if err != nil {
+		closeStores()
+		return nil, nil, err
+	}
+	logger.Info("Opened stores.",
+		tag.NewStringTag("source", migrator.source.execution.GetName()),
+		tag.NewStringTag("target", migrator.target.execution.GetName()),
+	)
+	return migrator, closeStores, nil
+}
+
+// newDataStoreFactory returns the factory of the default store of the config, which must be a Cassandra or SQL store.
+func newDataStoreFactory(cfg *config.Config, logger log.Logger) (persistence.DataStoreFactory, error) {
+	storeCfg, ok := cfg.Persistence.DataStores[cfg.Persistence.DefaultStore]
+	if !ok {
+		return nil, fmt.Errorf("default store %q isn't configured", cfg.Persistence.DefaultStore)
+	}
+	clusterName := cfg.ClusterMetadata.CurrentClusterName
+	switch {
+	case storeCfg.Cassandra != nil:
+		return cassandra.NewFactory(*storeCfg.Cassandra, resolver.NewNoopResolver(), clusterName, logger, metrics.NoopMetricsHandler), nil
+	case storeCfg.SQL != nil:
+		return sql.NewFactory(*storeCfg.SQL, resolver.NewNoopResolver(), clusterName, logger, metrics.NoopMetricsHandler), nil
+	default:
+		return nil, fmt.Errorf("default store %q must be a cassandra or sql store", cfg.Persistence.DefaultStore)
+	}
+}
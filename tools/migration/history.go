@@ -0,0 +1,159 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// historyBranch is a branch of a history tree with the tokens to read it from the source and write it to the target.
+	historyBranch struct {
+		info         *persistencespb.HistoryBranch
+		treeInfo     *persistencespb.HistoryTreeInfo
+		treeInfoBlob *commonpb.DataBlob
+		shardID      int32
+		sourceToken  []byte
+		targetToken  []byte
+	}
+)
+
+// migrateHistory copies the branches of all history trees along with their nodes.
+func (m *Migrator) migrateHistory(ctx context.Context, cp *checkpoint) error {
+	return paginate(cp, cursor{Phase: PhaseHistory}, func(pageToken []byte) ([]byte, error) {
+		resp, err := m.source.execution.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+			NextPageToken: pageToken,
+			PageSize:      m.config.PageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, detail := range resp.Branches {
+			if err := m.migrateHistoryBranch(ctx, detail); err != nil {
+				return nil, err
+			}
+		}
+		return resp.NextPageToken, nil
+	})
+}
+
+// migrateHistoryBranch writes the tree row of the branch first, so the copied nodes of a branch are never left without
+// it if the migration is interrupted.
+func (m *Migrator) migrateHistoryBranch(ctx context.Context, detail persistence.InternalHistoryBranchDetail) error {
+	branch, err := m.newHistoryBranch(detail)
+	if err != nil {
+		return err
+	}
+	forkNodeID := common.FirstEventID
+	if ancestors := branch.info.GetAncestors(); len(ancestors) > 0 {
+		forkNodeID = ancestors[len(ancestors)-1].GetEndNodeId()
+	}
+	err = m.target.execution.ForkHistoryBranch(ctx, &persistence.InternalForkHistoryBranchRequest{
+		NewBranchToken: branch.targetToken,
+		ForkBranchInfo: branch.info,
+		TreeInfo:       branch.treeInfoBlob,
+		ForkNodeID:     forkNodeID,
+		NewBranchID:    branch.info.GetBranchId(),
+		Info:           branch.treeInfo.GetInfo(),
+		ShardID:        branch.shardID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return readHistoryNodes(ctx, m.source, branch.shardID, branch.sourceToken, branch.info.GetBranchId(), m.config.PageSize, func(node persistence.InternalHistoryNode) error {
+		err := m.target.execution.AppendHistoryNodes(ctx, &persistence.InternalAppendHistoryNodesRequest{
+			BranchToken: branch.targetToken,
+			IsNewBranch: false,
+			Info:        branch.treeInfo.GetInfo(),
+			BranchInfo:  branch.info,
+			TreeInfo:    branch.treeInfoBlob,
+			Node:        node,
+			ShardID:     branch.shardID,
+		})
+		var conditionFailed *persistence.ConditionFailedError
+		if errors.As(err, &conditionFailed) {
+			// copied before the migration was interrupted
+			return nil
+		}
+		return err
+	})
+}
+
+// newHistoryBranch decodes the tree info of the branch, which records the execution the tree belongs to. History trees
+// are stored in the shard of their execution.
+func (m *Migrator) newHistoryBranch(detail persistence.InternalHistoryBranchDetail) (*historyBranch, error) {
+	treeInfoBlob := persistence.NewDataBlob(detail.Data, detail.Encoding)
+	treeInfo, err := m.serializer.HistoryTreeInfoFromBlob(treeInfoBlob)
+	if err != nil {
+		return nil, err
+	}
+	namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(treeInfo.GetInfo())
+	if err != nil {
+		return nil, err
+	}
+	info := treeInfo.GetBranchInfo()
+	branchID := info.GetBranchId()
+	sourceToken, err := m.source.execution.GetHistoryBranchUtil().NewHistoryBranch(
+		namespaceID, workflowID, runID, info.GetTreeId(), &branchID, info.GetAncestors(), 0, 0, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	targetToken, err := m.target.execution.GetHistoryBranchUtil().NewHistoryBranch(
+		namespaceID, workflowID, runID, info.GetTreeId(), &branchID, info.GetAncestors(), 0, 0, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyBranch{
+		info:         info,
+		treeInfo:     treeInfo,
+		treeInfoBlob: treeInfoBlob,
+		shardID:      common.WorkflowIDToHistoryShard(namespaceID, workflowID, m.config.NumHistoryShards),
+		sourceToken:  sourceToken,
+		targetToken:  targetToken,
+	}, nil
+}
+
+// readHistoryNodes calls fn with the nodes of the branch itself, without the nodes of its ancestors. All transactions
+// of a node are returned, including the ones which were overwritten by a later transaction.
+func readHistoryNodes(
+	ctx context.Context,
+	s *stores,
+	shardID int32,
+	branchToken []byte,
+	branchID string,
+	pageSize int,
+	fn func(node persistence.InternalHistoryNode) error,
+) error {
+	var pageToken []byte
+	for {
+		resp, err := s.execution.ReadHistoryBranch(ctx, &persistence.InternalReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			BranchID:      branchID,
+			MinNodeID:     common.FirstEventID,
+			MaxNodeID:     math.MaxInt64,
+			PageSize:      pageSize,
+			NextPageToken: pageToken,
+			ShardID:       shardID,
+		})
+		if err != nil {
+			return err
+		}
+		for _, node := range resp.Nodes {
+			if err := fn(node); err != nil {
+				return err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
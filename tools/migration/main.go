@@ -0,0 +1,114 @@
+package migration
+
+import (
+	"os"
+
+	"github.com/urfave/cli"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	flagSourceConfigDir = "source-config-dir"
+	flagSourceEnv       = "source-env"
+	flagSourceZone      = "source-zone"
+	flagTargetConfigDir = "target-config-dir"
+	flagTargetEnv       = "target-env"
+	flagTargetZone      = "target-zone"
+	flagCheckpointFile  = "checkpoint-file"
+	flagPageSize        = "page-size"
+	flagSkipVerify      = "skip-verify"
+)
+
+// RunTool runs the temporal-migration-tool command line tool
+func RunTool(args []string) error {
+	app := BuildCLIOptions()
+	return app.Run(args)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context, logger log.Logger) error, logger log.Logger) {
+	if err := handler(c, logger); err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+// BuildCLIOptions builds the options for cli
+func BuildCLIOptions() *cli.App {
+	app := cli.NewApp()
+	app.Name = "temporal-migration-tool"
+	app.Usage = "Command line tool to migrate the data of a temporal cluster between persistence stores"
+	app.Version = "0.0.1"
+
+	logger := log.NewCLILogger()
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   flagSourceConfigDir,
+			Value:  "config",
+			Usage:  "config directory of the cluster whose default store is read",
+			EnvVar: "MIGRATION_SOURCE_CONFIG_DIR",
+		},
+		cli.StringFlag{
+			Name:   flagSourceEnv,
+			Value:  "development",
+			Usage:  "runtime environment of the source config",
+			EnvVar: "MIGRATION_SOURCE_ENV",
+		},
+		cli.StringFlag{
+			Name:   flagSourceZone,
+			Usage:  "availability zone of the source config",
+			EnvVar: "MIGRATION_SOURCE_ZONE",
+		},
+		cli.StringFlag{
+			Name:   flagTargetConfigDir,
+			Usage:  "config directory of the cluster whose default store is written",
+			EnvVar: "MIGRATION_TARGET_CONFIG_DIR",
+		},
+		cli.StringFlag{
+			Name:   flagTargetEnv,
+			Value:  "development",
+			Usage:  "runtime environment of the target config",
+			EnvVar: "MIGRATION_TARGET_ENV",
+		},
+		cli.StringFlag{
+			Name:   flagTargetZone,
+			Usage:  "availability zone of the target config",
+			EnvVar: "MIGRATION_TARGET_ZONE",
+		},
+		cli.IntFlag{
+			Name:  flagPageSize,
+			Value: defaultPageSize,
+			Usage: "number of items read from the stores at once",
+		},
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:  "migrate",
+			Usage: "copies the data of the source store into the target store, the servers of both clusters must be stopped",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  flagCheckpointFile,
+					Usage: "file the progress is recorded in; an interrupted migration is resumed from it when run again",
+				},
+				cli.BoolFlag{
+					Name:  flagSkipVerify,
+					Usage: "don't compare the source and the target after the migration",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, migrate, logger)
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "compares the number of items and checksums of their content between the source and the target stores",
+			Action: func(c *cli.Context) {
+				cliHandler(c, verify, logger)
+			},
+		},
+	}
+
+	return app
+}
@@ -0,0 +1,398 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// Phase is a category of data copied by a Migrator.
+type Phase string
+
+const (
+	PhaseNamespaces        Phase = "namespaces"
+	PhaseNexusEndpoints    Phase = "nexus-endpoints"
+	PhaseShards            Phase = "shards"
+	PhaseHistory           Phase = "history"
+	PhaseExecutions        Phase = "executions"
+	PhaseHistoryTasks      Phase = "history-tasks"
+	PhaseTaskQueues        Phase = "task-queues"
+	PhaseTaskQueueUserData Phase = "task-queue-user-data"
+
+	defaultPageSize = 100
+)
+
+// Phases are the phases of a migration, in the order they are run. Shards are copied before the executions and
+// history tasks, since writes to them are conditioned on the range ID of their shard, and history is copied before the
+// executions, so a copied execution never references missing history.
+var Phases = []Phase{
+	PhaseNamespaces,
+	PhaseNexusEndpoints,
+	PhaseShards,
+	PhaseHistory,
+	PhaseExecutions,
+	PhaseHistoryTasks,
+	PhaseTaskQueues,
+	PhaseTaskQueueUserData,
+}
+
+type (
+	// Config is the config of a Migrator.
+	Config struct {
+		// NumHistoryShards is the number of history shards of both the source and the target.
+		NumHistoryShards int32
+		// PageSize is the number of items read at once, defaults to 100.
+		PageSize int
+		// CheckpointFile is the path of the file the progress of the migration is recorded in, so an interrupted
+		// migration can be resumed. The progress isn't recorded if empty.
+		CheckpointFile string
+		// KeyProvider decrypts the blobs of the source which are encrypted at rest, see config.PersistenceEncryption.
+		// It's required if any are: blobs are copied as they are, but execution infos are read to route executions and
+		// CHASM nodes are converted when migrating between Cassandra and SQL stores.
+		KeyProvider serialization.KeyProvider
+	}
+
+	// Migrator copies the data of a persistence store into another, which may use a different database, e.g. from
+	// Cassandra to PostgreSQL. Data is copied through the store level persistence interfaces, so blobs are copied as
+	// they are without being decoded. The servers using either store should be stopped during the migration.
+	//
+	// Items which already exist in the target are skipped, so a migration can be resumed from its checkpoint after
+	// it was interrupted, see Config.CheckpointFile.
+	Migrator struct {
+		source     *stores
+		target     *stores
+		config     Config
+		serializer serialization.Serializer
+		logger     log.Logger
+
+		// targetRangeIDs are the range IDs of the target shards, writes to the executions and history tasks of a
+		// shard are conditioned on it.
+		targetRangeIDs map[int32]int64
+	}
+
+	// stores are the stores of a DataStoreFactory holding the data copied by a Migrator.
+	stores struct {
+		shard     persistence.ShardStore
+		task      persistence.TaskStore
+		metadata  persistence.MetadataStore
+		execution persistence.ExecutionStore
+		nexus     persistence.NexusEndpointStore
+	}
+)
+
+// NewMigrator returns a Migrator copying the data of source into target. The factories are owned by the caller.
+func NewMigrator(
+	source persistence.DataStoreFactory,
+	target persistence.DataStoreFactory,
+	config Config,
+	logger log.Logger,
+) (*Migrator, error) {
+	if config.NumHistoryShards <= 0 {
+		return nil, fmt.Errorf("number of history shards must be positive, got %d", config.NumHistoryShards)
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = defaultPageSize
+	}
+	sourceStores, err := newStores(source)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open source stores: %w", err)
+	}
+	targetStores, err := newStores(target)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open target stores: %w", err)
+	}
+	return &Migrator{
+		source:         sourceStores,
+		target:         targetStores,
+		config:         config,
+		serializer:     serialization.NewSerializer(),
+		logger:         logger,
+		targetRangeIDs: make(map[int32]int64),
+	}, nil
+}
+
+func newStores(factory persistence.DataStoreFactory) (*stores, error) {
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		return nil, err
+	}
+	taskStore, err := factory.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	metadataStore, err := factory.NewMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	executionStore, err := factory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	nexusStore, err := factory.NewNexusEndpointStore()
+	if err != nil {
+		return nil, err
+	}
+	return &stores{
+		shard:     shardStore,
+		task:      taskStore,
+		metadata:  metadataStore,
+		execution: executionStore,
+		nexus:     nexusStore,
+	}, nil
+}
+
+// isCassandra returns true if the stores are Cassandra stores, which store CHASM nodes in a single blob.
+func (s *stores) isCassandra() bool {
+	return strings.Contains(s.execution.GetName(), "cassandra")
+}
+
+// Migrate copies all phases of the migration which aren't completed yet according to the checkpoint.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	cp, err := loadCheckpoint(m.config.CheckpointFile)
+	if err != nil {
+		return err
+	}
+	for _, phase := range Phases {
+		if cp.isCompleted(phase) {
+			m.logger.Info("Skipping completed migration phase.", tag.NewStringTag("phase", string(phase)))
+			continue
+		}
+		if cp.cursorOf(phase) != nil {
+			m.logger.Info("Resuming migration phase.", tag.NewStringTag("phase", string(phase)))
+		} else {
+			m.logger.Info("Starting migration phase.", tag.NewStringTag("phase", string(phase)))
+		}
+		if err := m.migratePhase(ctx, phase, cp); err != nil {
+			return fmt.Errorf("unable to migrate %v: %w", phase, err)
+		}
+		if err := cp.complete(phase); err != nil {
+			return err
+		}
+	}
+	m.logger.Info("Migration completed.")
+	return nil
+}
+
+func (m *Migrator) migratePhase(ctx context.Context, phase Phase, cp *checkpoint) error {
+	switch phase {
+	case PhaseNamespaces:
+		return m.migrateNamespaces(ctx, cp)
+	case PhaseNexusEndpoints:
+		return m.migrateNexusEndpoints(ctx, cp)
+	case PhaseShards:
+		return m.migrateShards(ctx)
+	case PhaseHistory:
+		return m.migrateHistory(ctx, cp)
+	case PhaseExecutions:
+		return m.migrateExecutions(ctx, cp)
+	case PhaseHistoryTasks:
+		return m.migrateHistoryTasks(ctx, cp)
+	case PhaseTaskQueues:
+		return m.migrateTaskQueues(ctx, cp)
+	case PhaseTaskQueueUserData:
+		return m.migrateTaskQueueUserData(ctx, cp)
+	default:
+		return fmt.Errorf("unknown phase %q", phase)
+	}
+}
+
+func (m *Migrator) migrateNamespaces(ctx context.Context, cp *checkpoint) error {
+	return paginate(cp, cursor{Phase: PhaseNamespaces}, func(pageToken []byte) ([]byte, error) {
+		resp, err := m.source.metadata.ListNamespaces(ctx, &persistence.InternalListNamespacesRequest{
+			PageSize:      m.config.PageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			if err := m.migrateNamespace(ctx, ns); err != nil {
+				return nil, err
+			}
+		}
+		return resp.NextPageToken, nil
+	})
+}
+
+func (m *Migrator) migrateNamespace(ctx context.Context, ns *persistence.InternalGetNamespaceResponse) error {
+	detail, err := m.serializer.NamespaceDetailFromBlob(ns.Namespace)
+	if err != nil {
+		return err
+	}
+	id := detail.GetInfo().GetId()
+	_, err = m.target.metadata.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: id})
+	if !isNotFound(err) {
+		return err
+	}
+	_, err = m.target.metadata.CreateNamespace(ctx, &persistence.InternalCreateNamespaceRequest{
+		ID:        id,
+		Name:      detail.GetInfo().GetName(),
+		Namespace: ns.Namespace,
+		IsGlobal:  ns.IsGlobal,
+	})
+	return err
+}
+
+// migrateNexusEndpoints copies the endpoints, which are assigned a new version by the target.
+func (m *Migrator) migrateNexusEndpoints(ctx context.Context, cp *checkpoint) error {
+	resp, err := m.target.nexus.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{PageSize: 1})
+	if err != nil {
+		return err
+	}
+	targetTableVersion := resp.TableVersion
+
+	return paginate(cp, cursor{Phase: PhaseNexusEndpoints}, func(pageToken []byte) ([]byte, error) {
+		resp, err := m.source.nexus.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{
+			NextPageToken: pageToken,
+			PageSize:      m.config.PageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, endpoint := range resp.Endpoints {
+			_, err := m.target.nexus.GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: endpoint.ID})
+			if !isNotFound(err) {
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+			err = m.target.nexus.CreateOrUpdateNexusEndpoint(ctx, &persistence.InternalCreateOrUpdateNexusEndpointRequest{
+				LastKnownTableVersion: targetTableVersion,
+				Endpoint: persistence.InternalNexusEndpoint{
+					ID:   endpoint.ID,
+					Data: endpoint.Data,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			targetTableVersion++
+		}
+		return resp.NextPageToken, nil
+	})
+}
+
+// migrateShards copies the shards with their range ID. Shards which were never acquired don't exist in the source and
+// aren't copied either.
+func (m *Migrator) migrateShards(ctx context.Context) error {
+	for shardID := int32(1); shardID <= m.config.NumHistoryShards; shardID++ {
+		resp, err := m.source.shard.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{ShardID: shardID})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		shardInfo, err := m.serializer.ShardInfoFromBlob(resp.ShardInfo)
+		if err != nil {
+			return err
+		}
+		_, err = m.target.shard.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{
+			ShardID: shardID,
+			CreateShardInfo: func() (int64, *commonpb.DataBlob, error) {
+				return shardInfo.GetRangeId(), resp.ShardInfo, nil
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) targetRangeID(ctx context.Context, shardID int32) (int64, error) {
+	if rangeID, ok := m.targetRangeIDs[shardID]; ok {
+		return rangeID, nil
+	}
+	resp, err := m.target.shard.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{ShardID: shardID})
+	if err != nil {
+		return 0, fmt.Errorf("unable to get target shard %d: %w", shardID, err)
+	}
+	shardInfo, err := m.serializer.ShardInfoFromBlob(resp.ShardInfo)
+	if err != nil {
+		return 0, err
+	}
+	m.targetRangeIDs[shardID] = shardInfo.GetRangeId()
+	return shardInfo.GetRangeId(), nil
+}
+
+// paginate calls copyPage with the page tokens of the pages at position until it returns an empty next page token.
+// It starts from the page recorded in the checkpoint if the migration is resumed at position. The position of each
+// page is recorded before the page is copied, so a page is copied again if the migration is interrupted while the
+// page is being copied.
+func paginate(cp *checkpoint, position cursor, copyPage func(pageToken []byte) ([]byte, error)) error {
+	if resumed := cp.cursorOf(position.Phase); resumed != nil && resumed.samePartition(position) {
+		position.PageToken = resumed.PageToken
+	}
+	for {
+		if err := cp.advance(position); err != nil {
+			return err
+		}
+		nextPageToken, err := copyPage(position.PageToken)
+		if err != nil {
+			return err
+		}
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		position.PageToken = nextPageToken
+	}
+}
+
+// samePartition returns true if the cursors are in the same shard, category and namespace.
+func (c *cursor) samePartition(other cursor) bool {
+	return c.ShardID == other.ShardID && c.CategoryID == other.CategoryID && c.NamespaceID == other.NamespaceID
+}
+
+// resumedShardID returns the shard to start the phase from, which is the first shard unless it's resumed.
+func resumedShardID(cp *checkpoint, phase Phase) int32 {
+	if resumed := cp.cursorOf(phase); resumed != nil && resumed.ShardID > 0 {
+		return resumed.ShardID
+	}
+	return 1
+}
+
+// listNamespaceIDs returns the IDs of all namespaces of the stores, in ascending order.
+func (m *Migrator) listNamespaceIDs(ctx context.Context, s *stores) ([]string, error) {
+	var ids []string
+	var pageToken []byte
+	for {
+		resp, err := s.metadata.ListNamespaces(ctx, &persistence.InternalListNamespacesRequest{
+			PageSize:      m.config.PageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			detail, err := m.serializer.NamespaceDetailFromBlob(ns.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, detail.GetInfo().GetId())
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// isNotFound returns true if the error is a not found error of any kind.
+func isNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	return errors.As(err, &notFound) || errors.As(err, &namespaceNotFound)
+}
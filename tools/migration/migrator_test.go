@@ -0,0 +1,349 @@
+package migration
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	clockspb "go.temporal.io/server/api/clock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/sql"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/tests"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testNumHistoryShards = 4
+
+type testCluster struct {
+	factory   p.DataStoreFactory
+	shard     p.ShardManager
+	execution p.ExecutionManager
+	task      p.TaskManager
+	metadata  p.MetadataManager
+	nexus     p.NexusEndpointStore
+}
+
+func newTestCluster(t *testing.T) *testCluster {
+	logger := log.NewTestLogger()
+	factory := sql.NewFactory(
+		config.SQL{
+			PluginName:         "sqlite",
+			DatabaseName:       uuid.NewString(),
+			TaskScanPartitions: 1,
+			ConnectAttributes:  map[string]string{"mode": "memory", "cache": "private"},
+		},
+		resolver.NewNoopResolver(),
+		"active",
+		logger,
+		metrics.NoopMetricsHandler,
+	)
+	t.Cleanup(factory.Close)
+
+	serializer := serialization.NewSerializer()
+	shardStore, err := factory.NewShardStore()
+	require.NoError(t, err)
+	executionStore, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+	taskStore, err := factory.NewTaskStore()
+	require.NoError(t, err)
+	metadataStore, err := factory.NewMetadataStore()
+	require.NoError(t, err)
+	nexusStore, err := factory.NewNexusEndpointStore()
+	require.NoError(t, err)
+	return &testCluster{
+		factory: factory,
+		shard:   p.NewShardManager(shardStore, serializer),
+		execution: p.NewExecutionManager(
+			executionStore,
+			serializer,
+			nil,
+			logger,
+			metrics.NoopMetricsHandler,
+			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(serialization.HistoryCompressionNone),
+			nil,
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(false),
+		),
+		task:     p.NewTaskManager(taskStore, serializer),
+		metadata: p.NewMetadataManagerImpl(metadataStore, serializer, logger, "active"),
+		nexus:    nexusStore,
+	}
+}
+
+// seed writes an item of each kind of data copied by a Migrator.
+func (c *testCluster) seed(t *testing.T) {
+	ctx := context.Background()
+	namespaceID := uuid.NewString()
+	_, err := c.metadata.CreateNamespace(ctx, &p.CreateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:              &persistencespb.NamespaceInfo{Id: namespaceID, Name: "migration-test-" + namespaceID, State: enumspb.NAMESPACE_STATE_REGISTERED},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	})
+	require.NoError(t, err)
+
+	endpoints, err := c.nexus.ListNexusEndpoints(ctx, &p.ListNexusEndpointsRequest{PageSize: 1})
+	require.NoError(t, err)
+	err = c.nexus.CreateOrUpdateNexusEndpoint(ctx, &p.InternalCreateOrUpdateNexusEndpointRequest{
+		LastKnownTableVersion: endpoints.TableVersion,
+		Endpoint: p.InternalNexusEndpoint{
+			ID:   uuid.NewString(),
+			Data: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("endpoint")},
+		},
+	})
+	require.NoError(t, err)
+
+	workflowID := uuid.NewString()
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, testNumHistoryShards)
+	shard, err := c.shard.GetOrCreateShard(ctx, &p.GetOrCreateShardRequest{
+		ShardID:          shardID,
+		InitialShardInfo: &persistencespb.ShardInfo{ShardId: shardID, RangeId: 1},
+	})
+	require.NoError(t, err)
+	rangeID := shard.ShardInfo.GetRangeId()
+
+	// a closed run which isn't the current run of its workflow
+	c.createExecution(t, shardID, rangeID, namespaceID, workflowID, uuid.NewString(), p.CreateWorkflowModeBypassCurrent,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	// the current run, with buffered events and a forked history branch
+	runID := uuid.NewString()
+	branchToken, dbRecordVersion := c.createExecution(t, shardID, rangeID, namespaceID, workflowID, runID, p.CreateWorkflowModeBrandNew,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	nextEventID := common.FirstEventID + 1
+	for range 2 {
+		dbRecordVersion++
+		mutation, events := tests.RandomMutation(t, namespaceID, workflowID, runID, nextEventID, rand.Int63(),
+			enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, dbRecordVersion, branchToken)
+		mutation.ClearBufferedEvents = false
+		mutation.NewBufferedEvents = events[0].Events
+		_, err := c.execution.UpdateWorkflowExecution(ctx, &p.UpdateWorkflowExecutionRequest{
+			ShardID:                shardID,
+			RangeID:                rangeID,
+			Mode:                   p.UpdateWorkflowModeUpdateCurrent,
+			UpdateWorkflowMutation: *mutation,
+			UpdateWorkflowEvents:   events,
+		})
+		require.NoError(t, err)
+		nextEventID++
+	}
+	_, err = c.execution.ForkHistoryBranch(ctx, &p.ForkHistoryBranchRequest{
+		ShardID:         shardID,
+		NamespaceID:     namespaceID,
+		ForkBranchToken: branchToken,
+		ForkNodeID:      nextEventID - 1,
+		Info:            p.BuildHistoryGarbageCleanupInfo(namespaceID, workflowID, runID),
+		NewRunID:        uuid.NewString(),
+	})
+	require.NoError(t, err)
+
+	// both seeds may use the same shard
+	taskID := rangeID<<20 + rand.Int63n(1<<18)*2
+	workflowKey := definition.NewWorkflowKey(namespaceID, workflowID, runID)
+	err = c.execution.AddHistoryTasks(ctx, &p.AddHistoryTasksRequest{
+		ShardID:     shardID,
+		RangeID:     rangeID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer: {&tasks.ActivityTask{
+				WorkflowKey:         workflowKey,
+				VisibilityTimestamp: time.Now().UTC(),
+				TaskID:              taskID,
+				TaskQueue:           "migration-test",
+				ScheduledEventID:    common.FirstEventID,
+			}},
+			tasks.CategoryTimer: {&tasks.UserTimerTask{
+				WorkflowKey:         workflowKey,
+				VisibilityTimestamp: time.Now().UTC().Add(time.Hour),
+				TaskID:              taskID + 1,
+				EventID:             common.FirstEventID,
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	taskQueue := &persistencespb.TaskQueueInfo{
+		NamespaceId:    namespaceID,
+		Name:           "migration-test",
+		TaskType:       enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Kind:           enumspb.TASK_QUEUE_KIND_NORMAL,
+		AckLevel:       10,
+		LastUpdateTime: timestamppb.Now(),
+	}
+	_, err = c.task.CreateTaskQueue(ctx, &p.CreateTaskQueueRequest{RangeID: 1, TaskQueueInfo: taskQueue})
+	require.NoError(t, err)
+	var queuedTasks []*persistencespb.AllocatedTaskInfo
+	for _, taskID := range []int64{5, 11, 12} {
+		queuedTasks = append(queuedTasks, &persistencespb.AllocatedTaskInfo{
+			TaskId: taskID,
+			Data: &persistencespb.TaskInfo{
+				NamespaceId:      namespaceID,
+				WorkflowId:       workflowID,
+				RunId:            runID,
+				ScheduledEventId: common.FirstEventID,
+				CreateTime:       timestamppb.Now(),
+			},
+		})
+	}
+	_, err = c.task.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{Data: taskQueue, RangeID: 1},
+		Tasks:         queuedTasks,
+	})
+	require.NoError(t, err)
+
+	err = c.task.UpdateTaskQueueUserData(ctx, &p.UpdateTaskQueueUserDataRequest{
+		NamespaceID: namespaceID,
+		Updates: map[string]*p.SingleTaskQueueUserDataUpdate{
+			"migration-test": {
+				UserData: &persistencespb.VersionedTaskQueueUserData{
+					Data:    &persistencespb.TaskQueueUserData{Clock: &clockspb.HybridLogicalClock{WallClock: 1}},
+					Version: 0,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+// createExecution creates an execution with a single event, and returns its branch token and DB record version.
+func (c *testCluster) createExecution(
+	t *testing.T,
+	shardID int32,
+	rangeID int64,
+	namespaceID string,
+	workflowID string,
+	runID string,
+	mode p.CreateWorkflowMode,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+) ([]byte, int64) {
+	branchToken := tests.RandomBranchToken(namespaceID, workflowID, runID, c.execution.GetHistoryBranchUtil())
+	snapshot, events := tests.RandomSnapshot(t, namespaceID, workflowID, runID, common.FirstEventID, rand.Int63(),
+		state, status, 1, branchToken)
+	_, err := c.execution.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
+		ShardID:             shardID,
+		RangeID:             rangeID,
+		Mode:                mode,
+		NewWorkflowSnapshot: *snapshot,
+		NewWorkflowEvents:   events,
+	})
+	require.NoError(t, err)
+	return branchToken, snapshot.DBRecordVersion
+}
+
+func newTestMigrator(t *testing.T, source *testCluster, target *testCluster, checkpointFile string) *Migrator {
+	migrator, err := NewMigrator(source.factory, target.factory, Config{
+		NumHistoryShards: testNumHistoryShards,
+		PageSize:         2,
+		CheckpointFile:   checkpointFile,
+	}, log.NewTestLogger())
+	require.NoError(t, err)
+	return migrator
+}
+
+func requireMatches(t *testing.T, results []VerificationResult) {
+	require.Len(t, results, len(verifyCategories))
+	for _, result := range results {
+		require.Truef(t, result.Matches(), "%v", result)
+		require.NotZerof(t, result.SourceCount, "%v", result)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	source := newTestCluster(t)
+	target := newTestCluster(t)
+	source.seed(t)
+	source.seed(t)
+
+	migrator := newTestMigrator(t, source, target, "")
+	require.NoError(t, migrator.Migrate(ctx))
+	results, err := migrator.Verify(ctx)
+	require.NoError(t, err)
+	requireMatches(t, results)
+
+	// the copy is usable through the managers
+	for shardID := int32(1); shardID <= testNumHistoryShards; shardID++ {
+		resp, err := source.execution.ListConcreteExecutions(ctx, &p.ListConcreteExecutionsRequest{ShardID: shardID, PageSize: 10})
+		require.NoError(t, err)
+		for _, state := range resp.States {
+			copied, err := target.execution.GetWorkflowExecution(ctx, &p.GetWorkflowExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: state.ExecutionInfo.NamespaceId,
+				WorkflowID:  state.ExecutionInfo.WorkflowId,
+				RunID:       state.ExecutionState.RunId,
+			})
+			require.NoError(t, err)
+			require.Equal(t, state.NextEventId, copied.State.NextEventId)
+			require.Len(t, copied.State.BufferedEvents, len(state.BufferedEvents))
+		}
+	}
+}
+
+func TestMigrate_Resume(t *testing.T) {
+	ctx := context.Background()
+	source := newTestCluster(t)
+	target := newTestCluster(t)
+	source.seed(t)
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	require.NoError(t, newTestMigrator(t, source, target, checkpointFile).Migrate(ctx))
+	cp, err := loadCheckpoint(checkpointFile)
+	require.NoError(t, err)
+	require.Equal(t, Phases, cp.Completed)
+	require.Nil(t, cp.Cursor)
+
+	// resume a migration interrupted in the middle of the executions, which copies some items again
+	cp.Completed = Phases[:4]
+	cp.Cursor = &cursor{Phase: PhaseExecutions, ShardID: 2}
+	require.NoError(t, cp.save())
+	require.NoError(t, newTestMigrator(t, source, target, checkpointFile).Migrate(ctx))
+
+	// copy everything again
+	migrator := newTestMigrator(t, source, target, "")
+	require.NoError(t, migrator.Migrate(ctx))
+	results, err := migrator.Verify(ctx)
+	require.NoError(t, err)
+	requireMatches(t, results)
+}
+
+func TestVerify_Mismatch(t *testing.T) {
+	ctx := context.Background()
+	source := newTestCluster(t)
+	target := newTestCluster(t)
+	source.seed(t)
+
+	migrator := newTestMigrator(t, source, target, "")
+	require.NoError(t, migrator.Migrate(ctx))
+	source.seed(t)
+
+	results, err := migrator.Verify(ctx)
+	require.NoError(t, err)
+	for _, result := range results {
+		if result.Category == VerifyShards {
+			// the shard may have been copied already
+			continue
+		}
+		require.Falsef(t, result.Matches(), "%v", result)
+		require.Greater(t, result.SourceCount, result.TargetCount)
+	}
+}
@@ -0,0 +1,283 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/matching"
+)
+
+func (m *Migrator) migrateTaskQueues(ctx context.Context, cp *checkpoint) error {
+	return paginate(cp, cursor{Phase: PhaseTaskQueues}, func(pageToken []byte) ([]byte, error) {
+		resp, err := m.source.task.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+			PageSize:  m.config.PageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			if err := m.migrateTaskQueue(ctx, item); err != nil {
+				return nil, err
+			}
+		}
+		return resp.NextPageToken, nil
+	})
+}
+
+// migrateTaskQueue copies the task queue and the tasks of its subqueues which weren't acked yet. The tasks are
+// written with the range ID of the task queue in the target, which differs from the one of the source if the task queue
+// was copied and then updated by a server.
+func (m *Migrator) migrateTaskQueue(ctx context.Context, item *persistence.InternalListTaskQueueItem) error {
+	info, err := m.serializer.TaskQueueInfoFromBlob(item.TaskQueue)
+	if err != nil {
+		return err
+	}
+	rangeID := item.RangeID
+	existing, err := m.target.task.GetTaskQueue(ctx, &persistence.InternalGetTaskQueueRequest{
+		NamespaceID: info.GetNamespaceId(),
+		TaskQueue:   info.GetName(),
+		TaskType:    info.GetTaskType(),
+	})
+	switch {
+	case err == nil:
+		rangeID = existing.RangeID
+	case isNotFound(err):
+		err = m.target.task.CreateTaskQueue(ctx, &persistence.InternalCreateTaskQueueRequest{
+			NamespaceID:   info.GetNamespaceId(),
+			TaskQueue:     info.GetName(),
+			TaskType:      info.GetTaskType(),
+			RangeID:       item.RangeID,
+			TaskQueueInfo: item.TaskQueue,
+			TaskQueueKind: info.GetKind(),
+			ExpiryTime:    info.GetExpiryTime(),
+		})
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	for subqueue := range numSubqueues(info) {
+		if err := m.migrateTasks(ctx, info, subqueue, item, rangeID); err != nil {
+			return fmt.Errorf("task queue %v/%v/%v, subqueue %d: %w",
+				info.GetNamespaceId(), info.GetName(), info.GetTaskType(), subqueue, err)
+		}
+	}
+	return nil
+}
+
+// migrateTasks copies the tasks of the subqueue, except the ones which were copied before the migration was
+// interrupted, which stores may not allow to write twice.
+func (m *Migrator) migrateTasks(
+	ctx context.Context,
+	info *persistencespb.TaskQueueInfo,
+	subqueue int,
+	item *persistence.InternalListTaskQueueItem,
+	targetRangeID int64,
+) error {
+	var pageToken []byte
+	for {
+		resp, err := m.source.task.GetTasks(ctx, &persistence.GetTasksRequest{
+			NamespaceID:        info.GetNamespaceId(),
+			TaskQueue:          info.GetName(),
+			TaskType:           info.GetTaskType(),
+			InclusiveMinTaskID: subqueueAckLevel(info, subqueue) + 1,
+			ExclusiveMaxTaskID: math.MaxInt64,
+			Subqueue:           subqueue,
+			PageSize:           m.config.PageSize,
+			NextPageToken:      pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		if err := m.migrateTaskPage(ctx, info, subqueue, item, targetRangeID, resp); err != nil {
+			return err
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) migrateTaskPage(
+	ctx context.Context,
+	info *persistencespb.TaskQueueInfo,
+	subqueue int,
+	item *persistence.InternalListTaskQueueItem,
+	targetRangeID int64,
+	page *persistence.InternalGetTasksResponse,
+) error {
+	tasks := make([]*persistence.InternalCreateTask, 0, len(page.Tasks))
+	for _, blob := range page.Tasks {
+		task, err := m.serializer.TaskInfoFromBlob(blob)
+		if err != nil {
+			return err
+		}
+		tasks = append(tasks, &persistence.InternalCreateTask{
+			TaskId:     task.GetTaskId(),
+			ExpiryTime: task.GetData().GetExpiryTime(),
+			Task:       blob,
+			Subqueue:   subqueue,
+		})
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	existing := make(map[int64]struct{})
+	err := m.readTasks(ctx, m.target, info, subqueue, tasks[0].TaskId, tasks[len(tasks)-1].TaskId+1, m.config.PageSize,
+		func(task *persistencespb.AllocatedTaskInfo) error {
+			existing[task.GetTaskId()] = struct{}{}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	missing := tasks[:0]
+	for _, task := range tasks {
+		if _, ok := existing[task.TaskId]; !ok {
+			missing = append(missing, task)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	_, err = m.target.task.CreateTasks(ctx, &persistence.InternalCreateTasksRequest{
+		NamespaceID:   info.GetNamespaceId(),
+		TaskQueue:     info.GetName(),
+		TaskType:      info.GetTaskType(),
+		RangeID:       targetRangeID,
+		TaskQueueInfo: item.TaskQueue,
+		Tasks:         missing,
+	})
+	return err
+}
+
+// readTasks calls fn with the decoded tasks of the subqueue in the task ID range.
+func (m *Migrator) readTasks(
+	ctx context.Context,
+	s *stores,
+	info *persistencespb.TaskQueueInfo,
+	subqueue int,
+	inclusiveMinTaskID int64,
+	exclusiveMaxTaskID int64,
+	pageSize int,
+	fn func(task *persistencespb.AllocatedTaskInfo) error,
+) error {
+	var pageToken []byte
+	for {
+		resp, err := s.task.GetTasks(ctx, &persistence.GetTasksRequest{
+			NamespaceID:        info.GetNamespaceId(),
+			TaskQueue:          info.GetName(),
+			TaskType:           info.GetTaskType(),
+			InclusiveMinTaskID: inclusiveMinTaskID,
+			ExclusiveMaxTaskID: exclusiveMaxTaskID,
+			Subqueue:           subqueue,
+			PageSize:           pageSize,
+			NextPageToken:      pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, blob := range resp.Tasks {
+			task, err := m.serializer.TaskInfoFromBlob(blob)
+			if err != nil {
+				return err
+			}
+			if err := fn(task); err != nil {
+				return err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// numSubqueues returns the number of subqueues of the task queue, the first subqueue always exists.
+func numSubqueues(info *persistencespb.TaskQueueInfo) int {
+	return max(1, len(info.GetSubqueues()))
+}
+
+// subqueueAckLevel returns the ack level of the subqueue, the tasks up to it were processed already.
+func subqueueAckLevel(info *persistencespb.TaskQueueInfo, subqueue int) int64 {
+	if subqueue < len(info.GetSubqueues()) {
+		return info.GetSubqueues()[subqueue].GetAckLevel()
+	}
+	return info.GetAckLevel()
+}
+
+// migrateTaskQueueUserData copies the user data of the task queues of each namespace. The user data is assigned a new
+// version by the target.
+func (m *Migrator) migrateTaskQueueUserData(ctx context.Context, cp *checkpoint) error {
+	namespaceIDs, err := m.listNamespaceIDs(ctx, m.source)
+	if err != nil {
+		return err
+	}
+	if resumed := cp.cursorOf(PhaseTaskQueueUserData); resumed != nil {
+		for len(namespaceIDs) > 0 && namespaceIDs[0] < resumed.NamespaceID {
+			namespaceIDs = namespaceIDs[1:]
+		}
+	}
+	for _, namespaceID := range namespaceIDs {
+		position := cursor{Phase: PhaseTaskQueueUserData, NamespaceID: namespaceID}
+		err := paginate(cp, position, func(pageToken []byte) ([]byte, error) {
+			resp, err := m.source.task.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+				NamespaceID:   namespaceID,
+				PageSize:      m.config.PageSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range resp.Entries {
+				if err := m.migrateUserData(ctx, namespaceID, entry); err != nil {
+					return nil, err
+				}
+			}
+			return resp.NextPageToken, nil
+		})
+		if err != nil {
+			return fmt.Errorf("namespace %v: %w", namespaceID, err)
+		}
+	}
+	return nil
+}
+
+// migrateUserData copies the user data of the task queue, and indexes the task queue by the build IDs of its
+// versioning data like matching does when the user data is updated.
+func (m *Migrator) migrateUserData(
+	ctx context.Context,
+	namespaceID string,
+	entry persistence.InternalTaskQueueUserDataEntry,
+) error {
+	_, err := m.target.task.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: namespaceID,
+		TaskQueue:   entry.TaskQueue,
+	})
+	if !isNotFound(err) {
+		return err
+	}
+	userData, err := m.serializer.TaskQueueUserDataFromBlob(entry.Data)
+	if err != nil {
+		return err
+	}
+	buildIDs, _ := matching.GetBuildIdDeltas(nil, userData.GetVersioningData())
+	return m.target.task.UpdateTaskQueueUserData(ctx, &persistence.InternalUpdateTaskQueueUserDataRequest{
+		NamespaceID: namespaceID,
+		Updates: map[string]*persistence.InternalSingleTaskQueueUserDataUpdate{
+			entry.TaskQueue: {
+				UserData:      entry.Data,
+				BuildIdsAdded: buildIDs,
+			},
+		},
+	})
+}
@@ -0,0 +1,461 @@
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	VerifyNamespaces        = "namespaces"
+	VerifyNexusEndpoints    = "nexus-endpoints"
+	VerifyShards            = "shards"
+	VerifyHistoryBranches   = "history-branches"
+	VerifyHistoryNodes      = "history-nodes"
+	VerifyExecutions        = "executions"
+	VerifyHistoryTasks      = "history-tasks"
+	VerifyTaskQueues        = "task-queues"
+	VerifyTasks             = "tasks"
+	VerifyTaskQueueUserData = "task-queue-user-data"
+)
+
+// verifyCategories are the categories compared by Migrator.Verify, in the order they are reported.
+var verifyCategories = []string{
+	VerifyNamespaces,
+	VerifyNexusEndpoints,
+	VerifyShards,
+	VerifyHistoryBranches,
+	VerifyHistoryNodes,
+	VerifyExecutions,
+	VerifyHistoryTasks,
+	VerifyTaskQueues,
+	VerifyTasks,
+	VerifyTaskQueueUserData,
+}
+
+type (
+	// VerificationResult is the comparison of a category of data between the source and the target.
+	VerificationResult struct {
+		Category       string
+		SourceCount    uint64
+		TargetCount    uint64
+		SourceChecksum uint64
+		TargetChecksum uint64
+	}
+
+	// digest is the number of items of a category and a checksum of their content. The checksum doesn't depend on
+	// the order the items are read in, which differs between stores.
+	digest struct {
+		count    uint64
+		checksum uint64
+	}
+
+	digests map[string]*digest
+)
+
+// Matches returns true if the source and the target have the same items in the category.
+func (r VerificationResult) Matches() bool {
+	return r.SourceCount == r.TargetCount && r.SourceChecksum == r.TargetChecksum
+}
+
+func (r VerificationResult) String() string {
+	status := "OK"
+	if !r.Matches() {
+		status = "MISMATCH"
+	}
+	return fmt.Sprintf("%-22s %-8s source: %d items (checksum %016x), target: %d items (checksum %016x)",
+		r.Category, status, r.SourceCount, r.SourceChecksum, r.TargetCount, r.TargetChecksum)
+}
+
+// add adds an item made of parts to the digest.
+func (d *digest) add(parts ...[]byte) {
+	hash := sha256.New()
+	var length [8]byte
+	for _, part := range parts {
+		binary.BigEndian.PutUint64(length[:], uint64(len(part)))
+		_, _ = hash.Write(length[:])
+		_, _ = hash.Write(part)
+	}
+	d.count++
+	d.checksum += binary.BigEndian.Uint64(hash.Sum(nil))
+}
+
+func (d digests) of(category string) *digest {
+	if d[category] == nil {
+		d[category] = &digest{}
+	}
+	return d[category]
+}
+
+// Verify compares the number of items and a checksum of their content between the source and the target for each
+// category of data, after a migration. Versions which the target assigns when the data is written, like the versions of
+// Nexus endpoints and task queue user data, aren't compared.
+func (m *Migrator) Verify(ctx context.Context) ([]VerificationResult, error) {
+	source, err := m.digests(ctx, m.source)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read source: %w", err)
+	}
+	target, err := m.digests(ctx, m.target)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read target: %w", err)
+	}
+	results := make([]VerificationResult, 0, len(verifyCategories))
+	for _, category := range verifyCategories {
+		results = append(results, VerificationResult{
+			Category:       category,
+			SourceCount:    source.of(category).count,
+			TargetCount:    target.of(category).count,
+			SourceChecksum: source.of(category).checksum,
+			TargetChecksum: target.of(category).checksum,
+		})
+	}
+	return results, nil
+}
+
+func (m *Migrator) digests(ctx context.Context, s *stores) (digests, error) {
+	d := make(digests)
+	steps := []func(context.Context, *stores, digests) error{
+		m.digestNamespaces,
+		m.digestNexusEndpoints,
+		m.digestShards,
+		m.digestHistory,
+		m.digestExecutions,
+		m.digestHistoryTasks,
+		m.digestTaskQueues,
+		m.digestTaskQueueUserData,
+	}
+	for _, step := range steps {
+		if err := step(ctx, s, d); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (m *Migrator) digestNamespaces(ctx context.Context, s *stores, d digests) error {
+	var pageToken []byte
+	for {
+		resp, err := s.metadata.ListNamespaces(ctx, &persistence.InternalListNamespacesRequest{
+			PageSize:      m.config.PageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ns := range resp.Namespaces {
+			d.of(VerifyNamespaces).add(blobBytes(ns.Namespace), strconv.AppendBool(nil, ns.IsGlobal))
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) digestNexusEndpoints(ctx context.Context, s *stores, d digests) error {
+	var pageToken []byte
+	for {
+		resp, err := s.nexus.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{
+			NextPageToken: pageToken,
+			PageSize:      m.config.PageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, endpoint := range resp.Endpoints {
+			d.of(VerifyNexusEndpoints).add([]byte(endpoint.ID), blobBytes(endpoint.Data))
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) digestShards(ctx context.Context, s *stores, d digests) error {
+	for shardID := int32(1); shardID <= m.config.NumHistoryShards; shardID++ {
+		resp, err := s.shard.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{ShardID: shardID})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		d.of(VerifyShards).add(int64Bytes(int64(shardID)), blobBytes(resp.ShardInfo))
+	}
+	return nil
+}
+
+// digestHistory adds the branches of all history trees, and the nodes of each branch.
+func (m *Migrator) digestHistory(ctx context.Context, s *stores, d digests) error {
+	var pageToken []byte
+	for {
+		resp, err := s.execution.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+			NextPageToken: pageToken,
+			PageSize:      m.config.PageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, detail := range resp.Branches {
+			d.of(VerifyHistoryBranches).add([]byte(detail.TreeID), []byte(detail.BranchID), detail.Data)
+
+			branch, err := m.newHistoryBranch(detail)
+			if err != nil {
+				return err
+			}
+			token := branch.sourceToken
+			if s == m.target {
+				token = branch.targetToken
+			}
+			err = readHistoryNodes(ctx, s, branch.shardID, token, detail.BranchID, m.config.PageSize, func(node persistence.InternalHistoryNode) error {
+				d.of(VerifyHistoryNodes).add(
+					[]byte(detail.TreeID),
+					[]byte(detail.BranchID),
+					int64Bytes(node.NodeID),
+					int64Bytes(node.TransactionID),
+					int64Bytes(node.PrevTransactionID),
+					blobBytes(node.Events),
+				)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) digestExecutions(ctx context.Context, s *stores, d digests) error {
+	for shardID := int32(1); shardID <= m.config.NumHistoryShards; shardID++ {
+		var pageToken []byte
+		for {
+			resp, err := s.execution.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+				ShardID:   shardID,
+				PageSize:  m.config.PageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return fmt.Errorf("shard %d: %w", shardID, err)
+			}
+			for _, state := range resp.States {
+				parts, err := m.executionParts(ctx, s, shardID, state)
+				if err != nil {
+					return fmt.Errorf("shard %d: %w", shardID, err)
+				}
+				d.of(VerifyExecutions).add(parts...)
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return nil
+}
+
+// executionParts returns the content of the execution compared by Verify, in a deterministic order. The execution
+// state is compared decoded, and so are CHASM nodes if the source and the target store them differently.
+func (m *Migrator) executionParts(
+	ctx context.Context,
+	s *stores,
+	shardID int32,
+	state *persistence.InternalWorkflowMutableState,
+) ([][]byte, error) {
+	snapshot, err := m.newWorkflowSnapshot(state)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: snapshot.NamespaceID,
+		WorkflowID:  snapshot.WorkflowID,
+	})
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	isCurrent := err == nil && current.RunID == snapshot.RunID
+	// SQL stores encode the execution state again when it's written, and the encoding of its request IDs map isn't
+	// deterministic
+	executionState, err := proto.MarshalOptions{Deterministic: true}.Marshal(snapshot.ExecutionState)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := [][]byte{
+		int64Bytes(int64(shardID)),
+		[]byte(snapshot.NamespaceID),
+		[]byte(snapshot.WorkflowID),
+		[]byte(snapshot.RunID),
+		strconv.AppendBool(nil, isCurrent),
+		blobBytes(state.ExecutionInfo),
+		executionState,
+		int64Bytes(state.NextEventID),
+		int64Bytes(state.DBRecordVersion),
+		blobBytes(state.Checksum),
+	}
+	for _, infos := range []map[int64]*commonpb.DataBlob{
+		state.ActivityInfos,
+		state.ChildExecutionInfos,
+		state.RequestCancelInfos,
+		state.SignalInfos,
+	} {
+		parts = append(parts, int64Bytes(int64(len(infos))))
+		for _, key := range slices.Sorted(maps.Keys(infos)) {
+			parts = append(parts, int64Bytes(key), blobBytes(infos[key]))
+		}
+	}
+	parts = append(parts, int64Bytes(int64(len(state.TimerInfos))))
+	for _, key := range slices.Sorted(maps.Keys(state.TimerInfos)) {
+		parts = append(parts, []byte(key), blobBytes(state.TimerInfos[key]))
+	}
+	parts = append(parts, int64Bytes(int64(len(state.SignalRequestedIDs))))
+	for _, id := range slices.Sorted(slices.Values(state.SignalRequestedIDs)) {
+		parts = append(parts, []byte(id))
+	}
+	parts = append(parts, int64Bytes(int64(len(state.BufferedEvents))))
+	for _, events := range state.BufferedEvents {
+		parts = append(parts, blobBytes(events))
+	}
+	parts = append(parts, int64Bytes(int64(len(state.ChasmNodes))))
+	for _, path := range slices.Sorted(maps.Keys(state.ChasmNodes)) {
+		node := state.ChasmNodes[path]
+		parts = append(parts, []byte(path))
+		if m.source.isCassandra() == m.target.isCassandra() {
+			parts = append(parts, blobBytes(node.CassandraBlob), blobBytes(node.Metadata), blobBytes(node.Data))
+			continue
+		}
+		decoded, _, err := m.decodeChasmNode(node)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode CHASM node %v: %w", path, err)
+		}
+		content, err := proto.MarshalOptions{Deterministic: true}.Marshal(decoded)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, content)
+	}
+	return parts, nil
+}
+
+func (m *Migrator) digestHistoryTasks(ctx context.Context, s *stores, d digests) error {
+	for shardID := int32(1); shardID <= m.config.NumHistoryShards; shardID++ {
+		for _, category := range historyTaskCategories {
+			err := readHistoryTasks(ctx, s, shardID, category, tasks.MinimumKey, tasks.MaximumKey, m.config.PageSize,
+				func(task persistence.InternalHistoryTask) error {
+					d.of(VerifyHistoryTasks).add(
+						int64Bytes(int64(shardID)),
+						int64Bytes(int64(category.ID())),
+						int64Bytes(task.Key.FireTime.UnixNano()),
+						int64Bytes(task.Key.TaskID),
+						blobBytes(task.Blob),
+					)
+					return nil
+				})
+			if err != nil {
+				return fmt.Errorf("shard %d, category %v: %w", shardID, category.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// digestTaskQueues adds the task queues, and the tasks of their subqueues which weren't acked yet.
+func (m *Migrator) digestTaskQueues(ctx context.Context, s *stores, d digests) error {
+	var pageToken []byte
+	for {
+		resp, err := s.task.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+			PageSize:  m.config.PageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, item := range resp.Items {
+			info, err := m.serializer.TaskQueueInfoFromBlob(item.TaskQueue)
+			if err != nil {
+				return err
+			}
+			key := [][]byte{
+				[]byte(info.GetNamespaceId()),
+				[]byte(info.GetName()),
+				int64Bytes(int64(info.GetTaskType())),
+			}
+			d.of(VerifyTaskQueues).add(append(key, int64Bytes(item.RangeID), blobBytes(item.TaskQueue))...)
+
+			for subqueue := range numSubqueues(info) {
+				err := m.readTasks(ctx, s, info, subqueue, subqueueAckLevel(info, subqueue)+1, math.MaxInt64, m.config.PageSize,
+					func(task *persistencespb.AllocatedTaskInfo) error {
+						content, err := proto.MarshalOptions{Deterministic: true}.Marshal(task)
+						if err != nil {
+							return err
+						}
+						d.of(VerifyTasks).add(append(slices.Clone(key), int64Bytes(int64(subqueue)), content)...)
+						return nil
+					})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) digestTaskQueueUserData(ctx context.Context, s *stores, d digests) error {
+	namespaceIDs, err := m.listNamespaceIDs(ctx, s)
+	if err != nil {
+		return err
+	}
+	for _, namespaceID := range namespaceIDs {
+		var pageToken []byte
+		for {
+			resp, err := s.task.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+				NamespaceID:   namespaceID,
+				PageSize:      m.config.PageSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, entry := range resp.Entries {
+				d.of(VerifyTaskQueueUserData).add([]byte(namespaceID), []byte(entry.TaskQueue), blobBytes(entry.Data))
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return nil
+}
+
+// blobBytes returns the encoding and the data of the blob, or nothing if it's nil.
+func blobBytes(blob *commonpb.DataBlob) []byte {
+	if blob == nil {
+		return nil
+	}
+	return append([]byte(blob.GetEncodingType().String()+":"), blob.GetData()...)
+}
+
+func int64Bytes(v int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(v))
+}
@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/tools/common/schema"
 )
 
@@ -114,6 +116,60 @@ func DoDropDatabase(cfg *config.SQL, defaultDb string, logger log.Logger) error
 	return nil
 }
 
+// snapshotDatabase writes a snapshot of a sqlite database
+func snapshotDatabase(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseSQLiteConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	output := cli.String(schema.CLIOptOutput)
+	if output == "" {
+		err := schema.NewConfigError("missing " + flag(schema.CLIOptOutput) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+	path, err := sqlite.Snapshot(context.Background(), cfg, output)
+	if err != nil {
+		logger.Error("Unable to snapshot SQLite database.", tag.Error(err))
+		return err
+	}
+	logger.Info("Snapshot written.", tag.NewStringTag("path", path))
+	return nil
+}
+
+// restoreDatabase restores a sqlite database from a snapshot
+func restoreDatabase(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseSQLiteConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	input := cli.String(schema.CLIOptInput)
+	if input == "" {
+		err := schema.NewConfigError("missing " + flag(schema.CLIOptInput) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+	if err := sqlite.Restore(context.Background(), cfg, input); err != nil {
+		logger.Error("Unable to restore SQLite database.", tag.Error(err))
+		return err
+	}
+	logger.Info("Database restored.", tag.NewStringTag("snapshot", input))
+	return nil
+}
+
+func parseSQLiteConnectConfig(cli *cli.Context) (*config.SQL, error) {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PluginName != sqlite.PluginName {
+		return nil, fmt.Errorf("snapshot and restore are only supported by the %v plugin", sqlite.PluginName)
+	}
+	return cfg, nil
+}
+
 func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 	cfg := new(config.SQL)
 
@@ -216,6 +216,48 @@ func BuildCLIOptions() *cli.App {
 				}
 			},
 		},
+		{
+			Name:  "snapshot",
+			Usage: "writes a point-in-time snapshot of a file-backed sqlite database, while the server may be running",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagOutput,
+					Usage: "path of the snapshot file; if this is a directory, the snapshot is named after the database and the current time",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, snapshotDatabase, logger)
+			},
+		},
+		{
+			Name:  "restore",
+			Usage: "restores a file-backed sqlite database from a snapshot, the server must be stopped",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagInput,
+					Usage: "path of the snapshot file",
+				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagForce,
+					Usage: "don't prompt for confirmation",
+				},
+			},
+			Action: func(c *cli.Context) {
+				restore := c.Bool(schema.CLIOptForce)
+				if !restore {
+					database := c.GlobalString(schema.CLIOptDatabase)
+					fmt.Printf("Are you sure you want to replace database %q with snapshot %q (y/N)? ", database, c.String(schema.CLIOptInput))
+					y := ""
+					_, _ = fmt.Scanln(&y)
+					if y == "y" || y == "Y" {
+						restore = true
+					}
+				}
+				if restore {
+					cliHandler(c, restoreDatabase, logger)
+				}
+			},
+		},
 	}
 
 	return app
@@ -236,6 +236,40 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminRestoreArchivedWorkflow imports the archived history of a workflow execution back into the database
+func AdminRestoreArchivedWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.RestoreArchivedWorkflowExecution(ctx, &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore archived workflow execution: %s", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Restored archived workflow execution with %d history events.\n", resp.GetHistoryLength())
+	return nil
+}
+
 // AdminDescribeWorkflow describe a new workflow execution for admin
 func AdminDescribeWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	resp, err := describeMutableState(c, clientFactory)
@@ -44,6 +44,11 @@ var (
 	FlagLastMessageID              = "last-message-id"
 	FlagJobToken                   = "job-token"
 	FlagReason                     = "reason"
+	FlagIdentity                   = "identity"
+	FlagWorkflowIDPrefix           = "workflow-id-prefix"
+	FlagTypeName                   = "type-name"
+	FlagMinAge                     = "min-age"
+	FlagMaxTasks                   = "max-tasks"
 	FlagYes                        = "yes"
 	FlagMore                       = "more"
 	FlagMinEventVersion            = "min-event-version"
@@ -68,4 +73,13 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagQuery                      = "query"
+	FlagSampleRate                 = "sample-rate"
+	FlagRepair                     = "repair"
+	FlagSavedQueryName             = "name"
+	FlagDefaultColumns             = "default-column"
+	FlagSearchAttributeName        = "name"
+	FlagNewName                    = "new-name"
+	FlagNewType                    = "new-type"
+	FlagCursor                     = "cursor"
 )
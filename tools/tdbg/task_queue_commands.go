@@ -9,6 +9,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -245,3 +246,160 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+// AdminPauseTaskQueue pauses the dispatch of tasks of a task queue
+func AdminPauseTaskQueue(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqTypes, err := getTaskQueueTypes(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.PauseTaskQueueRequest{
+		Namespace:      namespace,
+		TaskQueue:      c.String(FlagTaskQueue),
+		TaskQueueTypes: tqTypes,
+		Reason:         c.String(FlagReason),
+		Identity:       c.String(FlagIdentity),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := client.PauseTaskQueue(ctx, req); err != nil {
+		return fmt.Errorf("unable to pause Task Queue: %w", err)
+	}
+	fmt.Fprintln(c.App.Writer, "Task Queue paused")
+	return nil
+}
+
+// AdminResumeTaskQueue resumes the dispatch of tasks of a paused task queue
+func AdminResumeTaskQueue(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqTypes, err := getTaskQueueTypes(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.ResumeTaskQueueRequest{
+		Namespace:      namespace,
+		TaskQueue:      c.String(FlagTaskQueue),
+		TaskQueueTypes: tqTypes,
+		Identity:       c.String(FlagIdentity),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := client.ResumeTaskQueue(ctx, req); err != nil {
+		return fmt.Errorf("unable to resume Task Queue: %w", err)
+	}
+	fmt.Fprintln(c.App.Writer, "Task Queue resumed")
+	return nil
+}
+
+// getTaskQueueTypes returns the task queue types of the task queue type flag, which may be repeated
+func getTaskQueueTypes(c *cli.Context) ([]enumspb.TaskQueueType, error) {
+	var tqTypes []enumspb.TaskQueueType
+	for _, tqTypeString := range c.StringSlice(FlagTaskQueueType) {
+		tlTypeInt, err := StringToEnum(tqTypeString, enumspb.TaskQueueType_value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task queue type: %w", err)
+		}
+		tqType := enumspb.TaskQueueType(tlTypeInt)
+		if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+			return nil, errors.New("invalid task queue type") // nolint
+		}
+		tqTypes = append(tqTypes, tqType)
+	}
+	return tqTypes, nil
+}
+
+// AdminDescribeTaskQueueBacklog summarizes the tasks persisted in the backlog of a task queue partition
+func AdminDescribeTaskQueueBacklog(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return errors.New("invalid task queue type") // nolint
+	}
+
+	tqPartition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     c.String(FlagTaskQueue),
+		TaskQueueType: tqType,
+	}
+	if stickyName := c.String(FlagStickyName); stickyName != "" {
+		tqPartition.PartitionId = &taskqueuespb.TaskQueuePartition_StickyName{StickyName: stickyName}
+	} else {
+		tqPartition.PartitionId = &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(c.Int(FlagPartitionID))}
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.DescribeTaskQueueBacklogRequest{
+		Namespace:          namespace,
+		TaskQueuePartition: tqPartition,
+		MaxTasks:           int32(c.Int(FlagMaxTasks)),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.DescribeTaskQueueBacklog(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to describe Task Queue backlog: %w", err)
+	}
+	prettyPrintJSONObject(c, resp.GetSummary())
+	return nil
+}
+
+// AdminPurgeTaskQueueTasks deletes the backlog tasks of a task queue matching a filter
+func AdminPurgeTaskQueueTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqTypes, err := getTaskQueueTypes(c)
+	if err != nil {
+		return err
+	}
+	workflowIDPrefix := c.String(FlagWorkflowIDPrefix)
+	typeName := c.String(FlagTypeName)
+	minAge := c.Duration(FlagMinAge)
+	if workflowIDPrefix == "" && typeName == "" && minAge <= 0 {
+		return fmt.Errorf("at least one of --%s, --%s and --%s is required", FlagWorkflowIDPrefix, FlagTypeName, FlagMinAge)
+	}
+	prompter.Prompt(fmt.Sprintf("Namespace: %s\nDelete the backlog tasks of task queue %s matching the filter?", namespace, c.String(FlagTaskQueue)))
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.PurgeTaskQueueTasksRequest{
+		Namespace:        namespace,
+		TaskQueue:        c.String(FlagTaskQueue),
+		TaskQueueTypes:   tqTypes,
+		WorkflowIdPrefix: workflowIDPrefix,
+		TypeName:         typeName,
+		Reason:           c.String(FlagReason),
+		Identity:         c.String(FlagIdentity),
+	}
+	if minAge > 0 {
+		req.MinAge = durationpb.New(minAge)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := client.PurgeTaskQueueTasks(ctx, req); err != nil {
+		return fmt.Errorf("unable to purge Task Queue: %w", err)
+	}
+	fmt.Fprintln(c.App.Writer, "Task Queue purged, matching tasks are deleted asynchronously")
+	return nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.uber.org/mock/gomock"
@@ -19,6 +21,10 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		pauseTaskQueueFn                func(request *adminservice.PauseTaskQueueRequest) (*adminservice.PauseTaskQueueResponse, error)
+		resumeTaskQueueFn               func(request *adminservice.ResumeTaskQueueRequest) (*adminservice.ResumeTaskQueueResponse, error)
+		purgeTaskQueueTasksFn           func(request *adminservice.PurgeTaskQueueTasksRequest) (*adminservice.PurgeTaskQueueTasksResponse, error)
+		describeTaskQueueBacklogFn      func(request *adminservice.DescribeTaskQueueBacklogRequest) (*adminservice.DescribeTaskQueueBacklogResponse, error)
 	}
 )
 
@@ -82,6 +88,22 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) PauseTaskQueue(_ context.Context, request *adminservice.PauseTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.PauseTaskQueueResponse, error) {
+	return t.pauseTaskQueueFn(request)
+}
+
+func (t *testClient) ResumeTaskQueue(_ context.Context, request *adminservice.ResumeTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.ResumeTaskQueueResponse, error) {
+	return t.resumeTaskQueueFn(request)
+}
+
+func (t *testClient) PurgeTaskQueueTasks(_ context.Context, request *adminservice.PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	return t.purgeTaskQueueTasksFn(request)
+}
+
+func (t *testClient) DescribeTaskQueueBacklog(_ context.Context, request *adminservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	return t.describeTaskQueueBacklogFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
@@ -95,6 +117,7 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 			return &adminservice.ForceUnloadTaskQueuePartitionResponse{}, nil
 		},
 	}
+	s.client = client
 	s.app = NewCliApp(func(params *Params) {
 		params.ClientFactory = client
 	})
@@ -108,6 +131,7 @@ type taskQueueCommandTestSuite struct {
 	*require.Assertions
 	suite.Suite
 	controller *gomock.Controller
+	client     *testClient
 	app        *cli.App
 }
 
@@ -163,3 +187,63 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+func (s *taskQueueCommandTestSuite) TestPauseResumeTaskQueue() {
+	var pauseRequest *adminservice.PauseTaskQueueRequest
+	s.client.pauseTaskQueueFn = func(request *adminservice.PauseTaskQueueRequest) (*adminservice.PauseTaskQueueResponse, error) {
+		pauseRequest = request
+		return &adminservice.PauseTaskQueueResponse{}, nil
+	}
+	var resumeRequest *adminservice.ResumeTaskQueueRequest
+	s.client.resumeTaskQueueFn = func(request *adminservice.ResumeTaskQueueRequest) (*adminservice.ResumeTaskQueueResponse, error) {
+		resumeRequest = request
+		return &adminservice.ResumeTaskQueueResponse{}, nil
+	}
+
+	s.NoError(s.app.Run([]string{"tdbg", "taskqueue", "pause", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY", "--reason", "incident"}))
+	s.Equal("test", pauseRequest.GetTaskQueue())
+	s.Equal([]enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY}, pauseRequest.GetTaskQueueTypes())
+	s.Equal("incident", pauseRequest.GetReason())
+
+	s.ErrorContains(s.app.Run([]string{"tdbg", "taskqueue", "pause", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_UNSPECIFIED"}), "invalid task queue type")
+
+	s.NoError(s.app.Run([]string{"tdbg", "taskqueue", "resume", "--task-queue", "test"}))
+	s.Equal("test", resumeRequest.GetTaskQueue())
+	s.Empty(resumeRequest.GetTaskQueueTypes())
+}
+
+func (s *taskQueueCommandTestSuite) TestPurgeTaskQueueTasks() {
+	var request *adminservice.PurgeTaskQueueTasksRequest
+	s.client.purgeTaskQueueTasksFn = func(r *adminservice.PurgeTaskQueueTasksRequest) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+		request = r
+		return &adminservice.PurgeTaskQueueTasksResponse{}, nil
+	}
+
+	s.ErrorContains(s.app.Run([]string{"tdbg", "--yes", "taskqueue", "purge-tasks", "--task-queue", "test"}), "is required")
+	s.Nil(request)
+
+	s.NoError(s.app.Run([]string{"tdbg", "--yes", "taskqueue", "purge-tasks", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY", "--workflow-id-prefix", "order-", "--min-age", "1h"}))
+	s.Equal("test", request.GetTaskQueue())
+	s.Equal([]enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY}, request.GetTaskQueueTypes())
+	s.Equal("order-", request.GetWorkflowIdPrefix())
+	s.Empty(request.GetTypeName())
+	s.Equal(time.Hour, request.GetMinAge().AsDuration())
+}
+
+func (s *taskQueueCommandTestSuite) TestDescribeTaskQueueBacklog() {
+	var request *adminservice.DescribeTaskQueueBacklogRequest
+	s.client.describeTaskQueueBacklogFn = func(r *adminservice.DescribeTaskQueueBacklogRequest) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+		request = r
+		return &adminservice.DescribeTaskQueueBacklogResponse{}, nil
+	}
+
+	s.NoError(s.app.Run([]string{"tdbg", "taskqueue", "describe-backlog", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY", "--partition-id", "2", "--max-tasks", "100"}))
+	s.Equal("test", request.GetTaskQueuePartition().GetTaskQueue())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetTaskQueuePartition().GetTaskQueueType())
+	s.Equal(int32(2), request.GetTaskQueuePartition().GetNormalPartitionId())
+	s.Equal(int32(100), request.GetMaxTasks())
+}
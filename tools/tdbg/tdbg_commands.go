@@ -43,7 +43,13 @@ func getCommands(
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
 			Usage:       "Run admin operation on taskQueue",
-			Subcommands: newAdminTaskQueueCommands(clientFactory),
+			Subcommands: newAdminTaskQueueCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "visibility",
+			Aliases:     []string{"v"},
+			Usage:       "Run admin operation on visibility",
+			Subcommands: newAdminVisibilityCommands(clientFactory, prompterFactory),
 		},
 		{
 			Name:        "membership",
@@ -95,6 +101,25 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminImportWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "restore-archived",
+			Usage: "Restore an archived workflow execution back into primary persistence",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestoreArchivedWorkflow(c, clientFactory)
+			},
+		},
 		{
 			Name:  "show",
 			Usage: "show workflow history from database",
@@ -364,6 +389,178 @@ func getTaskCategoryFlag(taskCategoryRegistry tasks.TaskCategoryRegistry) *cli.S
 	return flag
 }
 
+func newAdminVisibilityCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "check-consistency",
+			Usage: "Compare the records of the primary and secondary visibility stores",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "Visibility query restricting the checked workflow executions",
+				},
+				&cli.Float64Flag{
+					Name:  FlagSampleRate,
+					Value: 1,
+					Usage: "Fraction of the records of the primary store to check, 1 for a full scan of both stores",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Regenerate the visibility records of the mismatched workflow executions",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of records read from the visibility stores at once",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCheckVisibilityConsistency(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "explain",
+			Usage: "Show the query a visibility query is translated to and, for SQL visibility, its plan",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagQuery,
+					Usage:    "Visibility query to explain",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Page size of the query, defaults to the maximum page size of the namespace",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExplainVisibilityQuery(c, clientFactory)
+			},
+		},
+		{
+			Name:  "watch",
+			Usage: "Stream the changes made to the visibility records of the workflow executions of a namespace (experimental)",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagCursor,
+					Usage: "Cursor of a previously received change, the stream starts with the next changes when not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStreamVisibilityChanges(c, clientFactory)
+			},
+		},
+		{
+			Name:        "saved-query",
+			Aliases:     []string{"view"},
+			Usage:       "Manage the saved visibility queries of a namespace, listed with the \"view:<name>\" query",
+			Subcommands: newAdminSavedQueryCommands(clientFactory),
+		},
+		{
+			Name:        "search-attribute",
+			Usage:       "Rename, change the type of or drop a custom search attribute of a namespace (SQL visibility only)",
+			Subcommands: newAdminSearchAttributeCommands(clientFactory, prompterFactory),
+		},
+	}
+}
+
+func newAdminSearchAttributeCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "update",
+			Usage: "Rename a custom search attribute, or change its type and backfill its values in the background",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSearchAttributeName,
+					Usage:    "Name of the search attribute",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagNewName,
+					Usage: "New name of the search attribute",
+				},
+				&cli.StringFlag{
+					Name:  FlagNewType,
+					Usage: "New type of the search attribute: Text, Keyword, Int, Double, Bool, Datetime or KeywordList",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateSearchAttribute(c, clientFactory)
+			},
+		},
+		{
+			Name:  "drop",
+			Usage: "Remove a custom search attribute and clear its values in the background",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSearchAttributeName,
+					Usage:    "Name of the search attribute",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDropSearchAttribute(c, clientFactory, prompterFactory(c))
+			},
+		},
+	}
+}
+
+func newAdminSavedQueryCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "upsert",
+			Usage: "Create or replace a saved visibility query",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSavedQueryName,
+					Usage:    "Name of the saved query",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagQuery,
+					Usage:    "Visibility query",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagDefaultColumns,
+					Usage: "Search attribute displayed by default as a column, can be passed multiple times",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpsertSavedVisibilityQuery(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a saved visibility query",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSavedQueryName,
+					Usage:    "Name of the saved query",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteSavedVisibilityQuery(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the saved visibility queries",
+			Action: func(c *cli.Context) error {
+				return AdminListSavedVisibilityQueries(c, clientFactory)
+			},
+		},
+	}
+}
+
 func newAdminMembershipCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
@@ -458,7 +655,7 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
-func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminTaskQueueCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "list-tasks",
@@ -588,6 +785,125 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "Pause the dispatch of tasks of a task queue in all its partitions, new tasks are still added to the backlog",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue types to pause: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY. Default is both",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for pausing the task queue",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseTaskQueue(c, clientFactory)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume the dispatch of tasks of a paused task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue types to resume: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY. Default is both",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResumeTaskQueue(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-backlog",
+			Usage: "Summarize the tasks persisted in the backlog of a task queue partition by type, priority, fairness key and age",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.Int64Flag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagStickyName,
+					Usage: "Sticky Name for a task queue partition, if present",
+					Value: "",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxTasks,
+					Usage: "Maximum number of tasks read for the summary. Default is 10000",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskQueueBacklog(c, clientFactory)
+			},
+		},
+		{
+			Name:  "purge-tasks",
+			Usage: "Delete the backlog tasks of a task queue matching all the given filters, in all its partitions",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue types to purge: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY. Default is both",
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowIDPrefix,
+					Usage: "Only delete tasks of workflows whose ID starts with this prefix",
+				},
+				&cli.StringFlag{
+					Name:  FlagTypeName,
+					Usage: "Only delete tasks of this workflow type (workflow tasks) or activity type (activity tasks)",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinAge,
+					Usage: "Only delete tasks older than this duration",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for purging the task queue",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPurgeTaskQueueTasks(c, clientFactory, prompterFactory(c))
+			},
+		},
 	}
 }
 
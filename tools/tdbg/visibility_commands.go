@@ -0,0 +1,278 @@
+package tdbg
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// AdminCheckVisibilityConsistency compares the visibility records of the primary and secondary visibility
+// stores of a namespace, page by page, and prints the mismatches.
+func AdminCheckVisibilityConsistency(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	sampleRate := c.Float64(FlagSampleRate)
+	if sampleRate <= 0 || sampleRate > 1 {
+		return fmt.Errorf("invalid %s: must be in (0, 1]", FlagSampleRate)
+	}
+	repair := c.Bool(FlagRepair)
+	if repair {
+		prompter.Prompt(fmt.Sprintf("Namespace: %s\nRegenerate the visibility records of the mismatched workflow executions?", namespace))
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	request := &adminservice.CheckVisibilityConsistencyRequest{
+		Namespace:  namespace,
+		Query:      c.String(FlagQuery),
+		SampleRate: sampleRate,
+		Repair:     repair,
+		PageSize:   int32(c.Int(FlagPageSize)),
+	}
+
+	var checkedCount, mismatchCount, repairedCount int64
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.CheckVisibilityConsistency(ctx, request)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to check visibility consistency: %w", err)
+		}
+
+		checkedCount += resp.GetCheckedCount()
+		for _, mismatch := range resp.GetMismatches() {
+			mismatchCount++
+			if mismatch.GetRepaired() {
+				repairedCount++
+			}
+			if c.Bool(FlagPrintJSON) {
+				prettyPrintJSONObject(c, mismatch)
+				continue
+			}
+			fmt.Fprintf(c.App.Writer, "%s WorkflowID: %s RunID: %s",
+				mismatch.GetType(), mismatch.GetExecution().GetWorkflowId(), mismatch.GetExecution().GetRunId())
+			if len(mismatch.GetFields()) > 0 {
+				fmt.Fprintf(c.App.Writer, " Fields: %s", strings.Join(mismatch.GetFields(), ","))
+			}
+			if mismatch.GetRepaired() {
+				fmt.Fprint(c.App.Writer, " (repaired)")
+			}
+			fmt.Fprintln(c.App.Writer)
+		}
+
+		if len(resp.GetNextPageToken()) == 0 {
+			break
+		}
+		request.NextPageToken = resp.GetNextPageToken()
+	}
+
+	fmt.Fprintf(c.App.Writer, "Checked %d workflow executions, found %d mismatches", checkedCount, mismatchCount)
+	if repair {
+		fmt.Fprintf(c.App.Writer, ", repaired %d", repairedCount)
+	}
+	fmt.Fprintln(c.App.Writer, ".")
+	return nil
+}
+
+// AdminExplainVisibilityQuery prints the query a visibility query is translated to by the visibility store of a
+// namespace, the search attribute aliases it resolved and, for SQL visibility, the plan of the database.
+func AdminExplainVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ExplainVisibilityQuery(ctx, &adminservice.ExplainVisibilityQueryRequest{
+		Namespace: namespace,
+		Query:     c.String(FlagQuery),
+		PageSize:  int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to explain visibility query: %w", err)
+	}
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(c, resp)
+		return nil
+	}
+
+	fmt.Fprintf(c.App.Writer, "Store: %s\n\nQuery:\n%s\n", resp.GetStoreName(), resp.GetTranslatedQuery())
+	if len(resp.GetQueryArgs()) > 0 {
+		fmt.Fprintf(c.App.Writer, "\nArguments:\n%s\n", strings.Join(resp.GetQueryArgs(), "\n"))
+	}
+	if len(resp.GetSearchAttributeAliases()) > 0 {
+		fmt.Fprintln(c.App.Writer, "\nSearch attributes:")
+		for _, alias := range slices.Sorted(maps.Keys(resp.GetSearchAttributeAliases())) {
+			fmt.Fprintf(c.App.Writer, "%s -> %s\n", alias, resp.GetSearchAttributeAliases()[alias])
+		}
+	}
+	if resp.GetPlan() != "" {
+		fmt.Fprintf(c.App.Writer, "\nPlan:\n%s\n", resp.GetPlan())
+	}
+	return nil
+}
+
+// AdminStreamVisibilityChanges prints the changes made to the visibility records of a namespace until the
+// command is interrupted.
+func AdminStreamVisibilityChanges(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	cursor, err := base64.StdEncoding.DecodeString(c.String(FlagCursor))
+	if err != nil {
+		return fmt.Errorf("unable to parse cursor: %w", err)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	stream, err := adminClient.StreamVisibilityChanges(c.Context, &adminservice.StreamVisibilityChangesRequest{
+		Namespace: namespace,
+		Cursor:    cursor,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to stream visibility changes: %w", err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to stream visibility changes: %w", err)
+		}
+		if len(resp.GetChanges()) > 0 {
+			prettyPrintJSONObject(c, resp)
+		}
+	}
+}
+
+// AdminUpsertSavedVisibilityQuery creates or replaces a saved visibility query of a namespace.
+func AdminUpsertSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = adminClient.UpsertSavedVisibilityQuery(ctx, &adminservice.UpsertSavedVisibilityQueryRequest{
+		Namespace: namespace,
+		Name:      c.String(FlagSavedQueryName),
+		SavedQuery: &persistencespb.SavedVisibilityQuery{
+			Query:          c.String(FlagQuery),
+			DefaultColumns: c.StringSlice(FlagDefaultColumns),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to save visibility query: %w", err)
+	}
+	return nil
+}
+
+// AdminDeleteSavedVisibilityQuery deletes a saved visibility query of a namespace.
+func AdminDeleteSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = adminClient.DeleteSavedVisibilityQuery(ctx, &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: namespace,
+		Name:      c.String(FlagSavedQueryName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete saved visibility query: %w", err)
+	}
+	return nil
+}
+
+// AdminListSavedVisibilityQueries prints the saved visibility queries of a namespace.
+func AdminListSavedVisibilityQueries(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListSavedVisibilityQueries(ctx, &adminservice.ListSavedVisibilityQueriesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list saved visibility queries: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminUpdateSearchAttribute renames a custom search attribute of a namespace, or changes its type. A type change is
+// applied by a system workflow, which is printed.
+func AdminUpdateSearchAttribute(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	newType := enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED
+	if c.IsSet(FlagNewType) {
+		newType, err = enumspb.IndexedValueTypeFromString(c.String(FlagNewType))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", FlagNewType, err)
+		}
+	}
+	if c.String(FlagNewName) == "" && newType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("either %s or %s must be set", FlagNewName, FlagNewType)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.UpdateSearchAttribute(ctx, &adminservice.UpdateSearchAttributeRequest{
+		Namespace: namespace,
+		Name:      c.String(FlagSearchAttributeName),
+		NewName:   c.String(FlagNewName),
+		NewType:   newType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update search attribute: %w", err)
+	}
+	if resp.GetExecution() != nil {
+		prettyPrintJSONObject(c, resp)
+	}
+	return nil
+}
+
+// AdminDropSearchAttribute removes a custom search attribute of a namespace. Its values are cleared by a system
+// workflow, which is printed.
+func AdminDropSearchAttribute(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	name := c.String(FlagSearchAttributeName)
+	prompter.Prompt(fmt.Sprintf("Namespace: %s\nDrop search attribute %s and clear its values?", namespace, name))
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DropSearchAttribute(ctx, &adminservice.DropSearchAttributeRequest{
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to drop search attribute: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
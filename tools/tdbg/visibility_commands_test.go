@@ -0,0 +1,92 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"google.golang.org/grpc"
+)
+
+type visibilityTestClient struct {
+	adminservice.AdminServiceClient
+	requests  []*adminservice.CheckVisibilityConsistencyRequest
+	responses []*adminservice.CheckVisibilityConsistencyResponse
+}
+
+func (t *visibilityTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *visibilityTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *visibilityTestClient) CheckVisibilityConsistency(_ context.Context, request *adminservice.CheckVisibilityConsistencyRequest, _ ...grpc.CallOption) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	t.requests = append(t.requests, request)
+	resp := t.responses[0]
+	t.responses = t.responses[1:]
+	return resp, nil
+}
+
+func TestAdminCheckVisibilityConsistency(t *testing.T) {
+	client := &visibilityTestClient{
+		responses: []*adminservice.CheckVisibilityConsistencyResponse{
+			{
+				CheckedCount: 10,
+				Mismatches: []*adminservice.CheckVisibilityConsistencyResponse_Mismatch{
+					{
+						Execution: &commonpb.WorkflowExecution{WorkflowId: "wid1", RunId: "rid1"},
+						Type:      enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_FIELDS_DIFFER,
+						Fields:    []string{"ExecutionStatus", "CloseTime"},
+						Repaired:  true,
+					},
+				},
+				NextPageToken: []byte("next"),
+			},
+			{
+				Mismatches: []*adminservice.CheckVisibilityConsistencyResponse_Mismatch{
+					{
+						Execution: &commonpb.WorkflowExecution{WorkflowId: "wid2", RunId: "rid2"},
+						Type:      enumsspb.VISIBILITY_CONSISTENCY_MISMATCH_TYPE_MISSING_IN_PRIMARY,
+					},
+				},
+			},
+		},
+	}
+	var output bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &output
+	})
+
+	err := app.Run([]string{"tdbg", "--yes", "--namespace", "test-namespace", "visibility", "check-consistency",
+		"--query", "WorkflowType = 'test'", "--repair"})
+	require.NoError(t, err)
+
+	require.Len(t, client.requests, 2)
+	require.Equal(t, "test-namespace", client.requests[0].GetNamespace())
+	require.Equal(t, "WorkflowType = 'test'", client.requests[0].GetQuery())
+	require.Equal(t, float64(1), client.requests[0].GetSampleRate())
+	require.True(t, client.requests[0].GetRepair())
+	require.Equal(t, []byte("next"), client.requests[1].GetNextPageToken())
+	require.Equal(t, "FieldsDiffer WorkflowID: wid1 RunID: rid1 Fields: ExecutionStatus,CloseTime (repaired)\n"+
+		"MissingInPrimary WorkflowID: wid2 RunID: rid2\n"+
+		"Checked 10 workflow executions, found 2 mismatches, repaired 1.\n", output.String())
+}
+
+func TestAdminCheckVisibilityConsistency_InvalidSampleRate(t *testing.T) {
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = &visibilityTestClient{}
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "visibility", "check-consistency", "--sample-rate", "1.5"})
+	require.ErrorContains(t, err, "invalid sample-rate")
+}